@@ -243,18 +243,6 @@ func (v *HistoryService_StartWorkflowExecution_Result) ToWire() (wire.Value, err
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _StartWorkflowExecutionResponse_Read(w wire.Value) (*shared.StartWorkflowExecutionResponse, error) {
-	var v shared.StartWorkflowExecutionResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowExecutionAlreadyStartedError_Read(w wire.Value) (*shared.WorkflowExecutionAlreadyStartedError, error) {
-	var v shared.WorkflowExecutionAlreadyStartedError
-	err := v.FromWire(w)
-	return &v, err
-}
-
 func (v *HistoryService_StartWorkflowExecution_Result) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
@@ -597,11 +597,12 @@ type ActivityTaskScheduledEventAttributes struct {
 	StartToCloseTimeoutSeconds    *int32        `json:"startToCloseTimeoutSeconds,omitempty"`
 	HeartbeatTimeoutSeconds       *int32        `json:"heartbeatTimeoutSeconds,omitempty"`
 	DecisionTaskCompletedEventId  *int64        `json:"decisionTaskCompletedEventId,omitempty"`
+	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 }
 
 func (v *ActivityTaskScheduledEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [10]wire.Field
+		fields [11]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -686,6 +687,14 @@ func (v *ActivityTaskScheduledEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
@@ -701,6 +710,12 @@ func _TaskList_Read(w wire.Value) (*TaskList, error) {
 	return &v, err
 }
 
+func _RetryPolicy_Read(w wire.Value) (*RetryPolicy, error) {
+	var v RetryPolicy
+	err := v.FromWire(w)
+	return &v, err
+}
+
 func (v *ActivityTaskScheduledEventAttributes) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
@@ -789,6 +804,13 @@ func (v *ActivityTaskScheduledEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 			}
+		case 110:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryPolicy, err = _RetryPolicy_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
@@ -798,7 +820,7 @@ func (v *ActivityTaskScheduledEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [10]string
+	var fields [11]string
 	i := 0
 	if v.ActivityId != nil {
 		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
@@ -840,6 +862,10 @@ func (v *ActivityTaskScheduledEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
+	if v.RetryPolicy != nil {
+		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
+		i++
+	}
 	return fmt.Sprintf("ActivityTaskScheduledEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
@@ -883,6 +909,9 @@ func (v *ActivityTaskScheduledEventAttributes) Equals(rhs *ActivityTaskScheduled
 	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
+	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
+		return false
+	}
 	return true
 }
 
@@ -890,11 +919,12 @@ type ActivityTaskStartedEventAttributes struct {
 	ScheduledEventId *int64  `json:"scheduledEventId,omitempty"`
 	Identity         *string `json:"identity,omitempty"`
 	RequestId        *string `json:"requestId,omitempty"`
+	Attempt          *int32  `json:"attempt,omitempty"`
 }
 
 func (v *ActivityTaskStartedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -923,6 +953,14 @@ func (v *ActivityTaskStartedEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
@@ -957,6 +995,15 @@ func (v *ActivityTaskStartedEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
@@ -966,7 +1013,7 @@ func (v *ActivityTaskStartedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	if v.ScheduledEventId != nil {
 		fields[i] = fmt.Sprintf("ScheduledEventId: %v", *(v.ScheduledEventId))
@@ -980,6 +1027,10 @@ func (v *ActivityTaskStartedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
 		i++
 	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
 	return fmt.Sprintf("ActivityTaskStartedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
@@ -993,6 +1044,9 @@ func (v *ActivityTaskStartedEventAttributes) Equals(rhs *ActivityTaskStartedEven
 	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
 	return true
 }
 
@@ -2934,16 +2988,19 @@ func (v *CompleteWorkflowExecutionDecisionAttributes) Equals(rhs *CompleteWorkfl
 }
 
 type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
-	WorkflowType                        *WorkflowType `json:"workflowType,omitempty"`
-	TaskList                            *TaskList     `json:"taskList,omitempty"`
-	Input                               []byte        `json:"input"`
-	ExecutionStartToCloseTimeoutSeconds *int32        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	WorkflowType                        *WorkflowType     `json:"workflowType,omitempty"`
+	TaskList                            *TaskList         `json:"taskList,omitempty"`
+	Input                               []byte            `json:"input"`
+	ExecutionStartToCloseTimeoutSeconds *int32            `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32            `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	BackoffStartIntervalInSeconds       *int32            `json:"backoffStartIntervalInSeconds,omitempty"`
+	RetryPolicy                         *RetryPolicy      `json:"retryPolicy,omitempty"`
+	SearchAttributes                    *SearchAttributes `json:"searchAttributes,omitempty"`
 }
 
 func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2988,9 +3045,39 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) ToWire() (wire.Value,
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.BackoffStartIntervalInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.BackoffStartIntervalInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
+func _SearchAttributes_Read(w wire.Value) (*SearchAttributes, error) {
+	var v SearchAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
 func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
@@ -3034,6 +3121,29 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) FromWire(w wire.Value
 					return err
 				}
 			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.BackoffStartIntervalInSeconds = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryPolicy, err = _RetryPolicy_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 80:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
@@ -3043,7 +3153,7 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [5]string
+	var fields [8]string
 	i := 0
 	if v.WorkflowType != nil {
 		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
@@ -3065,6 +3175,18 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) String() string {
 		fields[i] = fmt.Sprintf("TaskStartToCloseTimeoutSeconds: %v", *(v.TaskStartToCloseTimeoutSeconds))
 		i++
 	}
+	if v.BackoffStartIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("BackoffStartIntervalInSeconds: %v", *(v.BackoffStartIntervalInSeconds))
+		i++
+	}
+	if v.RetryPolicy != nil {
+		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
+		i++
+	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
 	return fmt.Sprintf("ContinueAsNewWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
@@ -3084,6 +3206,160 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) Equals(rhs *ContinueA
 	if !_I32_EqualsPtr(v.TaskStartToCloseTimeoutSeconds, rhs.TaskStartToCloseTimeoutSeconds) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.BackoffStartIntervalInSeconds, rhs.BackoffStartIntervalInSeconds) {
+		return false
+	}
+	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
+		return false
+	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
+	return true
+}
+
+type CountWorkflowExecutionsRequest struct {
+	Domain *string `json:"domain,omitempty"`
+	Query  *string `json:"query,omitempty"`
+}
+
+func (v *CountWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Query != nil {
+		w, err = wire.NewValueString(*(v.Query)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *CountWorkflowExecutionsRequest) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Query = &x
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *CountWorkflowExecutionsRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Query != nil {
+		fields[i] = fmt.Sprintf("Query: %v", *(v.Query))
+		i++
+	}
+	return fmt.Sprintf("CountWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *CountWorkflowExecutionsRequest) Equals(rhs *CountWorkflowExecutionsRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Query, rhs.Query) {
+		return false
+	}
+	return true
+}
+
+type CountWorkflowExecutionsResponse struct {
+	Count *int64 `json:"count,omitempty"`
+}
+
+func (v *CountWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.Count != nil {
+		w, err = wire.NewValueI64(*(v.Count)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *CountWorkflowExecutionsResponse) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Count = &x
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *CountWorkflowExecutionsResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [1]string
+	i := 0
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
+		i++
+	}
+	return fmt.Sprintf("CountWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *CountWorkflowExecutionsResponse) Equals(rhs *CountWorkflowExecutionsResponse) bool {
+	if !_I64_EqualsPtr(v.Count, rhs.Count) {
+		return false
+	}
 	return true
 }
 
@@ -3100,11 +3376,13 @@ type Decision struct {
 	RecordMarkerDecisionAttributes                           *RecordMarkerDecisionAttributes                           `json:"recordMarkerDecisionAttributes,omitempty"`
 	ContinueAsNewWorkflowExecutionDecisionAttributes         *ContinueAsNewWorkflowExecutionDecisionAttributes         `json:"continueAsNewWorkflowExecutionDecisionAttributes,omitempty"`
 	StartChildWorkflowExecutionDecisionAttributes            *StartChildWorkflowExecutionDecisionAttributes            `json:"startChildWorkflowExecutionDecisionAttributes,omitempty"`
+	SignalExternalWorkflowExecutionDecisionAttributes        *SignalExternalWorkflowExecutionDecisionAttributes        `json:"signalExternalWorkflowExecutionDecisionAttributes,omitempty"`
+	UpsertWorkflowSearchAttributesDecisionAttributes         *UpsertWorkflowSearchAttributesDecisionAttributes         `json:"upsertWorkflowSearchAttributesDecisionAttributes,omitempty"`
 }
 
 func (v *Decision) ToWire() (wire.Value, error) {
 	var (
-		fields [12]wire.Field
+		fields [14]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -3205,6 +3483,22 @@ func (v *Decision) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 100, Value: w}
 		i++
 	}
+	if v.SignalExternalWorkflowExecutionDecisionAttributes != nil {
+		w, err = v.SignalExternalWorkflowExecutionDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.UpsertWorkflowSearchAttributesDecisionAttributes != nil {
+		w, err = v.UpsertWorkflowSearchAttributesDecisionAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
@@ -3280,6 +3574,18 @@ func _StartChildWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*StartCh
 	return &v, err
 }
 
+func _SignalExternalWorkflowExecutionDecisionAttributes_Read(w wire.Value) (*SignalExternalWorkflowExecutionDecisionAttributes, error) {
+	var v SignalExternalWorkflowExecutionDecisionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _UpsertWorkflowSearchAttributesDecisionAttributes_Read(w wire.Value) (*UpsertWorkflowSearchAttributesDecisionAttributes, error) {
+	var v UpsertWorkflowSearchAttributesDecisionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
 func (v *Decision) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
@@ -3370,16 +3676,30 @@ func (v *Decision) FromWire(w wire.Value) error {
 					return err
 				}
 			}
-		}
-	}
-	return nil
-}
-
-func (v *Decision) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-	var fields [12]string
+		case 110:
+			if field.Value.Type() == wire.TStruct {
+				v.SignalExternalWorkflowExecutionDecisionAttributes, err = _SignalExternalWorkflowExecutionDecisionAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 120:
+			if field.Value.Type() == wire.TStruct {
+				v.UpsertWorkflowSearchAttributesDecisionAttributes, err = _UpsertWorkflowSearchAttributesDecisionAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *Decision) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [14]string
 	i := 0
 	if v.DecisionType != nil {
 		fields[i] = fmt.Sprintf("DecisionType: %v", *(v.DecisionType))
@@ -3429,6 +3749,14 @@ func (v *Decision) String() string {
 		fields[i] = fmt.Sprintf("StartChildWorkflowExecutionDecisionAttributes: %v", v.StartChildWorkflowExecutionDecisionAttributes)
 		i++
 	}
+	if v.SignalExternalWorkflowExecutionDecisionAttributes != nil {
+		fields[i] = fmt.Sprintf("SignalExternalWorkflowExecutionDecisionAttributes: %v", v.SignalExternalWorkflowExecutionDecisionAttributes)
+		i++
+	}
+	if v.UpsertWorkflowSearchAttributesDecisionAttributes != nil {
+		fields[i] = fmt.Sprintf("UpsertWorkflowSearchAttributesDecisionAttributes: %v", v.UpsertWorkflowSearchAttributesDecisionAttributes)
+		i++
+	}
 	return fmt.Sprintf("Decision{%v}", strings.Join(fields[:i], ", "))
 }
 
@@ -3478,6 +3806,12 @@ func (v *Decision) Equals(rhs *Decision) bool {
 	if !((v.StartChildWorkflowExecutionDecisionAttributes == nil && rhs.StartChildWorkflowExecutionDecisionAttributes == nil) || (v.StartChildWorkflowExecutionDecisionAttributes != nil && rhs.StartChildWorkflowExecutionDecisionAttributes != nil && v.StartChildWorkflowExecutionDecisionAttributes.Equals(rhs.StartChildWorkflowExecutionDecisionAttributes))) {
 		return false
 	}
+	if !((v.SignalExternalWorkflowExecutionDecisionAttributes == nil && rhs.SignalExternalWorkflowExecutionDecisionAttributes == nil) || (v.SignalExternalWorkflowExecutionDecisionAttributes != nil && rhs.SignalExternalWorkflowExecutionDecisionAttributes != nil && v.SignalExternalWorkflowExecutionDecisionAttributes.Equals(rhs.SignalExternalWorkflowExecutionDecisionAttributes))) {
+		return false
+	}
+	if !((v.UpsertWorkflowSearchAttributesDecisionAttributes == nil && rhs.UpsertWorkflowSearchAttributesDecisionAttributes == nil) || (v.UpsertWorkflowSearchAttributesDecisionAttributes != nil && rhs.UpsertWorkflowSearchAttributesDecisionAttributes != nil && v.UpsertWorkflowSearchAttributesDecisionAttributes.Equals(rhs.UpsertWorkflowSearchAttributesDecisionAttributes))) {
+		return false
+	}
 	return true
 }
 
@@ -3629,10 +3963,13 @@ const (
 	DecisionTaskFailedCauseBadRequestCancelExternalWorkflowExecutionAttributes DecisionTaskFailedCause = 9
 	DecisionTaskFailedCauseBadContinueAsNewAttributes                          DecisionTaskFailedCause = 10
 	DecisionTaskFailedCauseStartTimerDuplicateID                               DecisionTaskFailedCause = 11
+	DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes                DecisionTaskFailedCause = 12
+	DecisionTaskFailedCauseResetWorkflow                                       DecisionTaskFailedCause = 13
+	DecisionTaskFailedCauseBadSearchAttributes                                 DecisionTaskFailedCause = 14
 )
 
 func DecisionTaskFailedCause_Values() []DecisionTaskFailedCause {
-	return []DecisionTaskFailedCause{DecisionTaskFailedCauseUnhandledDecision, DecisionTaskFailedCauseBadScheduleActivityAttributes, DecisionTaskFailedCauseBadRequestCancelActivityAttributes, DecisionTaskFailedCauseBadStartTimerAttributes, DecisionTaskFailedCauseBadCancelTimerAttributes, DecisionTaskFailedCauseBadRecordMarkerAttributes, DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes, DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes, DecisionTaskFailedCauseBadCancelWorkflowExecutionAttributes, DecisionTaskFailedCauseBadRequestCancelExternalWorkflowExecutionAttributes, DecisionTaskFailedCauseBadContinueAsNewAttributes, DecisionTaskFailedCauseStartTimerDuplicateID}
+	return []DecisionTaskFailedCause{DecisionTaskFailedCauseUnhandledDecision, DecisionTaskFailedCauseBadScheduleActivityAttributes, DecisionTaskFailedCauseBadRequestCancelActivityAttributes, DecisionTaskFailedCauseBadStartTimerAttributes, DecisionTaskFailedCauseBadCancelTimerAttributes, DecisionTaskFailedCauseBadRecordMarkerAttributes, DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes, DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes, DecisionTaskFailedCauseBadCancelWorkflowExecutionAttributes, DecisionTaskFailedCauseBadRequestCancelExternalWorkflowExecutionAttributes, DecisionTaskFailedCauseBadContinueAsNewAttributes, DecisionTaskFailedCauseStartTimerDuplicateID, DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes, DecisionTaskFailedCauseResetWorkflow, DecisionTaskFailedCauseBadSearchAttributes}
 }
 
 func (v *DecisionTaskFailedCause) UnmarshalText(value []byte) error {
@@ -3673,6 +4010,15 @@ func (v *DecisionTaskFailedCause) UnmarshalText(value []byte) error {
 	case "START_TIMER_DUPLICATE_ID":
 		*v = DecisionTaskFailedCauseStartTimerDuplicateID
 		return nil
+	case "BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES":
+		*v = DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes
+		return nil
+	case "RESET_WORKFLOW":
+		*v = DecisionTaskFailedCauseResetWorkflow
+		return nil
+	case "BAD_SEARCH_ATTRIBUTES":
+		*v = DecisionTaskFailedCauseBadSearchAttributes
+		return nil
 	default:
 		return fmt.Errorf("unknown enum value %q for %q", value, "DecisionTaskFailedCause")
 	}
@@ -3714,6 +4060,12 @@ func (v DecisionTaskFailedCause) String() string {
 		return "BAD_CONTINUE_AS_NEW_ATTRIBUTES"
 	case 11:
 		return "START_TIMER_DUPLICATE_ID"
+	case 12:
+		return "BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES"
+	case 13:
+		return "RESET_WORKFLOW"
+	case 14:
+		return "BAD_SEARCH_ATTRIBUTES"
 	}
 	return fmt.Sprintf("DecisionTaskFailedCause(%d)", w)
 }
@@ -3748,6 +4100,12 @@ func (v DecisionTaskFailedCause) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"BAD_CONTINUE_AS_NEW_ATTRIBUTES\""), nil
 	case 11:
 		return ([]byte)("\"START_TIMER_DUPLICATE_ID\""), nil
+	case 12:
+		return ([]byte)("\"BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES\""), nil
+	case 13:
+		return ([]byte)("\"RESET_WORKFLOW\""), nil
+	case 14:
+		return ([]byte)("\"BAD_SEARCH_ATTRIBUTES\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -4247,10 +4605,12 @@ const (
 	DecisionTypeRecordMarker                           DecisionType = 8
 	DecisionTypeContinueAsNewWorkflowExecution         DecisionType = 9
 	DecisionTypeStartChildWorkflowExecution            DecisionType = 10
+	DecisionTypeSignalExternalWorkflowExecution        DecisionType = 11
+	DecisionTypeUpsertWorkflowSearchAttributes         DecisionType = 12
 )
 
 func DecisionType_Values() []DecisionType {
-	return []DecisionType{DecisionTypeScheduleActivityTask, DecisionTypeRequestCancelActivityTask, DecisionTypeStartTimer, DecisionTypeCompleteWorkflowExecution, DecisionTypeFailWorkflowExecution, DecisionTypeCancelTimer, DecisionTypeCancelWorkflowExecution, DecisionTypeRequestCancelExternalWorkflowExecution, DecisionTypeRecordMarker, DecisionTypeContinueAsNewWorkflowExecution, DecisionTypeStartChildWorkflowExecution}
+	return []DecisionType{DecisionTypeScheduleActivityTask, DecisionTypeRequestCancelActivityTask, DecisionTypeStartTimer, DecisionTypeCompleteWorkflowExecution, DecisionTypeFailWorkflowExecution, DecisionTypeCancelTimer, DecisionTypeCancelWorkflowExecution, DecisionTypeRequestCancelExternalWorkflowExecution, DecisionTypeRecordMarker, DecisionTypeContinueAsNewWorkflowExecution, DecisionTypeStartChildWorkflowExecution, DecisionTypeSignalExternalWorkflowExecution, DecisionTypeUpsertWorkflowSearchAttributes}
 }
 
 func (v *DecisionType) UnmarshalText(value []byte) error {
@@ -4288,6 +4648,12 @@ func (v *DecisionType) UnmarshalText(value []byte) error {
 	case "StartChildWorkflowExecution":
 		*v = DecisionTypeStartChildWorkflowExecution
 		return nil
+	case "SignalExternalWorkflowExecution":
+		*v = DecisionTypeSignalExternalWorkflowExecution
+		return nil
+	case "UpsertWorkflowSearchAttributes":
+		*v = DecisionTypeUpsertWorkflowSearchAttributes
+		return nil
 	default:
 		return fmt.Errorf("unknown enum value %q for %q", value, "DecisionType")
 	}
@@ -4327,6 +4693,10 @@ func (v DecisionType) String() string {
 		return "ContinueAsNewWorkflowExecution"
 	case 10:
 		return "StartChildWorkflowExecution"
+	case 11:
+		return "SignalExternalWorkflowExecution"
+	case 12:
+		return "UpsertWorkflowSearchAttributes"
 	}
 	return fmt.Sprintf("DecisionType(%d)", w)
 }
@@ -4359,6 +4729,10 @@ func (v DecisionType) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"ContinueAsNewWorkflowExecution\""), nil
 	case 10:
 		return ([]byte)("\"StartChildWorkflowExecution\""), nil
+	case 11:
+		return ([]byte)("\"SignalExternalWorkflowExecution\""), nil
+	case 12:
+		return ([]byte)("\"UpsertWorkflowSearchAttributes\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -4604,118 +4978,71 @@ func (v *DescribeDomainResponse) Equals(rhs *DescribeDomainResponse) bool {
 	return true
 }
 
-type DomainAlreadyExistsError struct {
-	Message string `json:"message,required"`
-}
-
-func (v *DomainAlreadyExistsError) ToWire() (wire.Value, error) {
-	var (
-		fields [1]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func (v *DomainAlreadyExistsError) FromWire(w wire.Value) error {
-	var err error
-	messageIsSet := false
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				messageIsSet = true
-			}
-		}
-	}
-	if !messageIsSet {
-		return errors.New("field Message of DomainAlreadyExistsError is required")
-	}
-	return nil
-}
-
-func (v *DomainAlreadyExistsError) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-	var fields [1]string
-	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
-	return fmt.Sprintf("DomainAlreadyExistsError{%v}", strings.Join(fields[:i], ", "))
-}
-
-func (v *DomainAlreadyExistsError) Equals(rhs *DomainAlreadyExistsError) bool {
-	if !(v.Message == rhs.Message) {
-		return false
-	}
-	return true
-}
-
-func (v *DomainAlreadyExistsError) Error() string {
-	return v.String()
-}
-
-type DomainConfiguration struct {
-	WorkflowExecutionRetentionPeriodInDays *int32 `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
-	EmitMetric                             *bool  `json:"emitMetric,omitempty"`
+type DescribeHistoryHostRequest struct {
+	HostAddress      *string            `json:"hostAddress,omitempty"`
+	ShardIdForHost   *int32             `json:"shardIdForHost,omitempty"`
+	ExecutionForHost *WorkflowExecution `json:"executionForHost,omitempty"`
 }
 
-func (v *DomainConfiguration) ToWire() (wire.Value, error) {
+func (v *DescribeHistoryHostRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		w, err = wire.NewValueI32(*(v.WorkflowExecutionRetentionPeriodInDays)), error(nil)
+	if v.HostAddress != nil {
+		w, err = wire.NewValueString(*(v.HostAddress)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.EmitMetric != nil {
-		w, err = wire.NewValueBool(*(v.EmitMetric)), error(nil)
+	if v.ShardIdForHost != nil {
+		w, err = wire.NewValueI32(*(v.ShardIdForHost)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.ExecutionForHost != nil {
+		w, err = v.ExecutionForHost.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *DomainConfiguration) FromWire(w wire.Value) error {
+func (v *DescribeHistoryHostRequest) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.HostAddress = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
 			if field.Value.Type() == wire.TI32 {
 				var x int32
 				x, err = field.Value.GetI32(), error(nil)
-				v.WorkflowExecutionRetentionPeriodInDays = &x
+				v.ShardIdForHost = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 20:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.EmitMetric = &x
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.ExecutionForHost, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -4725,133 +5052,185 @@ func (v *DomainConfiguration) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *DomainConfiguration) String() string {
+func (v *DescribeHistoryHostRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [2]string
+	var fields [3]string
 	i := 0
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionRetentionPeriodInDays: %v", *(v.WorkflowExecutionRetentionPeriodInDays))
+	if v.HostAddress != nil {
+		fields[i] = fmt.Sprintf("HostAddress: %v", *(v.HostAddress))
 		i++
 	}
-	if v.EmitMetric != nil {
-		fields[i] = fmt.Sprintf("EmitMetric: %v", *(v.EmitMetric))
+	if v.ShardIdForHost != nil {
+		fields[i] = fmt.Sprintf("ShardIdForHost: %v", *(v.ShardIdForHost))
 		i++
 	}
-	return fmt.Sprintf("DomainConfiguration{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
-		x := *lhs
-		y := *rhs
-		return (x == y)
+	if v.ExecutionForHost != nil {
+		fields[i] = fmt.Sprintf("ExecutionForHost: %v", v.ExecutionForHost)
+		i++
 	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("DescribeHistoryHostRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *DomainConfiguration) Equals(rhs *DomainConfiguration) bool {
-	if !_I32_EqualsPtr(v.WorkflowExecutionRetentionPeriodInDays, rhs.WorkflowExecutionRetentionPeriodInDays) {
+func (v *DescribeHistoryHostRequest) Equals(rhs *DescribeHistoryHostRequest) bool {
+	if !_String_EqualsPtr(v.HostAddress, rhs.HostAddress) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.EmitMetric, rhs.EmitMetric) {
+	if !_I32_EqualsPtr(v.ShardIdForHost, rhs.ShardIdForHost) {
+		return false
+	}
+	if !((v.ExecutionForHost == nil && rhs.ExecutionForHost == nil) || (v.ExecutionForHost != nil && rhs.ExecutionForHost != nil && v.ExecutionForHost.Equals(rhs.ExecutionForHost))) {
 		return false
 	}
 	return true
 }
 
-type DomainInfo struct {
-	Name        *string       `json:"name,omitempty"`
-	Status      *DomainStatus `json:"status,omitempty"`
-	Description *string       `json:"description,omitempty"`
-	OwnerEmail  *string       `json:"ownerEmail,omitempty"`
+type DescribeHistoryHostResponse struct {
+	NumberOfShards        *int32  `json:"numberOfShards,omitempty"`
+	ShardIDs              []int32 `json:"shardIDs"`
+	CacheSize             *int32  `json:"cacheSize,omitempty"`
+	ShardControllerStatus *string `json:"shardControllerStatus,omitempty"`
+	Address               *string `json:"address,omitempty"`
 }
 
-func (v *DomainInfo) ToWire() (wire.Value, error) {
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueI32(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_I32_ValueList) Close() {
+}
+
+func (v *DescribeHistoryHostResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.NumberOfShards != nil {
+		w, err = wire.NewValueI32(*(v.NumberOfShards)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Status != nil {
-		w, err = v.Status.ToWire()
+	if v.ShardIDs != nil {
+		w, err = wire.NewValueList(_List_I32_ValueList(v.ShardIDs)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Description != nil {
-		w, err = wire.NewValueString(*(v.Description)), error(nil)
+	if v.CacheSize != nil {
+		w, err = wire.NewValueI32(*(v.CacheSize)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.OwnerEmail != nil {
-		w, err = wire.NewValueString(*(v.OwnerEmail)), error(nil)
+	if v.ShardControllerStatus != nil {
+		w, err = wire.NewValueString(*(v.ShardControllerStatus)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.Address != nil {
+		w, err = wire.NewValueString(*(v.Address)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DomainStatus_Read(w wire.Value) (DomainStatus, error) {
-	var v DomainStatus
-	err := v.FromWire(w)
-	return v, err
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetI32(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
 }
 
-func (v *DomainInfo) FromWire(w wire.Value) error {
+func (v *DescribeHistoryHostResponse) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumberOfShards = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 20:
-			if field.Value.Type() == wire.TI32 {
-				var x DomainStatus
-				x, err = _DomainStatus_Read(field.Value)
-				v.Status = &x
+			if field.Value.Type() == wire.TList {
+				v.ShardIDs, err = _List_I32_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 			}
 		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.CacheSize = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 40:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Description = &x
+				v.ShardControllerStatus = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 40:
+		case 50:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.OwnerEmail = &x
+				v.Address = &x
 				if err != nil {
 					return err
 				}
@@ -4861,412 +5240,1750 @@ func (v *DomainInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *DomainInfo) String() string {
+func (v *DescribeHistoryHostResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [4]string
+	var fields [5]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+	if v.NumberOfShards != nil {
+		fields[i] = fmt.Sprintf("NumberOfShards: %v", *(v.NumberOfShards))
 		i++
 	}
-	if v.Status != nil {
-		fields[i] = fmt.Sprintf("Status: %v", *(v.Status))
+	if v.ShardIDs != nil {
+		fields[i] = fmt.Sprintf("ShardIDs: %v", v.ShardIDs)
 		i++
 	}
-	if v.Description != nil {
-		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
+	if v.CacheSize != nil {
+		fields[i] = fmt.Sprintf("CacheSize: %v", *(v.CacheSize))
 		i++
 	}
-	if v.OwnerEmail != nil {
-		fields[i] = fmt.Sprintf("OwnerEmail: %v", *(v.OwnerEmail))
+	if v.ShardControllerStatus != nil {
+		fields[i] = fmt.Sprintf("ShardControllerStatus: %v", *(v.ShardControllerStatus))
 		i++
 	}
-	return fmt.Sprintf("DomainInfo{%v}", strings.Join(fields[:i], ", "))
-}
+	if v.Address != nil {
+		fields[i] = fmt.Sprintf("Address: %v", *(v.Address))
+		i++
+	}
+	return fmt.Sprintf("DescribeHistoryHostResponse{%v}", strings.Join(fields[:i], ", "))
+}
 
-func _DomainStatus_EqualsPtr(lhs, rhs *DomainStatus) bool {
-	if lhs != nil && rhs != nil {
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
-	return lhs == nil && rhs == nil
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
 }
 
-func (v *DomainInfo) Equals(rhs *DomainInfo) bool {
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
+func (v *DescribeHistoryHostResponse) Equals(rhs *DescribeHistoryHostResponse) bool {
+	if !_I32_EqualsPtr(v.NumberOfShards, rhs.NumberOfShards) {
 		return false
 	}
-	if !_DomainStatus_EqualsPtr(v.Status, rhs.Status) {
+	if !((v.ShardIDs == nil && rhs.ShardIDs == nil) || (v.ShardIDs != nil && rhs.ShardIDs != nil && _List_I32_Equals(v.ShardIDs, rhs.ShardIDs))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Description, rhs.Description) {
+	if !_I32_EqualsPtr(v.CacheSize, rhs.CacheSize) {
 		return false
 	}
-	if !_String_EqualsPtr(v.OwnerEmail, rhs.OwnerEmail) {
+	if !_String_EqualsPtr(v.ShardControllerStatus, rhs.ShardControllerStatus) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Address, rhs.Address) {
 		return false
 	}
 	return true
 }
 
-type DomainStatus int32
-
-const (
-	DomainStatusRegistered DomainStatus = 0
-	DomainStatusDeprecated DomainStatus = 1
-	DomainStatusDeleted    DomainStatus = 2
-)
-
-func DomainStatus_Values() []DomainStatus {
-	return []DomainStatus{DomainStatusRegistered, DomainStatusDeprecated, DomainStatusDeleted}
+type DescribeShardRequest struct {
+	ShardID *int32 `json:"shardID,omitempty"`
 }
 
-func (v *DomainStatus) UnmarshalText(value []byte) error {
-	switch string(value) {
-	case "REGISTERED":
-		*v = DomainStatusRegistered
-		return nil
-	case "DEPRECATED":
-		*v = DomainStatusDeprecated
-		return nil
-	case "DELETED":
-		*v = DomainStatusDeleted
-		return nil
-	default:
-		return fmt.Errorf("unknown enum value %q for %q", value, "DomainStatus")
+func (v *DescribeShardRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v DomainStatus) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
-}
-
-func (v *DomainStatus) FromWire(w wire.Value) error {
-	*v = (DomainStatus)(w.GetI32())
+func (v *DescribeShardRequest) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
 	return nil
 }
 
-func (v DomainStatus) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "REGISTERED"
-	case 1:
-		return "DEPRECATED"
-	case 2:
-		return "DELETED"
+func (v *DescribeShardRequest) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	return fmt.Sprintf("DomainStatus(%d)", w)
-}
-
-func (v DomainStatus) Equals(rhs DomainStatus) bool {
-	return v == rhs
-}
-
-func (v DomainStatus) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"REGISTERED\""), nil
-	case 1:
-		return ([]byte)("\"DEPRECATED\""), nil
-	case 2:
-		return ([]byte)("\"DELETED\""), nil
+	var fields [1]string
+	i := 0
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
 	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+	return fmt.Sprintf("DescribeShardRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *DomainStatus) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DomainStatus")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DomainStatus")
-		}
-		*v = (DomainStatus)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DomainStatus")
+func (v *DescribeShardRequest) Equals(rhs *DescribeShardRequest) bool {
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
 	}
+	return true
 }
 
-type EntityNotExistsError struct {
-	Message string `json:"message,required"`
+type DescribeShardResponse struct {
+	ShardID              *int32  `json:"shardID,omitempty"`
+	Owner                *string `json:"owner,omitempty"`
+	RangeID              *int64  `json:"rangeID,omitempty"`
+	TransferAckLevel     *int64  `json:"transferAckLevel,omitempty"`
+	TransferMaxReadLevel *int64  `json:"transferMaxReadLevel,omitempty"`
+	TimerAckLevel        *int64  `json:"timerAckLevel,omitempty"`
 }
 
-func (v *EntityNotExistsError) ToWire() (wire.Value, error) {
+func (v *DescribeShardResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Owner != nil {
+		w, err = wire.NewValueString(*(v.Owner)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.RangeID != nil {
+		w, err = wire.NewValueI64(*(v.RangeID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TransferAckLevel != nil {
+		w, err = wire.NewValueI64(*(v.TransferAckLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.TransferMaxReadLevel != nil {
+		w, err = wire.NewValueI64(*(v.TransferMaxReadLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.TimerAckLevel != nil {
+		w, err = wire.NewValueI64(*(v.TimerAckLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *EntityNotExistsError) FromWire(w wire.Value) error {
+func (v *DescribeShardResponse) FromWire(w wire.Value) error {
 	var err error
-	messageIsSet := false
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
 			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Owner = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.RangeID = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TransferAckLevel = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TransferMaxReadLevel = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TimerAckLevel = &x
 				if err != nil {
 					return err
 				}
-				messageIsSet = true
 			}
 		}
 	}
-	if !messageIsSet {
-		return errors.New("field Message of EntityNotExistsError is required")
-	}
 	return nil
 }
 
-func (v *EntityNotExistsError) String() string {
+func (v *DescribeShardResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [1]string
+	var fields [6]string
 	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
-	return fmt.Sprintf("EntityNotExistsError{%v}", strings.Join(fields[:i], ", "))
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.Owner != nil {
+		fields[i] = fmt.Sprintf("Owner: %v", *(v.Owner))
+		i++
+	}
+	if v.RangeID != nil {
+		fields[i] = fmt.Sprintf("RangeID: %v", *(v.RangeID))
+		i++
+	}
+	if v.TransferAckLevel != nil {
+		fields[i] = fmt.Sprintf("TransferAckLevel: %v", *(v.TransferAckLevel))
+		i++
+	}
+	if v.TransferMaxReadLevel != nil {
+		fields[i] = fmt.Sprintf("TransferMaxReadLevel: %v", *(v.TransferMaxReadLevel))
+		i++
+	}
+	if v.TimerAckLevel != nil {
+		fields[i] = fmt.Sprintf("TimerAckLevel: %v", *(v.TimerAckLevel))
+		i++
+	}
+	return fmt.Sprintf("DescribeShardResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *EntityNotExistsError) Equals(rhs *EntityNotExistsError) bool {
-	if !(v.Message == rhs.Message) {
+func (v *DescribeShardResponse) Equals(rhs *DescribeShardResponse) bool {
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
 		return false
 	}
-	return true
-}
-
-func (v *EntityNotExistsError) Error() string {
-	return v.String()
+	if !_String_EqualsPtr(v.Owner, rhs.Owner) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.RangeID, rhs.RangeID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TransferAckLevel, rhs.TransferAckLevel) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TransferMaxReadLevel, rhs.TransferMaxReadLevel) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.TimerAckLevel, rhs.TimerAckLevel) {
+		return false
+	}
+	return true
 }
 
-type EventType int32
-
-const (
-	EventTypeWorkflowExecutionStarted                        EventType = 0
-	EventTypeWorkflowExecutionCompleted                      EventType = 1
-	EventTypeWorkflowExecutionFailed                         EventType = 2
-	EventTypeWorkflowExecutionTimedOut                       EventType = 3
-	EventTypeDecisionTaskScheduled                           EventType = 4
-	EventTypeDecisionTaskStarted                             EventType = 5
-	EventTypeDecisionTaskCompleted                           EventType = 6
-	EventTypeDecisionTaskTimedOut                            EventType = 7
-	EventTypeDecisionTaskFailed                              EventType = 8
-	EventTypeActivityTaskScheduled                           EventType = 9
-	EventTypeActivityTaskStarted                             EventType = 10
-	EventTypeActivityTaskCompleted                           EventType = 11
-	EventTypeActivityTaskFailed                              EventType = 12
-	EventTypeActivityTaskTimedOut                            EventType = 13
-	EventTypeActivityTaskCancelRequested                     EventType = 14
-	EventTypeRequestCancelActivityTaskFailed                 EventType = 15
-	EventTypeActivityTaskCanceled                            EventType = 16
-	EventTypeTimerStarted                                    EventType = 17
-	EventTypeTimerFired                                      EventType = 18
-	EventTypeCancelTimerFailed                               EventType = 19
-	EventTypeTimerCanceled                                   EventType = 20
-	EventTypeWorkflowExecutionCancelRequested                EventType = 21
-	EventTypeWorkflowExecutionCanceled                       EventType = 22
-	EventTypeRequestCancelExternalWorkflowExecutionInitiated EventType = 23
-	EventTypeRequestCancelExternalWorkflowExecutionFailed    EventType = 24
-	EventTypeExternalWorkflowExecutionCancelRequested        EventType = 25
-	EventTypeMarkerRecorded                                  EventType = 26
-	EventTypeWorkflowExecutionSignaled                       EventType = 27
-	EventTypeWorkflowExecutionTerminated                     EventType = 28
-	EventTypeWorkflowExecutionContinuedAsNew                 EventType = 29
-	EventTypeStartChildWorkflowExecutionInitiated            EventType = 30
-	EventTypeStartChildWorkflowExecutionFailed               EventType = 31
-	EventTypeChildWorkflowExecutionStarted                   EventType = 32
-	EventTypeChildWorkflowExecutionCompleted                 EventType = 33
-	EventTypeChildWorkflowExecutionFailed                    EventType = 34
-	EventTypeChildWorkflowExecutionCanceled                  EventType = 35
-	EventTypeChildWorkflowExecutionTimedOut                  EventType = 36
-	EventTypeChildWorkflowExecutionTerminated                EventType = 37
-)
-
-func EventType_Values() []EventType {
-	return []EventType{EventTypeWorkflowExecutionStarted, EventTypeWorkflowExecutionCompleted, EventTypeWorkflowExecutionFailed, EventTypeWorkflowExecutionTimedOut, EventTypeDecisionTaskScheduled, EventTypeDecisionTaskStarted, EventTypeDecisionTaskCompleted, EventTypeDecisionTaskTimedOut, EventTypeDecisionTaskFailed, EventTypeActivityTaskScheduled, EventTypeActivityTaskStarted, EventTypeActivityTaskCompleted, EventTypeActivityTaskFailed, EventTypeActivityTaskTimedOut, EventTypeActivityTaskCancelRequested, EventTypeRequestCancelActivityTaskFailed, EventTypeActivityTaskCanceled, EventTypeTimerStarted, EventTypeTimerFired, EventTypeCancelTimerFailed, EventTypeTimerCanceled, EventTypeWorkflowExecutionCancelRequested, EventTypeWorkflowExecutionCanceled, EventTypeRequestCancelExternalWorkflowExecutionInitiated, EventTypeRequestCancelExternalWorkflowExecutionFailed, EventTypeExternalWorkflowExecutionCancelRequested, EventTypeMarkerRecorded, EventTypeWorkflowExecutionSignaled, EventTypeWorkflowExecutionTerminated, EventTypeWorkflowExecutionContinuedAsNew, EventTypeStartChildWorkflowExecutionInitiated, EventTypeStartChildWorkflowExecutionFailed, EventTypeChildWorkflowExecutionStarted, EventTypeChildWorkflowExecutionCompleted, EventTypeChildWorkflowExecutionFailed, EventTypeChildWorkflowExecutionCanceled, EventTypeChildWorkflowExecutionTimedOut, EventTypeChildWorkflowExecutionTerminated}
+type DescribeTaskListRequest struct {
+	Domain       *string       `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
 }
 
-func (v *EventType) UnmarshalText(value []byte) error {
-	switch string(value) {
-	case "WorkflowExecutionStarted":
-		*v = EventTypeWorkflowExecutionStarted
-		return nil
-	case "WorkflowExecutionCompleted":
-		*v = EventTypeWorkflowExecutionCompleted
-		return nil
-	case "WorkflowExecutionFailed":
-		*v = EventTypeWorkflowExecutionFailed
-		return nil
-	case "WorkflowExecutionTimedOut":
-		*v = EventTypeWorkflowExecutionTimedOut
-		return nil
-	case "DecisionTaskScheduled":
-		*v = EventTypeDecisionTaskScheduled
-		return nil
-	case "DecisionTaskStarted":
-		*v = EventTypeDecisionTaskStarted
-		return nil
-	case "DecisionTaskCompleted":
-		*v = EventTypeDecisionTaskCompleted
-		return nil
-	case "DecisionTaskTimedOut":
-		*v = EventTypeDecisionTaskTimedOut
-		return nil
-	case "DecisionTaskFailed":
-		*v = EventTypeDecisionTaskFailed
-		return nil
-	case "ActivityTaskScheduled":
-		*v = EventTypeActivityTaskScheduled
-		return nil
-	case "ActivityTaskStarted":
-		*v = EventTypeActivityTaskStarted
-		return nil
-	case "ActivityTaskCompleted":
-		*v = EventTypeActivityTaskCompleted
-		return nil
-	case "ActivityTaskFailed":
-		*v = EventTypeActivityTaskFailed
-		return nil
-	case "ActivityTaskTimedOut":
-		*v = EventTypeActivityTaskTimedOut
-		return nil
-	case "ActivityTaskCancelRequested":
-		*v = EventTypeActivityTaskCancelRequested
-		return nil
-	case "RequestCancelActivityTaskFailed":
-		*v = EventTypeRequestCancelActivityTaskFailed
-		return nil
-	case "ActivityTaskCanceled":
-		*v = EventTypeActivityTaskCanceled
-		return nil
-	case "TimerStarted":
-		*v = EventTypeTimerStarted
-		return nil
-	case "TimerFired":
-		*v = EventTypeTimerFired
-		return nil
-	case "CancelTimerFailed":
-		*v = EventTypeCancelTimerFailed
-		return nil
-	case "TimerCanceled":
-		*v = EventTypeTimerCanceled
-		return nil
-	case "WorkflowExecutionCancelRequested":
-		*v = EventTypeWorkflowExecutionCancelRequested
-		return nil
-	case "WorkflowExecutionCanceled":
-		*v = EventTypeWorkflowExecutionCanceled
-		return nil
-	case "RequestCancelExternalWorkflowExecutionInitiated":
-		*v = EventTypeRequestCancelExternalWorkflowExecutionInitiated
-		return nil
-	case "RequestCancelExternalWorkflowExecutionFailed":
-		*v = EventTypeRequestCancelExternalWorkflowExecutionFailed
-		return nil
-	case "ExternalWorkflowExecutionCancelRequested":
-		*v = EventTypeExternalWorkflowExecutionCancelRequested
-		return nil
-	case "MarkerRecorded":
-		*v = EventTypeMarkerRecorded
-		return nil
-	case "WorkflowExecutionSignaled":
-		*v = EventTypeWorkflowExecutionSignaled
-		return nil
-	case "WorkflowExecutionTerminated":
-		*v = EventTypeWorkflowExecutionTerminated
-		return nil
-	case "WorkflowExecutionContinuedAsNew":
-		*v = EventTypeWorkflowExecutionContinuedAsNew
-		return nil
-	case "StartChildWorkflowExecutionInitiated":
-		*v = EventTypeStartChildWorkflowExecutionInitiated
-		return nil
-	case "StartChildWorkflowExecutionFailed":
-		*v = EventTypeStartChildWorkflowExecutionFailed
-		return nil
-	case "ChildWorkflowExecutionStarted":
-		*v = EventTypeChildWorkflowExecutionStarted
-		return nil
-	case "ChildWorkflowExecutionCompleted":
-		*v = EventTypeChildWorkflowExecutionCompleted
-		return nil
-	case "ChildWorkflowExecutionFailed":
-		*v = EventTypeChildWorkflowExecutionFailed
-		return nil
-	case "ChildWorkflowExecutionCanceled":
-		*v = EventTypeChildWorkflowExecutionCanceled
-		return nil
-	case "ChildWorkflowExecutionTimedOut":
-		*v = EventTypeChildWorkflowExecutionTimedOut
-		return nil
-	case "ChildWorkflowExecutionTerminated":
-		*v = EventTypeChildWorkflowExecutionTerminated
-		return nil
-	default:
-		return fmt.Errorf("unknown enum value %q for %q", value, "EventType")
+func (v *DescribeTaskListRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.TaskListType != nil {
+		w, err = v.TaskListType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
 	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v EventType) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
+func _TaskListType_Read(w wire.Value) (TaskListType, error) {
+	var v TaskListType
+	err := v.FromWire(w)
+	return v, err
 }
 
-func (v *EventType) FromWire(w wire.Value) error {
-	*v = (EventType)(w.GetI32())
+func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x TaskListType
+				x, err = _TaskListType_Read(field.Value)
+				v.TaskListType = &x
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
 	return nil
 }
 
-func (v EventType) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "WorkflowExecutionStarted"
-	case 1:
-		return "WorkflowExecutionCompleted"
-	case 2:
-		return "WorkflowExecutionFailed"
-	case 3:
-		return "WorkflowExecutionTimedOut"
-	case 4:
-		return "DecisionTaskScheduled"
-	case 5:
-		return "DecisionTaskStarted"
-	case 6:
-		return "DecisionTaskCompleted"
-	case 7:
-		return "DecisionTaskTimedOut"
-	case 8:
-		return "DecisionTaskFailed"
-	case 9:
+func (v *DescribeTaskListRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [3]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.TaskListType != nil {
+		fields[i] = fmt.Sprintf("TaskListType: %v", *(v.TaskListType))
+		i++
+	}
+	return fmt.Sprintf("DescribeTaskListRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _TaskListType_EqualsPtr(lhs, rhs *TaskListType) bool {
+	if lhs != nil && rhs != nil {
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func (v *DescribeTaskListRequest) Equals(rhs *DescribeTaskListRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_TaskListType_EqualsPtr(v.TaskListType, rhs.TaskListType) {
+		return false
+	}
+	return true
+}
+
+type DescribeTaskListResponse struct {
+	Pollers        []*PollerInfo   `json:"pollers"`
+	TaskListStatus *TaskListStatus `json:"taskListStatus,omitempty"`
+}
+
+type _List_PollerInfo_ValueList []*PollerInfo
+
+func (v _List_PollerInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_PollerInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_PollerInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_PollerInfo_ValueList) Close() {
+}
+
+func (v *DescribeTaskListResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.Pollers != nil {
+		w, err = wire.NewValueList(_List_PollerInfo_ValueList(v.Pollers)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskListStatus != nil {
+		w, err = v.TaskListStatus.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _PollerInfo_Read(w wire.Value) (*PollerInfo, error) {
+	var v PollerInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_PollerInfo_Read(l wire.ValueList) ([]*PollerInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+	o := make([]*PollerInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _PollerInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _TaskListStatus_Read(w wire.Value) (*TaskListStatus, error) {
+	var v TaskListStatus
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func (v *DescribeTaskListResponse) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Pollers, err = _List_PollerInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskListStatus, err = _TaskListStatus_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *DescribeTaskListResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [2]string
+	i := 0
+	if v.Pollers != nil {
+		fields[i] = fmt.Sprintf("Pollers: %v", v.Pollers)
+		i++
+	}
+	if v.TaskListStatus != nil {
+		fields[i] = fmt.Sprintf("TaskListStatus: %v", v.TaskListStatus)
+		i++
+	}
+	return fmt.Sprintf("DescribeTaskListResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_PollerInfo_Equals(lhs, rhs []*PollerInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *DescribeTaskListResponse) Equals(rhs *DescribeTaskListResponse) bool {
+	if !((v.Pollers == nil && rhs.Pollers == nil) || (v.Pollers != nil && rhs.Pollers != nil && _List_PollerInfo_Equals(v.Pollers, rhs.Pollers))) {
+		return false
+	}
+	if !((v.TaskListStatus == nil && rhs.TaskListStatus == nil) || (v.TaskListStatus != nil && rhs.TaskListStatus != nil && v.TaskListStatus.Equals(rhs.TaskListStatus))) {
+		return false
+	}
+	return true
+}
+
+type DescribeWorkflowExecutionRequest struct {
+	Domain    *string            `json:"domain,omitempty"`
+	Execution *WorkflowExecution `json:"execution,omitempty"`
+}
+
+func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *DescribeWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecutionRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	return true
+}
+
+type DescribeWorkflowExecutionResponse struct {
+	ExecutionConfiguration *WorkflowExecutionConfiguration `json:"executionConfiguration,omitempty"`
+	WorkflowExecutionInfo  *WorkflowExecutionInfo          `json:"workflowExecutionInfo,omitempty"`
+	PendingActivities      []*PendingActivityInfo          `json:"pendingActivities"`
+	PendingChildren        []*PendingChildExecutionInfo    `json:"pendingChildren"`
+	PendingDecision        *PendingDecisionInfo            `json:"pendingDecision,omitempty"`
+}
+
+type _List_PendingActivityInfo_ValueList []*PendingActivityInfo
+
+func (v _List_PendingActivityInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_PendingActivityInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_PendingActivityInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_PendingActivityInfo_ValueList) Close() {
+}
+
+type _List_PendingChildExecutionInfo_ValueList []*PendingChildExecutionInfo
+
+func (v _List_PendingChildExecutionInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_PendingChildExecutionInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_PendingChildExecutionInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_PendingChildExecutionInfo_ValueList) Close() {
+}
+
+func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.ExecutionConfiguration != nil {
+		w, err = v.ExecutionConfiguration.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionInfo != nil {
+		w, err = v.WorkflowExecutionInfo.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.PendingActivities != nil {
+		w, err = wire.NewValueList(_List_PendingActivityInfo_ValueList(v.PendingActivities)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.PendingChildren != nil {
+		w, err = wire.NewValueList(_List_PendingChildExecutionInfo_ValueList(v.PendingChildren)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.PendingDecision != nil {
+		w, err = v.PendingDecision.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _WorkflowExecutionConfiguration_Read(w wire.Value) (*WorkflowExecutionConfiguration, error) {
+	var v WorkflowExecutionConfiguration
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionInfo_Read(w wire.Value) (*WorkflowExecutionInfo, error) {
+	var v WorkflowExecutionInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _PendingActivityInfo_Read(w wire.Value) (*PendingActivityInfo, error) {
+	var v PendingActivityInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_PendingActivityInfo_Read(l wire.ValueList) ([]*PendingActivityInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+	o := make([]*PendingActivityInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _PendingActivityInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _PendingChildExecutionInfo_Read(w wire.Value) (*PendingChildExecutionInfo, error) {
+	var v PendingChildExecutionInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_PendingChildExecutionInfo_Read(l wire.ValueList) ([]*PendingChildExecutionInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+	o := make([]*PendingChildExecutionInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _PendingChildExecutionInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _PendingDecisionInfo_Read(w wire.Value) (*PendingDecisionInfo, error) {
+	var v PendingDecisionInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func (v *DescribeWorkflowExecutionResponse) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.ExecutionConfiguration, err = _WorkflowExecutionConfiguration_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionInfo, err = _WorkflowExecutionInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TList {
+				v.PendingActivities, err = _List_PendingActivityInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+			}
+		case 40:
+			if field.Value.Type() == wire.TList {
+				v.PendingChildren, err = _List_PendingChildExecutionInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.PendingDecision, err = _PendingDecisionInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *DescribeWorkflowExecutionResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [5]string
+	i := 0
+	if v.ExecutionConfiguration != nil {
+		fields[i] = fmt.Sprintf("ExecutionConfiguration: %v", v.ExecutionConfiguration)
+		i++
+	}
+	if v.WorkflowExecutionInfo != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionInfo: %v", v.WorkflowExecutionInfo)
+		i++
+	}
+	if v.PendingActivities != nil {
+		fields[i] = fmt.Sprintf("PendingActivities: %v", v.PendingActivities)
+		i++
+	}
+	if v.PendingChildren != nil {
+		fields[i] = fmt.Sprintf("PendingChildren: %v", v.PendingChildren)
+		i++
+	}
+	if v.PendingDecision != nil {
+		fields[i] = fmt.Sprintf("PendingDecision: %v", v.PendingDecision)
+		i++
+	}
+	return fmt.Sprintf("DescribeWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_PendingActivityInfo_Equals(lhs, rhs []*PendingActivityInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _List_PendingChildExecutionInfo_Equals(lhs, rhs []*PendingChildExecutionInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *DescribeWorkflowExecutionResponse) Equals(rhs *DescribeWorkflowExecutionResponse) bool {
+	if !((v.ExecutionConfiguration == nil && rhs.ExecutionConfiguration == nil) || (v.ExecutionConfiguration != nil && rhs.ExecutionConfiguration != nil && v.ExecutionConfiguration.Equals(rhs.ExecutionConfiguration))) {
+		return false
+	}
+	if !((v.WorkflowExecutionInfo == nil && rhs.WorkflowExecutionInfo == nil) || (v.WorkflowExecutionInfo != nil && rhs.WorkflowExecutionInfo != nil && v.WorkflowExecutionInfo.Equals(rhs.WorkflowExecutionInfo))) {
+		return false
+	}
+	if !((v.PendingActivities == nil && rhs.PendingActivities == nil) || (v.PendingActivities != nil && rhs.PendingActivities != nil && _List_PendingActivityInfo_Equals(v.PendingActivities, rhs.PendingActivities))) {
+		return false
+	}
+	if !((v.PendingChildren == nil && rhs.PendingChildren == nil) || (v.PendingChildren != nil && rhs.PendingChildren != nil && _List_PendingChildExecutionInfo_Equals(v.PendingChildren, rhs.PendingChildren))) {
+		return false
+	}
+	if !((v.PendingDecision == nil && rhs.PendingDecision == nil) || (v.PendingDecision != nil && rhs.PendingDecision != nil && v.PendingDecision.Equals(rhs.PendingDecision))) {
+		return false
+	}
+	return true
+}
+
+type DomainAlreadyExistsError struct {
+	Message string `json:"message,required"`
+}
+
+func (v *DomainAlreadyExistsError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *DomainAlreadyExistsError) FromWire(w wire.Value) error {
+	var err error
+	messageIsSet := false
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
+	}
+	if !messageIsSet {
+		return errors.New("field Message of DomainAlreadyExistsError is required")
+	}
+	return nil
+}
+
+func (v *DomainAlreadyExistsError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+	return fmt.Sprintf("DomainAlreadyExistsError{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *DomainAlreadyExistsError) Equals(rhs *DomainAlreadyExistsError) bool {
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+	return true
+}
+
+func (v *DomainAlreadyExistsError) Error() string {
+	return v.String()
+}
+
+type DomainConfiguration struct {
+	WorkflowExecutionRetentionPeriodInDays *int32 `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
+	EmitMetric                             *bool  `json:"emitMetric,omitempty"`
+	StartWorkflowRPS                       *int32 `json:"startWorkflowRPS,omitempty"`
+	SignalWorkflowRPS                      *int32 `json:"signalWorkflowRPS,omitempty"`
+	PollRPS                                *int32 `json:"pollRPS,omitempty"`
+	HistoryReadRPS                         *int32 `json:"historyReadRPS,omitempty"`
+}
+
+func (v *DomainConfiguration) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		w, err = wire.NewValueI32(*(v.WorkflowExecutionRetentionPeriodInDays)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.EmitMetric != nil {
+		w, err = wire.NewValueBool(*(v.EmitMetric)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.StartWorkflowRPS != nil {
+		w, err = wire.NewValueI32(*(v.StartWorkflowRPS)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.SignalWorkflowRPS != nil {
+		w, err = wire.NewValueI32(*(v.SignalWorkflowRPS)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.PollRPS != nil {
+		w, err = wire.NewValueI32(*(v.PollRPS)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.HistoryReadRPS != nil {
+		w, err = wire.NewValueI32(*(v.HistoryReadRPS)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *DomainConfiguration) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.WorkflowExecutionRetentionPeriodInDays = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.EmitMetric = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.StartWorkflowRPS = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.SignalWorkflowRPS = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.PollRPS = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.HistoryReadRPS = &x
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *DomainConfiguration) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [6]string
+	i := 0
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionRetentionPeriodInDays: %v", *(v.WorkflowExecutionRetentionPeriodInDays))
+		i++
+	}
+	if v.EmitMetric != nil {
+		fields[i] = fmt.Sprintf("EmitMetric: %v", *(v.EmitMetric))
+		i++
+	}
+	if v.StartWorkflowRPS != nil {
+		fields[i] = fmt.Sprintf("StartWorkflowRPS: %v", *(v.StartWorkflowRPS))
+		i++
+	}
+	if v.SignalWorkflowRPS != nil {
+		fields[i] = fmt.Sprintf("SignalWorkflowRPS: %v", *(v.SignalWorkflowRPS))
+		i++
+	}
+	if v.PollRPS != nil {
+		fields[i] = fmt.Sprintf("PollRPS: %v", *(v.PollRPS))
+		i++
+	}
+	if v.HistoryReadRPS != nil {
+		fields[i] = fmt.Sprintf("HistoryReadRPS: %v", *(v.HistoryReadRPS))
+		i++
+	}
+	return fmt.Sprintf("DomainConfiguration{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func (v *DomainConfiguration) Equals(rhs *DomainConfiguration) bool {
+	if !_I32_EqualsPtr(v.WorkflowExecutionRetentionPeriodInDays, rhs.WorkflowExecutionRetentionPeriodInDays) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.EmitMetric, rhs.EmitMetric) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.StartWorkflowRPS, rhs.StartWorkflowRPS) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.SignalWorkflowRPS, rhs.SignalWorkflowRPS) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.PollRPS, rhs.PollRPS) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.HistoryReadRPS, rhs.HistoryReadRPS) {
+		return false
+	}
+	return true
+}
+
+type DomainInfo struct {
+	Name        *string       `json:"name,omitempty"`
+	Status      *DomainStatus `json:"status,omitempty"`
+	Description *string       `json:"description,omitempty"`
+	OwnerEmail  *string       `json:"ownerEmail,omitempty"`
+}
+
+func (v *DomainInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Status != nil {
+		w, err = v.Status.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Description != nil {
+		w, err = wire.NewValueString(*(v.Description)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.OwnerEmail != nil {
+		w, err = wire.NewValueString(*(v.OwnerEmail)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DomainStatus_Read(w wire.Value) (DomainStatus, error) {
+	var v DomainStatus
+	err := v.FromWire(w)
+	return v, err
+}
+
+func (v *DomainInfo) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x DomainStatus
+				x, err = _DomainStatus_Read(field.Value)
+				v.Status = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Description = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.OwnerEmail = &x
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *DomainInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [4]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.Status != nil {
+		fields[i] = fmt.Sprintf("Status: %v", *(v.Status))
+		i++
+	}
+	if v.Description != nil {
+		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
+		i++
+	}
+	if v.OwnerEmail != nil {
+		fields[i] = fmt.Sprintf("OwnerEmail: %v", *(v.OwnerEmail))
+		i++
+	}
+	return fmt.Sprintf("DomainInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _DomainStatus_EqualsPtr(lhs, rhs *DomainStatus) bool {
+	if lhs != nil && rhs != nil {
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func (v *DomainInfo) Equals(rhs *DomainInfo) bool {
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !_DomainStatus_EqualsPtr(v.Status, rhs.Status) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Description, rhs.Description) {
+		return false
+	}
+	if !_String_EqualsPtr(v.OwnerEmail, rhs.OwnerEmail) {
+		return false
+	}
+	return true
+}
+
+type DomainStatus int32
+
+const (
+	DomainStatusRegistered DomainStatus = 0
+	DomainStatusDeprecated DomainStatus = 1
+	DomainStatusDeleted    DomainStatus = 2
+)
+
+func DomainStatus_Values() []DomainStatus {
+	return []DomainStatus{DomainStatusRegistered, DomainStatusDeprecated, DomainStatusDeleted}
+}
+
+func (v *DomainStatus) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "REGISTERED":
+		*v = DomainStatusRegistered
+		return nil
+	case "DEPRECATED":
+		*v = DomainStatusDeprecated
+		return nil
+	case "DELETED":
+		*v = DomainStatusDeleted
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "DomainStatus")
+	}
+}
+
+func (v DomainStatus) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+func (v *DomainStatus) FromWire(w wire.Value) error {
+	*v = (DomainStatus)(w.GetI32())
+	return nil
+}
+
+func (v DomainStatus) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "REGISTERED"
+	case 1:
+		return "DEPRECATED"
+	case 2:
+		return "DELETED"
+	}
+	return fmt.Sprintf("DomainStatus(%d)", w)
+}
+
+func (v DomainStatus) Equals(rhs DomainStatus) bool {
+	return v == rhs
+}
+
+func (v DomainStatus) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"REGISTERED\""), nil
+	case 1:
+		return ([]byte)("\"DEPRECATED\""), nil
+	case 2:
+		return ([]byte)("\"DELETED\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+func (v *DomainStatus) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "DomainStatus")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "DomainStatus")
+		}
+		*v = (DomainStatus)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "DomainStatus")
+	}
+}
+
+type EntityNotExistsError struct {
+	Message string `json:"message,required"`
+}
+
+func (v *EntityNotExistsError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *EntityNotExistsError) FromWire(w wire.Value) error {
+	var err error
+	messageIsSet := false
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
+	}
+	if !messageIsSet {
+		return errors.New("field Message of EntityNotExistsError is required")
+	}
+	return nil
+}
+
+func (v *EntityNotExistsError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+	return fmt.Sprintf("EntityNotExistsError{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *EntityNotExistsError) Equals(rhs *EntityNotExistsError) bool {
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+	return true
+}
+
+func (v *EntityNotExistsError) Error() string {
+	return v.String()
+}
+
+type EventType int32
+
+const (
+	EventTypeWorkflowExecutionStarted                        EventType = 0
+	EventTypeWorkflowExecutionCompleted                      EventType = 1
+	EventTypeWorkflowExecutionFailed                         EventType = 2
+	EventTypeWorkflowExecutionTimedOut                       EventType = 3
+	EventTypeDecisionTaskScheduled                           EventType = 4
+	EventTypeDecisionTaskStarted                             EventType = 5
+	EventTypeDecisionTaskCompleted                           EventType = 6
+	EventTypeDecisionTaskTimedOut                            EventType = 7
+	EventTypeDecisionTaskFailed                              EventType = 8
+	EventTypeActivityTaskScheduled                           EventType = 9
+	EventTypeActivityTaskStarted                             EventType = 10
+	EventTypeActivityTaskCompleted                           EventType = 11
+	EventTypeActivityTaskFailed                              EventType = 12
+	EventTypeActivityTaskTimedOut                            EventType = 13
+	EventTypeActivityTaskCancelRequested                     EventType = 14
+	EventTypeRequestCancelActivityTaskFailed                 EventType = 15
+	EventTypeActivityTaskCanceled                            EventType = 16
+	EventTypeTimerStarted                                    EventType = 17
+	EventTypeTimerFired                                      EventType = 18
+	EventTypeCancelTimerFailed                               EventType = 19
+	EventTypeTimerCanceled                                   EventType = 20
+	EventTypeWorkflowExecutionCancelRequested                EventType = 21
+	EventTypeWorkflowExecutionCanceled                       EventType = 22
+	EventTypeRequestCancelExternalWorkflowExecutionInitiated EventType = 23
+	EventTypeRequestCancelExternalWorkflowExecutionFailed    EventType = 24
+	EventTypeExternalWorkflowExecutionCancelRequested        EventType = 25
+	EventTypeMarkerRecorded                                  EventType = 26
+	EventTypeWorkflowExecutionSignaled                       EventType = 27
+	EventTypeWorkflowExecutionTerminated                     EventType = 28
+	EventTypeWorkflowExecutionContinuedAsNew                 EventType = 29
+	EventTypeStartChildWorkflowExecutionInitiated            EventType = 30
+	EventTypeStartChildWorkflowExecutionFailed               EventType = 31
+	EventTypeChildWorkflowExecutionStarted                   EventType = 32
+	EventTypeChildWorkflowExecutionCompleted                 EventType = 33
+	EventTypeChildWorkflowExecutionFailed                    EventType = 34
+	EventTypeChildWorkflowExecutionCanceled                  EventType = 35
+	EventTypeChildWorkflowExecutionTimedOut                  EventType = 36
+	EventTypeChildWorkflowExecutionTerminated                EventType = 37
+	EventTypeSignalExternalWorkflowExecutionInitiated        EventType = 38
+	EventTypeSignalExternalWorkflowExecutionFailed           EventType = 39
+	EventTypeExternalWorkflowExecutionSignaled               EventType = 40
+	EventTypeUpsertWorkflowSearchAttributes                  EventType = 41
+)
+
+func EventType_Values() []EventType {
+	return []EventType{EventTypeWorkflowExecutionStarted, EventTypeWorkflowExecutionCompleted, EventTypeWorkflowExecutionFailed, EventTypeWorkflowExecutionTimedOut, EventTypeDecisionTaskScheduled, EventTypeDecisionTaskStarted, EventTypeDecisionTaskCompleted, EventTypeDecisionTaskTimedOut, EventTypeDecisionTaskFailed, EventTypeActivityTaskScheduled, EventTypeActivityTaskStarted, EventTypeActivityTaskCompleted, EventTypeActivityTaskFailed, EventTypeActivityTaskTimedOut, EventTypeActivityTaskCancelRequested, EventTypeRequestCancelActivityTaskFailed, EventTypeActivityTaskCanceled, EventTypeTimerStarted, EventTypeTimerFired, EventTypeCancelTimerFailed, EventTypeTimerCanceled, EventTypeWorkflowExecutionCancelRequested, EventTypeWorkflowExecutionCanceled, EventTypeRequestCancelExternalWorkflowExecutionInitiated, EventTypeRequestCancelExternalWorkflowExecutionFailed, EventTypeExternalWorkflowExecutionCancelRequested, EventTypeMarkerRecorded, EventTypeWorkflowExecutionSignaled, EventTypeWorkflowExecutionTerminated, EventTypeWorkflowExecutionContinuedAsNew, EventTypeStartChildWorkflowExecutionInitiated, EventTypeStartChildWorkflowExecutionFailed, EventTypeChildWorkflowExecutionStarted, EventTypeChildWorkflowExecutionCompleted, EventTypeChildWorkflowExecutionFailed, EventTypeChildWorkflowExecutionCanceled, EventTypeChildWorkflowExecutionTimedOut, EventTypeChildWorkflowExecutionTerminated, EventTypeSignalExternalWorkflowExecutionInitiated, EventTypeSignalExternalWorkflowExecutionFailed, EventTypeExternalWorkflowExecutionSignaled, EventTypeUpsertWorkflowSearchAttributes}
+}
+
+func (v *EventType) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "WorkflowExecutionStarted":
+		*v = EventTypeWorkflowExecutionStarted
+		return nil
+	case "WorkflowExecutionCompleted":
+		*v = EventTypeWorkflowExecutionCompleted
+		return nil
+	case "WorkflowExecutionFailed":
+		*v = EventTypeWorkflowExecutionFailed
+		return nil
+	case "WorkflowExecutionTimedOut":
+		*v = EventTypeWorkflowExecutionTimedOut
+		return nil
+	case "DecisionTaskScheduled":
+		*v = EventTypeDecisionTaskScheduled
+		return nil
+	case "DecisionTaskStarted":
+		*v = EventTypeDecisionTaskStarted
+		return nil
+	case "DecisionTaskCompleted":
+		*v = EventTypeDecisionTaskCompleted
+		return nil
+	case "DecisionTaskTimedOut":
+		*v = EventTypeDecisionTaskTimedOut
+		return nil
+	case "DecisionTaskFailed":
+		*v = EventTypeDecisionTaskFailed
+		return nil
+	case "ActivityTaskScheduled":
+		*v = EventTypeActivityTaskScheduled
+		return nil
+	case "ActivityTaskStarted":
+		*v = EventTypeActivityTaskStarted
+		return nil
+	case "ActivityTaskCompleted":
+		*v = EventTypeActivityTaskCompleted
+		return nil
+	case "ActivityTaskFailed":
+		*v = EventTypeActivityTaskFailed
+		return nil
+	case "ActivityTaskTimedOut":
+		*v = EventTypeActivityTaskTimedOut
+		return nil
+	case "ActivityTaskCancelRequested":
+		*v = EventTypeActivityTaskCancelRequested
+		return nil
+	case "RequestCancelActivityTaskFailed":
+		*v = EventTypeRequestCancelActivityTaskFailed
+		return nil
+	case "ActivityTaskCanceled":
+		*v = EventTypeActivityTaskCanceled
+		return nil
+	case "TimerStarted":
+		*v = EventTypeTimerStarted
+		return nil
+	case "TimerFired":
+		*v = EventTypeTimerFired
+		return nil
+	case "CancelTimerFailed":
+		*v = EventTypeCancelTimerFailed
+		return nil
+	case "TimerCanceled":
+		*v = EventTypeTimerCanceled
+		return nil
+	case "WorkflowExecutionCancelRequested":
+		*v = EventTypeWorkflowExecutionCancelRequested
+		return nil
+	case "WorkflowExecutionCanceled":
+		*v = EventTypeWorkflowExecutionCanceled
+		return nil
+	case "RequestCancelExternalWorkflowExecutionInitiated":
+		*v = EventTypeRequestCancelExternalWorkflowExecutionInitiated
+		return nil
+	case "RequestCancelExternalWorkflowExecutionFailed":
+		*v = EventTypeRequestCancelExternalWorkflowExecutionFailed
+		return nil
+	case "ExternalWorkflowExecutionCancelRequested":
+		*v = EventTypeExternalWorkflowExecutionCancelRequested
+		return nil
+	case "MarkerRecorded":
+		*v = EventTypeMarkerRecorded
+		return nil
+	case "WorkflowExecutionSignaled":
+		*v = EventTypeWorkflowExecutionSignaled
+		return nil
+	case "WorkflowExecutionTerminated":
+		*v = EventTypeWorkflowExecutionTerminated
+		return nil
+	case "WorkflowExecutionContinuedAsNew":
+		*v = EventTypeWorkflowExecutionContinuedAsNew
+		return nil
+	case "StartChildWorkflowExecutionInitiated":
+		*v = EventTypeStartChildWorkflowExecutionInitiated
+		return nil
+	case "StartChildWorkflowExecutionFailed":
+		*v = EventTypeStartChildWorkflowExecutionFailed
+		return nil
+	case "ChildWorkflowExecutionStarted":
+		*v = EventTypeChildWorkflowExecutionStarted
+		return nil
+	case "ChildWorkflowExecutionCompleted":
+		*v = EventTypeChildWorkflowExecutionCompleted
+		return nil
+	case "ChildWorkflowExecutionFailed":
+		*v = EventTypeChildWorkflowExecutionFailed
+		return nil
+	case "ChildWorkflowExecutionCanceled":
+		*v = EventTypeChildWorkflowExecutionCanceled
+		return nil
+	case "ChildWorkflowExecutionTimedOut":
+		*v = EventTypeChildWorkflowExecutionTimedOut
+		return nil
+	case "ChildWorkflowExecutionTerminated":
+		*v = EventTypeChildWorkflowExecutionTerminated
+		return nil
+	case "SignalExternalWorkflowExecutionInitiated":
+		*v = EventTypeSignalExternalWorkflowExecutionInitiated
+		return nil
+	case "SignalExternalWorkflowExecutionFailed":
+		*v = EventTypeSignalExternalWorkflowExecutionFailed
+		return nil
+	case "ExternalWorkflowExecutionSignaled":
+		*v = EventTypeExternalWorkflowExecutionSignaled
+		return nil
+	case "UpsertWorkflowSearchAttributes":
+		*v = EventTypeUpsertWorkflowSearchAttributes
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "EventType")
+	}
+}
+
+func (v EventType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+func (v *EventType) FromWire(w wire.Value) error {
+	*v = (EventType)(w.GetI32())
+	return nil
+}
+
+func (v EventType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "WorkflowExecutionStarted"
+	case 1:
+		return "WorkflowExecutionCompleted"
+	case 2:
+		return "WorkflowExecutionFailed"
+	case 3:
+		return "WorkflowExecutionTimedOut"
+	case 4:
+		return "DecisionTaskScheduled"
+	case 5:
+		return "DecisionTaskStarted"
+	case 6:
+		return "DecisionTaskCompleted"
+	case 7:
+		return "DecisionTaskTimedOut"
+	case 8:
+		return "DecisionTaskFailed"
+	case 9:
 		return "ActivityTaskScheduled"
 	case 10:
 		return "ActivityTaskStarted"
@@ -5324,97 +7041,2411 @@ func (v EventType) String() string {
 		return "ChildWorkflowExecutionTimedOut"
 	case 37:
 		return "ChildWorkflowExecutionTerminated"
+	case 38:
+		return "SignalExternalWorkflowExecutionInitiated"
+	case 39:
+		return "SignalExternalWorkflowExecutionFailed"
+	case 40:
+		return "ExternalWorkflowExecutionSignaled"
+	case 41:
+		return "UpsertWorkflowSearchAttributes"
+	}
+	return fmt.Sprintf("EventType(%d)", w)
+}
+
+func (v EventType) Equals(rhs EventType) bool {
+	return v == rhs
+}
+
+func (v EventType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"WorkflowExecutionStarted\""), nil
+	case 1:
+		return ([]byte)("\"WorkflowExecutionCompleted\""), nil
+	case 2:
+		return ([]byte)("\"WorkflowExecutionFailed\""), nil
+	case 3:
+		return ([]byte)("\"WorkflowExecutionTimedOut\""), nil
+	case 4:
+		return ([]byte)("\"DecisionTaskScheduled\""), nil
+	case 5:
+		return ([]byte)("\"DecisionTaskStarted\""), nil
+	case 6:
+		return ([]byte)("\"DecisionTaskCompleted\""), nil
+	case 7:
+		return ([]byte)("\"DecisionTaskTimedOut\""), nil
+	case 8:
+		return ([]byte)("\"DecisionTaskFailed\""), nil
+	case 9:
+		return ([]byte)("\"ActivityTaskScheduled\""), nil
+	case 10:
+		return ([]byte)("\"ActivityTaskStarted\""), nil
+	case 11:
+		return ([]byte)("\"ActivityTaskCompleted\""), nil
+	case 12:
+		return ([]byte)("\"ActivityTaskFailed\""), nil
+	case 13:
+		return ([]byte)("\"ActivityTaskTimedOut\""), nil
+	case 14:
+		return ([]byte)("\"ActivityTaskCancelRequested\""), nil
+	case 15:
+		return ([]byte)("\"RequestCancelActivityTaskFailed\""), nil
+	case 16:
+		return ([]byte)("\"ActivityTaskCanceled\""), nil
+	case 17:
+		return ([]byte)("\"TimerStarted\""), nil
+	case 18:
+		return ([]byte)("\"TimerFired\""), nil
+	case 19:
+		return ([]byte)("\"CancelTimerFailed\""), nil
+	case 20:
+		return ([]byte)("\"TimerCanceled\""), nil
+	case 21:
+		return ([]byte)("\"WorkflowExecutionCancelRequested\""), nil
+	case 22:
+		return ([]byte)("\"WorkflowExecutionCanceled\""), nil
+	case 23:
+		return ([]byte)("\"RequestCancelExternalWorkflowExecutionInitiated\""), nil
+	case 24:
+		return ([]byte)("\"RequestCancelExternalWorkflowExecutionFailed\""), nil
+	case 25:
+		return ([]byte)("\"ExternalWorkflowExecutionCancelRequested\""), nil
+	case 26:
+		return ([]byte)("\"MarkerRecorded\""), nil
+	case 27:
+		return ([]byte)("\"WorkflowExecutionSignaled\""), nil
+	case 28:
+		return ([]byte)("\"WorkflowExecutionTerminated\""), nil
+	case 29:
+		return ([]byte)("\"WorkflowExecutionContinuedAsNew\""), nil
+	case 30:
+		return ([]byte)("\"StartChildWorkflowExecutionInitiated\""), nil
+	case 31:
+		return ([]byte)("\"StartChildWorkflowExecutionFailed\""), nil
+	case 32:
+		return ([]byte)("\"ChildWorkflowExecutionStarted\""), nil
+	case 33:
+		return ([]byte)("\"ChildWorkflowExecutionCompleted\""), nil
+	case 34:
+		return ([]byte)("\"ChildWorkflowExecutionFailed\""), nil
+	case 35:
+		return ([]byte)("\"ChildWorkflowExecutionCanceled\""), nil
+	case 36:
+		return ([]byte)("\"ChildWorkflowExecutionTimedOut\""), nil
+	case 37:
+		return ([]byte)("\"ChildWorkflowExecutionTerminated\""), nil
+	case 38:
+		return ([]byte)("\"SignalExternalWorkflowExecutionInitiated\""), nil
+	case 39:
+		return ([]byte)("\"SignalExternalWorkflowExecutionFailed\""), nil
+	case 40:
+		return ([]byte)("\"ExternalWorkflowExecutionSignaled\""), nil
+	case 41:
+		return ([]byte)("\"UpsertWorkflowSearchAttributes\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+func (v *EventType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "EventType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "EventType")
+		}
+		*v = (EventType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "EventType")
+	}
+}
+
+type ExternalWorkflowExecutionCancelRequestedEventAttributes struct {
+	InitiatedEventId  *int64             `json:"initiatedEventId,omitempty"`
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+}
+
+func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.InitiatedEventId != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InitiatedEventId = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [3]string
+	i := 0
+	if v.InitiatedEventId != nil {
+		fields[i] = fmt.Sprintf("InitiatedEventId: %v", *(v.InitiatedEventId))
+		i++
+	}
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	return fmt.Sprintf("ExternalWorkflowExecutionCancelRequestedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) Equals(rhs *ExternalWorkflowExecutionCancelRequestedEventAttributes) bool {
+	if !_I64_EqualsPtr(v.InitiatedEventId, rhs.InitiatedEventId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	return true
+}
+
+type ExternalWorkflowExecutionSignaledEventAttributes struct {
+	InitiatedEventId  *int64             `json:"initiatedEventId,omitempty"`
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Control           []byte             `json:"control"`
+}
+
+func (v *ExternalWorkflowExecutionSignaledEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.InitiatedEventId != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Control != nil {
+		w, err = wire.NewValueBinary(v.Control), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *ExternalWorkflowExecutionSignaledEventAttributes) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InitiatedEventId = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				v.Control, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *ExternalWorkflowExecutionSignaledEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [4]string
+	i := 0
+	if v.InitiatedEventId != nil {
+		fields[i] = fmt.Sprintf("InitiatedEventId: %v", *(v.InitiatedEventId))
+		i++
+	}
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.Control != nil {
+		fields[i] = fmt.Sprintf("Control: %v", v.Control)
+		i++
+	}
+	return fmt.Sprintf("ExternalWorkflowExecutionSignaledEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *ExternalWorkflowExecutionSignaledEventAttributes) Equals(rhs *ExternalWorkflowExecutionSignaledEventAttributes) bool {
+	if !_I64_EqualsPtr(v.InitiatedEventId, rhs.InitiatedEventId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
+		return false
+	}
+	return true
+}
+
+type FailWorkflowExecutionDecisionAttributes struct {
+	Reason  *string `json:"reason,omitempty"`
+	Details []byte  `json:"details"`
+}
+
+func (v *FailWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *FailWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.Details, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *FailWorkflowExecutionDecisionAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [2]string
+	i := 0
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+		i++
+	}
+	return fmt.Sprintf("FailWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *FailWorkflowExecutionDecisionAttributes) Equals(rhs *FailWorkflowExecutionDecisionAttributes) bool {
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+		return false
+	}
+	return true
+}
+
+type GetSearchAttributesResponse struct {
+	Keys map[string]IndexedValueType `json:"keys"`
+}
+
+type _Map_String_IndexedValueType_MapItemList map[string]IndexedValueType
+
+func (m _Map_String_IndexedValueType_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_IndexedValueType_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_IndexedValueType_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_IndexedValueType_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_String_IndexedValueType_MapItemList) Close() {
+}
+
+func (v *GetSearchAttributesResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.Keys != nil {
+		w, err = wire.NewValueMap(_Map_String_IndexedValueType_MapItemList(v.Keys)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _IndexedValueType_Read(w wire.Value) (IndexedValueType, error) {
+	var v IndexedValueType
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Map_String_IndexedValueType_Read(m wire.MapItemList) (map[string]IndexedValueType, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+	o := make(map[string]IndexedValueType, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		v, err := _IndexedValueType_Read(x.Value)
+		if err != nil {
+			return err
+		}
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func (v *GetSearchAttributesResponse) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TMap {
+				v.Keys, err = _Map_String_IndexedValueType_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *GetSearchAttributesResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [1]string
+	i := 0
+	if v.Keys != nil {
+		fields[i] = fmt.Sprintf("Keys: %v", v.Keys)
+		i++
+	}
+	return fmt.Sprintf("GetSearchAttributesResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_String_IndexedValueType_Equals(lhs, rhs map[string]IndexedValueType) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *GetSearchAttributesResponse) Equals(rhs *GetSearchAttributesResponse) bool {
+	if !((v.Keys == nil && rhs.Keys == nil) || (v.Keys != nil && rhs.Keys != nil && _Map_String_IndexedValueType_Equals(v.Keys, rhs.Keys))) {
+		return false
+	}
+	return true
+}
+
+type GetWorkflowExecutionHistoryRequest struct {
+	Domain                 *string                 `json:"domain,omitempty"`
+	Execution              *WorkflowExecution      `json:"execution,omitempty"`
+	MaximumPageSize        *int32                  `json:"maximumPageSize,omitempty"`
+	NextPageToken          []byte                  `json:"nextPageToken"`
+	WaitForNewEvent        *bool                   `json:"waitForNewEvent,omitempty"`
+	HistoryEventFilterType *HistoryEventFilterType `json:"historyEventFilterType,omitempty"`
+}
+
+func (v *GetWorkflowExecutionHistoryRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.WaitForNewEvent != nil {
+		w, err = wire.NewValueBool(*(v.WaitForNewEvent)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.HistoryEventFilterType != nil {
+		w, err = v.HistoryEventFilterType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _HistoryEventFilterType_Read(w wire.Value) (HistoryEventFilterType, error) {
+	var v HistoryEventFilterType
+	err := v.FromWire(w)
+	return v, err
+}
+
+func (v *GetWorkflowExecutionHistoryRequest) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
+		case 50:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.WaitForNewEvent = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x HistoryEventFilterType
+				x, err = _HistoryEventFilterType_Read(field.Value)
+				v.HistoryEventFilterType = &x
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *GetWorkflowExecutionHistoryRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [6]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+	if v.WaitForNewEvent != nil {
+		fields[i] = fmt.Sprintf("WaitForNewEvent: %v", *(v.WaitForNewEvent))
+		i++
+	}
+	if v.HistoryEventFilterType != nil {
+		fields[i] = fmt.Sprintf("HistoryEventFilterType: %v", *(v.HistoryEventFilterType))
+		i++
+	}
+	return fmt.Sprintf("GetWorkflowExecutionHistoryRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _HistoryEventFilterType_EqualsPtr(lhs, rhs *HistoryEventFilterType) bool {
+	if lhs != nil && rhs != nil {
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func (v *GetWorkflowExecutionHistoryRequest) Equals(rhs *GetWorkflowExecutionHistoryRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.WaitForNewEvent, rhs.WaitForNewEvent) {
+		return false
+	}
+	if !_HistoryEventFilterType_EqualsPtr(v.HistoryEventFilterType, rhs.HistoryEventFilterType) {
+		return false
+	}
+	return true
+}
+
+type GetWorkflowExecutionHistoryResponse struct {
+	History       *History `json:"history,omitempty"`
+	NextPageToken []byte   `json:"nextPageToken"`
+}
+
+func (v *GetWorkflowExecutionHistoryResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.History != nil {
+		w, err = v.History.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _History_Read(w wire.Value) (*History, error) {
+	var v History
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func (v *GetWorkflowExecutionHistoryResponse) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.History, err = _History_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *GetWorkflowExecutionHistoryResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [2]string
+	i := 0
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+	return fmt.Sprintf("GetWorkflowExecutionHistoryResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *GetWorkflowExecutionHistoryResponse) Equals(rhs *GetWorkflowExecutionHistoryResponse) bool {
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	return true
+}
+
+type History struct {
+	Events []*HistoryEvent `json:"events"`
+}
+
+type _List_HistoryEvent_ValueList []*HistoryEvent
+
+func (v _List_HistoryEvent_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_HistoryEvent_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_HistoryEvent_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_HistoryEvent_ValueList) Close() {
+}
+
+func (v *History) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.Events != nil {
+		w, err = wire.NewValueList(_List_HistoryEvent_ValueList(v.Events)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _HistoryEvent_Read(w wire.Value) (*HistoryEvent, error) {
+	var v HistoryEvent
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_HistoryEvent_Read(l wire.ValueList) ([]*HistoryEvent, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+	o := make([]*HistoryEvent, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _HistoryEvent_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func (v *History) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Events, err = _List_HistoryEvent_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *History) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [1]string
+	i := 0
+	if v.Events != nil {
+		fields[i] = fmt.Sprintf("Events: %v", v.Events)
+		i++
+	}
+	return fmt.Sprintf("History{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_HistoryEvent_Equals(lhs, rhs []*HistoryEvent) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *History) Equals(rhs *History) bool {
+	if !((v.Events == nil && rhs.Events == nil) || (v.Events != nil && rhs.Events != nil && _List_HistoryEvent_Equals(v.Events, rhs.Events))) {
+		return false
+	}
+	return true
+}
+
+type HistoryEvent struct {
+	EventId                                                        *int64                                                          `json:"eventId,omitempty"`
+	Timestamp                                                      *int64                                                          `json:"timestamp,omitempty"`
+	EventType                                                      *EventType                                                      `json:"eventType,omitempty"`
+	WorkflowExecutionStartedEventAttributes                        *WorkflowExecutionStartedEventAttributes                        `json:"workflowExecutionStartedEventAttributes,omitempty"`
+	WorkflowExecutionCompletedEventAttributes                      *WorkflowExecutionCompletedEventAttributes                      `json:"workflowExecutionCompletedEventAttributes,omitempty"`
+	WorkflowExecutionFailedEventAttributes                         *WorkflowExecutionFailedEventAttributes                         `json:"workflowExecutionFailedEventAttributes,omitempty"`
+	WorkflowExecutionTimedOutEventAttributes                       *WorkflowExecutionTimedOutEventAttributes                       `json:"workflowExecutionTimedOutEventAttributes,omitempty"`
+	DecisionTaskScheduledEventAttributes                           *DecisionTaskScheduledEventAttributes                           `json:"decisionTaskScheduledEventAttributes,omitempty"`
+	DecisionTaskStartedEventAttributes                             *DecisionTaskStartedEventAttributes                             `json:"decisionTaskStartedEventAttributes,omitempty"`
+	DecisionTaskCompletedEventAttributes                           *DecisionTaskCompletedEventAttributes                           `json:"decisionTaskCompletedEventAttributes,omitempty"`
+	DecisionTaskTimedOutEventAttributes                            *DecisionTaskTimedOutEventAttributes                            `json:"decisionTaskTimedOutEventAttributes,omitempty"`
+	DecisionTaskFailedEventAttributes                              *DecisionTaskFailedEventAttributes                              `json:"decisionTaskFailedEventAttributes,omitempty"`
+	ActivityTaskScheduledEventAttributes                           *ActivityTaskScheduledEventAttributes                           `json:"activityTaskScheduledEventAttributes,omitempty"`
+	ActivityTaskStartedEventAttributes                             *ActivityTaskStartedEventAttributes                             `json:"activityTaskStartedEventAttributes,omitempty"`
+	ActivityTaskCompletedEventAttributes                           *ActivityTaskCompletedEventAttributes                           `json:"activityTaskCompletedEventAttributes,omitempty"`
+	ActivityTaskFailedEventAttributes                              *ActivityTaskFailedEventAttributes                              `json:"activityTaskFailedEventAttributes,omitempty"`
+	ActivityTaskTimedOutEventAttributes                            *ActivityTaskTimedOutEventAttributes                            `json:"activityTaskTimedOutEventAttributes,omitempty"`
+	TimerStartedEventAttributes                                    *TimerStartedEventAttributes                                    `json:"timerStartedEventAttributes,omitempty"`
+	TimerFiredEventAttributes                                      *TimerFiredEventAttributes                                      `json:"timerFiredEventAttributes,omitempty"`
+	ActivityTaskCancelRequestedEventAttributes                     *ActivityTaskCancelRequestedEventAttributes                     `json:"activityTaskCancelRequestedEventAttributes,omitempty"`
+	RequestCancelActivityTaskFailedEventAttributes                 *RequestCancelActivityTaskFailedEventAttributes                 `json:"requestCancelActivityTaskFailedEventAttributes,omitempty"`
+	ActivityTaskCanceledEventAttributes                            *ActivityTaskCanceledEventAttributes                            `json:"activityTaskCanceledEventAttributes,omitempty"`
+	TimerCanceledEventAttributes                                   *TimerCanceledEventAttributes                                   `json:"timerCanceledEventAttributes,omitempty"`
+	CancelTimerFailedEventAttributes                               *CancelTimerFailedEventAttributes                               `json:"cancelTimerFailedEventAttributes,omitempty"`
+	MarkerRecordedEventAttributes                                  *MarkerRecordedEventAttributes                                  `json:"markerRecordedEventAttributes,omitempty"`
+	WorkflowExecutionSignaledEventAttributes                       *WorkflowExecutionSignaledEventAttributes                       `json:"workflowExecutionSignaledEventAttributes,omitempty"`
+	WorkflowExecutionTerminatedEventAttributes                     *WorkflowExecutionTerminatedEventAttributes                     `json:"workflowExecutionTerminatedEventAttributes,omitempty"`
+	WorkflowExecutionCancelRequestedEventAttributes                *WorkflowExecutionCancelRequestedEventAttributes                `json:"workflowExecutionCancelRequestedEventAttributes,omitempty"`
+	WorkflowExecutionCanceledEventAttributes                       *WorkflowExecutionCanceledEventAttributes                       `json:"workflowExecutionCanceledEventAttributes,omitempty"`
+	RequestCancelExternalWorkflowExecutionInitiatedEventAttributes *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes `json:"requestCancelExternalWorkflowExecutionInitiatedEventAttributes,omitempty"`
+	RequestCancelExternalWorkflowExecutionFailedEventAttributes    *RequestCancelExternalWorkflowExecutionFailedEventAttributes    `json:"requestCancelExternalWorkflowExecutionFailedEventAttributes,omitempty"`
+	ExternalWorkflowExecutionCancelRequestedEventAttributes        *ExternalWorkflowExecutionCancelRequestedEventAttributes        `json:"externalWorkflowExecutionCancelRequestedEventAttributes,omitempty"`
+	WorkflowExecutionContinuedAsNewEventAttributes                 *WorkflowExecutionContinuedAsNewEventAttributes                 `json:"workflowExecutionContinuedAsNewEventAttributes,omitempty"`
+	StartChildWorkflowExecutionInitiatedEventAttributes            *StartChildWorkflowExecutionInitiatedEventAttributes            `json:"startChildWorkflowExecutionInitiatedEventAttributes,omitempty"`
+	StartChildWorkflowExecutionFailedEventAttributes               *StartChildWorkflowExecutionFailedEventAttributes               `json:"startChildWorkflowExecutionFailedEventAttributes,omitempty"`
+	ChildWorkflowExecutionStartedEventAttributes                   *ChildWorkflowExecutionStartedEventAttributes                   `json:"childWorkflowExecutionStartedEventAttributes,omitempty"`
+	ChildWorkflowExecutionCompletedEventAttributes                 *ChildWorkflowExecutionCompletedEventAttributes                 `json:"childWorkflowExecutionCompletedEventAttributes,omitempty"`
+	ChildWorkflowExecutionFailedEventAttributes                    *ChildWorkflowExecutionFailedEventAttributes                    `json:"childWorkflowExecutionFailedEventAttributes,omitempty"`
+	ChildWorkflowExecutionCanceledEventAttributes                  *ChildWorkflowExecutionCanceledEventAttributes                  `json:"childWorkflowExecutionCanceledEventAttributes,omitempty"`
+	ChildWorkflowExecutionTimedOutEventAttributes                  *ChildWorkflowExecutionTimedOutEventAttributes                  `json:"childWorkflowExecutionTimedOutEventAttributes,omitempty"`
+	ChildWorkflowExecutionTerminatedEventAttributes                *ChildWorkflowExecutionTerminatedEventAttributes                `json:"childWorkflowExecutionTerminatedEventAttributes,omitempty"`
+	SignalExternalWorkflowExecutionInitiatedEventAttributes        *SignalExternalWorkflowExecutionInitiatedEventAttributes        `json:"signalExternalWorkflowExecutionInitiatedEventAttributes,omitempty"`
+	SignalExternalWorkflowExecutionFailedEventAttributes           *SignalExternalWorkflowExecutionFailedEventAttributes           `json:"signalExternalWorkflowExecutionFailedEventAttributes,omitempty"`
+	ExternalWorkflowExecutionSignaledEventAttributes               *ExternalWorkflowExecutionSignaledEventAttributes               `json:"externalWorkflowExecutionSignaledEventAttributes,omitempty"`
+	UpsertWorkflowSearchAttributesEventAttributes                  *UpsertWorkflowSearchAttributesEventAttributes                  `json:"upsertWorkflowSearchAttributesEventAttributes,omitempty"`
+}
+
+func (v *HistoryEvent) ToWire() (wire.Value, error) {
+	var (
+		fields [45]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.EventId != nil {
+		w, err = wire.NewValueI64(*(v.EventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Timestamp != nil {
+		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.EventType != nil {
+		w, err = v.EventType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionStartedEventAttributes != nil {
+		w, err = v.WorkflowExecutionStartedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionCompletedEventAttributes != nil {
+		w, err = v.WorkflowExecutionCompletedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionFailedEventAttributes != nil {
+		w, err = v.WorkflowExecutionFailedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionTimedOutEventAttributes != nil {
+		w, err = v.WorkflowExecutionTimedOutEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.DecisionTaskScheduledEventAttributes != nil {
+		w, err = v.DecisionTaskScheduledEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.DecisionTaskStartedEventAttributes != nil {
+		w, err = v.DecisionTaskStartedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.DecisionTaskCompletedEventAttributes != nil {
+		w, err = v.DecisionTaskCompletedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.DecisionTaskTimedOutEventAttributes != nil {
+		w, err = v.DecisionTaskTimedOutEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.DecisionTaskFailedEventAttributes != nil {
+		w, err = v.DecisionTaskFailedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.ActivityTaskScheduledEventAttributes != nil {
+		w, err = v.ActivityTaskScheduledEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.ActivityTaskStartedEventAttributes != nil {
+		w, err = v.ActivityTaskStartedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
+	if v.ActivityTaskCompletedEventAttributes != nil {
+		w, err = v.ActivityTaskCompletedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 150, Value: w}
+		i++
+	}
+	if v.ActivityTaskFailedEventAttributes != nil {
+		w, err = v.ActivityTaskFailedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 160, Value: w}
+		i++
+	}
+	if v.ActivityTaskTimedOutEventAttributes != nil {
+		w, err = v.ActivityTaskTimedOutEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 170, Value: w}
+		i++
+	}
+	if v.TimerStartedEventAttributes != nil {
+		w, err = v.TimerStartedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 180, Value: w}
+		i++
+	}
+	if v.TimerFiredEventAttributes != nil {
+		w, err = v.TimerFiredEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 190, Value: w}
+		i++
+	}
+	if v.ActivityTaskCancelRequestedEventAttributes != nil {
+		w, err = v.ActivityTaskCancelRequestedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 200, Value: w}
+		i++
+	}
+	if v.RequestCancelActivityTaskFailedEventAttributes != nil {
+		w, err = v.RequestCancelActivityTaskFailedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 210, Value: w}
+		i++
+	}
+	if v.ActivityTaskCanceledEventAttributes != nil {
+		w, err = v.ActivityTaskCanceledEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 220, Value: w}
+		i++
+	}
+	if v.TimerCanceledEventAttributes != nil {
+		w, err = v.TimerCanceledEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 230, Value: w}
+		i++
+	}
+	if v.CancelTimerFailedEventAttributes != nil {
+		w, err = v.CancelTimerFailedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 240, Value: w}
+		i++
+	}
+	if v.MarkerRecordedEventAttributes != nil {
+		w, err = v.MarkerRecordedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 250, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionSignaledEventAttributes != nil {
+		w, err = v.WorkflowExecutionSignaledEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 260, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionTerminatedEventAttributes != nil {
+		w, err = v.WorkflowExecutionTerminatedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 270, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionCancelRequestedEventAttributes != nil {
+		w, err = v.WorkflowExecutionCancelRequestedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 280, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionCanceledEventAttributes != nil {
+		w, err = v.WorkflowExecutionCanceledEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 290, Value: w}
+		i++
+	}
+	if v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil {
+		w, err = v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 300, Value: w}
+		i++
+	}
+	if v.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil {
+		w, err = v.RequestCancelExternalWorkflowExecutionFailedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 310, Value: w}
+		i++
+	}
+	if v.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil {
+		w, err = v.ExternalWorkflowExecutionCancelRequestedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 320, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionContinuedAsNewEventAttributes != nil {
+		w, err = v.WorkflowExecutionContinuedAsNewEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 330, Value: w}
+		i++
+	}
+	if v.StartChildWorkflowExecutionInitiatedEventAttributes != nil {
+		w, err = v.StartChildWorkflowExecutionInitiatedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 340, Value: w}
+		i++
+	}
+	if v.StartChildWorkflowExecutionFailedEventAttributes != nil {
+		w, err = v.StartChildWorkflowExecutionFailedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 350, Value: w}
+		i++
+	}
+	if v.ChildWorkflowExecutionStartedEventAttributes != nil {
+		w, err = v.ChildWorkflowExecutionStartedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 360, Value: w}
+		i++
+	}
+	if v.ChildWorkflowExecutionCompletedEventAttributes != nil {
+		w, err = v.ChildWorkflowExecutionCompletedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 370, Value: w}
+		i++
+	}
+	if v.ChildWorkflowExecutionFailedEventAttributes != nil {
+		w, err = v.ChildWorkflowExecutionFailedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 380, Value: w}
+		i++
+	}
+	if v.ChildWorkflowExecutionCanceledEventAttributes != nil {
+		w, err = v.ChildWorkflowExecutionCanceledEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 390, Value: w}
+		i++
+	}
+	if v.ChildWorkflowExecutionTimedOutEventAttributes != nil {
+		w, err = v.ChildWorkflowExecutionTimedOutEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 400, Value: w}
+		i++
+	}
+	if v.ChildWorkflowExecutionTerminatedEventAttributes != nil {
+		w, err = v.ChildWorkflowExecutionTerminatedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 410, Value: w}
+		i++
+	}
+	if v.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil {
+		w, err = v.SignalExternalWorkflowExecutionInitiatedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 420, Value: w}
+		i++
+	}
+	if v.SignalExternalWorkflowExecutionFailedEventAttributes != nil {
+		w, err = v.SignalExternalWorkflowExecutionFailedEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 430, Value: w}
+		i++
+	}
+	if v.ExternalWorkflowExecutionSignaledEventAttributes != nil {
+		w, err = v.ExternalWorkflowExecutionSignaledEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 440, Value: w}
+		i++
+	}
+	if v.UpsertWorkflowSearchAttributesEventAttributes != nil {
+		w, err = v.UpsertWorkflowSearchAttributesEventAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 450, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _EventType_Read(w wire.Value) (EventType, error) {
+	var v EventType
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _WorkflowExecutionStartedEventAttributes_Read(w wire.Value) (*WorkflowExecutionStartedEventAttributes, error) {
+	var v WorkflowExecutionStartedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionCompletedEventAttributes_Read(w wire.Value) (*WorkflowExecutionCompletedEventAttributes, error) {
+	var v WorkflowExecutionCompletedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionFailedEventAttributes_Read(w wire.Value) (*WorkflowExecutionFailedEventAttributes, error) {
+	var v WorkflowExecutionFailedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionTimedOutEventAttributes_Read(w wire.Value) (*WorkflowExecutionTimedOutEventAttributes, error) {
+	var v WorkflowExecutionTimedOutEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DecisionTaskScheduledEventAttributes_Read(w wire.Value) (*DecisionTaskScheduledEventAttributes, error) {
+	var v DecisionTaskScheduledEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DecisionTaskStartedEventAttributes_Read(w wire.Value) (*DecisionTaskStartedEventAttributes, error) {
+	var v DecisionTaskStartedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DecisionTaskCompletedEventAttributes_Read(w wire.Value) (*DecisionTaskCompletedEventAttributes, error) {
+	var v DecisionTaskCompletedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DecisionTaskTimedOutEventAttributes_Read(w wire.Value) (*DecisionTaskTimedOutEventAttributes, error) {
+	var v DecisionTaskTimedOutEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DecisionTaskFailedEventAttributes_Read(w wire.Value) (*DecisionTaskFailedEventAttributes, error) {
+	var v DecisionTaskFailedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ActivityTaskScheduledEventAttributes_Read(w wire.Value) (*ActivityTaskScheduledEventAttributes, error) {
+	var v ActivityTaskScheduledEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ActivityTaskStartedEventAttributes_Read(w wire.Value) (*ActivityTaskStartedEventAttributes, error) {
+	var v ActivityTaskStartedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ActivityTaskCompletedEventAttributes_Read(w wire.Value) (*ActivityTaskCompletedEventAttributes, error) {
+	var v ActivityTaskCompletedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ActivityTaskFailedEventAttributes_Read(w wire.Value) (*ActivityTaskFailedEventAttributes, error) {
+	var v ActivityTaskFailedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ActivityTaskTimedOutEventAttributes_Read(w wire.Value) (*ActivityTaskTimedOutEventAttributes, error) {
+	var v ActivityTaskTimedOutEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _TimerStartedEventAttributes_Read(w wire.Value) (*TimerStartedEventAttributes, error) {
+	var v TimerStartedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _TimerFiredEventAttributes_Read(w wire.Value) (*TimerFiredEventAttributes, error) {
+	var v TimerFiredEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ActivityTaskCancelRequestedEventAttributes_Read(w wire.Value) (*ActivityTaskCancelRequestedEventAttributes, error) {
+	var v ActivityTaskCancelRequestedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _RequestCancelActivityTaskFailedEventAttributes_Read(w wire.Value) (*RequestCancelActivityTaskFailedEventAttributes, error) {
+	var v RequestCancelActivityTaskFailedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ActivityTaskCanceledEventAttributes_Read(w wire.Value) (*ActivityTaskCanceledEventAttributes, error) {
+	var v ActivityTaskCanceledEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _TimerCanceledEventAttributes_Read(w wire.Value) (*TimerCanceledEventAttributes, error) {
+	var v TimerCanceledEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _CancelTimerFailedEventAttributes_Read(w wire.Value) (*CancelTimerFailedEventAttributes, error) {
+	var v CancelTimerFailedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _MarkerRecordedEventAttributes_Read(w wire.Value) (*MarkerRecordedEventAttributes, error) {
+	var v MarkerRecordedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionSignaledEventAttributes_Read(w wire.Value) (*WorkflowExecutionSignaledEventAttributes, error) {
+	var v WorkflowExecutionSignaledEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionTerminatedEventAttributes_Read(w wire.Value) (*WorkflowExecutionTerminatedEventAttributes, error) {
+	var v WorkflowExecutionTerminatedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionCancelRequestedEventAttributes_Read(w wire.Value) (*WorkflowExecutionCancelRequestedEventAttributes, error) {
+	var v WorkflowExecutionCancelRequestedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionCanceledEventAttributes_Read(w wire.Value) (*WorkflowExecutionCanceledEventAttributes, error) {
+	var v WorkflowExecutionCanceledEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _RequestCancelExternalWorkflowExecutionInitiatedEventAttributes_Read(w wire.Value) (*RequestCancelExternalWorkflowExecutionInitiatedEventAttributes, error) {
+	var v RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _RequestCancelExternalWorkflowExecutionFailedEventAttributes_Read(w wire.Value) (*RequestCancelExternalWorkflowExecutionFailedEventAttributes, error) {
+	var v RequestCancelExternalWorkflowExecutionFailedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ExternalWorkflowExecutionCancelRequestedEventAttributes_Read(w wire.Value) (*ExternalWorkflowExecutionCancelRequestedEventAttributes, error) {
+	var v ExternalWorkflowExecutionCancelRequestedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionContinuedAsNewEventAttributes_Read(w wire.Value) (*WorkflowExecutionContinuedAsNewEventAttributes, error) {
+	var v WorkflowExecutionContinuedAsNewEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _StartChildWorkflowExecutionInitiatedEventAttributes_Read(w wire.Value) (*StartChildWorkflowExecutionInitiatedEventAttributes, error) {
+	var v StartChildWorkflowExecutionInitiatedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _StartChildWorkflowExecutionFailedEventAttributes_Read(w wire.Value) (*StartChildWorkflowExecutionFailedEventAttributes, error) {
+	var v StartChildWorkflowExecutionFailedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ChildWorkflowExecutionStartedEventAttributes_Read(w wire.Value) (*ChildWorkflowExecutionStartedEventAttributes, error) {
+	var v ChildWorkflowExecutionStartedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ChildWorkflowExecutionCompletedEventAttributes_Read(w wire.Value) (*ChildWorkflowExecutionCompletedEventAttributes, error) {
+	var v ChildWorkflowExecutionCompletedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ChildWorkflowExecutionFailedEventAttributes_Read(w wire.Value) (*ChildWorkflowExecutionFailedEventAttributes, error) {
+	var v ChildWorkflowExecutionFailedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ChildWorkflowExecutionCanceledEventAttributes_Read(w wire.Value) (*ChildWorkflowExecutionCanceledEventAttributes, error) {
+	var v ChildWorkflowExecutionCanceledEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ChildWorkflowExecutionTimedOutEventAttributes_Read(w wire.Value) (*ChildWorkflowExecutionTimedOutEventAttributes, error) {
+	var v ChildWorkflowExecutionTimedOutEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ChildWorkflowExecutionTerminatedEventAttributes_Read(w wire.Value) (*ChildWorkflowExecutionTerminatedEventAttributes, error) {
+	var v ChildWorkflowExecutionTerminatedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _SignalExternalWorkflowExecutionInitiatedEventAttributes_Read(w wire.Value) (*SignalExternalWorkflowExecutionInitiatedEventAttributes, error) {
+	var v SignalExternalWorkflowExecutionInitiatedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _SignalExternalWorkflowExecutionFailedEventAttributes_Read(w wire.Value) (*SignalExternalWorkflowExecutionFailedEventAttributes, error) {
+	var v SignalExternalWorkflowExecutionFailedEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ExternalWorkflowExecutionSignaledEventAttributes_Read(w wire.Value) (*ExternalWorkflowExecutionSignaledEventAttributes, error) {
+	var v ExternalWorkflowExecutionSignaledEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _UpsertWorkflowSearchAttributesEventAttributes_Read(w wire.Value) (*UpsertWorkflowSearchAttributesEventAttributes, error) {
+	var v UpsertWorkflowSearchAttributesEventAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func (v *HistoryEvent) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.EventId = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Timestamp = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x EventType
+				x, err = _EventType_Read(field.Value)
+				v.EventType = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionStartedEventAttributes, err = _WorkflowExecutionStartedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionCompletedEventAttributes, err = _WorkflowExecutionCompletedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionFailedEventAttributes, err = _WorkflowExecutionFailedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionTimedOutEventAttributes, err = _WorkflowExecutionTimedOutEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 80:
+			if field.Value.Type() == wire.TStruct {
+				v.DecisionTaskScheduledEventAttributes, err = _DecisionTaskScheduledEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 90:
+			if field.Value.Type() == wire.TStruct {
+				v.DecisionTaskStartedEventAttributes, err = _DecisionTaskStartedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 100:
+			if field.Value.Type() == wire.TStruct {
+				v.DecisionTaskCompletedEventAttributes, err = _DecisionTaskCompletedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 110:
+			if field.Value.Type() == wire.TStruct {
+				v.DecisionTaskTimedOutEventAttributes, err = _DecisionTaskTimedOutEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 120:
+			if field.Value.Type() == wire.TStruct {
+				v.DecisionTaskFailedEventAttributes, err = _DecisionTaskFailedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 130:
+			if field.Value.Type() == wire.TStruct {
+				v.ActivityTaskScheduledEventAttributes, err = _ActivityTaskScheduledEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 140:
+			if field.Value.Type() == wire.TStruct {
+				v.ActivityTaskStartedEventAttributes, err = _ActivityTaskStartedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 150:
+			if field.Value.Type() == wire.TStruct {
+				v.ActivityTaskCompletedEventAttributes, err = _ActivityTaskCompletedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 160:
+			if field.Value.Type() == wire.TStruct {
+				v.ActivityTaskFailedEventAttributes, err = _ActivityTaskFailedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 170:
+			if field.Value.Type() == wire.TStruct {
+				v.ActivityTaskTimedOutEventAttributes, err = _ActivityTaskTimedOutEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 180:
+			if field.Value.Type() == wire.TStruct {
+				v.TimerStartedEventAttributes, err = _TimerStartedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 190:
+			if field.Value.Type() == wire.TStruct {
+				v.TimerFiredEventAttributes, err = _TimerFiredEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 200:
+			if field.Value.Type() == wire.TStruct {
+				v.ActivityTaskCancelRequestedEventAttributes, err = _ActivityTaskCancelRequestedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 210:
+			if field.Value.Type() == wire.TStruct {
+				v.RequestCancelActivityTaskFailedEventAttributes, err = _RequestCancelActivityTaskFailedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 220:
+			if field.Value.Type() == wire.TStruct {
+				v.ActivityTaskCanceledEventAttributes, err = _ActivityTaskCanceledEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 230:
+			if field.Value.Type() == wire.TStruct {
+				v.TimerCanceledEventAttributes, err = _TimerCanceledEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 240:
+			if field.Value.Type() == wire.TStruct {
+				v.CancelTimerFailedEventAttributes, err = _CancelTimerFailedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 250:
+			if field.Value.Type() == wire.TStruct {
+				v.MarkerRecordedEventAttributes, err = _MarkerRecordedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 260:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionSignaledEventAttributes, err = _WorkflowExecutionSignaledEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 270:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionTerminatedEventAttributes, err = _WorkflowExecutionTerminatedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 280:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionCancelRequestedEventAttributes, err = _WorkflowExecutionCancelRequestedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 290:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionCanceledEventAttributes, err = _WorkflowExecutionCanceledEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 300:
+			if field.Value.Type() == wire.TStruct {
+				v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes, err = _RequestCancelExternalWorkflowExecutionInitiatedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 310:
+			if field.Value.Type() == wire.TStruct {
+				v.RequestCancelExternalWorkflowExecutionFailedEventAttributes, err = _RequestCancelExternalWorkflowExecutionFailedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 320:
+			if field.Value.Type() == wire.TStruct {
+				v.ExternalWorkflowExecutionCancelRequestedEventAttributes, err = _ExternalWorkflowExecutionCancelRequestedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 330:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionContinuedAsNewEventAttributes, err = _WorkflowExecutionContinuedAsNewEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 340:
+			if field.Value.Type() == wire.TStruct {
+				v.StartChildWorkflowExecutionInitiatedEventAttributes, err = _StartChildWorkflowExecutionInitiatedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 350:
+			if field.Value.Type() == wire.TStruct {
+				v.StartChildWorkflowExecutionFailedEventAttributes, err = _StartChildWorkflowExecutionFailedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 360:
+			if field.Value.Type() == wire.TStruct {
+				v.ChildWorkflowExecutionStartedEventAttributes, err = _ChildWorkflowExecutionStartedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 370:
+			if field.Value.Type() == wire.TStruct {
+				v.ChildWorkflowExecutionCompletedEventAttributes, err = _ChildWorkflowExecutionCompletedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 380:
+			if field.Value.Type() == wire.TStruct {
+				v.ChildWorkflowExecutionFailedEventAttributes, err = _ChildWorkflowExecutionFailedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 390:
+			if field.Value.Type() == wire.TStruct {
+				v.ChildWorkflowExecutionCanceledEventAttributes, err = _ChildWorkflowExecutionCanceledEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 400:
+			if field.Value.Type() == wire.TStruct {
+				v.ChildWorkflowExecutionTimedOutEventAttributes, err = _ChildWorkflowExecutionTimedOutEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 410:
+			if field.Value.Type() == wire.TStruct {
+				v.ChildWorkflowExecutionTerminatedEventAttributes, err = _ChildWorkflowExecutionTerminatedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 420:
+			if field.Value.Type() == wire.TStruct {
+				v.SignalExternalWorkflowExecutionInitiatedEventAttributes, err = _SignalExternalWorkflowExecutionInitiatedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 430:
+			if field.Value.Type() == wire.TStruct {
+				v.SignalExternalWorkflowExecutionFailedEventAttributes, err = _SignalExternalWorkflowExecutionFailedEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 440:
+			if field.Value.Type() == wire.TStruct {
+				v.ExternalWorkflowExecutionSignaledEventAttributes, err = _ExternalWorkflowExecutionSignaledEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 450:
+			if field.Value.Type() == wire.TStruct {
+				v.UpsertWorkflowSearchAttributesEventAttributes, err = _UpsertWorkflowSearchAttributesEventAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *HistoryEvent) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [45]string
+	i := 0
+	if v.EventId != nil {
+		fields[i] = fmt.Sprintf("EventId: %v", *(v.EventId))
+		i++
+	}
+	if v.Timestamp != nil {
+		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
+		i++
+	}
+	if v.EventType != nil {
+		fields[i] = fmt.Sprintf("EventType: %v", *(v.EventType))
+		i++
+	}
+	if v.WorkflowExecutionStartedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionStartedEventAttributes: %v", v.WorkflowExecutionStartedEventAttributes)
+		i++
+	}
+	if v.WorkflowExecutionCompletedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionCompletedEventAttributes: %v", v.WorkflowExecutionCompletedEventAttributes)
+		i++
+	}
+	if v.WorkflowExecutionFailedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionFailedEventAttributes: %v", v.WorkflowExecutionFailedEventAttributes)
+		i++
+	}
+	if v.WorkflowExecutionTimedOutEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionTimedOutEventAttributes: %v", v.WorkflowExecutionTimedOutEventAttributes)
+		i++
+	}
+	if v.DecisionTaskScheduledEventAttributes != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskScheduledEventAttributes: %v", v.DecisionTaskScheduledEventAttributes)
+		i++
+	}
+	if v.DecisionTaskStartedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskStartedEventAttributes: %v", v.DecisionTaskStartedEventAttributes)
+		i++
+	}
+	if v.DecisionTaskCompletedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventAttributes: %v", v.DecisionTaskCompletedEventAttributes)
+		i++
+	}
+	if v.DecisionTaskTimedOutEventAttributes != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskTimedOutEventAttributes: %v", v.DecisionTaskTimedOutEventAttributes)
+		i++
+	}
+	if v.DecisionTaskFailedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskFailedEventAttributes: %v", v.DecisionTaskFailedEventAttributes)
+		i++
+	}
+	if v.ActivityTaskScheduledEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ActivityTaskScheduledEventAttributes: %v", v.ActivityTaskScheduledEventAttributes)
+		i++
+	}
+	if v.ActivityTaskStartedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ActivityTaskStartedEventAttributes: %v", v.ActivityTaskStartedEventAttributes)
+		i++
+	}
+	if v.ActivityTaskCompletedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ActivityTaskCompletedEventAttributes: %v", v.ActivityTaskCompletedEventAttributes)
+		i++
+	}
+	if v.ActivityTaskFailedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ActivityTaskFailedEventAttributes: %v", v.ActivityTaskFailedEventAttributes)
+		i++
+	}
+	if v.ActivityTaskTimedOutEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ActivityTaskTimedOutEventAttributes: %v", v.ActivityTaskTimedOutEventAttributes)
+		i++
+	}
+	if v.TimerStartedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("TimerStartedEventAttributes: %v", v.TimerStartedEventAttributes)
+		i++
+	}
+	if v.TimerFiredEventAttributes != nil {
+		fields[i] = fmt.Sprintf("TimerFiredEventAttributes: %v", v.TimerFiredEventAttributes)
+		i++
+	}
+	if v.ActivityTaskCancelRequestedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ActivityTaskCancelRequestedEventAttributes: %v", v.ActivityTaskCancelRequestedEventAttributes)
+		i++
+	}
+	if v.RequestCancelActivityTaskFailedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("RequestCancelActivityTaskFailedEventAttributes: %v", v.RequestCancelActivityTaskFailedEventAttributes)
+		i++
+	}
+	if v.ActivityTaskCanceledEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ActivityTaskCanceledEventAttributes: %v", v.ActivityTaskCanceledEventAttributes)
+		i++
+	}
+	if v.TimerCanceledEventAttributes != nil {
+		fields[i] = fmt.Sprintf("TimerCanceledEventAttributes: %v", v.TimerCanceledEventAttributes)
+		i++
+	}
+	if v.CancelTimerFailedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("CancelTimerFailedEventAttributes: %v", v.CancelTimerFailedEventAttributes)
+		i++
+	}
+	if v.MarkerRecordedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("MarkerRecordedEventAttributes: %v", v.MarkerRecordedEventAttributes)
+		i++
+	}
+	if v.WorkflowExecutionSignaledEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionSignaledEventAttributes: %v", v.WorkflowExecutionSignaledEventAttributes)
+		i++
+	}
+	if v.WorkflowExecutionTerminatedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionTerminatedEventAttributes: %v", v.WorkflowExecutionTerminatedEventAttributes)
+		i++
+	}
+	if v.WorkflowExecutionCancelRequestedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionCancelRequestedEventAttributes: %v", v.WorkflowExecutionCancelRequestedEventAttributes)
+		i++
+	}
+	if v.WorkflowExecutionCanceledEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionCanceledEventAttributes: %v", v.WorkflowExecutionCanceledEventAttributes)
+		i++
+	}
+	if v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("RequestCancelExternalWorkflowExecutionInitiatedEventAttributes: %v", v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes)
+		i++
+	}
+	if v.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("RequestCancelExternalWorkflowExecutionFailedEventAttributes: %v", v.RequestCancelExternalWorkflowExecutionFailedEventAttributes)
+		i++
+	}
+	if v.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ExternalWorkflowExecutionCancelRequestedEventAttributes: %v", v.ExternalWorkflowExecutionCancelRequestedEventAttributes)
+		i++
+	}
+	if v.WorkflowExecutionContinuedAsNewEventAttributes != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionContinuedAsNewEventAttributes: %v", v.WorkflowExecutionContinuedAsNewEventAttributes)
+		i++
+	}
+	if v.StartChildWorkflowExecutionInitiatedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("StartChildWorkflowExecutionInitiatedEventAttributes: %v", v.StartChildWorkflowExecutionInitiatedEventAttributes)
+		i++
+	}
+	if v.StartChildWorkflowExecutionFailedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("StartChildWorkflowExecutionFailedEventAttributes: %v", v.StartChildWorkflowExecutionFailedEventAttributes)
+		i++
+	}
+	if v.ChildWorkflowExecutionStartedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowExecutionStartedEventAttributes: %v", v.ChildWorkflowExecutionStartedEventAttributes)
+		i++
+	}
+	if v.ChildWorkflowExecutionCompletedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowExecutionCompletedEventAttributes: %v", v.ChildWorkflowExecutionCompletedEventAttributes)
+		i++
+	}
+	if v.ChildWorkflowExecutionFailedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowExecutionFailedEventAttributes: %v", v.ChildWorkflowExecutionFailedEventAttributes)
+		i++
+	}
+	if v.ChildWorkflowExecutionCanceledEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowExecutionCanceledEventAttributes: %v", v.ChildWorkflowExecutionCanceledEventAttributes)
+		i++
+	}
+	if v.ChildWorkflowExecutionTimedOutEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowExecutionTimedOutEventAttributes: %v", v.ChildWorkflowExecutionTimedOutEventAttributes)
+		i++
+	}
+	if v.ChildWorkflowExecutionTerminatedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowExecutionTerminatedEventAttributes: %v", v.ChildWorkflowExecutionTerminatedEventAttributes)
+		i++
+	}
+	if v.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("SignalExternalWorkflowExecutionInitiatedEventAttributes: %v", v.SignalExternalWorkflowExecutionInitiatedEventAttributes)
+		i++
+	}
+	if v.SignalExternalWorkflowExecutionFailedEventAttributes != nil {
+		fields[i] = fmt.Sprintf("SignalExternalWorkflowExecutionFailedEventAttributes: %v", v.SignalExternalWorkflowExecutionFailedEventAttributes)
+		i++
+	}
+	if v.ExternalWorkflowExecutionSignaledEventAttributes != nil {
+		fields[i] = fmt.Sprintf("ExternalWorkflowExecutionSignaledEventAttributes: %v", v.ExternalWorkflowExecutionSignaledEventAttributes)
+		i++
+	}
+	if v.UpsertWorkflowSearchAttributesEventAttributes != nil {
+		fields[i] = fmt.Sprintf("UpsertWorkflowSearchAttributesEventAttributes: %v", v.UpsertWorkflowSearchAttributesEventAttributes)
+		i++
+	}
+	return fmt.Sprintf("HistoryEvent{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _EventType_EqualsPtr(lhs, rhs *EventType) bool {
+	if lhs != nil && rhs != nil {
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func (v *HistoryEvent) Equals(rhs *HistoryEvent) bool {
+	if !_I64_EqualsPtr(v.EventId, rhs.EventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
+		return false
+	}
+	if !_EventType_EqualsPtr(v.EventType, rhs.EventType) {
+		return false
+	}
+	if !((v.WorkflowExecutionStartedEventAttributes == nil && rhs.WorkflowExecutionStartedEventAttributes == nil) || (v.WorkflowExecutionStartedEventAttributes != nil && rhs.WorkflowExecutionStartedEventAttributes != nil && v.WorkflowExecutionStartedEventAttributes.Equals(rhs.WorkflowExecutionStartedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionCompletedEventAttributes == nil && rhs.WorkflowExecutionCompletedEventAttributes == nil) || (v.WorkflowExecutionCompletedEventAttributes != nil && rhs.WorkflowExecutionCompletedEventAttributes != nil && v.WorkflowExecutionCompletedEventAttributes.Equals(rhs.WorkflowExecutionCompletedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionFailedEventAttributes == nil && rhs.WorkflowExecutionFailedEventAttributes == nil) || (v.WorkflowExecutionFailedEventAttributes != nil && rhs.WorkflowExecutionFailedEventAttributes != nil && v.WorkflowExecutionFailedEventAttributes.Equals(rhs.WorkflowExecutionFailedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionTimedOutEventAttributes == nil && rhs.WorkflowExecutionTimedOutEventAttributes == nil) || (v.WorkflowExecutionTimedOutEventAttributes != nil && rhs.WorkflowExecutionTimedOutEventAttributes != nil && v.WorkflowExecutionTimedOutEventAttributes.Equals(rhs.WorkflowExecutionTimedOutEventAttributes))) {
+		return false
+	}
+	if !((v.DecisionTaskScheduledEventAttributes == nil && rhs.DecisionTaskScheduledEventAttributes == nil) || (v.DecisionTaskScheduledEventAttributes != nil && rhs.DecisionTaskScheduledEventAttributes != nil && v.DecisionTaskScheduledEventAttributes.Equals(rhs.DecisionTaskScheduledEventAttributes))) {
+		return false
+	}
+	if !((v.DecisionTaskStartedEventAttributes == nil && rhs.DecisionTaskStartedEventAttributes == nil) || (v.DecisionTaskStartedEventAttributes != nil && rhs.DecisionTaskStartedEventAttributes != nil && v.DecisionTaskStartedEventAttributes.Equals(rhs.DecisionTaskStartedEventAttributes))) {
+		return false
+	}
+	if !((v.DecisionTaskCompletedEventAttributes == nil && rhs.DecisionTaskCompletedEventAttributes == nil) || (v.DecisionTaskCompletedEventAttributes != nil && rhs.DecisionTaskCompletedEventAttributes != nil && v.DecisionTaskCompletedEventAttributes.Equals(rhs.DecisionTaskCompletedEventAttributes))) {
+		return false
+	}
+	if !((v.DecisionTaskTimedOutEventAttributes == nil && rhs.DecisionTaskTimedOutEventAttributes == nil) || (v.DecisionTaskTimedOutEventAttributes != nil && rhs.DecisionTaskTimedOutEventAttributes != nil && v.DecisionTaskTimedOutEventAttributes.Equals(rhs.DecisionTaskTimedOutEventAttributes))) {
+		return false
+	}
+	if !((v.DecisionTaskFailedEventAttributes == nil && rhs.DecisionTaskFailedEventAttributes == nil) || (v.DecisionTaskFailedEventAttributes != nil && rhs.DecisionTaskFailedEventAttributes != nil && v.DecisionTaskFailedEventAttributes.Equals(rhs.DecisionTaskFailedEventAttributes))) {
+		return false
+	}
+	if !((v.ActivityTaskScheduledEventAttributes == nil && rhs.ActivityTaskScheduledEventAttributes == nil) || (v.ActivityTaskScheduledEventAttributes != nil && rhs.ActivityTaskScheduledEventAttributes != nil && v.ActivityTaskScheduledEventAttributes.Equals(rhs.ActivityTaskScheduledEventAttributes))) {
+		return false
+	}
+	if !((v.ActivityTaskStartedEventAttributes == nil && rhs.ActivityTaskStartedEventAttributes == nil) || (v.ActivityTaskStartedEventAttributes != nil && rhs.ActivityTaskStartedEventAttributes != nil && v.ActivityTaskStartedEventAttributes.Equals(rhs.ActivityTaskStartedEventAttributes))) {
+		return false
+	}
+	if !((v.ActivityTaskCompletedEventAttributes == nil && rhs.ActivityTaskCompletedEventAttributes == nil) || (v.ActivityTaskCompletedEventAttributes != nil && rhs.ActivityTaskCompletedEventAttributes != nil && v.ActivityTaskCompletedEventAttributes.Equals(rhs.ActivityTaskCompletedEventAttributes))) {
+		return false
+	}
+	if !((v.ActivityTaskFailedEventAttributes == nil && rhs.ActivityTaskFailedEventAttributes == nil) || (v.ActivityTaskFailedEventAttributes != nil && rhs.ActivityTaskFailedEventAttributes != nil && v.ActivityTaskFailedEventAttributes.Equals(rhs.ActivityTaskFailedEventAttributes))) {
+		return false
+	}
+	if !((v.ActivityTaskTimedOutEventAttributes == nil && rhs.ActivityTaskTimedOutEventAttributes == nil) || (v.ActivityTaskTimedOutEventAttributes != nil && rhs.ActivityTaskTimedOutEventAttributes != nil && v.ActivityTaskTimedOutEventAttributes.Equals(rhs.ActivityTaskTimedOutEventAttributes))) {
+		return false
+	}
+	if !((v.TimerStartedEventAttributes == nil && rhs.TimerStartedEventAttributes == nil) || (v.TimerStartedEventAttributes != nil && rhs.TimerStartedEventAttributes != nil && v.TimerStartedEventAttributes.Equals(rhs.TimerStartedEventAttributes))) {
+		return false
+	}
+	if !((v.TimerFiredEventAttributes == nil && rhs.TimerFiredEventAttributes == nil) || (v.TimerFiredEventAttributes != nil && rhs.TimerFiredEventAttributes != nil && v.TimerFiredEventAttributes.Equals(rhs.TimerFiredEventAttributes))) {
+		return false
+	}
+	if !((v.ActivityTaskCancelRequestedEventAttributes == nil && rhs.ActivityTaskCancelRequestedEventAttributes == nil) || (v.ActivityTaskCancelRequestedEventAttributes != nil && rhs.ActivityTaskCancelRequestedEventAttributes != nil && v.ActivityTaskCancelRequestedEventAttributes.Equals(rhs.ActivityTaskCancelRequestedEventAttributes))) {
+		return false
+	}
+	if !((v.RequestCancelActivityTaskFailedEventAttributes == nil && rhs.RequestCancelActivityTaskFailedEventAttributes == nil) || (v.RequestCancelActivityTaskFailedEventAttributes != nil && rhs.RequestCancelActivityTaskFailedEventAttributes != nil && v.RequestCancelActivityTaskFailedEventAttributes.Equals(rhs.RequestCancelActivityTaskFailedEventAttributes))) {
+		return false
+	}
+	if !((v.ActivityTaskCanceledEventAttributes == nil && rhs.ActivityTaskCanceledEventAttributes == nil) || (v.ActivityTaskCanceledEventAttributes != nil && rhs.ActivityTaskCanceledEventAttributes != nil && v.ActivityTaskCanceledEventAttributes.Equals(rhs.ActivityTaskCanceledEventAttributes))) {
+		return false
+	}
+	if !((v.TimerCanceledEventAttributes == nil && rhs.TimerCanceledEventAttributes == nil) || (v.TimerCanceledEventAttributes != nil && rhs.TimerCanceledEventAttributes != nil && v.TimerCanceledEventAttributes.Equals(rhs.TimerCanceledEventAttributes))) {
+		return false
+	}
+	if !((v.CancelTimerFailedEventAttributes == nil && rhs.CancelTimerFailedEventAttributes == nil) || (v.CancelTimerFailedEventAttributes != nil && rhs.CancelTimerFailedEventAttributes != nil && v.CancelTimerFailedEventAttributes.Equals(rhs.CancelTimerFailedEventAttributes))) {
+		return false
+	}
+	if !((v.MarkerRecordedEventAttributes == nil && rhs.MarkerRecordedEventAttributes == nil) || (v.MarkerRecordedEventAttributes != nil && rhs.MarkerRecordedEventAttributes != nil && v.MarkerRecordedEventAttributes.Equals(rhs.MarkerRecordedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionSignaledEventAttributes == nil && rhs.WorkflowExecutionSignaledEventAttributes == nil) || (v.WorkflowExecutionSignaledEventAttributes != nil && rhs.WorkflowExecutionSignaledEventAttributes != nil && v.WorkflowExecutionSignaledEventAttributes.Equals(rhs.WorkflowExecutionSignaledEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionTerminatedEventAttributes == nil && rhs.WorkflowExecutionTerminatedEventAttributes == nil) || (v.WorkflowExecutionTerminatedEventAttributes != nil && rhs.WorkflowExecutionTerminatedEventAttributes != nil && v.WorkflowExecutionTerminatedEventAttributes.Equals(rhs.WorkflowExecutionTerminatedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionCancelRequestedEventAttributes == nil && rhs.WorkflowExecutionCancelRequestedEventAttributes == nil) || (v.WorkflowExecutionCancelRequestedEventAttributes != nil && rhs.WorkflowExecutionCancelRequestedEventAttributes != nil && v.WorkflowExecutionCancelRequestedEventAttributes.Equals(rhs.WorkflowExecutionCancelRequestedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionCanceledEventAttributes == nil && rhs.WorkflowExecutionCanceledEventAttributes == nil) || (v.WorkflowExecutionCanceledEventAttributes != nil && rhs.WorkflowExecutionCanceledEventAttributes != nil && v.WorkflowExecutionCanceledEventAttributes.Equals(rhs.WorkflowExecutionCanceledEventAttributes))) {
+		return false
+	}
+	if !((v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes == nil && rhs.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes == nil) || (v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil && rhs.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil && v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.Equals(rhs.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes))) {
+		return false
+	}
+	if !((v.RequestCancelExternalWorkflowExecutionFailedEventAttributes == nil && rhs.RequestCancelExternalWorkflowExecutionFailedEventAttributes == nil) || (v.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil && rhs.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil && v.RequestCancelExternalWorkflowExecutionFailedEventAttributes.Equals(rhs.RequestCancelExternalWorkflowExecutionFailedEventAttributes))) {
+		return false
+	}
+	if !((v.ExternalWorkflowExecutionCancelRequestedEventAttributes == nil && rhs.ExternalWorkflowExecutionCancelRequestedEventAttributes == nil) || (v.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil && rhs.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil && v.ExternalWorkflowExecutionCancelRequestedEventAttributes.Equals(rhs.ExternalWorkflowExecutionCancelRequestedEventAttributes))) {
+		return false
+	}
+	if !((v.WorkflowExecutionContinuedAsNewEventAttributes == nil && rhs.WorkflowExecutionContinuedAsNewEventAttributes == nil) || (v.WorkflowExecutionContinuedAsNewEventAttributes != nil && rhs.WorkflowExecutionContinuedAsNewEventAttributes != nil && v.WorkflowExecutionContinuedAsNewEventAttributes.Equals(rhs.WorkflowExecutionContinuedAsNewEventAttributes))) {
+		return false
+	}
+	if !((v.StartChildWorkflowExecutionInitiatedEventAttributes == nil && rhs.StartChildWorkflowExecutionInitiatedEventAttributes == nil) || (v.StartChildWorkflowExecutionInitiatedEventAttributes != nil && rhs.StartChildWorkflowExecutionInitiatedEventAttributes != nil && v.StartChildWorkflowExecutionInitiatedEventAttributes.Equals(rhs.StartChildWorkflowExecutionInitiatedEventAttributes))) {
+		return false
+	}
+	if !((v.StartChildWorkflowExecutionFailedEventAttributes == nil && rhs.StartChildWorkflowExecutionFailedEventAttributes == nil) || (v.StartChildWorkflowExecutionFailedEventAttributes != nil && rhs.StartChildWorkflowExecutionFailedEventAttributes != nil && v.StartChildWorkflowExecutionFailedEventAttributes.Equals(rhs.StartChildWorkflowExecutionFailedEventAttributes))) {
+		return false
+	}
+	if !((v.ChildWorkflowExecutionStartedEventAttributes == nil && rhs.ChildWorkflowExecutionStartedEventAttributes == nil) || (v.ChildWorkflowExecutionStartedEventAttributes != nil && rhs.ChildWorkflowExecutionStartedEventAttributes != nil && v.ChildWorkflowExecutionStartedEventAttributes.Equals(rhs.ChildWorkflowExecutionStartedEventAttributes))) {
+		return false
+	}
+	if !((v.ChildWorkflowExecutionCompletedEventAttributes == nil && rhs.ChildWorkflowExecutionCompletedEventAttributes == nil) || (v.ChildWorkflowExecutionCompletedEventAttributes != nil && rhs.ChildWorkflowExecutionCompletedEventAttributes != nil && v.ChildWorkflowExecutionCompletedEventAttributes.Equals(rhs.ChildWorkflowExecutionCompletedEventAttributes))) {
+		return false
+	}
+	if !((v.ChildWorkflowExecutionFailedEventAttributes == nil && rhs.ChildWorkflowExecutionFailedEventAttributes == nil) || (v.ChildWorkflowExecutionFailedEventAttributes != nil && rhs.ChildWorkflowExecutionFailedEventAttributes != nil && v.ChildWorkflowExecutionFailedEventAttributes.Equals(rhs.ChildWorkflowExecutionFailedEventAttributes))) {
+		return false
+	}
+	if !((v.ChildWorkflowExecutionCanceledEventAttributes == nil && rhs.ChildWorkflowExecutionCanceledEventAttributes == nil) || (v.ChildWorkflowExecutionCanceledEventAttributes != nil && rhs.ChildWorkflowExecutionCanceledEventAttributes != nil && v.ChildWorkflowExecutionCanceledEventAttributes.Equals(rhs.ChildWorkflowExecutionCanceledEventAttributes))) {
+		return false
+	}
+	if !((v.ChildWorkflowExecutionTimedOutEventAttributes == nil && rhs.ChildWorkflowExecutionTimedOutEventAttributes == nil) || (v.ChildWorkflowExecutionTimedOutEventAttributes != nil && rhs.ChildWorkflowExecutionTimedOutEventAttributes != nil && v.ChildWorkflowExecutionTimedOutEventAttributes.Equals(rhs.ChildWorkflowExecutionTimedOutEventAttributes))) {
+		return false
+	}
+	if !((v.ChildWorkflowExecutionTerminatedEventAttributes == nil && rhs.ChildWorkflowExecutionTerminatedEventAttributes == nil) || (v.ChildWorkflowExecutionTerminatedEventAttributes != nil && rhs.ChildWorkflowExecutionTerminatedEventAttributes != nil && v.ChildWorkflowExecutionTerminatedEventAttributes.Equals(rhs.ChildWorkflowExecutionTerminatedEventAttributes))) {
+		return false
+	}
+	if !((v.SignalExternalWorkflowExecutionInitiatedEventAttributes == nil && rhs.SignalExternalWorkflowExecutionInitiatedEventAttributes == nil) || (v.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil && rhs.SignalExternalWorkflowExecutionInitiatedEventAttributes != nil && v.SignalExternalWorkflowExecutionInitiatedEventAttributes.Equals(rhs.SignalExternalWorkflowExecutionInitiatedEventAttributes))) {
+		return false
+	}
+	if !((v.SignalExternalWorkflowExecutionFailedEventAttributes == nil && rhs.SignalExternalWorkflowExecutionFailedEventAttributes == nil) || (v.SignalExternalWorkflowExecutionFailedEventAttributes != nil && rhs.SignalExternalWorkflowExecutionFailedEventAttributes != nil && v.SignalExternalWorkflowExecutionFailedEventAttributes.Equals(rhs.SignalExternalWorkflowExecutionFailedEventAttributes))) {
+		return false
+	}
+	if !((v.ExternalWorkflowExecutionSignaledEventAttributes == nil && rhs.ExternalWorkflowExecutionSignaledEventAttributes == nil) || (v.ExternalWorkflowExecutionSignaledEventAttributes != nil && rhs.ExternalWorkflowExecutionSignaledEventAttributes != nil && v.ExternalWorkflowExecutionSignaledEventAttributes.Equals(rhs.ExternalWorkflowExecutionSignaledEventAttributes))) {
+		return false
+	}
+	if !((v.UpsertWorkflowSearchAttributesEventAttributes == nil && rhs.UpsertWorkflowSearchAttributesEventAttributes == nil) || (v.UpsertWorkflowSearchAttributesEventAttributes != nil && rhs.UpsertWorkflowSearchAttributesEventAttributes != nil && v.UpsertWorkflowSearchAttributesEventAttributes.Equals(rhs.UpsertWorkflowSearchAttributesEventAttributes))) {
+		return false
 	}
-	return fmt.Sprintf("EventType(%d)", w)
+	return true
 }
 
-func (v EventType) Equals(rhs EventType) bool {
+type HistoryEventFilterType int32
+
+const (
+	HistoryEventFilterTypeAllEvent   HistoryEventFilterType = 0
+	HistoryEventFilterTypeCloseEvent HistoryEventFilterType = 1
+)
+
+func HistoryEventFilterType_Values() []HistoryEventFilterType {
+	return []HistoryEventFilterType{HistoryEventFilterTypeAllEvent, HistoryEventFilterTypeCloseEvent}
+}
+
+func (v *HistoryEventFilterType) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "ALL_EVENT":
+		*v = HistoryEventFilterTypeAllEvent
+		return nil
+	case "CLOSE_EVENT":
+		*v = HistoryEventFilterTypeCloseEvent
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "HistoryEventFilterType")
+	}
+}
+
+func (v HistoryEventFilterType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+func (v *HistoryEventFilterType) FromWire(w wire.Value) error {
+	*v = (HistoryEventFilterType)(w.GetI32())
+	return nil
+}
+
+func (v HistoryEventFilterType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "ALL_EVENT"
+	case 1:
+		return "CLOSE_EVENT"
+	}
+	return fmt.Sprintf("HistoryEventFilterType(%d)", w)
+}
+
+func (v HistoryEventFilterType) Equals(rhs HistoryEventFilterType) bool {
 	return v == rhs
 }
 
-func (v EventType) MarshalJSON() ([]byte, error) {
+func (v HistoryEventFilterType) MarshalJSON() ([]byte, error) {
 	switch int32(v) {
 	case 0:
-		return ([]byte)("\"WorkflowExecutionStarted\""), nil
+		return ([]byte)("\"ALL_EVENT\""), nil
 	case 1:
-		return ([]byte)("\"WorkflowExecutionCompleted\""), nil
-	case 2:
-		return ([]byte)("\"WorkflowExecutionFailed\""), nil
-	case 3:
-		return ([]byte)("\"WorkflowExecutionTimedOut\""), nil
-	case 4:
-		return ([]byte)("\"DecisionTaskScheduled\""), nil
-	case 5:
-		return ([]byte)("\"DecisionTaskStarted\""), nil
-	case 6:
-		return ([]byte)("\"DecisionTaskCompleted\""), nil
-	case 7:
-		return ([]byte)("\"DecisionTaskTimedOut\""), nil
-	case 8:
-		return ([]byte)("\"DecisionTaskFailed\""), nil
-	case 9:
-		return ([]byte)("\"ActivityTaskScheduled\""), nil
-	case 10:
-		return ([]byte)("\"ActivityTaskStarted\""), nil
-	case 11:
-		return ([]byte)("\"ActivityTaskCompleted\""), nil
-	case 12:
-		return ([]byte)("\"ActivityTaskFailed\""), nil
-	case 13:
-		return ([]byte)("\"ActivityTaskTimedOut\""), nil
-	case 14:
-		return ([]byte)("\"ActivityTaskCancelRequested\""), nil
-	case 15:
-		return ([]byte)("\"RequestCancelActivityTaskFailed\""), nil
-	case 16:
-		return ([]byte)("\"ActivityTaskCanceled\""), nil
-	case 17:
-		return ([]byte)("\"TimerStarted\""), nil
-	case 18:
-		return ([]byte)("\"TimerFired\""), nil
-	case 19:
-		return ([]byte)("\"CancelTimerFailed\""), nil
-	case 20:
-		return ([]byte)("\"TimerCanceled\""), nil
-	case 21:
-		return ([]byte)("\"WorkflowExecutionCancelRequested\""), nil
-	case 22:
-		return ([]byte)("\"WorkflowExecutionCanceled\""), nil
-	case 23:
-		return ([]byte)("\"RequestCancelExternalWorkflowExecutionInitiated\""), nil
-	case 24:
-		return ([]byte)("\"RequestCancelExternalWorkflowExecutionFailed\""), nil
-	case 25:
-		return ([]byte)("\"ExternalWorkflowExecutionCancelRequested\""), nil
-	case 26:
-		return ([]byte)("\"MarkerRecorded\""), nil
-	case 27:
-		return ([]byte)("\"WorkflowExecutionSignaled\""), nil
-	case 28:
-		return ([]byte)("\"WorkflowExecutionTerminated\""), nil
-	case 29:
-		return ([]byte)("\"WorkflowExecutionContinuedAsNew\""), nil
-	case 30:
-		return ([]byte)("\"StartChildWorkflowExecutionInitiated\""), nil
-	case 31:
-		return ([]byte)("\"StartChildWorkflowExecutionFailed\""), nil
-	case 32:
-		return ([]byte)("\"ChildWorkflowExecutionStarted\""), nil
-	case 33:
-		return ([]byte)("\"ChildWorkflowExecutionCompleted\""), nil
-	case 34:
-		return ([]byte)("\"ChildWorkflowExecutionFailed\""), nil
-	case 35:
-		return ([]byte)("\"ChildWorkflowExecutionCanceled\""), nil
-	case 36:
-		return ([]byte)("\"ChildWorkflowExecutionTimedOut\""), nil
-	case 37:
-		return ([]byte)("\"ChildWorkflowExecutionTerminated\""), nil
+		return ([]byte)("\"CLOSE_EVENT\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-func (v *EventType) UnmarshalJSON(text []byte) error {
+func (v *HistoryEventFilterType) UnmarshalJSON(text []byte) error {
 	d := json.NewDecoder(bytes.NewReader(text))
 	d.UseNumber()
 	t, err := d.Token()
@@ -5428,221 +9459,214 @@ func (v *EventType) UnmarshalJSON(text []byte) error {
 			return err
 		}
 		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "EventType")
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "HistoryEventFilterType")
 		}
 		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "EventType")
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "HistoryEventFilterType")
 		}
-		*v = (EventType)(x)
+		*v = (HistoryEventFilterType)(x)
 		return nil
 	case string:
 		return v.UnmarshalText([]byte(w))
 	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "EventType")
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "HistoryEventFilterType")
 	}
 }
 
-type ExternalWorkflowExecutionCancelRequestedEventAttributes struct {
-	InitiatedEventId  *int64             `json:"initiatedEventId,omitempty"`
-	Domain            *string            `json:"domain,omitempty"`
-	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+type IndexedValueType int32
+
+const (
+	IndexedValueTypeString   IndexedValueType = 0
+	IndexedValueTypeKeyword  IndexedValueType = 1
+	IndexedValueTypeInt      IndexedValueType = 2
+	IndexedValueTypeDouble   IndexedValueType = 3
+	IndexedValueTypeBool     IndexedValueType = 4
+	IndexedValueTypeDatetime IndexedValueType = 5
+)
+
+func IndexedValueType_Values() []IndexedValueType {
+	return []IndexedValueType{IndexedValueTypeString, IndexedValueTypeKeyword, IndexedValueTypeInt, IndexedValueTypeDouble, IndexedValueTypeBool, IndexedValueTypeDatetime}
 }
 
-func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) ToWire() (wire.Value, error) {
-	var (
-		fields [3]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-	if v.InitiatedEventId != nil {
-		w, err = wire.NewValueI64(*(v.InitiatedEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
+func (v *IndexedValueType) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "STRING":
+		*v = IndexedValueTypeString
+		return nil
+	case "KEYWORD":
+		*v = IndexedValueTypeKeyword
+		return nil
+	case "INT":
+		*v = IndexedValueTypeInt
+		return nil
+	case "DOUBLE":
+		*v = IndexedValueTypeDouble
+		return nil
+	case "BOOL":
+		*v = IndexedValueTypeBool
+		return nil
+	case "DATETIME":
+		*v = IndexedValueTypeDatetime
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "IndexedValueType")
 	}
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) FromWire(w wire.Value) error {
-	var err error
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.InitiatedEventId = &x
-				if err != nil {
-					return err
-				}
-			}
-		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
-				if err != nil {
-					return err
-				}
-			}
-		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		}
-	}
+func (v IndexedValueType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+func (v *IndexedValueType) FromWire(w wire.Value) error {
+	*v = (IndexedValueType)(w.GetI32())
 	return nil
 }
 
-func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-	var fields [3]string
-	i := 0
-	if v.InitiatedEventId != nil {
-		fields[i] = fmt.Sprintf("InitiatedEventId: %v", *(v.InitiatedEventId))
-		i++
-	}
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
-		i++
-	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
-		i++
+func (v IndexedValueType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "STRING"
+	case 1:
+		return "KEYWORD"
+	case 2:
+		return "INT"
+	case 3:
+		return "DOUBLE"
+	case 4:
+		return "BOOL"
+	case 5:
+		return "DATETIME"
 	}
-	return fmt.Sprintf("ExternalWorkflowExecutionCancelRequestedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("IndexedValueType(%d)", w)
 }
 
-func (v *ExternalWorkflowExecutionCancelRequestedEventAttributes) Equals(rhs *ExternalWorkflowExecutionCancelRequestedEventAttributes) bool {
-	if !_I64_EqualsPtr(v.InitiatedEventId, rhs.InitiatedEventId) {
-		return false
+func (v IndexedValueType) Equals(rhs IndexedValueType) bool {
+	return v == rhs
+}
+
+func (v IndexedValueType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"STRING\""), nil
+	case 1:
+		return ([]byte)("\"KEYWORD\""), nil
+	case 2:
+		return ([]byte)("\"INT\""), nil
+	case 3:
+		return ([]byte)("\"DOUBLE\""), nil
+	case 4:
+		return ([]byte)("\"BOOL\""), nil
+	case 5:
+		return ([]byte)("\"DATETIME\""), nil
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+func (v *IndexedValueType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
 	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
-		return false
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "IndexedValueType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "IndexedValueType")
+		}
+		*v = (IndexedValueType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "IndexedValueType")
 	}
-	return true
 }
 
-type FailWorkflowExecutionDecisionAttributes struct {
-	Reason  *string `json:"reason,omitempty"`
-	Details []byte  `json:"details"`
+type InternalServiceError struct {
+	Message string `json:"message,required"`
 }
 
-func (v *FailWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
+func (v *InternalServiceError) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.Reason != nil {
-		w, err = wire.NewValueString(*(v.Reason)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
 	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *FailWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) error {
+func (v *InternalServiceError) FromWire(w wire.Value) error {
 	var err error
+	messageIsSet := false
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Reason = &x
-				if err != nil {
-					return err
-				}
-			}
-		case 20:
+		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
+				v.Message, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
+				messageIsSet = true
 			}
 		}
 	}
+	if !messageIsSet {
+		return errors.New("field Message of InternalServiceError is required")
+	}
 	return nil
 }
 
-func (v *FailWorkflowExecutionDecisionAttributes) String() string {
+func (v *InternalServiceError) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [2]string
+	var fields [1]string
 	i := 0
-	if v.Reason != nil {
-		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
-		i++
-	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
-		i++
-	}
-	return fmt.Sprintf("FailWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+	return fmt.Sprintf("InternalServiceError{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *FailWorkflowExecutionDecisionAttributes) Equals(rhs *FailWorkflowExecutionDecisionAttributes) bool {
-	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
-		return false
-	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+func (v *InternalServiceError) Equals(rhs *InternalServiceError) bool {
+	if !(v.Message == rhs.Message) {
 		return false
 	}
 	return true
 }
 
-type GetWorkflowExecutionHistoryRequest struct {
-	Domain          *string            `json:"domain,omitempty"`
-	Execution       *WorkflowExecution `json:"execution,omitempty"`
-	MaximumPageSize *int32             `json:"maximumPageSize,omitempty"`
-	NextPageToken   []byte             `json:"nextPageToken"`
+func (v *InternalServiceError) Error() string {
+	return v.String()
 }
 
-func (v *GetWorkflowExecutionHistoryRequest) ToWire() (wire.Value, error) {
+type ListClosedWorkflowExecutionsRequest struct {
+	Domain          *string                       `json:"domain,omitempty"`
+	MaximumPageSize *int32                        `json:"maximumPageSize,omitempty"`
+	NextPageToken   []byte                        `json:"nextPageToken"`
+	StartTimeFilter *StartTimeFilter              `json:"StartTimeFilter,omitempty"`
+	ExecutionFilter *WorkflowExecutionFilter      `json:"executionFilter,omitempty"`
+	TypeFilter      *WorkflowTypeFilter           `json:"typeFilter,omitempty"`
+	StatusFilter    *WorkflowExecutionCloseStatus `json:"statusFilter,omitempty"`
+}
+
+func (v *ListClosedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -5655,34 +9679,82 @@ func (v *GetWorkflowExecutionHistoryRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.MaximumPageSize != nil {
-		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+	if v.StartTimeFilter != nil {
+		w, err = v.StartTimeFilter.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.ExecutionFilter != nil {
+		w, err = v.ExecutionFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.TypeFilter != nil {
+		w, err = v.TypeFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.StatusFilter != nil {
+		w, err = v.StatusFilter.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *GetWorkflowExecutionHistoryRequest) FromWire(w wire.Value) error {
+func _StartTimeFilter_Read(w wire.Value) (*StartTimeFilter, error) {
+	var v StartTimeFilter
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionFilter_Read(w wire.Value) (*WorkflowExecutionFilter, error) {
+	var v WorkflowExecutionFilter
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowTypeFilter_Read(w wire.Value) (*WorkflowTypeFilter, error) {
+	var v WorkflowTypeFilter
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowExecutionCloseStatus_Read(w wire.Value) (WorkflowExecutionCloseStatus, error) {
+	var v WorkflowExecutionCloseStatus
+	err := v.FromWire(w)
+	return v, err
+}
+
+func (v *ListClosedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
@@ -5696,13 +9768,6 @@ func (v *GetWorkflowExecutionHistoryRequest) FromWire(w wire.Value) error {
 				}
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 30:
 			if field.Value.Type() == wire.TI32 {
 				var x int32
 				x, err = field.Value.GetI32(), error(nil)
@@ -5711,110 +9776,39 @@ func (v *GetWorkflowExecutionHistoryRequest) FromWire(w wire.Value) error {
 					return err
 				}
 			}
-		case 40:
+		case 30:
 			if field.Value.Type() == wire.TBinary {
 				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 			}
-		}
-	}
-	return nil
-}
-
-func (v *GetWorkflowExecutionHistoryRequest) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-	var fields [4]string
-	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
-		i++
-	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
-		i++
-	}
-	if v.MaximumPageSize != nil {
-		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
-		i++
-	}
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
-		i++
-	}
-	return fmt.Sprintf("GetWorkflowExecutionHistoryRequest{%v}", strings.Join(fields[:i], ", "))
-}
-
-func (v *GetWorkflowExecutionHistoryRequest) Equals(rhs *GetWorkflowExecutionHistoryRequest) bool {
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
-	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
-		return false
-	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
-		return false
-	}
-	return true
-}
-
-type GetWorkflowExecutionHistoryResponse struct {
-	History       *History `json:"history,omitempty"`
-	NextPageToken []byte   `json:"nextPageToken"`
-}
-
-func (v *GetWorkflowExecutionHistoryResponse) ToWire() (wire.Value, error) {
-	var (
-		fields [2]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-	if v.History != nil {
-		w, err = v.History.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _History_Read(w wire.Value) (*History, error) {
-	var v History
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func (v *GetWorkflowExecutionHistoryResponse) FromWire(w wire.Value) error {
-	var err error
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.StartTimeFilter, err = _StartTimeFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 50:
 			if field.Value.Type() == wire.TStruct {
-				v.History, err = _History_Read(field.Value)
+				v.ExecutionFilter, err = _WorkflowExecutionFilter_Read(field.Value)
 				if err != nil {
 					return err
 				}
 			}
-		case 20:
-			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.TypeFilter, err = _WorkflowTypeFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x WorkflowExecutionCloseStatus
+				x, err = _WorkflowExecutionCloseStatus_Read(field.Value)
+				v.StatusFilter = &x
 				if err != nil {
 					return err
 				}
@@ -5824,40 +9818,85 @@ func (v *GetWorkflowExecutionHistoryResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *GetWorkflowExecutionHistoryResponse) String() string {
+func (v *ListClosedWorkflowExecutionsRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [2]string
+	var fields [7]string
 	i := 0
-	if v.History != nil {
-		fields[i] = fmt.Sprintf("History: %v", v.History)
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
 		i++
 	}
 	if v.NextPageToken != nil {
 		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
-	return fmt.Sprintf("GetWorkflowExecutionHistoryResponse{%v}", strings.Join(fields[:i], ", "))
+	if v.StartTimeFilter != nil {
+		fields[i] = fmt.Sprintf("StartTimeFilter: %v", v.StartTimeFilter)
+		i++
+	}
+	if v.ExecutionFilter != nil {
+		fields[i] = fmt.Sprintf("ExecutionFilter: %v", v.ExecutionFilter)
+		i++
+	}
+	if v.TypeFilter != nil {
+		fields[i] = fmt.Sprintf("TypeFilter: %v", v.TypeFilter)
+		i++
+	}
+	if v.StatusFilter != nil {
+		fields[i] = fmt.Sprintf("StatusFilter: %v", *(v.StatusFilter))
+		i++
+	}
+	return fmt.Sprintf("ListClosedWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *GetWorkflowExecutionHistoryResponse) Equals(rhs *GetWorkflowExecutionHistoryResponse) bool {
-	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+func _WorkflowExecutionCloseStatus_EqualsPtr(lhs, rhs *WorkflowExecutionCloseStatus) bool {
+	if lhs != nil && rhs != nil {
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func (v *ListClosedWorkflowExecutionsRequest) Equals(rhs *ListClosedWorkflowExecutionsRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
 		return false
 	}
 	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
+	if !((v.StartTimeFilter == nil && rhs.StartTimeFilter == nil) || (v.StartTimeFilter != nil && rhs.StartTimeFilter != nil && v.StartTimeFilter.Equals(rhs.StartTimeFilter))) {
+		return false
+	}
+	if !((v.ExecutionFilter == nil && rhs.ExecutionFilter == nil) || (v.ExecutionFilter != nil && rhs.ExecutionFilter != nil && v.ExecutionFilter.Equals(rhs.ExecutionFilter))) {
+		return false
+	}
+	if !((v.TypeFilter == nil && rhs.TypeFilter == nil) || (v.TypeFilter != nil && rhs.TypeFilter != nil && v.TypeFilter.Equals(rhs.TypeFilter))) {
+		return false
+	}
+	if !_WorkflowExecutionCloseStatus_EqualsPtr(v.StatusFilter, rhs.StatusFilter) {
+		return false
+	}
 	return true
 }
 
-type History struct {
-	Events []*HistoryEvent `json:"events"`
+type ListClosedWorkflowExecutionsResponse struct {
+	Executions    []*WorkflowExecutionInfo `json:"executions"`
+	NextPageToken []byte                   `json:"nextPageToken"`
 }
 
-type _List_HistoryEvent_ValueList []*HistoryEvent
+type _List_WorkflowExecutionInfo_ValueList []*WorkflowExecutionInfo
 
-func (v _List_HistoryEvent_ValueList) ForEach(f func(wire.Value) error) error {
+func (v _List_WorkflowExecutionInfo_ValueList) ForEach(f func(wire.Value) error) error {
 	for i, x := range v {
 		if x == nil {
 			return fmt.Errorf("invalid [%v]: value is nil", i)
@@ -5874,48 +9913,50 @@ func (v _List_HistoryEvent_ValueList) ForEach(f func(wire.Value) error) error {
 	return nil
 }
 
-func (v _List_HistoryEvent_ValueList) Size() int {
+func (v _List_WorkflowExecutionInfo_ValueList) Size() int {
 	return len(v)
 }
 
-func (_List_HistoryEvent_ValueList) ValueType() wire.Type {
+func (_List_WorkflowExecutionInfo_ValueList) ValueType() wire.Type {
 	return wire.TStruct
 }
 
-func (_List_HistoryEvent_ValueList) Close() {
+func (_List_WorkflowExecutionInfo_ValueList) Close() {
 }
 
-func (v *History) ToWire() (wire.Value, error) {
+func (v *ListClosedWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.Events != nil {
-		w, err = wire.NewValueList(_List_HistoryEvent_ValueList(v.Events)), error(nil)
+	if v.Executions != nil {
+		w, err = wire.NewValueList(_List_WorkflowExecutionInfo_ValueList(v.Executions)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _HistoryEvent_Read(w wire.Value) (*HistoryEvent, error) {
-	var v HistoryEvent
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_HistoryEvent_Read(l wire.ValueList) ([]*HistoryEvent, error) {
+func _List_WorkflowExecutionInfo_Read(l wire.ValueList) ([]*WorkflowExecutionInfo, error) {
 	if l.ValueType() != wire.TStruct {
 		return nil, nil
 	}
-	o := make([]*HistoryEvent, 0, l.Size())
+	o := make([]*WorkflowExecutionInfo, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := _HistoryEvent_Read(x)
+		i, err := _WorkflowExecutionInfo_Read(x)
 		if err != nil {
 			return err
 		}
@@ -5926,13 +9967,20 @@ func _List_HistoryEvent_Read(l wire.ValueList) ([]*HistoryEvent, error) {
 	return o, err
 }
 
-func (v *History) FromWire(w wire.Value) error {
+func (v *ListClosedWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TList {
-				v.Events, err = _List_HistoryEvent_Read(field.Value.GetList())
+				v.Executions, err = _List_WorkflowExecutionInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -5942,20 +9990,24 @@ func (v *History) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *History) String() string {
+func (v *ListClosedWorkflowExecutionsResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [1]string
+	var fields [2]string
 	i := 0
-	if v.Events != nil {
-		fields[i] = fmt.Sprintf("Events: %v", v.Events)
+	if v.Executions != nil {
+		fields[i] = fmt.Sprintf("Executions: %v", v.Executions)
 		i++
 	}
-	return fmt.Sprintf("History{%v}", strings.Join(fields[:i], ", "))
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+	return fmt.Sprintf("ListClosedWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_HistoryEvent_Equals(lhs, rhs []*HistoryEvent) bool {
+func _List_WorkflowExecutionInfo_Equals(lhs, rhs []*WorkflowExecutionInfo) bool {
 	if len(lhs) != len(rhs) {
 		return false
 	}
@@ -5968,638 +10020,1135 @@ func _List_HistoryEvent_Equals(lhs, rhs []*HistoryEvent) bool {
 	return true
 }
 
-func (v *History) Equals(rhs *History) bool {
-	if !((v.Events == nil && rhs.Events == nil) || (v.Events != nil && rhs.Events != nil && _List_HistoryEvent_Equals(v.Events, rhs.Events))) {
+func (v *ListClosedWorkflowExecutionsResponse) Equals(rhs *ListClosedWorkflowExecutionsResponse) bool {
+	if !((v.Executions == nil && rhs.Executions == nil) || (v.Executions != nil && rhs.Executions != nil && _List_WorkflowExecutionInfo_Equals(v.Executions, rhs.Executions))) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
 	return true
 }
 
-type HistoryEvent struct {
-	EventId                                                        *int64                                                          `json:"eventId,omitempty"`
-	Timestamp                                                      *int64                                                          `json:"timestamp,omitempty"`
-	EventType                                                      *EventType                                                      `json:"eventType,omitempty"`
-	WorkflowExecutionStartedEventAttributes                        *WorkflowExecutionStartedEventAttributes                        `json:"workflowExecutionStartedEventAttributes,omitempty"`
-	WorkflowExecutionCompletedEventAttributes                      *WorkflowExecutionCompletedEventAttributes                      `json:"workflowExecutionCompletedEventAttributes,omitempty"`
-	WorkflowExecutionFailedEventAttributes                         *WorkflowExecutionFailedEventAttributes                         `json:"workflowExecutionFailedEventAttributes,omitempty"`
-	WorkflowExecutionTimedOutEventAttributes                       *WorkflowExecutionTimedOutEventAttributes                       `json:"workflowExecutionTimedOutEventAttributes,omitempty"`
-	DecisionTaskScheduledEventAttributes                           *DecisionTaskScheduledEventAttributes                           `json:"decisionTaskScheduledEventAttributes,omitempty"`
-	DecisionTaskStartedEventAttributes                             *DecisionTaskStartedEventAttributes                             `json:"decisionTaskStartedEventAttributes,omitempty"`
-	DecisionTaskCompletedEventAttributes                           *DecisionTaskCompletedEventAttributes                           `json:"decisionTaskCompletedEventAttributes,omitempty"`
-	DecisionTaskTimedOutEventAttributes                            *DecisionTaskTimedOutEventAttributes                            `json:"decisionTaskTimedOutEventAttributes,omitempty"`
-	DecisionTaskFailedEventAttributes                              *DecisionTaskFailedEventAttributes                              `json:"decisionTaskFailedEventAttributes,omitempty"`
-	ActivityTaskScheduledEventAttributes                           *ActivityTaskScheduledEventAttributes                           `json:"activityTaskScheduledEventAttributes,omitempty"`
-	ActivityTaskStartedEventAttributes                             *ActivityTaskStartedEventAttributes                             `json:"activityTaskStartedEventAttributes,omitempty"`
-	ActivityTaskCompletedEventAttributes                           *ActivityTaskCompletedEventAttributes                           `json:"activityTaskCompletedEventAttributes,omitempty"`
-	ActivityTaskFailedEventAttributes                              *ActivityTaskFailedEventAttributes                              `json:"activityTaskFailedEventAttributes,omitempty"`
-	ActivityTaskTimedOutEventAttributes                            *ActivityTaskTimedOutEventAttributes                            `json:"activityTaskTimedOutEventAttributes,omitempty"`
-	TimerStartedEventAttributes                                    *TimerStartedEventAttributes                                    `json:"timerStartedEventAttributes,omitempty"`
-	TimerFiredEventAttributes                                      *TimerFiredEventAttributes                                      `json:"timerFiredEventAttributes,omitempty"`
-	ActivityTaskCancelRequestedEventAttributes                     *ActivityTaskCancelRequestedEventAttributes                     `json:"activityTaskCancelRequestedEventAttributes,omitempty"`
-	RequestCancelActivityTaskFailedEventAttributes                 *RequestCancelActivityTaskFailedEventAttributes                 `json:"requestCancelActivityTaskFailedEventAttributes,omitempty"`
-	ActivityTaskCanceledEventAttributes                            *ActivityTaskCanceledEventAttributes                            `json:"activityTaskCanceledEventAttributes,omitempty"`
-	TimerCanceledEventAttributes                                   *TimerCanceledEventAttributes                                   `json:"timerCanceledEventAttributes,omitempty"`
-	CancelTimerFailedEventAttributes                               *CancelTimerFailedEventAttributes                               `json:"cancelTimerFailedEventAttributes,omitempty"`
-	MarkerRecordedEventAttributes                                  *MarkerRecordedEventAttributes                                  `json:"markerRecordedEventAttributes,omitempty"`
-	WorkflowExecutionSignaledEventAttributes                       *WorkflowExecutionSignaledEventAttributes                       `json:"workflowExecutionSignaledEventAttributes,omitempty"`
-	WorkflowExecutionTerminatedEventAttributes                     *WorkflowExecutionTerminatedEventAttributes                     `json:"workflowExecutionTerminatedEventAttributes,omitempty"`
-	WorkflowExecutionCancelRequestedEventAttributes                *WorkflowExecutionCancelRequestedEventAttributes                `json:"workflowExecutionCancelRequestedEventAttributes,omitempty"`
-	WorkflowExecutionCanceledEventAttributes                       *WorkflowExecutionCanceledEventAttributes                       `json:"workflowExecutionCanceledEventAttributes,omitempty"`
-	RequestCancelExternalWorkflowExecutionInitiatedEventAttributes *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes `json:"requestCancelExternalWorkflowExecutionInitiatedEventAttributes,omitempty"`
-	RequestCancelExternalWorkflowExecutionFailedEventAttributes    *RequestCancelExternalWorkflowExecutionFailedEventAttributes    `json:"requestCancelExternalWorkflowExecutionFailedEventAttributes,omitempty"`
-	ExternalWorkflowExecutionCancelRequestedEventAttributes        *ExternalWorkflowExecutionCancelRequestedEventAttributes        `json:"externalWorkflowExecutionCancelRequestedEventAttributes,omitempty"`
-	WorkflowExecutionContinuedAsNewEventAttributes                 *WorkflowExecutionContinuedAsNewEventAttributes                 `json:"workflowExecutionContinuedAsNewEventAttributes,omitempty"`
-	StartChildWorkflowExecutionInitiatedEventAttributes            *StartChildWorkflowExecutionInitiatedEventAttributes            `json:"startChildWorkflowExecutionInitiatedEventAttributes,omitempty"`
-	StartChildWorkflowExecutionFailedEventAttributes               *StartChildWorkflowExecutionFailedEventAttributes               `json:"startChildWorkflowExecutionFailedEventAttributes,omitempty"`
-	ChildWorkflowExecutionStartedEventAttributes                   *ChildWorkflowExecutionStartedEventAttributes                   `json:"childWorkflowExecutionStartedEventAttributes,omitempty"`
-	ChildWorkflowExecutionCompletedEventAttributes                 *ChildWorkflowExecutionCompletedEventAttributes                 `json:"childWorkflowExecutionCompletedEventAttributes,omitempty"`
-	ChildWorkflowExecutionFailedEventAttributes                    *ChildWorkflowExecutionFailedEventAttributes                    `json:"childWorkflowExecutionFailedEventAttributes,omitempty"`
-	ChildWorkflowExecutionCanceledEventAttributes                  *ChildWorkflowExecutionCanceledEventAttributes                  `json:"childWorkflowExecutionCanceledEventAttributes,omitempty"`
-	ChildWorkflowExecutionTimedOutEventAttributes                  *ChildWorkflowExecutionTimedOutEventAttributes                  `json:"childWorkflowExecutionTimedOutEventAttributes,omitempty"`
-	ChildWorkflowExecutionTerminatedEventAttributes                *ChildWorkflowExecutionTerminatedEventAttributes                `json:"childWorkflowExecutionTerminatedEventAttributes,omitempty"`
+type ListOpenWorkflowExecutionsRequest struct {
+	Domain          *string                  `json:"domain,omitempty"`
+	MaximumPageSize *int32                   `json:"maximumPageSize,omitempty"`
+	NextPageToken   []byte                   `json:"nextPageToken"`
+	StartTimeFilter *StartTimeFilter         `json:"StartTimeFilter,omitempty"`
+	ExecutionFilter *WorkflowExecutionFilter `json:"executionFilter,omitempty"`
+	TypeFilter      *WorkflowTypeFilter      `json:"typeFilter,omitempty"`
 }
 
-func (v *HistoryEvent) ToWire() (wire.Value, error) {
+func (v *ListOpenWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [41]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.EventId != nil {
-		w, err = wire.NewValueI64(*(v.EventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.Timestamp != nil {
-		w, err = wire.NewValueI64(*(v.Timestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.EventType != nil {
-		w, err = v.EventType.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.WorkflowExecutionStartedEventAttributes != nil {
-		w, err = v.WorkflowExecutionStartedEventAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.WorkflowExecutionCompletedEventAttributes != nil {
-		w, err = v.WorkflowExecutionCompletedEventAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.WorkflowExecutionFailedEventAttributes != nil {
-		w, err = v.WorkflowExecutionFailedEventAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.WorkflowExecutionTimedOutEventAttributes != nil {
-		w, err = v.WorkflowExecutionTimedOutEventAttributes.ToWire()
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 70, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.DecisionTaskScheduledEventAttributes != nil {
-		w, err = v.DecisionTaskScheduledEventAttributes.ToWire()
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 80, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.DecisionTaskStartedEventAttributes != nil {
-		w, err = v.DecisionTaskStartedEventAttributes.ToWire()
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 90, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.DecisionTaskCompletedEventAttributes != nil {
-		w, err = v.DecisionTaskCompletedEventAttributes.ToWire()
+	if v.StartTimeFilter != nil {
+		w, err = v.StartTimeFilter.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 100, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.DecisionTaskTimedOutEventAttributes != nil {
-		w, err = v.DecisionTaskTimedOutEventAttributes.ToWire()
+	if v.ExecutionFilter != nil {
+		w, err = v.ExecutionFilter.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 110, Value: w}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.DecisionTaskFailedEventAttributes != nil {
-		w, err = v.DecisionTaskFailedEventAttributes.ToWire()
+	if v.TypeFilter != nil {
+		w, err = v.TypeFilter.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 120, Value: w}
+		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.ActivityTaskScheduledEventAttributes != nil {
-		w, err = v.ActivityTaskScheduledEventAttributes.ToWire()
-		if err != nil {
-			return w, err
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *ListOpenWorkflowExecutionsRequest) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.StartTimeFilter, err = _StartTimeFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.ExecutionFilter, err = _WorkflowExecutionFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.TypeFilter, err = _WorkflowTypeFilter_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
 		}
-		fields[i] = wire.Field{ID: 130, Value: w}
+	}
+	return nil
+}
+
+func (v *ListOpenWorkflowExecutionsRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [6]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.ActivityTaskStartedEventAttributes != nil {
-		w, err = v.ActivityTaskStartedEventAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 140, Value: w}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
 		i++
 	}
-	if v.ActivityTaskCompletedEventAttributes != nil {
-		w, err = v.ActivityTaskCompletedEventAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 150, Value: w}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
-	if v.ActivityTaskFailedEventAttributes != nil {
-		w, err = v.ActivityTaskFailedEventAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 160, Value: w}
+	if v.StartTimeFilter != nil {
+		fields[i] = fmt.Sprintf("StartTimeFilter: %v", v.StartTimeFilter)
 		i++
 	}
-	if v.ActivityTaskTimedOutEventAttributes != nil {
-		w, err = v.ActivityTaskTimedOutEventAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 170, Value: w}
+	if v.ExecutionFilter != nil {
+		fields[i] = fmt.Sprintf("ExecutionFilter: %v", v.ExecutionFilter)
 		i++
 	}
-	if v.TimerStartedEventAttributes != nil {
-		w, err = v.TimerStartedEventAttributes.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 180, Value: w}
+	if v.TypeFilter != nil {
+		fields[i] = fmt.Sprintf("TypeFilter: %v", v.TypeFilter)
 		i++
 	}
-	if v.TimerFiredEventAttributes != nil {
-		w, err = v.TimerFiredEventAttributes.ToWire()
+	return fmt.Sprintf("ListOpenWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *ListOpenWorkflowExecutionsRequest) Equals(rhs *ListOpenWorkflowExecutionsRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	if !((v.StartTimeFilter == nil && rhs.StartTimeFilter == nil) || (v.StartTimeFilter != nil && rhs.StartTimeFilter != nil && v.StartTimeFilter.Equals(rhs.StartTimeFilter))) {
+		return false
+	}
+	if !((v.ExecutionFilter == nil && rhs.ExecutionFilter == nil) || (v.ExecutionFilter != nil && rhs.ExecutionFilter != nil && v.ExecutionFilter.Equals(rhs.ExecutionFilter))) {
+		return false
+	}
+	if !((v.TypeFilter == nil && rhs.TypeFilter == nil) || (v.TypeFilter != nil && rhs.TypeFilter != nil && v.TypeFilter.Equals(rhs.TypeFilter))) {
+		return false
+	}
+	return true
+}
+
+type ListOpenWorkflowExecutionsResponse struct {
+	Executions    []*WorkflowExecutionInfo `json:"executions"`
+	NextPageToken []byte                   `json:"nextPageToken"`
+}
+
+func (v *ListOpenWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.Executions != nil {
+		w, err = wire.NewValueList(_List_WorkflowExecutionInfo_ValueList(v.Executions)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 190, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.ActivityTaskCancelRequestedEventAttributes != nil {
-		w, err = v.ActivityTaskCancelRequestedEventAttributes.ToWire()
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 200, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.RequestCancelActivityTaskFailedEventAttributes != nil {
-		w, err = v.RequestCancelActivityTaskFailedEventAttributes.ToWire()
-		if err != nil {
-			return w, err
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *ListOpenWorkflowExecutionsResponse) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Executions, err = _List_WorkflowExecutionInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
 		}
-		fields[i] = wire.Field{ID: 210, Value: w}
+	}
+	return nil
+}
+
+func (v *ListOpenWorkflowExecutionsResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [2]string
+	i := 0
+	if v.Executions != nil {
+		fields[i] = fmt.Sprintf("Executions: %v", v.Executions)
 		i++
 	}
-	if v.ActivityTaskCanceledEventAttributes != nil {
-		w, err = v.ActivityTaskCanceledEventAttributes.ToWire()
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+	return fmt.Sprintf("ListOpenWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *ListOpenWorkflowExecutionsResponse) Equals(rhs *ListOpenWorkflowExecutionsResponse) bool {
+	if !((v.Executions == nil && rhs.Executions == nil) || (v.Executions != nil && rhs.Executions != nil && _List_WorkflowExecutionInfo_Equals(v.Executions, rhs.Executions))) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	return true
+}
+
+type ListWorkflowExecutionsRequest struct {
+	Domain        *string `json:"domain,omitempty"`
+	PageSize      *int32  `json:"pageSize,omitempty"`
+	NextPageToken []byte  `json:"nextPageToken"`
+	Query         *string `json:"query,omitempty"`
+}
+
+func (v *ListWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 220, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.TimerCanceledEventAttributes != nil {
-		w, err = v.TimerCanceledEventAttributes.ToWire()
+	if v.PageSize != nil {
+		w, err = wire.NewValueI32(*(v.PageSize)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 230, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.CancelTimerFailedEventAttributes != nil {
-		w, err = v.CancelTimerFailedEventAttributes.ToWire()
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 240, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.MarkerRecordedEventAttributes != nil {
-		w, err = v.MarkerRecordedEventAttributes.ToWire()
+	if v.Query != nil {
+		w, err = wire.NewValueString(*(v.Query)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 250, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.WorkflowExecutionSignaledEventAttributes != nil {
-		w, err = v.WorkflowExecutionSignaledEventAttributes.ToWire()
-		if err != nil {
-			return w, err
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *ListWorkflowExecutionsRequest) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.PageSize = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Query = &x
+				if err != nil {
+					return err
+				}
+			}
 		}
-		fields[i] = wire.Field{ID: 260, Value: w}
+	}
+	return nil
+}
+
+func (v *ListWorkflowExecutionsRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [4]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.WorkflowExecutionTerminatedEventAttributes != nil {
-		w, err = v.WorkflowExecutionTerminatedEventAttributes.ToWire()
+	if v.PageSize != nil {
+		fields[i] = fmt.Sprintf("PageSize: %v", *(v.PageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+	if v.Query != nil {
+		fields[i] = fmt.Sprintf("Query: %v", *(v.Query))
+		i++
+	}
+	return fmt.Sprintf("ListWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *ListWorkflowExecutionsRequest) Equals(rhs *ListWorkflowExecutionsRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.PageSize, rhs.PageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Query, rhs.Query) {
+		return false
+	}
+	return true
+}
+
+type ListWorkflowExecutionsResponse struct {
+	Executions    []*WorkflowExecutionInfo `json:"executions"`
+	NextPageToken []byte                   `json:"nextPageToken"`
+}
+
+func (v *ListWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.Executions != nil {
+		w, err = wire.NewValueList(_List_WorkflowExecutionInfo_ValueList(v.Executions)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 270, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecutionCancelRequestedEventAttributes != nil {
-		w, err = v.WorkflowExecutionCancelRequestedEventAttributes.ToWire()
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 280, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.WorkflowExecutionCanceledEventAttributes != nil {
-		w, err = v.WorkflowExecutionCanceledEventAttributes.ToWire()
-		if err != nil {
-			return w, err
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *ListWorkflowExecutionsResponse) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.Executions, err = _List_WorkflowExecutionInfo_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
 		}
-		fields[i] = wire.Field{ID: 290, Value: w}
+	}
+	return nil
+}
+
+func (v *ListWorkflowExecutionsResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [2]string
+	i := 0
+	if v.Executions != nil {
+		fields[i] = fmt.Sprintf("Executions: %v", v.Executions)
 		i++
 	}
-	if v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil {
-		w, err = v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.ToWire()
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+	return fmt.Sprintf("ListWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *ListWorkflowExecutionsResponse) Equals(rhs *ListWorkflowExecutionsResponse) bool {
+	if !((v.Executions == nil && rhs.Executions == nil) || (v.Executions != nil && rhs.Executions != nil && _List_WorkflowExecutionInfo_Equals(v.Executions, rhs.Executions))) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	return true
+}
+
+type MarkerRecordedEventAttributes struct {
+	MarkerName                   *string `json:"markerName,omitempty"`
+	Details                      []byte  `json:"details"`
+	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
+}
+
+func (v *MarkerRecordedEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.MarkerName != nil {
+		w, err = wire.NewValueString(*(v.MarkerName)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 300, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil {
-		w, err = v.RequestCancelExternalWorkflowExecutionFailedEventAttributes.ToWire()
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 310, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil {
-		w, err = v.ExternalWorkflowExecutionCancelRequestedEventAttributes.ToWire()
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 320, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.WorkflowExecutionContinuedAsNewEventAttributes != nil {
-		w, err = v.WorkflowExecutionContinuedAsNewEventAttributes.ToWire()
-		if err != nil {
-			return w, err
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *MarkerRecordedEventAttributes) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.MarkerName = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.Details, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
+				if err != nil {
+					return err
+				}
+			}
 		}
-		fields[i] = wire.Field{ID: 330, Value: w}
+	}
+	return nil
+}
+
+func (v *MarkerRecordedEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [3]string
+	i := 0
+	if v.MarkerName != nil {
+		fields[i] = fmt.Sprintf("MarkerName: %v", *(v.MarkerName))
+		i++
+	}
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+		i++
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
-	if v.StartChildWorkflowExecutionInitiatedEventAttributes != nil {
-		w, err = v.StartChildWorkflowExecutionInitiatedEventAttributes.ToWire()
+	return fmt.Sprintf("MarkerRecordedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *MarkerRecordedEventAttributes) Equals(rhs *MarkerRecordedEventAttributes) bool {
+	if !_String_EqualsPtr(v.MarkerName, rhs.MarkerName) {
+		return false
+	}
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+		return false
+	}
+	return true
+}
+
+type PendingActivityInfo struct {
+	ActivityID             *string               `json:"activityID,omitempty"`
+	ActivityType           *ActivityType         `json:"activityType,omitempty"`
+	State                  *PendingActivityState `json:"state,omitempty"`
+	HeartbeatDetails       []byte                `json:"heartbeatDetails"`
+	LastHeartbeatTimestamp *int64                `json:"lastHeartbeatTimestamp,omitempty"`
+	ScheduledTimestamp     *int64                `json:"scheduledTimestamp,omitempty"`
+	LastStartedTimestamp   *int64                `json:"lastStartedTimestamp,omitempty"`
+	Attempt                *int32                `json:"attempt,omitempty"`
+}
+
+func (v *PendingActivityInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.ActivityID != nil {
+		w, err = wire.NewValueString(*(v.ActivityID)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 340, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.StartChildWorkflowExecutionFailedEventAttributes != nil {
-		w, err = v.StartChildWorkflowExecutionFailedEventAttributes.ToWire()
+	if v.ActivityType != nil {
+		w, err = v.ActivityType.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 350, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ChildWorkflowExecutionStartedEventAttributes != nil {
-		w, err = v.ChildWorkflowExecutionStartedEventAttributes.ToWire()
+	if v.State != nil {
+		w, err = v.State.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 360, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.ChildWorkflowExecutionCompletedEventAttributes != nil {
-		w, err = v.ChildWorkflowExecutionCompletedEventAttributes.ToWire()
+	if v.HeartbeatDetails != nil {
+		w, err = wire.NewValueBinary(v.HeartbeatDetails), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 370, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.ChildWorkflowExecutionFailedEventAttributes != nil {
-		w, err = v.ChildWorkflowExecutionFailedEventAttributes.ToWire()
+	if v.LastHeartbeatTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.LastHeartbeatTimestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 380, Value: w}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.ChildWorkflowExecutionCanceledEventAttributes != nil {
-		w, err = v.ChildWorkflowExecutionCanceledEventAttributes.ToWire()
+	if v.ScheduledTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledTimestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 390, Value: w}
+		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.ChildWorkflowExecutionTimedOutEventAttributes != nil {
-		w, err = v.ChildWorkflowExecutionTimedOutEventAttributes.ToWire()
+	if v.LastStartedTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.LastStartedTimestamp)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 400, Value: w}
+		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
-	if v.ChildWorkflowExecutionTerminatedEventAttributes != nil {
-		w, err = v.ChildWorkflowExecutionTerminatedEventAttributes.ToWire()
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 410, Value: w}
+		fields[i] = wire.Field{ID: 80, Value: w}
 		i++
 	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _EventType_Read(w wire.Value) (EventType, error) {
-	var v EventType
+func _PendingActivityState_Read(w wire.Value) (PendingActivityState, error) {
+	var v PendingActivityState
 	err := v.FromWire(w)
 	return v, err
 }
 
-func _WorkflowExecutionStartedEventAttributes_Read(w wire.Value) (*WorkflowExecutionStartedEventAttributes, error) {
-	var v WorkflowExecutionStartedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowExecutionCompletedEventAttributes_Read(w wire.Value) (*WorkflowExecutionCompletedEventAttributes, error) {
-	var v WorkflowExecutionCompletedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowExecutionFailedEventAttributes_Read(w wire.Value) (*WorkflowExecutionFailedEventAttributes, error) {
-	var v WorkflowExecutionFailedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowExecutionTimedOutEventAttributes_Read(w wire.Value) (*WorkflowExecutionTimedOutEventAttributes, error) {
-	var v WorkflowExecutionTimedOutEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _DecisionTaskScheduledEventAttributes_Read(w wire.Value) (*DecisionTaskScheduledEventAttributes, error) {
-	var v DecisionTaskScheduledEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _DecisionTaskStartedEventAttributes_Read(w wire.Value) (*DecisionTaskStartedEventAttributes, error) {
-	var v DecisionTaskStartedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _DecisionTaskCompletedEventAttributes_Read(w wire.Value) (*DecisionTaskCompletedEventAttributes, error) {
-	var v DecisionTaskCompletedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _DecisionTaskTimedOutEventAttributes_Read(w wire.Value) (*DecisionTaskTimedOutEventAttributes, error) {
-	var v DecisionTaskTimedOutEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _DecisionTaskFailedEventAttributes_Read(w wire.Value) (*DecisionTaskFailedEventAttributes, error) {
-	var v DecisionTaskFailedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ActivityTaskScheduledEventAttributes_Read(w wire.Value) (*ActivityTaskScheduledEventAttributes, error) {
-	var v ActivityTaskScheduledEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ActivityTaskStartedEventAttributes_Read(w wire.Value) (*ActivityTaskStartedEventAttributes, error) {
-	var v ActivityTaskStartedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ActivityTaskCompletedEventAttributes_Read(w wire.Value) (*ActivityTaskCompletedEventAttributes, error) {
-	var v ActivityTaskCompletedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ActivityTaskFailedEventAttributes_Read(w wire.Value) (*ActivityTaskFailedEventAttributes, error) {
-	var v ActivityTaskFailedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ActivityTaskTimedOutEventAttributes_Read(w wire.Value) (*ActivityTaskTimedOutEventAttributes, error) {
-	var v ActivityTaskTimedOutEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _TimerStartedEventAttributes_Read(w wire.Value) (*TimerStartedEventAttributes, error) {
-	var v TimerStartedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _TimerFiredEventAttributes_Read(w wire.Value) (*TimerFiredEventAttributes, error) {
-	var v TimerFiredEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ActivityTaskCancelRequestedEventAttributes_Read(w wire.Value) (*ActivityTaskCancelRequestedEventAttributes, error) {
-	var v ActivityTaskCancelRequestedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func (v *PendingActivityInfo) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityID = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.ActivityType, err = _ActivityType_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x PendingActivityState
+				x, err = _PendingActivityState_Read(field.Value)
+				v.State = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				v.HeartbeatDetails, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastHeartbeatTimestamp = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledTimestamp = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastStartedTimestamp = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
 }
 
-func _RequestCancelActivityTaskFailedEventAttributes_Read(w wire.Value) (*RequestCancelActivityTaskFailedEventAttributes, error) {
-	var v RequestCancelActivityTaskFailedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func (v *PendingActivityInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [8]string
+	i := 0
+	if v.ActivityID != nil {
+		fields[i] = fmt.Sprintf("ActivityID: %v", *(v.ActivityID))
+		i++
+	}
+	if v.ActivityType != nil {
+		fields[i] = fmt.Sprintf("ActivityType: %v", v.ActivityType)
+		i++
+	}
+	if v.State != nil {
+		fields[i] = fmt.Sprintf("State: %v", *(v.State))
+		i++
+	}
+	if v.HeartbeatDetails != nil {
+		fields[i] = fmt.Sprintf("HeartbeatDetails: %v", v.HeartbeatDetails)
+		i++
+	}
+	if v.LastHeartbeatTimestamp != nil {
+		fields[i] = fmt.Sprintf("LastHeartbeatTimestamp: %v", *(v.LastHeartbeatTimestamp))
+		i++
+	}
+	if v.ScheduledTimestamp != nil {
+		fields[i] = fmt.Sprintf("ScheduledTimestamp: %v", *(v.ScheduledTimestamp))
+		i++
+	}
+	if v.LastStartedTimestamp != nil {
+		fields[i] = fmt.Sprintf("LastStartedTimestamp: %v", *(v.LastStartedTimestamp))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	return fmt.Sprintf("PendingActivityInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _ActivityTaskCanceledEventAttributes_Read(w wire.Value) (*ActivityTaskCanceledEventAttributes, error) {
-	var v ActivityTaskCanceledEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func _PendingActivityState_EqualsPtr(lhs, rhs *PendingActivityState) bool {
+	if lhs != nil && rhs != nil {
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
 }
 
-func _TimerCanceledEventAttributes_Read(w wire.Value) (*TimerCanceledEventAttributes, error) {
-	var v TimerCanceledEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func (v *PendingActivityInfo) Equals(rhs *PendingActivityInfo) bool {
+	if !_String_EqualsPtr(v.ActivityID, rhs.ActivityID) {
+		return false
+	}
+	if !((v.ActivityType == nil && rhs.ActivityType == nil) || (v.ActivityType != nil && rhs.ActivityType != nil && v.ActivityType.Equals(rhs.ActivityType))) {
+		return false
+	}
+	if !_PendingActivityState_EqualsPtr(v.State, rhs.State) {
+		return false
+	}
+	if !((v.HeartbeatDetails == nil && rhs.HeartbeatDetails == nil) || (v.HeartbeatDetails != nil && rhs.HeartbeatDetails != nil && bytes.Equal(v.HeartbeatDetails, rhs.HeartbeatDetails))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastHeartbeatTimestamp, rhs.LastHeartbeatTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledTimestamp, rhs.ScheduledTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LastStartedTimestamp, rhs.LastStartedTimestamp) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	return true
 }
 
-func _CancelTimerFailedEventAttributes_Read(w wire.Value) (*CancelTimerFailedEventAttributes, error) {
-	var v CancelTimerFailedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
+type PendingActivityState int32
 
-func _MarkerRecordedEventAttributes_Read(w wire.Value) (*MarkerRecordedEventAttributes, error) {
-	var v MarkerRecordedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
-}
+const (
+	PendingActivityStateScheduled       PendingActivityState = 0
+	PendingActivityStateStarted         PendingActivityState = 1
+	PendingActivityStateCancelRequested PendingActivityState = 2
+)
 
-func _WorkflowExecutionSignaledEventAttributes_Read(w wire.Value) (*WorkflowExecutionSignaledEventAttributes, error) {
-	var v WorkflowExecutionSignaledEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func PendingActivityState_Values() []PendingActivityState {
+	return []PendingActivityState{PendingActivityStateScheduled, PendingActivityStateStarted, PendingActivityStateCancelRequested}
 }
 
-func _WorkflowExecutionTerminatedEventAttributes_Read(w wire.Value) (*WorkflowExecutionTerminatedEventAttributes, error) {
-	var v WorkflowExecutionTerminatedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func (v *PendingActivityState) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "SCHEDULED":
+		*v = PendingActivityStateScheduled
+		return nil
+	case "STARTED":
+		*v = PendingActivityStateStarted
+		return nil
+	case "CANCEL_REQUESTED":
+		*v = PendingActivityStateCancelRequested
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "PendingActivityState")
+	}
 }
 
-func _WorkflowExecutionCancelRequestedEventAttributes_Read(w wire.Value) (*WorkflowExecutionCancelRequestedEventAttributes, error) {
-	var v WorkflowExecutionCancelRequestedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func (v PendingActivityState) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
 }
 
-func _WorkflowExecutionCanceledEventAttributes_Read(w wire.Value) (*WorkflowExecutionCanceledEventAttributes, error) {
-	var v WorkflowExecutionCanceledEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func (v *PendingActivityState) FromWire(w wire.Value) error {
+	*v = (PendingActivityState)(w.GetI32())
+	return nil
 }
 
-func _RequestCancelExternalWorkflowExecutionInitiatedEventAttributes_Read(w wire.Value) (*RequestCancelExternalWorkflowExecutionInitiatedEventAttributes, error) {
-	var v RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func (v PendingActivityState) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "SCHEDULED"
+	case 1:
+		return "STARTED"
+	case 2:
+		return "CANCEL_REQUESTED"
+	}
+	return fmt.Sprintf("PendingActivityState(%d)", w)
 }
 
-func _RequestCancelExternalWorkflowExecutionFailedEventAttributes_Read(w wire.Value) (*RequestCancelExternalWorkflowExecutionFailedEventAttributes, error) {
-	var v RequestCancelExternalWorkflowExecutionFailedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func (v PendingActivityState) Equals(rhs PendingActivityState) bool {
+	return v == rhs
 }
 
-func _ExternalWorkflowExecutionCancelRequestedEventAttributes_Read(w wire.Value) (*ExternalWorkflowExecutionCancelRequestedEventAttributes, error) {
-	var v ExternalWorkflowExecutionCancelRequestedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func (v PendingActivityState) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"SCHEDULED\""), nil
+	case 1:
+		return ([]byte)("\"STARTED\""), nil
+	case 2:
+		return ([]byte)("\"CANCEL_REQUESTED\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-func _WorkflowExecutionContinuedAsNewEventAttributes_Read(w wire.Value) (*WorkflowExecutionContinuedAsNewEventAttributes, error) {
-	var v WorkflowExecutionContinuedAsNewEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func (v *PendingActivityState) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "PendingActivityState")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "PendingActivityState")
+		}
+		*v = (PendingActivityState)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "PendingActivityState")
+	}
 }
 
-func _StartChildWorkflowExecutionInitiatedEventAttributes_Read(w wire.Value) (*StartChildWorkflowExecutionInitiatedEventAttributes, error) {
-	var v StartChildWorkflowExecutionInitiatedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+type PendingChildExecutionInfo struct {
+	WorkflowID       *string `json:"workflowID,omitempty"`
+	RunID            *string `json:"runID,omitempty"`
+	WorkflowTypeName *string `json:"workflowTypeName,omitempty"`
+	InitiatedID      *int64  `json:"initiatedID,omitempty"`
 }
 
-func _StartChildWorkflowExecutionFailedEventAttributes_Read(w wire.Value) (*StartChildWorkflowExecutionFailedEventAttributes, error) {
-	var v StartChildWorkflowExecutionFailedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func (v *PendingChildExecutionInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.WorkflowID != nil {
+		w, err = wire.NewValueString(*(v.WorkflowID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.RunID != nil {
+		w, err = wire.NewValueString(*(v.RunID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowTypeName != nil {
+		w, err = wire.NewValueString(*(v.WorkflowTypeName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.InitiatedID != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ChildWorkflowExecutionStartedEventAttributes_Read(w wire.Value) (*ChildWorkflowExecutionStartedEventAttributes, error) {
-	var v ChildWorkflowExecutionStartedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func (v *PendingChildExecutionInfo) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowID = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunID = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowTypeName = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InitiatedID = &x
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
 }
 
-func _ChildWorkflowExecutionCompletedEventAttributes_Read(w wire.Value) (*ChildWorkflowExecutionCompletedEventAttributes, error) {
-	var v ChildWorkflowExecutionCompletedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func (v *PendingChildExecutionInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [4]string
+	i := 0
+	if v.WorkflowID != nil {
+		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
+		i++
+	}
+	if v.RunID != nil {
+		fields[i] = fmt.Sprintf("RunID: %v", *(v.RunID))
+		i++
+	}
+	if v.WorkflowTypeName != nil {
+		fields[i] = fmt.Sprintf("WorkflowTypeName: %v", *(v.WorkflowTypeName))
+		i++
+	}
+	if v.InitiatedID != nil {
+		fields[i] = fmt.Sprintf("InitiatedID: %v", *(v.InitiatedID))
+		i++
+	}
+	return fmt.Sprintf("PendingChildExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _ChildWorkflowExecutionFailedEventAttributes_Read(w wire.Value) (*ChildWorkflowExecutionFailedEventAttributes, error) {
-	var v ChildWorkflowExecutionFailedEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func (v *PendingChildExecutionInfo) Equals(rhs *PendingChildExecutionInfo) bool {
+	if !_String_EqualsPtr(v.WorkflowID, rhs.WorkflowID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunID, rhs.RunID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.WorkflowTypeName, rhs.WorkflowTypeName) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InitiatedID, rhs.InitiatedID) {
+		return false
+	}
+	return true
 }
 
-func _ChildWorkflowExecutionCanceledEventAttributes_Read(w wire.Value) (*ChildWorkflowExecutionCanceledEventAttributes, error) {
-	var v ChildWorkflowExecutionCanceledEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+type PendingDecisionInfo struct {
+	State                      *PendingDecisionState `json:"state,omitempty"`
+	ScheduleID                 *int64                `json:"scheduleID,omitempty"`
+	StartedID                  *int64                `json:"startedID,omitempty"`
+	StartToCloseTimeoutSeconds *int32                `json:"startToCloseTimeoutSeconds,omitempty"`
 }
 
-func _ChildWorkflowExecutionTimedOutEventAttributes_Read(w wire.Value) (*ChildWorkflowExecutionTimedOutEventAttributes, error) {
-	var v ChildWorkflowExecutionTimedOutEventAttributes
-	err := v.FromWire(w)
-	return &v, err
+func (v *PendingDecisionInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.State != nil {
+		w, err = v.State.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ScheduleID != nil {
+		w, err = wire.NewValueI64(*(v.ScheduleID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.StartedID != nil {
+		w, err = wire.NewValueI64(*(v.StartedID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.StartToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.StartToCloseTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ChildWorkflowExecutionTerminatedEventAttributes_Read(w wire.Value) (*ChildWorkflowExecutionTerminatedEventAttributes, error) {
-	var v ChildWorkflowExecutionTerminatedEventAttributes
+func _PendingDecisionState_Read(w wire.Value) (PendingDecisionState, error) {
+	var v PendingDecisionState
 	err := v.FromWire(w)
-	return &v, err
+	return v, err
 }
 
-func (v *HistoryEvent) FromWire(w wire.Value) error {
+func (v *PendingDecisionInfo) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.EventId = &x
+			if field.Value.Type() == wire.TI32 {
+				var x PendingDecisionState
+				x, err = _PendingDecisionState_Read(field.Value)
+				v.State = &x
 				if err != nil {
 					return err
 				}
@@ -6608,282 +11157,893 @@ func (v *HistoryEvent) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.Timestamp = &x
+				v.ScheduleID = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 30:
-			if field.Value.Type() == wire.TI32 {
-				var x EventType
-				x, err = _EventType_Read(field.Value)
-				v.EventType = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedID = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionStartedEventAttributes, err = _WorkflowExecutionStartedEventAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionCompletedEventAttributes, err = _WorkflowExecutionCompletedEventAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 60:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionFailedEventAttributes, err = _WorkflowExecutionFailedEventAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 70:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionTimedOutEventAttributes, err = _WorkflowExecutionTimedOutEventAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 80:
-			if field.Value.Type() == wire.TStruct {
-				v.DecisionTaskScheduledEventAttributes, err = _DecisionTaskScheduledEventAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 90:
-			if field.Value.Type() == wire.TStruct {
-				v.DecisionTaskStartedEventAttributes, err = _DecisionTaskStartedEventAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 100:
-			if field.Value.Type() == wire.TStruct {
-				v.DecisionTaskCompletedEventAttributes, err = _DecisionTaskCompletedEventAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 110:
-			if field.Value.Type() == wire.TStruct {
-				v.DecisionTaskTimedOutEventAttributes, err = _DecisionTaskTimedOutEventAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 120:
-			if field.Value.Type() == wire.TStruct {
-				v.DecisionTaskFailedEventAttributes, err = _DecisionTaskFailedEventAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 130:
-			if field.Value.Type() == wire.TStruct {
-				v.ActivityTaskScheduledEventAttributes, err = _ActivityTaskScheduledEventAttributes_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 140:
-			if field.Value.Type() == wire.TStruct {
-				v.ActivityTaskStartedEventAttributes, err = _ActivityTaskStartedEventAttributes_Read(field.Value)
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.StartToCloseTimeoutSeconds = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 150:
-			if field.Value.Type() == wire.TStruct {
-				v.ActivityTaskCompletedEventAttributes, err = _ActivityTaskCompletedEventAttributes_Read(field.Value)
+		}
+	}
+	return nil
+}
+
+func (v *PendingDecisionInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [4]string
+	i := 0
+	if v.State != nil {
+		fields[i] = fmt.Sprintf("State: %v", *(v.State))
+		i++
+	}
+	if v.ScheduleID != nil {
+		fields[i] = fmt.Sprintf("ScheduleID: %v", *(v.ScheduleID))
+		i++
+	}
+	if v.StartedID != nil {
+		fields[i] = fmt.Sprintf("StartedID: %v", *(v.StartedID))
+		i++
+	}
+	if v.StartToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("StartToCloseTimeoutSeconds: %v", *(v.StartToCloseTimeoutSeconds))
+		i++
+	}
+	return fmt.Sprintf("PendingDecisionInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _PendingDecisionState_EqualsPtr(lhs, rhs *PendingDecisionState) bool {
+	if lhs != nil && rhs != nil {
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func (v *PendingDecisionInfo) Equals(rhs *PendingDecisionInfo) bool {
+	if !_PendingDecisionState_EqualsPtr(v.State, rhs.State) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduleID, rhs.ScheduleID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedID, rhs.StartedID) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.StartToCloseTimeoutSeconds, rhs.StartToCloseTimeoutSeconds) {
+		return false
+	}
+	return true
+}
+
+type PendingDecisionState int32
+
+const (
+	PendingDecisionStateScheduled PendingDecisionState = 0
+	PendingDecisionStateStarted   PendingDecisionState = 1
+)
+
+func PendingDecisionState_Values() []PendingDecisionState {
+	return []PendingDecisionState{PendingDecisionStateScheduled, PendingDecisionStateStarted}
+}
+
+func (v *PendingDecisionState) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "SCHEDULED":
+		*v = PendingDecisionStateScheduled
+		return nil
+	case "STARTED":
+		*v = PendingDecisionStateStarted
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "PendingDecisionState")
+	}
+}
+
+func (v PendingDecisionState) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+func (v *PendingDecisionState) FromWire(w wire.Value) error {
+	*v = (PendingDecisionState)(w.GetI32())
+	return nil
+}
+
+func (v PendingDecisionState) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "SCHEDULED"
+	case 1:
+		return "STARTED"
+	}
+	return fmt.Sprintf("PendingDecisionState(%d)", w)
+}
+
+func (v PendingDecisionState) Equals(rhs PendingDecisionState) bool {
+	return v == rhs
+}
+
+func (v PendingDecisionState) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"SCHEDULED\""), nil
+	case 1:
+		return ([]byte)("\"STARTED\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+func (v *PendingDecisionState) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "PendingDecisionState")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "PendingDecisionState")
+		}
+		*v = (PendingDecisionState)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "PendingDecisionState")
+	}
+}
+
+type PollForActivityTaskRequest struct {
+	Domain           *string           `json:"domain,omitempty"`
+	TaskList         *TaskList         `json:"taskList,omitempty"`
+	Identity         *string           `json:"identity,omitempty"`
+	TaskListMetadata *TaskListMetadata `json:"taskListMetadata,omitempty"`
+}
+
+func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.TaskListMetadata != nil {
+		w, err = v.TaskListMetadata.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _TaskListMetadata_Read(w wire.Value) (*TaskListMetadata, error) {
+	var v TaskListMetadata
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 160:
+		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.ActivityTaskFailedEventAttributes, err = _ActivityTaskFailedEventAttributes_Read(field.Value)
+				v.TaskList, err = _TaskList_Read(field.Value)
 				if err != nil {
 					return err
 				}
 			}
-		case 170:
-			if field.Value.Type() == wire.TStruct {
-				v.ActivityTaskTimedOutEventAttributes, err = _ActivityTaskTimedOutEventAttributes_Read(field.Value)
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 180:
+		case 40:
 			if field.Value.Type() == wire.TStruct {
-				v.TimerStartedEventAttributes, err = _TimerStartedEventAttributes_Read(field.Value)
+				v.TaskListMetadata, err = _TaskListMetadata_Read(field.Value)
 				if err != nil {
 					return err
 				}
 			}
-		case 190:
-			if field.Value.Type() == wire.TStruct {
-				v.TimerFiredEventAttributes, err = _TimerFiredEventAttributes_Read(field.Value)
+		}
+	}
+	return nil
+}
+
+func (v *PollForActivityTaskRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [4]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+	if v.TaskListMetadata != nil {
+		fields[i] = fmt.Sprintf("TaskListMetadata: %v", v.TaskListMetadata)
+		i++
+	}
+	return fmt.Sprintf("PollForActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+	if !((v.TaskListMetadata == nil && rhs.TaskListMetadata == nil) || (v.TaskListMetadata != nil && rhs.TaskListMetadata != nil && v.TaskListMetadata.Equals(rhs.TaskListMetadata))) {
+		return false
+	}
+	return true
+}
+
+type PollForActivityTaskResponse struct {
+	TaskToken                     []byte             `json:"taskToken"`
+	WorkflowExecution             *WorkflowExecution `json:"workflowExecution,omitempty"`
+	ActivityId                    *string            `json:"activityId,omitempty"`
+	ActivityType                  *ActivityType      `json:"activityType,omitempty"`
+	Input                         []byte             `json:"input"`
+	StartedEventId                *int64             `json:"startedEventId,omitempty"`
+	ScheduledTimestamp            *int64             `json:"scheduledTimestamp,omitempty"`
+	ScheduleToCloseTimeoutSeconds *int32             `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	StartedTimestamp              *int64             `json:"startedTimestamp,omitempty"`
+	StartToCloseTimeoutSeconds    *int32             `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds       *int32             `json:"heartbeatTimeoutSeconds,omitempty"`
+	Attempt                       *int32             `json:"attempt,omitempty"`
+}
+
+func (v *PollForActivityTaskResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [12]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.TaskToken != nil {
+		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ActivityId != nil {
+		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ActivityType != nil {
+		w, err = v.ActivityType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Input != nil {
+		w, err = wire.NewValueBinary(v.Input), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.StartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.ScheduledTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.ScheduleToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ScheduleToCloseTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.StartedTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.StartedTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.StartToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.StartToCloseTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.HeartbeatTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.HeartbeatTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *PollForActivityTaskResponse) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.TaskToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 			}
-		case 200:
+		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.ActivityTaskCancelRequestedEventAttributes, err = _ActivityTaskCancelRequestedEventAttributes_Read(field.Value)
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
 			}
-		case 210:
-			if field.Value.Type() == wire.TStruct {
-				v.RequestCancelActivityTaskFailedEventAttributes, err = _RequestCancelActivityTaskFailedEventAttributes_Read(field.Value)
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityId = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 220:
+		case 40:
 			if field.Value.Type() == wire.TStruct {
-				v.ActivityTaskCanceledEventAttributes, err = _ActivityTaskCanceledEventAttributes_Read(field.Value)
+				v.ActivityType, err = _ActivityType_Read(field.Value)
 				if err != nil {
 					return err
 				}
 			}
-		case 230:
-			if field.Value.Type() == wire.TStruct {
-				v.TimerCanceledEventAttributes, err = _TimerCanceledEventAttributes_Read(field.Value)
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				v.Input, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 			}
-		case 240:
-			if field.Value.Type() == wire.TStruct {
-				v.CancelTimerFailedEventAttributes, err = _CancelTimerFailedEventAttributes_Read(field.Value)
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedEventId = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 250:
-			if field.Value.Type() == wire.TStruct {
-				v.MarkerRecordedEventAttributes, err = _MarkerRecordedEventAttributes_Read(field.Value)
+		case 70:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledTimestamp = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 260:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionSignaledEventAttributes, err = _WorkflowExecutionSignaledEventAttributes_Read(field.Value)
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ScheduleToCloseTimeoutSeconds = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 270:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionTerminatedEventAttributes, err = _WorkflowExecutionTerminatedEventAttributes_Read(field.Value)
+		case 90:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedTimestamp = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 280:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionCancelRequestedEventAttributes, err = _WorkflowExecutionCancelRequestedEventAttributes_Read(field.Value)
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.StartToCloseTimeoutSeconds = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 290:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionCanceledEventAttributes, err = _WorkflowExecutionCanceledEventAttributes_Read(field.Value)
+		case 110:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.HeartbeatTimeoutSeconds = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 300:
-			if field.Value.Type() == wire.TStruct {
-				v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes, err = _RequestCancelExternalWorkflowExecutionInitiatedEventAttributes_Read(field.Value)
+		case 120:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 310:
-			if field.Value.Type() == wire.TStruct {
-				v.RequestCancelExternalWorkflowExecutionFailedEventAttributes, err = _RequestCancelExternalWorkflowExecutionFailedEventAttributes_Read(field.Value)
+		}
+	}
+	return nil
+}
+
+func (v *PollForActivityTaskResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [12]string
+	i := 0
+	if v.TaskToken != nil {
+		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.ActivityId != nil {
+		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
+		i++
+	}
+	if v.ActivityType != nil {
+		fields[i] = fmt.Sprintf("ActivityType: %v", v.ActivityType)
+		i++
+	}
+	if v.Input != nil {
+		fields[i] = fmt.Sprintf("Input: %v", v.Input)
+		i++
+	}
+	if v.StartedEventId != nil {
+		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
+		i++
+	}
+	if v.ScheduledTimestamp != nil {
+		fields[i] = fmt.Sprintf("ScheduledTimestamp: %v", *(v.ScheduledTimestamp))
+		i++
+	}
+	if v.ScheduleToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("ScheduleToCloseTimeoutSeconds: %v", *(v.ScheduleToCloseTimeoutSeconds))
+		i++
+	}
+	if v.StartedTimestamp != nil {
+		fields[i] = fmt.Sprintf("StartedTimestamp: %v", *(v.StartedTimestamp))
+		i++
+	}
+	if v.StartToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("StartToCloseTimeoutSeconds: %v", *(v.StartToCloseTimeoutSeconds))
+		i++
+	}
+	if v.HeartbeatTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("HeartbeatTimeoutSeconds: %v", *(v.HeartbeatTimeoutSeconds))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	return fmt.Sprintf("PollForActivityTaskResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *PollForActivityTaskResponse) Equals(rhs *PollForActivityTaskResponse) bool {
+	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
+		return false
+	}
+	if !((v.ActivityType == nil && rhs.ActivityType == nil) || (v.ActivityType != nil && rhs.ActivityType != nil && v.ActivityType.Equals(rhs.ActivityType))) {
+		return false
+	}
+	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledTimestamp, rhs.ScheduledTimestamp) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ScheduleToCloseTimeoutSeconds, rhs.ScheduleToCloseTimeoutSeconds) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedTimestamp, rhs.StartedTimestamp) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.StartToCloseTimeoutSeconds, rhs.StartToCloseTimeoutSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.HeartbeatTimeoutSeconds, rhs.HeartbeatTimeoutSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	return true
+}
+
+type PollForDecisionTaskRequest struct {
+	Domain   *string   `json:"domain,omitempty"`
+	TaskList *TaskList `json:"taskList,omitempty"`
+	Identity *string   `json:"identity,omitempty"`
+}
+
+func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 320:
+		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.ExternalWorkflowExecutionCancelRequestedEventAttributes, err = _ExternalWorkflowExecutionCancelRequestedEventAttributes_Read(field.Value)
+				v.TaskList, err = _TaskList_Read(field.Value)
 				if err != nil {
 					return err
 				}
 			}
-		case 330:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionContinuedAsNewEventAttributes, err = _WorkflowExecutionContinuedAsNewEventAttributes_Read(field.Value)
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 340:
-			if field.Value.Type() == wire.TStruct {
-				v.StartChildWorkflowExecutionInitiatedEventAttributes, err = _StartChildWorkflowExecutionInitiatedEventAttributes_Read(field.Value)
+		}
+	}
+	return nil
+}
+
+func (v *PollForDecisionTaskRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [3]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+	return fmt.Sprintf("PollForDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+	return true
+}
+
+type PollForDecisionTaskResponse struct {
+	TaskToken              []byte             `json:"taskToken"`
+	WorkflowExecution      *WorkflowExecution `json:"workflowExecution,omitempty"`
+	WorkflowType           *WorkflowType      `json:"workflowType,omitempty"`
+	PreviousStartedEventId *int64             `json:"previousStartedEventId,omitempty"`
+	StartedEventId         *int64             `json:"startedEventId,omitempty"`
+	History                *History           `json:"history,omitempty"`
+	NextPageToken          []byte             `json:"nextPageToken"`
+	Query                  *WorkflowQuery     `json:"query,omitempty"`
+}
+
+func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.TaskToken != nil {
+		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowType != nil {
+		w, err = v.WorkflowType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.PreviousStartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.PreviousStartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.StartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.History != nil {
+		w, err = v.History.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.Query != nil {
+		w, err = v.Query.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _WorkflowQuery_Read(w wire.Value) (*WorkflowQuery, error) {
+	var v WorkflowQuery
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.TaskToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 			}
-		case 350:
+		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.StartChildWorkflowExecutionFailedEventAttributes, err = _StartChildWorkflowExecutionFailedEventAttributes_Read(field.Value)
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
 			}
-		case 360:
+		case 30:
 			if field.Value.Type() == wire.TStruct {
-				v.ChildWorkflowExecutionStartedEventAttributes, err = _ChildWorkflowExecutionStartedEventAttributes_Read(field.Value)
+				v.WorkflowType, err = _WorkflowType_Read(field.Value)
 				if err != nil {
 					return err
 				}
 			}
-		case 370:
-			if field.Value.Type() == wire.TStruct {
-				v.ChildWorkflowExecutionCompletedEventAttributes, err = _ChildWorkflowExecutionCompletedEventAttributes_Read(field.Value)
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PreviousStartedEventId = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 380:
-			if field.Value.Type() == wire.TStruct {
-				v.ChildWorkflowExecutionFailedEventAttributes, err = _ChildWorkflowExecutionFailedEventAttributes_Read(field.Value)
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedEventId = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 390:
+		case 60:
 			if field.Value.Type() == wire.TStruct {
-				v.ChildWorkflowExecutionCanceledEventAttributes, err = _ChildWorkflowExecutionCanceledEventAttributes_Read(field.Value)
+				v.History, err = _History_Read(field.Value)
 				if err != nil {
 					return err
 				}
 			}
-		case 400:
-			if field.Value.Type() == wire.TStruct {
-				v.ChildWorkflowExecutionTimedOutEventAttributes, err = _ChildWorkflowExecutionTimedOutEventAttributes_Read(field.Value)
+		case 70:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 			}
-		case 410:
+		case 80:
 			if field.Value.Type() == wire.TStruct {
-				v.ChildWorkflowExecutionTerminatedEventAttributes, err = _ChildWorkflowExecutionTerminatedEventAttributes_Read(field.Value)
+				v.Query, err = _WorkflowQuery_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -6893,533 +12053,536 @@ func (v *HistoryEvent) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *HistoryEvent) String() string {
+func (v *PollForDecisionTaskResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [41]string
+	var fields [8]string
 	i := 0
-	if v.EventId != nil {
-		fields[i] = fmt.Sprintf("EventId: %v", *(v.EventId))
-		i++
-	}
-	if v.Timestamp != nil {
-		fields[i] = fmt.Sprintf("Timestamp: %v", *(v.Timestamp))
-		i++
-	}
-	if v.EventType != nil {
-		fields[i] = fmt.Sprintf("EventType: %v", *(v.EventType))
-		i++
-	}
-	if v.WorkflowExecutionStartedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionStartedEventAttributes: %v", v.WorkflowExecutionStartedEventAttributes)
-		i++
-	}
-	if v.WorkflowExecutionCompletedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionCompletedEventAttributes: %v", v.WorkflowExecutionCompletedEventAttributes)
-		i++
-	}
-	if v.WorkflowExecutionFailedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionFailedEventAttributes: %v", v.WorkflowExecutionFailedEventAttributes)
-		i++
-	}
-	if v.WorkflowExecutionTimedOutEventAttributes != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionTimedOutEventAttributes: %v", v.WorkflowExecutionTimedOutEventAttributes)
-		i++
-	}
-	if v.DecisionTaskScheduledEventAttributes != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskScheduledEventAttributes: %v", v.DecisionTaskScheduledEventAttributes)
-		i++
-	}
-	if v.DecisionTaskStartedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskStartedEventAttributes: %v", v.DecisionTaskStartedEventAttributes)
-		i++
-	}
-	if v.DecisionTaskCompletedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventAttributes: %v", v.DecisionTaskCompletedEventAttributes)
-		i++
-	}
-	if v.DecisionTaskTimedOutEventAttributes != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskTimedOutEventAttributes: %v", v.DecisionTaskTimedOutEventAttributes)
-		i++
-	}
-	if v.DecisionTaskFailedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskFailedEventAttributes: %v", v.DecisionTaskFailedEventAttributes)
-		i++
-	}
-	if v.ActivityTaskScheduledEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ActivityTaskScheduledEventAttributes: %v", v.ActivityTaskScheduledEventAttributes)
-		i++
-	}
-	if v.ActivityTaskStartedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ActivityTaskStartedEventAttributes: %v", v.ActivityTaskStartedEventAttributes)
-		i++
-	}
-	if v.ActivityTaskCompletedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ActivityTaskCompletedEventAttributes: %v", v.ActivityTaskCompletedEventAttributes)
-		i++
-	}
-	if v.ActivityTaskFailedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ActivityTaskFailedEventAttributes: %v", v.ActivityTaskFailedEventAttributes)
-		i++
-	}
-	if v.ActivityTaskTimedOutEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ActivityTaskTimedOutEventAttributes: %v", v.ActivityTaskTimedOutEventAttributes)
-		i++
-	}
-	if v.TimerStartedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("TimerStartedEventAttributes: %v", v.TimerStartedEventAttributes)
-		i++
-	}
-	if v.TimerFiredEventAttributes != nil {
-		fields[i] = fmt.Sprintf("TimerFiredEventAttributes: %v", v.TimerFiredEventAttributes)
-		i++
-	}
-	if v.ActivityTaskCancelRequestedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ActivityTaskCancelRequestedEventAttributes: %v", v.ActivityTaskCancelRequestedEventAttributes)
-		i++
-	}
-	if v.RequestCancelActivityTaskFailedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("RequestCancelActivityTaskFailedEventAttributes: %v", v.RequestCancelActivityTaskFailedEventAttributes)
-		i++
-	}
-	if v.ActivityTaskCanceledEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ActivityTaskCanceledEventAttributes: %v", v.ActivityTaskCanceledEventAttributes)
-		i++
-	}
-	if v.TimerCanceledEventAttributes != nil {
-		fields[i] = fmt.Sprintf("TimerCanceledEventAttributes: %v", v.TimerCanceledEventAttributes)
-		i++
-	}
-	if v.CancelTimerFailedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("CancelTimerFailedEventAttributes: %v", v.CancelTimerFailedEventAttributes)
-		i++
-	}
-	if v.MarkerRecordedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("MarkerRecordedEventAttributes: %v", v.MarkerRecordedEventAttributes)
-		i++
-	}
-	if v.WorkflowExecutionSignaledEventAttributes != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionSignaledEventAttributes: %v", v.WorkflowExecutionSignaledEventAttributes)
-		i++
-	}
-	if v.WorkflowExecutionTerminatedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionTerminatedEventAttributes: %v", v.WorkflowExecutionTerminatedEventAttributes)
-		i++
-	}
-	if v.WorkflowExecutionCancelRequestedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionCancelRequestedEventAttributes: %v", v.WorkflowExecutionCancelRequestedEventAttributes)
-		i++
-	}
-	if v.WorkflowExecutionCanceledEventAttributes != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionCanceledEventAttributes: %v", v.WorkflowExecutionCanceledEventAttributes)
-		i++
-	}
-	if v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("RequestCancelExternalWorkflowExecutionInitiatedEventAttributes: %v", v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes)
-		i++
-	}
-	if v.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("RequestCancelExternalWorkflowExecutionFailedEventAttributes: %v", v.RequestCancelExternalWorkflowExecutionFailedEventAttributes)
-		i++
-	}
-	if v.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ExternalWorkflowExecutionCancelRequestedEventAttributes: %v", v.ExternalWorkflowExecutionCancelRequestedEventAttributes)
-		i++
-	}
-	if v.WorkflowExecutionContinuedAsNewEventAttributes != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionContinuedAsNewEventAttributes: %v", v.WorkflowExecutionContinuedAsNewEventAttributes)
-		i++
-	}
-	if v.StartChildWorkflowExecutionInitiatedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("StartChildWorkflowExecutionInitiatedEventAttributes: %v", v.StartChildWorkflowExecutionInitiatedEventAttributes)
-		i++
-	}
-	if v.StartChildWorkflowExecutionFailedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("StartChildWorkflowExecutionFailedEventAttributes: %v", v.StartChildWorkflowExecutionFailedEventAttributes)
+	if v.TaskToken != nil {
+		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
 		i++
 	}
-	if v.ChildWorkflowExecutionStartedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowExecutionStartedEventAttributes: %v", v.ChildWorkflowExecutionStartedEventAttributes)
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
-	if v.ChildWorkflowExecutionCompletedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowExecutionCompletedEventAttributes: %v", v.ChildWorkflowExecutionCompletedEventAttributes)
+	if v.WorkflowType != nil {
+		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
 		i++
 	}
-	if v.ChildWorkflowExecutionFailedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowExecutionFailedEventAttributes: %v", v.ChildWorkflowExecutionFailedEventAttributes)
+	if v.PreviousStartedEventId != nil {
+		fields[i] = fmt.Sprintf("PreviousStartedEventId: %v", *(v.PreviousStartedEventId))
 		i++
 	}
-	if v.ChildWorkflowExecutionCanceledEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowExecutionCanceledEventAttributes: %v", v.ChildWorkflowExecutionCanceledEventAttributes)
+	if v.StartedEventId != nil {
+		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
 		i++
 	}
-	if v.ChildWorkflowExecutionTimedOutEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowExecutionTimedOutEventAttributes: %v", v.ChildWorkflowExecutionTimedOutEventAttributes)
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
 		i++
 	}
-	if v.ChildWorkflowExecutionTerminatedEventAttributes != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowExecutionTerminatedEventAttributes: %v", v.ChildWorkflowExecutionTerminatedEventAttributes)
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
-	return fmt.Sprintf("HistoryEvent{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _EventType_EqualsPtr(lhs, rhs *EventType) bool {
-	if lhs != nil && rhs != nil {
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
+	if v.Query != nil {
+		fields[i] = fmt.Sprintf("Query: %v", v.Query)
+		i++
 	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("PollForDecisionTaskResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *HistoryEvent) Equals(rhs *HistoryEvent) bool {
-	if !_I64_EqualsPtr(v.EventId, rhs.EventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.Timestamp, rhs.Timestamp) {
-		return false
-	}
-	if !_EventType_EqualsPtr(v.EventType, rhs.EventType) {
-		return false
-	}
-	if !((v.WorkflowExecutionStartedEventAttributes == nil && rhs.WorkflowExecutionStartedEventAttributes == nil) || (v.WorkflowExecutionStartedEventAttributes != nil && rhs.WorkflowExecutionStartedEventAttributes != nil && v.WorkflowExecutionStartedEventAttributes.Equals(rhs.WorkflowExecutionStartedEventAttributes))) {
-		return false
-	}
-	if !((v.WorkflowExecutionCompletedEventAttributes == nil && rhs.WorkflowExecutionCompletedEventAttributes == nil) || (v.WorkflowExecutionCompletedEventAttributes != nil && rhs.WorkflowExecutionCompletedEventAttributes != nil && v.WorkflowExecutionCompletedEventAttributes.Equals(rhs.WorkflowExecutionCompletedEventAttributes))) {
+func (v *PollForDecisionTaskResponse) Equals(rhs *PollForDecisionTaskResponse) bool {
+	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
 		return false
 	}
-	if !((v.WorkflowExecutionFailedEventAttributes == nil && rhs.WorkflowExecutionFailedEventAttributes == nil) || (v.WorkflowExecutionFailedEventAttributes != nil && rhs.WorkflowExecutionFailedEventAttributes != nil && v.WorkflowExecutionFailedEventAttributes.Equals(rhs.WorkflowExecutionFailedEventAttributes))) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
-	if !((v.WorkflowExecutionTimedOutEventAttributes == nil && rhs.WorkflowExecutionTimedOutEventAttributes == nil) || (v.WorkflowExecutionTimedOutEventAttributes != nil && rhs.WorkflowExecutionTimedOutEventAttributes != nil && v.WorkflowExecutionTimedOutEventAttributes.Equals(rhs.WorkflowExecutionTimedOutEventAttributes))) {
+	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
 		return false
 	}
-	if !((v.DecisionTaskScheduledEventAttributes == nil && rhs.DecisionTaskScheduledEventAttributes == nil) || (v.DecisionTaskScheduledEventAttributes != nil && rhs.DecisionTaskScheduledEventAttributes != nil && v.DecisionTaskScheduledEventAttributes.Equals(rhs.DecisionTaskScheduledEventAttributes))) {
+	if !_I64_EqualsPtr(v.PreviousStartedEventId, rhs.PreviousStartedEventId) {
 		return false
 	}
-	if !((v.DecisionTaskStartedEventAttributes == nil && rhs.DecisionTaskStartedEventAttributes == nil) || (v.DecisionTaskStartedEventAttributes != nil && rhs.DecisionTaskStartedEventAttributes != nil && v.DecisionTaskStartedEventAttributes.Equals(rhs.DecisionTaskStartedEventAttributes))) {
+	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
 		return false
 	}
-	if !((v.DecisionTaskCompletedEventAttributes == nil && rhs.DecisionTaskCompletedEventAttributes == nil) || (v.DecisionTaskCompletedEventAttributes != nil && rhs.DecisionTaskCompletedEventAttributes != nil && v.DecisionTaskCompletedEventAttributes.Equals(rhs.DecisionTaskCompletedEventAttributes))) {
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
 		return false
 	}
-	if !((v.DecisionTaskTimedOutEventAttributes == nil && rhs.DecisionTaskTimedOutEventAttributes == nil) || (v.DecisionTaskTimedOutEventAttributes != nil && rhs.DecisionTaskTimedOutEventAttributes != nil && v.DecisionTaskTimedOutEventAttributes.Equals(rhs.DecisionTaskTimedOutEventAttributes))) {
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
-	if !((v.DecisionTaskFailedEventAttributes == nil && rhs.DecisionTaskFailedEventAttributes == nil) || (v.DecisionTaskFailedEventAttributes != nil && rhs.DecisionTaskFailedEventAttributes != nil && v.DecisionTaskFailedEventAttributes.Equals(rhs.DecisionTaskFailedEventAttributes))) {
+	if !((v.Query == nil && rhs.Query == nil) || (v.Query != nil && rhs.Query != nil && v.Query.Equals(rhs.Query))) {
 		return false
 	}
-	if !((v.ActivityTaskScheduledEventAttributes == nil && rhs.ActivityTaskScheduledEventAttributes == nil) || (v.ActivityTaskScheduledEventAttributes != nil && rhs.ActivityTaskScheduledEventAttributes != nil && v.ActivityTaskScheduledEventAttributes.Equals(rhs.ActivityTaskScheduledEventAttributes))) {
-		return false
+	return true
+}
+
+type PollerInfo struct {
+	LastAccessTime *int64  `json:"lastAccessTime,omitempty"`
+	Identity       *string `json:"identity,omitempty"`
+}
+
+func (v *PollerInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.LastAccessTime != nil {
+		w, err = wire.NewValueI64(*(v.LastAccessTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
-	if !((v.ActivityTaskStartedEventAttributes == nil && rhs.ActivityTaskStartedEventAttributes == nil) || (v.ActivityTaskStartedEventAttributes != nil && rhs.ActivityTaskStartedEventAttributes != nil && v.ActivityTaskStartedEventAttributes.Equals(rhs.ActivityTaskStartedEventAttributes))) {
-		return false
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
-	if !((v.ActivityTaskCompletedEventAttributes == nil && rhs.ActivityTaskCompletedEventAttributes == nil) || (v.ActivityTaskCompletedEventAttributes != nil && rhs.ActivityTaskCompletedEventAttributes != nil && v.ActivityTaskCompletedEventAttributes.Equals(rhs.ActivityTaskCompletedEventAttributes))) {
-		return false
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *PollerInfo) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LastAccessTime = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
-	if !((v.ActivityTaskFailedEventAttributes == nil && rhs.ActivityTaskFailedEventAttributes == nil) || (v.ActivityTaskFailedEventAttributes != nil && rhs.ActivityTaskFailedEventAttributes != nil && v.ActivityTaskFailedEventAttributes.Equals(rhs.ActivityTaskFailedEventAttributes))) {
-		return false
+	return nil
+}
+
+func (v *PollerInfo) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	if !((v.ActivityTaskTimedOutEventAttributes == nil && rhs.ActivityTaskTimedOutEventAttributes == nil) || (v.ActivityTaskTimedOutEventAttributes != nil && rhs.ActivityTaskTimedOutEventAttributes != nil && v.ActivityTaskTimedOutEventAttributes.Equals(rhs.ActivityTaskTimedOutEventAttributes))) {
-		return false
+	var fields [2]string
+	i := 0
+	if v.LastAccessTime != nil {
+		fields[i] = fmt.Sprintf("LastAccessTime: %v", *(v.LastAccessTime))
+		i++
 	}
-	if !((v.TimerStartedEventAttributes == nil && rhs.TimerStartedEventAttributes == nil) || (v.TimerStartedEventAttributes != nil && rhs.TimerStartedEventAttributes != nil && v.TimerStartedEventAttributes.Equals(rhs.TimerStartedEventAttributes))) {
-		return false
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
 	}
-	if !((v.TimerFiredEventAttributes == nil && rhs.TimerFiredEventAttributes == nil) || (v.TimerFiredEventAttributes != nil && rhs.TimerFiredEventAttributes != nil && v.TimerFiredEventAttributes.Equals(rhs.TimerFiredEventAttributes))) {
+	return fmt.Sprintf("PollerInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *PollerInfo) Equals(rhs *PollerInfo) bool {
+	if !_I64_EqualsPtr(v.LastAccessTime, rhs.LastAccessTime) {
 		return false
 	}
-	if !((v.ActivityTaskCancelRequestedEventAttributes == nil && rhs.ActivityTaskCancelRequestedEventAttributes == nil) || (v.ActivityTaskCancelRequestedEventAttributes != nil && rhs.ActivityTaskCancelRequestedEventAttributes != nil && v.ActivityTaskCancelRequestedEventAttributes.Equals(rhs.ActivityTaskCancelRequestedEventAttributes))) {
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
-	if !((v.RequestCancelActivityTaskFailedEventAttributes == nil && rhs.RequestCancelActivityTaskFailedEventAttributes == nil) || (v.RequestCancelActivityTaskFailedEventAttributes != nil && rhs.RequestCancelActivityTaskFailedEventAttributes != nil && v.RequestCancelActivityTaskFailedEventAttributes.Equals(rhs.RequestCancelActivityTaskFailedEventAttributes))) {
-		return false
+	return true
+}
+
+type QueryFailedError struct {
+	Message string `json:"message,required"`
+}
+
+func (v *QueryFailedError) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
 	}
-	if !((v.ActivityTaskCanceledEventAttributes == nil && rhs.ActivityTaskCanceledEventAttributes == nil) || (v.ActivityTaskCanceledEventAttributes != nil && rhs.ActivityTaskCanceledEventAttributes != nil && v.ActivityTaskCanceledEventAttributes.Equals(rhs.ActivityTaskCanceledEventAttributes))) {
-		return false
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *QueryFailedError) FromWire(w wire.Value) error {
+	var err error
+	messageIsSet := false
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				messageIsSet = true
+			}
+		}
 	}
-	if !((v.TimerCanceledEventAttributes == nil && rhs.TimerCanceledEventAttributes == nil) || (v.TimerCanceledEventAttributes != nil && rhs.TimerCanceledEventAttributes != nil && v.TimerCanceledEventAttributes.Equals(rhs.TimerCanceledEventAttributes))) {
-		return false
+	if !messageIsSet {
+		return errors.New("field Message of QueryFailedError is required")
 	}
-	if !((v.CancelTimerFailedEventAttributes == nil && rhs.CancelTimerFailedEventAttributes == nil) || (v.CancelTimerFailedEventAttributes != nil && rhs.CancelTimerFailedEventAttributes != nil && v.CancelTimerFailedEventAttributes.Equals(rhs.CancelTimerFailedEventAttributes))) {
-		return false
+	return nil
+}
+
+func (v *QueryFailedError) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	if !((v.MarkerRecordedEventAttributes == nil && rhs.MarkerRecordedEventAttributes == nil) || (v.MarkerRecordedEventAttributes != nil && rhs.MarkerRecordedEventAttributes != nil && v.MarkerRecordedEventAttributes.Equals(rhs.MarkerRecordedEventAttributes))) {
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+	return fmt.Sprintf("QueryFailedError{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *QueryFailedError) Equals(rhs *QueryFailedError) bool {
+	if !(v.Message == rhs.Message) {
 		return false
 	}
-	if !((v.WorkflowExecutionSignaledEventAttributes == nil && rhs.WorkflowExecutionSignaledEventAttributes == nil) || (v.WorkflowExecutionSignaledEventAttributes != nil && rhs.WorkflowExecutionSignaledEventAttributes != nil && v.WorkflowExecutionSignaledEventAttributes.Equals(rhs.WorkflowExecutionSignaledEventAttributes))) {
-		return false
+	return true
+}
+
+func (v *QueryFailedError) Error() string {
+	return v.String()
+}
+
+type QueryTaskCompletedType int32
+
+const (
+	QueryTaskCompletedTypeCompleted QueryTaskCompletedType = 0
+	QueryTaskCompletedTypeFailed    QueryTaskCompletedType = 1
+)
+
+func QueryTaskCompletedType_Values() []QueryTaskCompletedType {
+	return []QueryTaskCompletedType{QueryTaskCompletedTypeCompleted, QueryTaskCompletedTypeFailed}
+}
+
+func (v *QueryTaskCompletedType) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "COMPLETED":
+		*v = QueryTaskCompletedTypeCompleted
+		return nil
+	case "FAILED":
+		*v = QueryTaskCompletedTypeFailed
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "QueryTaskCompletedType")
 	}
-	if !((v.WorkflowExecutionTerminatedEventAttributes == nil && rhs.WorkflowExecutionTerminatedEventAttributes == nil) || (v.WorkflowExecutionTerminatedEventAttributes != nil && rhs.WorkflowExecutionTerminatedEventAttributes != nil && v.WorkflowExecutionTerminatedEventAttributes.Equals(rhs.WorkflowExecutionTerminatedEventAttributes))) {
-		return false
+}
+
+func (v QueryTaskCompletedType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+func (v *QueryTaskCompletedType) FromWire(w wire.Value) error {
+	*v = (QueryTaskCompletedType)(w.GetI32())
+	return nil
+}
+
+func (v QueryTaskCompletedType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "COMPLETED"
+	case 1:
+		return "FAILED"
 	}
-	if !((v.WorkflowExecutionCancelRequestedEventAttributes == nil && rhs.WorkflowExecutionCancelRequestedEventAttributes == nil) || (v.WorkflowExecutionCancelRequestedEventAttributes != nil && rhs.WorkflowExecutionCancelRequestedEventAttributes != nil && v.WorkflowExecutionCancelRequestedEventAttributes.Equals(rhs.WorkflowExecutionCancelRequestedEventAttributes))) {
-		return false
+	return fmt.Sprintf("QueryTaskCompletedType(%d)", w)
+}
+
+func (v QueryTaskCompletedType) Equals(rhs QueryTaskCompletedType) bool {
+	return v == rhs
+}
+
+func (v QueryTaskCompletedType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"COMPLETED\""), nil
+	case 1:
+		return ([]byte)("\"FAILED\""), nil
 	}
-	if !((v.WorkflowExecutionCanceledEventAttributes == nil && rhs.WorkflowExecutionCanceledEventAttributes == nil) || (v.WorkflowExecutionCanceledEventAttributes != nil && rhs.WorkflowExecutionCanceledEventAttributes != nil && v.WorkflowExecutionCanceledEventAttributes.Equals(rhs.WorkflowExecutionCanceledEventAttributes))) {
-		return false
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+func (v *QueryTaskCompletedType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
 	}
-	if !((v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes == nil && rhs.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes == nil) || (v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil && rhs.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes != nil && v.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.Equals(rhs.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes))) {
-		return false
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "QueryTaskCompletedType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "QueryTaskCompletedType")
+		}
+		*v = (QueryTaskCompletedType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "QueryTaskCompletedType")
 	}
-	if !((v.RequestCancelExternalWorkflowExecutionFailedEventAttributes == nil && rhs.RequestCancelExternalWorkflowExecutionFailedEventAttributes == nil) || (v.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil && rhs.RequestCancelExternalWorkflowExecutionFailedEventAttributes != nil && v.RequestCancelExternalWorkflowExecutionFailedEventAttributes.Equals(rhs.RequestCancelExternalWorkflowExecutionFailedEventAttributes))) {
-		return false
+}
+
+type QueryWorkflowRequest struct {
+	Domain    *string            `json:"domain,omitempty"`
+	Execution *WorkflowExecution `json:"execution,omitempty"`
+	Query     *WorkflowQuery     `json:"query,omitempty"`
+}
+
+func (v *QueryWorkflowRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
-	if !((v.ExternalWorkflowExecutionCancelRequestedEventAttributes == nil && rhs.ExternalWorkflowExecutionCancelRequestedEventAttributes == nil) || (v.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil && rhs.ExternalWorkflowExecutionCancelRequestedEventAttributes != nil && v.ExternalWorkflowExecutionCancelRequestedEventAttributes.Equals(rhs.ExternalWorkflowExecutionCancelRequestedEventAttributes))) {
-		return false
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
-	if !((v.WorkflowExecutionContinuedAsNewEventAttributes == nil && rhs.WorkflowExecutionContinuedAsNewEventAttributes == nil) || (v.WorkflowExecutionContinuedAsNewEventAttributes != nil && rhs.WorkflowExecutionContinuedAsNewEventAttributes != nil && v.WorkflowExecutionContinuedAsNewEventAttributes.Equals(rhs.WorkflowExecutionContinuedAsNewEventAttributes))) {
-		return false
+	if v.Query != nil {
+		w, err = v.Query.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
 	}
-	if !((v.StartChildWorkflowExecutionInitiatedEventAttributes == nil && rhs.StartChildWorkflowExecutionInitiatedEventAttributes == nil) || (v.StartChildWorkflowExecutionInitiatedEventAttributes != nil && rhs.StartChildWorkflowExecutionInitiatedEventAttributes != nil && v.StartChildWorkflowExecutionInitiatedEventAttributes.Equals(rhs.StartChildWorkflowExecutionInitiatedEventAttributes))) {
-		return false
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *QueryWorkflowRequest) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.Query, err = _WorkflowQuery_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
-	if !((v.StartChildWorkflowExecutionFailedEventAttributes == nil && rhs.StartChildWorkflowExecutionFailedEventAttributes == nil) || (v.StartChildWorkflowExecutionFailedEventAttributes != nil && rhs.StartChildWorkflowExecutionFailedEventAttributes != nil && v.StartChildWorkflowExecutionFailedEventAttributes.Equals(rhs.StartChildWorkflowExecutionFailedEventAttributes))) {
-		return false
+	return nil
+}
+
+func (v *QueryWorkflowRequest) String() string {
+	if v == nil {
+		return "<nil>"
 	}
-	if !((v.ChildWorkflowExecutionStartedEventAttributes == nil && rhs.ChildWorkflowExecutionStartedEventAttributes == nil) || (v.ChildWorkflowExecutionStartedEventAttributes != nil && rhs.ChildWorkflowExecutionStartedEventAttributes != nil && v.ChildWorkflowExecutionStartedEventAttributes.Equals(rhs.ChildWorkflowExecutionStartedEventAttributes))) {
-		return false
+	var fields [3]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
 	}
-	if !((v.ChildWorkflowExecutionCompletedEventAttributes == nil && rhs.ChildWorkflowExecutionCompletedEventAttributes == nil) || (v.ChildWorkflowExecutionCompletedEventAttributes != nil && rhs.ChildWorkflowExecutionCompletedEventAttributes != nil && v.ChildWorkflowExecutionCompletedEventAttributes.Equals(rhs.ChildWorkflowExecutionCompletedEventAttributes))) {
-		return false
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
 	}
-	if !((v.ChildWorkflowExecutionFailedEventAttributes == nil && rhs.ChildWorkflowExecutionFailedEventAttributes == nil) || (v.ChildWorkflowExecutionFailedEventAttributes != nil && rhs.ChildWorkflowExecutionFailedEventAttributes != nil && v.ChildWorkflowExecutionFailedEventAttributes.Equals(rhs.ChildWorkflowExecutionFailedEventAttributes))) {
-		return false
+	if v.Query != nil {
+		fields[i] = fmt.Sprintf("Query: %v", v.Query)
+		i++
 	}
-	if !((v.ChildWorkflowExecutionCanceledEventAttributes == nil && rhs.ChildWorkflowExecutionCanceledEventAttributes == nil) || (v.ChildWorkflowExecutionCanceledEventAttributes != nil && rhs.ChildWorkflowExecutionCanceledEventAttributes != nil && v.ChildWorkflowExecutionCanceledEventAttributes.Equals(rhs.ChildWorkflowExecutionCanceledEventAttributes))) {
+	return fmt.Sprintf("QueryWorkflowRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *QueryWorkflowRequest) Equals(rhs *QueryWorkflowRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !((v.ChildWorkflowExecutionTimedOutEventAttributes == nil && rhs.ChildWorkflowExecutionTimedOutEventAttributes == nil) || (v.ChildWorkflowExecutionTimedOutEventAttributes != nil && rhs.ChildWorkflowExecutionTimedOutEventAttributes != nil && v.ChildWorkflowExecutionTimedOutEventAttributes.Equals(rhs.ChildWorkflowExecutionTimedOutEventAttributes))) {
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
 		return false
 	}
-	if !((v.ChildWorkflowExecutionTerminatedEventAttributes == nil && rhs.ChildWorkflowExecutionTerminatedEventAttributes == nil) || (v.ChildWorkflowExecutionTerminatedEventAttributes != nil && rhs.ChildWorkflowExecutionTerminatedEventAttributes != nil && v.ChildWorkflowExecutionTerminatedEventAttributes.Equals(rhs.ChildWorkflowExecutionTerminatedEventAttributes))) {
+	if !((v.Query == nil && rhs.Query == nil) || (v.Query != nil && rhs.Query != nil && v.Query.Equals(rhs.Query))) {
 		return false
 	}
 	return true
 }
 
-type InternalServiceError struct {
-	Message string `json:"message,required"`
+type QueryWorkflowResponse struct {
+	QueryResult []byte `json:"queryResult"`
 }
 
-func (v *InternalServiceError) ToWire() (wire.Value, error) {
+func (v *QueryWorkflowResponse) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
+	if v.QueryResult != nil {
+		w, err = wire.NewValueBinary(v.QueryResult), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *InternalServiceError) FromWire(w wire.Value) error {
+func (v *QueryWorkflowResponse) FromWire(w wire.Value) error {
 	var err error
-	messageIsSet := false
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 1:
+		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
+				v.QueryResult, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
-				messageIsSet = true
 			}
 		}
 	}
-	if !messageIsSet {
-		return errors.New("field Message of InternalServiceError is required")
-	}
 	return nil
 }
 
-func (v *InternalServiceError) String() string {
+func (v *QueryWorkflowResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 	var fields [1]string
 	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
-	return fmt.Sprintf("InternalServiceError{%v}", strings.Join(fields[:i], ", "))
+	if v.QueryResult != nil {
+		fields[i] = fmt.Sprintf("QueryResult: %v", v.QueryResult)
+		i++
+	}
+	return fmt.Sprintf("QueryWorkflowResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *InternalServiceError) Equals(rhs *InternalServiceError) bool {
-	if !(v.Message == rhs.Message) {
+func (v *QueryWorkflowResponse) Equals(rhs *QueryWorkflowResponse) bool {
+	if !((v.QueryResult == nil && rhs.QueryResult == nil) || (v.QueryResult != nil && rhs.QueryResult != nil && bytes.Equal(v.QueryResult, rhs.QueryResult))) {
 		return false
 	}
 	return true
 }
 
-func (v *InternalServiceError) Error() string {
-	return v.String()
-}
-
-type ListClosedWorkflowExecutionsRequest struct {
-	Domain          *string                       `json:"domain,omitempty"`
-	MaximumPageSize *int32                        `json:"maximumPageSize,omitempty"`
-	NextPageToken   []byte                        `json:"nextPageToken"`
-	StartTimeFilter *StartTimeFilter              `json:"StartTimeFilter,omitempty"`
-	ExecutionFilter *WorkflowExecutionFilter      `json:"executionFilter,omitempty"`
-	TypeFilter      *WorkflowTypeFilter           `json:"typeFilter,omitempty"`
-	StatusFilter    *WorkflowExecutionCloseStatus `json:"statusFilter,omitempty"`
+type RecordActivityTaskHeartbeatRequest struct {
+	TaskToken []byte  `json:"taskToken"`
+	Details   []byte  `json:"details"`
+	Identity  *string `json:"identity,omitempty"`
 }
 
-func (v *ListClosedWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
+func (v *RecordActivityTaskHeartbeatRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.TaskToken != nil {
+		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.MaximumPageSize != nil {
-		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.StartTimeFilter != nil {
-		w, err = v.StartTimeFilter.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.ExecutionFilter != nil {
-		w, err = v.ExecutionFilter.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.TypeFilter != nil {
-		w, err = v.TypeFilter.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
-	if v.StatusFilter != nil {
-		w, err = v.StatusFilter.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _StartTimeFilter_Read(w wire.Value) (*StartTimeFilter, error) {
-	var v StartTimeFilter
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowExecutionFilter_Read(w wire.Value) (*WorkflowExecutionFilter, error) {
-	var v WorkflowExecutionFilter
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowTypeFilter_Read(w wire.Value) (*WorkflowTypeFilter, error) {
-	var v WorkflowTypeFilter
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowExecutionCloseStatus_Read(w wire.Value) (WorkflowExecutionCloseStatus, error) {
-	var v WorkflowExecutionCloseStatus
-	err := v.FromWire(w)
-	return v, err
-}
-
-func (v *ListClosedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
+func (v *RecordActivityTaskHeartbeatRequest) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+				v.TaskToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 			}
 		case 20:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.MaximumPageSize = &x
-				if err != nil {
-					return err
-				}
-			}
-		case 30:
 			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-			}
-		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.StartTimeFilter, err = _StartTimeFilter_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.ExecutionFilter, err = _WorkflowExecutionFilter_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 60:
-			if field.Value.Type() == wire.TStruct {
-				v.TypeFilter, err = _WorkflowTypeFilter_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 70:
-			if field.Value.Type() == wire.TI32 {
-				var x WorkflowExecutionCloseStatus
-				x, err = _WorkflowExecutionCloseStatus_Read(field.Value)
-				v.StatusFilter = &x
+				v.Details, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
@@ -7429,129 +12592,122 @@ func (v *ListClosedWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *ListClosedWorkflowExecutionsRequest) String() string {
+func (v *RecordActivityTaskHeartbeatRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [7]string
+	var fields [3]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
-		i++
-	}
-	if v.MaximumPageSize != nil {
-		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
-		i++
-	}
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
-		i++
-	}
-	if v.StartTimeFilter != nil {
-		fields[i] = fmt.Sprintf("StartTimeFilter: %v", v.StartTimeFilter)
-		i++
-	}
-	if v.ExecutionFilter != nil {
-		fields[i] = fmt.Sprintf("ExecutionFilter: %v", v.ExecutionFilter)
+	if v.TaskToken != nil {
+		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
 		i++
 	}
-	if v.TypeFilter != nil {
-		fields[i] = fmt.Sprintf("TypeFilter: %v", v.TypeFilter)
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
 		i++
 	}
-	if v.StatusFilter != nil {
-		fields[i] = fmt.Sprintf("StatusFilter: %v", *(v.StatusFilter))
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
-	return fmt.Sprintf("ListClosedWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _WorkflowExecutionCloseStatus_EqualsPtr(lhs, rhs *WorkflowExecutionCloseStatus) bool {
-	if lhs != nil && rhs != nil {
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("RecordActivityTaskHeartbeatRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *ListClosedWorkflowExecutionsRequest) Equals(rhs *ListClosedWorkflowExecutionsRequest) bool {
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
-		return false
-	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
-		return false
-	}
-	if !((v.StartTimeFilter == nil && rhs.StartTimeFilter == nil) || (v.StartTimeFilter != nil && rhs.StartTimeFilter != nil && v.StartTimeFilter.Equals(rhs.StartTimeFilter))) {
-		return false
-	}
-	if !((v.ExecutionFilter == nil && rhs.ExecutionFilter == nil) || (v.ExecutionFilter != nil && rhs.ExecutionFilter != nil && v.ExecutionFilter.Equals(rhs.ExecutionFilter))) {
+func (v *RecordActivityTaskHeartbeatRequest) Equals(rhs *RecordActivityTaskHeartbeatRequest) bool {
+	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
 		return false
 	}
-	if !((v.TypeFilter == nil && rhs.TypeFilter == nil) || (v.TypeFilter != nil && rhs.TypeFilter != nil && v.TypeFilter.Equals(rhs.TypeFilter))) {
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
 		return false
 	}
-	if !_WorkflowExecutionCloseStatus_EqualsPtr(v.StatusFilter, rhs.StatusFilter) {
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
 	return true
 }
 
-type ListClosedWorkflowExecutionsResponse struct {
-	Executions    []*WorkflowExecutionInfo `json:"executions"`
-	NextPageToken []byte                   `json:"nextPageToken"`
+type RecordActivityTaskHeartbeatResponse struct {
+	CancelRequested *bool `json:"cancelRequested,omitempty"`
 }
 
-type _List_WorkflowExecutionInfo_ValueList []*WorkflowExecutionInfo
-
-func (v _List_WorkflowExecutionInfo_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
+func (v *RecordActivityTaskHeartbeatResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.CancelRequested != nil {
+		w, err = wire.NewValueBool(*(v.CancelRequested)), error(nil)
 		if err != nil {
-			return err
+			return w, err
 		}
-		err = f(w)
-		if err != nil {
-			return err
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *RecordActivityTaskHeartbeatResponse) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.CancelRequested = &x
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
 }
 
-func (v _List_WorkflowExecutionInfo_ValueList) Size() int {
-	return len(v)
+func (v *RecordActivityTaskHeartbeatResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [1]string
+	i := 0
+	if v.CancelRequested != nil {
+		fields[i] = fmt.Sprintf("CancelRequested: %v", *(v.CancelRequested))
+		i++
+	}
+	return fmt.Sprintf("RecordActivityTaskHeartbeatResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (_List_WorkflowExecutionInfo_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+func (v *RecordActivityTaskHeartbeatResponse) Equals(rhs *RecordActivityTaskHeartbeatResponse) bool {
+	if !_Bool_EqualsPtr(v.CancelRequested, rhs.CancelRequested) {
+		return false
+	}
+	return true
 }
 
-func (_List_WorkflowExecutionInfo_ValueList) Close() {
+type RecordMarkerDecisionAttributes struct {
+	MarkerName *string `json:"markerName,omitempty"`
+	Details    []byte  `json:"details"`
 }
 
-func (v *ListClosedWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
+func (v *RecordMarkerDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.Executions != nil {
-		w, err = wire.NewValueList(_List_WorkflowExecutionInfo_ValueList(v.Executions)), error(nil)
+	if v.MarkerName != nil {
+		w, err = wire.NewValueString(*(v.MarkerName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -7561,43 +12717,22 @@ func (v *ListClosedWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _WorkflowExecutionInfo_Read(w wire.Value) (*WorkflowExecutionInfo, error) {
-	var v WorkflowExecutionInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_WorkflowExecutionInfo_Read(l wire.ValueList) ([]*WorkflowExecutionInfo, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-	o := make([]*WorkflowExecutionInfo, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _WorkflowExecutionInfo_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-func (v *ListClosedWorkflowExecutionsResponse) FromWire(w wire.Value) error {
+func (v *RecordMarkerDecisionAttributes) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TList {
-				v.Executions, err = _List_WorkflowExecutionInfo_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.MarkerName = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 20:
 			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				v.Details, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -7607,114 +12742,92 @@ func (v *ListClosedWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *ListClosedWorkflowExecutionsResponse) String() string {
+func (v *RecordMarkerDecisionAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 	var fields [2]string
 	i := 0
-	if v.Executions != nil {
-		fields[i] = fmt.Sprintf("Executions: %v", v.Executions)
+	if v.MarkerName != nil {
+		fields[i] = fmt.Sprintf("MarkerName: %v", *(v.MarkerName))
 		i++
 	}
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
 		i++
 	}
-	return fmt.Sprintf("ListClosedWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_WorkflowExecutionInfo_Equals(lhs, rhs []*WorkflowExecutionInfo) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-	return true
+	return fmt.Sprintf("RecordMarkerDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *ListClosedWorkflowExecutionsResponse) Equals(rhs *ListClosedWorkflowExecutionsResponse) bool {
-	if !((v.Executions == nil && rhs.Executions == nil) || (v.Executions != nil && rhs.Executions != nil && _List_WorkflowExecutionInfo_Equals(v.Executions, rhs.Executions))) {
+func (v *RecordMarkerDecisionAttributes) Equals(rhs *RecordMarkerDecisionAttributes) bool {
+	if !_String_EqualsPtr(v.MarkerName, rhs.MarkerName) {
 		return false
 	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
 		return false
 	}
 	return true
 }
 
-type ListOpenWorkflowExecutionsRequest struct {
-	Domain          *string                  `json:"domain,omitempty"`
-	MaximumPageSize *int32                   `json:"maximumPageSize,omitempty"`
-	NextPageToken   []byte                   `json:"nextPageToken"`
-	StartTimeFilter *StartTimeFilter         `json:"StartTimeFilter,omitempty"`
-	ExecutionFilter *WorkflowExecutionFilter `json:"executionFilter,omitempty"`
-	TypeFilter      *WorkflowTypeFilter      `json:"typeFilter,omitempty"`
+type RegisterDomainRequest struct {
+	Name                                   *string `json:"name,omitempty"`
+	Description                            *string `json:"description,omitempty"`
+	OwnerEmail                             *string `json:"ownerEmail,omitempty"`
+	WorkflowExecutionRetentionPeriodInDays *int32  `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
+	EmitMetric                             *bool   `json:"emitMetric,omitempty"`
 }
 
-func (v *ListOpenWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
+func (v *RegisterDomainRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.MaximumPageSize != nil {
-		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+	if v.Description != nil {
+		w, err = wire.NewValueString(*(v.Description)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+	if v.OwnerEmail != nil {
+		w, err = wire.NewValueString(*(v.OwnerEmail)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
-	}
-	if v.StartTimeFilter != nil {
-		w, err = v.StartTimeFilter.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.ExecutionFilter != nil {
-		w, err = v.ExecutionFilter.ToWire()
+	}
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		w, err = wire.NewValueI32(*(v.WorkflowExecutionRetentionPeriodInDays)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.TypeFilter != nil {
-		w, err = v.TypeFilter.ToWire()
+	if v.EmitMetric != nil {
+		w, err = wire.NewValueBool(*(v.EmitMetric)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 60, Value: w}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *ListOpenWorkflowExecutionsRequest) FromWire(w wire.Value) error {
+func (v *RegisterDomainRequest) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
@@ -7722,44 +12835,43 @@ func (v *ListOpenWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+				v.Name = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 20:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.MaximumPageSize = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Description = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 30:
 			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.OwnerEmail = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.StartTimeFilter, err = _StartTimeFilter_Read(field.Value)
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.WorkflowExecutionRetentionPeriodInDays = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 50:
-			if field.Value.Type() == wire.TStruct {
-				v.ExecutionFilter, err = _WorkflowExecutionFilter_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 60:
-			if field.Value.Type() == wire.TStruct {
-				v.TypeFilter, err = _WorkflowTypeFilter_Read(field.Value)
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.EmitMetric = &x
 				if err != nil {
 					return err
 				}
@@ -7769,106 +12881,85 @@ func (v *ListOpenWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *ListOpenWorkflowExecutionsRequest) String() string {
+func (v *RegisterDomainRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [6]string
+	var fields [5]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
-		i++
-	}
-	if v.MaximumPageSize != nil {
-		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
 		i++
 	}
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+	if v.Description != nil {
+		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
 		i++
 	}
-	if v.StartTimeFilter != nil {
-		fields[i] = fmt.Sprintf("StartTimeFilter: %v", v.StartTimeFilter)
+	if v.OwnerEmail != nil {
+		fields[i] = fmt.Sprintf("OwnerEmail: %v", *(v.OwnerEmail))
 		i++
 	}
-	if v.ExecutionFilter != nil {
-		fields[i] = fmt.Sprintf("ExecutionFilter: %v", v.ExecutionFilter)
+	if v.WorkflowExecutionRetentionPeriodInDays != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionRetentionPeriodInDays: %v", *(v.WorkflowExecutionRetentionPeriodInDays))
 		i++
 	}
-	if v.TypeFilter != nil {
-		fields[i] = fmt.Sprintf("TypeFilter: %v", v.TypeFilter)
+	if v.EmitMetric != nil {
+		fields[i] = fmt.Sprintf("EmitMetric: %v", *(v.EmitMetric))
 		i++
 	}
-	return fmt.Sprintf("ListOpenWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RegisterDomainRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *ListOpenWorkflowExecutionsRequest) Equals(rhs *ListOpenWorkflowExecutionsRequest) bool {
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+func (v *RegisterDomainRequest) Equals(rhs *RegisterDomainRequest) bool {
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
 		return false
 	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+	if !_String_EqualsPtr(v.Description, rhs.Description) {
 		return false
 	}
-	if !((v.StartTimeFilter == nil && rhs.StartTimeFilter == nil) || (v.StartTimeFilter != nil && rhs.StartTimeFilter != nil && v.StartTimeFilter.Equals(rhs.StartTimeFilter))) {
+	if !_String_EqualsPtr(v.OwnerEmail, rhs.OwnerEmail) {
 		return false
 	}
-	if !((v.ExecutionFilter == nil && rhs.ExecutionFilter == nil) || (v.ExecutionFilter != nil && rhs.ExecutionFilter != nil && v.ExecutionFilter.Equals(rhs.ExecutionFilter))) {
+	if !_I32_EqualsPtr(v.WorkflowExecutionRetentionPeriodInDays, rhs.WorkflowExecutionRetentionPeriodInDays) {
 		return false
 	}
-	if !((v.TypeFilter == nil && rhs.TypeFilter == nil) || (v.TypeFilter != nil && rhs.TypeFilter != nil && v.TypeFilter.Equals(rhs.TypeFilter))) {
+	if !_Bool_EqualsPtr(v.EmitMetric, rhs.EmitMetric) {
 		return false
 	}
 	return true
 }
 
-type ListOpenWorkflowExecutionsResponse struct {
-	Executions    []*WorkflowExecutionInfo `json:"executions"`
-	NextPageToken []byte                   `json:"nextPageToken"`
+type RequestCancelActivityTaskDecisionAttributes struct {
+	ActivityId *string `json:"activityId,omitempty"`
 }
 
-func (v *ListOpenWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
+func (v *RequestCancelActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.Executions != nil {
-		w, err = wire.NewValueList(_List_WorkflowExecutionInfo_ValueList(v.Executions)), error(nil)
+	if v.ActivityId != nil {
+		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *ListOpenWorkflowExecutionsResponse) FromWire(w wire.Value) error {
+func (v *RequestCancelActivityTaskDecisionAttributes) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TList {
-				v.Executions, err = _List_WorkflowExecutionInfo_Read(field.Value.GetList())
-				if err != nil {
-					return err
-				}
-			}
-		case 20:
 			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityId = &x
 				if err != nil {
 					return err
 				}
@@ -7878,56 +12969,49 @@ func (v *ListOpenWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *ListOpenWorkflowExecutionsResponse) String() string {
+func (v *RequestCancelActivityTaskDecisionAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [2]string
+	var fields [1]string
 	i := 0
-	if v.Executions != nil {
-		fields[i] = fmt.Sprintf("Executions: %v", v.Executions)
-		i++
-	}
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+	if v.ActivityId != nil {
+		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
 		i++
 	}
-	return fmt.Sprintf("ListOpenWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RequestCancelActivityTaskDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *ListOpenWorkflowExecutionsResponse) Equals(rhs *ListOpenWorkflowExecutionsResponse) bool {
-	if !((v.Executions == nil && rhs.Executions == nil) || (v.Executions != nil && rhs.Executions != nil && _List_WorkflowExecutionInfo_Equals(v.Executions, rhs.Executions))) {
-		return false
-	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+func (v *RequestCancelActivityTaskDecisionAttributes) Equals(rhs *RequestCancelActivityTaskDecisionAttributes) bool {
+	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
 		return false
 	}
 	return true
 }
 
-type MarkerRecordedEventAttributes struct {
-	MarkerName                   *string `json:"markerName,omitempty"`
-	Details                      []byte  `json:"details"`
+type RequestCancelActivityTaskFailedEventAttributes struct {
+	ActivityId                   *string `json:"activityId,omitempty"`
+	Cause                        *string `json:"cause,omitempty"`
 	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
 }
 
-func (v *MarkerRecordedEventAttributes) ToWire() (wire.Value, error) {
+func (v *RequestCancelActivityTaskFailedEventAttributes) ToWire() (wire.Value, error) {
 	var (
 		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.MarkerName != nil {
-		w, err = wire.NewValueString(*(v.MarkerName)), error(nil)
+	if v.ActivityId != nil {
+		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
+	if v.Cause != nil {
+		w, err = wire.NewValueString(*(v.Cause)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -7945,7 +13029,7 @@ func (v *MarkerRecordedEventAttributes) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *MarkerRecordedEventAttributes) FromWire(w wire.Value) error {
+func (v *RequestCancelActivityTaskFailedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
@@ -7953,14 +13037,16 @@ func (v *MarkerRecordedEventAttributes) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.MarkerName = &x
+				v.ActivityId = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 20:
 			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Cause = &x
 				if err != nil {
 					return err
 				}
@@ -7979,32 +13065,32 @@ func (v *MarkerRecordedEventAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *MarkerRecordedEventAttributes) String() string {
+func (v *RequestCancelActivityTaskFailedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 	var fields [3]string
 	i := 0
-	if v.MarkerName != nil {
-		fields[i] = fmt.Sprintf("MarkerName: %v", *(v.MarkerName))
+	if v.ActivityId != nil {
+		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
 		i++
 	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+	if v.Cause != nil {
+		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
 		i++
 	}
 	if v.DecisionTaskCompletedEventId != nil {
 		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
-	return fmt.Sprintf("MarkerRecordedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RequestCancelActivityTaskFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *MarkerRecordedEventAttributes) Equals(rhs *MarkerRecordedEventAttributes) bool {
-	if !_String_EqualsPtr(v.MarkerName, rhs.MarkerName) {
+func (v *RequestCancelActivityTaskFailedEventAttributes) Equals(rhs *RequestCancelActivityTaskFailedEventAttributes) bool {
+	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
 		return false
 	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+	if !_String_EqualsPtr(v.Cause, rhs.Cause) {
 		return false
 	}
 	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
@@ -8013,15 +13099,16 @@ func (v *MarkerRecordedEventAttributes) Equals(rhs *MarkerRecordedEventAttribute
 	return true
 }
 
-type PollForActivityTaskRequest struct {
-	Domain   *string   `json:"domain,omitempty"`
-	TaskList *TaskList `json:"taskList,omitempty"`
-	Identity *string   `json:"identity,omitempty"`
+type RequestCancelExternalWorkflowExecutionDecisionAttributes struct {
+	Domain     *string `json:"domain,omitempty"`
+	WorkflowId *string `json:"workflowId,omitempty"`
+	RunId      *string `json:"runId,omitempty"`
+	Control    []byte  `json:"control"`
 }
 
-func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -8034,26 +13121,34 @@ func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.Control != nil {
+		w, err = wire.NewValueBinary(v.Control), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
@@ -8067,8 +13162,10 @@ func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
 				}
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.WorkflowId = &x
 				if err != nil {
 					return err
 				}
@@ -8077,7 +13174,14 @@ func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				v.Control, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -8087,166 +13191,138 @@ func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *PollForActivityTaskRequest) String() string {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
 		i++
 	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
 		i++
 	}
-	return fmt.Sprintf("PollForActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
+	if v.Control != nil {
+		fields[i] = fmt.Sprintf("Control: %v", v.Control)
+		i++
+	}
+	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) bool {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionDecisionAttributes) bool {
 	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
 		return false
 	}
 	return true
 }
 
-type PollForActivityTaskResponse struct {
-	TaskToken                     []byte             `json:"taskToken"`
-	WorkflowExecution             *WorkflowExecution `json:"workflowExecution,omitempty"`
-	ActivityId                    *string            `json:"activityId,omitempty"`
-	ActivityType                  *ActivityType      `json:"activityType,omitempty"`
-	Input                         []byte             `json:"input"`
-	StartedEventId                *int64             `json:"startedEventId,omitempty"`
-	ScheduledTimestamp            *int64             `json:"scheduledTimestamp,omitempty"`
-	ScheduleToCloseTimeoutSeconds *int32             `json:"scheduleToCloseTimeoutSeconds,omitempty"`
-	StartedTimestamp              *int64             `json:"startedTimestamp,omitempty"`
-	StartToCloseTimeoutSeconds    *int32             `json:"startToCloseTimeoutSeconds,omitempty"`
-	HeartbeatTimeoutSeconds       *int32             `json:"heartbeatTimeoutSeconds,omitempty"`
+type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
+	Cause                        *CancelExternalWorkflowExecutionFailedCause `json:"cause,omitempty"`
+	DecisionTaskCompletedEventId *int64                                      `json:"decisionTaskCompletedEventId,omitempty"`
+	Domain                       *string                                     `json:"domain,omitempty"`
+	WorkflowExecution            *WorkflowExecution                          `json:"workflowExecution,omitempty"`
+	InitiatedEventId             *int64                                      `json:"initiatedEventId,omitempty"`
+	Control                      []byte                                      `json:"control"`
 }
 
-func (v *PollForActivityTaskResponse) ToWire() (wire.Value, error) {
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [11]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.TaskToken != nil {
-		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+	if v.Cause != nil {
+		w, err = v.Cause.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ActivityId != nil {
-		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.ActivityType != nil {
-		w, err = v.ActivityType.ToWire()
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.Input != nil {
-		w, err = wire.NewValueBinary(v.Input), error(nil)
+	if v.InitiatedEventId != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.StartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+	if v.Control != nil {
+		w, err = wire.NewValueBinary(v.Control), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.ScheduledTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledTimestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.ScheduleToCloseTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.ScheduleToCloseTimeoutSeconds)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.StartedTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.StartedTimestamp)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
-	}
-	if v.StartToCloseTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.StartToCloseTimeoutSeconds)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 100, Value: w}
-		i++
-	}
-	if v.HeartbeatTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.HeartbeatTimeoutSeconds)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 110, Value: w}
-		i++
-	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *PollForActivityTaskResponse) FromWire(w wire.Value) error {
+func _CancelExternalWorkflowExecutionFailedCause_Read(w wire.Value) (CancelExternalWorkflowExecutionFailedCause, error) {
+	var v CancelExternalWorkflowExecutionFailedCause
+	err := v.FromWire(w)
+	return v, err
+}
+
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TI32 {
+				var x CancelExternalWorkflowExecutionFailedCause
+				x, err = _CancelExternalWorkflowExecutionFailedCause_Read(field.Value)
+				v.Cause = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
 				if err != nil {
 					return err
 				}
@@ -8255,75 +13331,184 @@ func (v *PollForActivityTaskResponse) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ActivityId = &x
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 40:
 			if field.Value.Type() == wire.TStruct {
-				v.ActivityType, err = _ActivityType_Read(field.Value)
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
 			}
 		case 50:
-			if field.Value.Type() == wire.TBinary {
-				v.Input, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-			}
-		case 60:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.StartedEventId = &x
+				v.InitiatedEventId = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 70:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledTimestamp = &x
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				v.Control, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 			}
-		case 80:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ScheduleToCloseTimeoutSeconds = &x
+		}
+	}
+	return nil
+}
+
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [6]string
+	i := 0
+	if v.Cause != nil {
+		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
+		i++
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+		i++
+	}
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.InitiatedEventId != nil {
+		fields[i] = fmt.Sprintf("InitiatedEventId: %v", *(v.InitiatedEventId))
+		i++
+	}
+	if v.Control != nil {
+		fields[i] = fmt.Sprintf("Control: %v", v.Control)
+		i++
+	}
+	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _CancelExternalWorkflowExecutionFailedCause_EqualsPtr(lhs, rhs *CancelExternalWorkflowExecutionFailedCause) bool {
+	if lhs != nil && rhs != nil {
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionFailedEventAttributes) bool {
+	if !_CancelExternalWorkflowExecutionFailedCause_EqualsPtr(v.Cause, rhs.Cause) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.InitiatedEventId, rhs.InitiatedEventId) {
+		return false
+	}
+	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
+		return false
+	}
+	return true
+}
+
+type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
+	DecisionTaskCompletedEventId *int64             `json:"decisionTaskCompletedEventId,omitempty"`
+	Domain                       *string            `json:"domain,omitempty"`
+	WorkflowExecution            *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Control                      []byte             `json:"control"`
+}
+
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Control != nil {
+		w, err = wire.NewValueBinary(v.Control), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 90:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedTimestamp = &x
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 100:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.StartToCloseTimeoutSeconds = &x
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
 			}
-		case 110:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.HeartbeatTimeoutSeconds = &x
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				v.Control, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -8333,105 +13518,57 @@ func (v *PollForActivityTaskResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *PollForActivityTaskResponse) String() string {
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [11]string
+	var fields [4]string
 	i := 0
-	if v.TaskToken != nil {
-		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
-		i++
-	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
-		i++
-	}
-	if v.ActivityId != nil {
-		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
-		i++
-	}
-	if v.ActivityType != nil {
-		fields[i] = fmt.Sprintf("ActivityType: %v", v.ActivityType)
-		i++
-	}
-	if v.Input != nil {
-		fields[i] = fmt.Sprintf("Input: %v", v.Input)
-		i++
-	}
-	if v.StartedEventId != nil {
-		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
-		i++
-	}
-	if v.ScheduledTimestamp != nil {
-		fields[i] = fmt.Sprintf("ScheduledTimestamp: %v", *(v.ScheduledTimestamp))
-		i++
-	}
-	if v.ScheduleToCloseTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("ScheduleToCloseTimeoutSeconds: %v", *(v.ScheduleToCloseTimeoutSeconds))
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
-	if v.StartedTimestamp != nil {
-		fields[i] = fmt.Sprintf("StartedTimestamp: %v", *(v.StartedTimestamp))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.StartToCloseTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("StartToCloseTimeoutSeconds: %v", *(v.StartToCloseTimeoutSeconds))
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
-	if v.HeartbeatTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("HeartbeatTimeoutSeconds: %v", *(v.HeartbeatTimeoutSeconds))
+	if v.Control != nil {
+		fields[i] = fmt.Sprintf("Control: %v", v.Control)
 		i++
 	}
-	return fmt.Sprintf("PollForActivityTaskResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *PollForActivityTaskResponse) Equals(rhs *PollForActivityTaskResponse) bool {
-	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
-		return false
-	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
-		return false
-	}
-	if !((v.ActivityType == nil && rhs.ActivityType == nil) || (v.ActivityType != nil && rhs.ActivityType != nil && v.ActivityType.Equals(rhs.ActivityType))) {
-		return false
-	}
-	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.ScheduledTimestamp, rhs.ScheduledTimestamp) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.ScheduleToCloseTimeoutSeconds, rhs.ScheduleToCloseTimeoutSeconds) {
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) bool {
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.StartedTimestamp, rhs.StartedTimestamp) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.StartToCloseTimeoutSeconds, rhs.StartToCloseTimeoutSeconds) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.HeartbeatTimeoutSeconds, rhs.HeartbeatTimeoutSeconds) {
+	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
 		return false
 	}
 	return true
 }
 
-type PollForDecisionTaskRequest struct {
-	Domain   *string   `json:"domain,omitempty"`
-	TaskList *TaskList `json:"taskList,omitempty"`
-	Identity *string   `json:"identity,omitempty"`
+type RequestCancelWorkflowExecutionRequest struct {
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Identity          *string            `json:"identity,omitempty"`
+	RequestId         *string            `json:"requestId,omitempty"`
 }
 
-func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
+func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -8444,8 +13581,8 @@ func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -8460,10 +13597,18 @@ func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
+func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
@@ -8478,7 +13623,7 @@ func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -8492,64 +13637,79 @@ func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
 					return err
 				}
 			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
 }
 
-func (v *PollForDecisionTaskRequest) String() string {
+func (v *RequestCancelWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
 	if v.Identity != nil {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
-	return fmt.Sprintf("PollForDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
+	return fmt.Sprintf("RequestCancelWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) bool {
+func (v *RequestCancelWorkflowExecutionRequest) Equals(rhs *RequestCancelWorkflowExecutionRequest) bool {
 	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+		return false
+	}
 	return true
 }
 
-type PollForDecisionTaskResponse struct {
-	TaskToken              []byte             `json:"taskToken"`
-	WorkflowExecution      *WorkflowExecution `json:"workflowExecution,omitempty"`
-	WorkflowType           *WorkflowType      `json:"workflowType,omitempty"`
-	PreviousStartedEventId *int64             `json:"previousStartedEventId,omitempty"`
-	StartedEventId         *int64             `json:"startedEventId,omitempty"`
-	History                *History           `json:"history,omitempty"`
-	NextPageToken          []byte             `json:"nextPageToken"`
+type ResetWorkflowExecutionRequest struct {
+	Domain                *string            `json:"domain,omitempty"`
+	WorkflowExecution     *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason                *string            `json:"reason,omitempty"`
+	DecisionFinishEventId *int64             `json:"decisionFinishEventId,omitempty"`
+	RequestId             *string            `json:"requestId,omitempty"`
+	Identity              *string            `json:"identity,omitempty"`
 }
 
-func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
+func (v *ResetWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.TaskToken != nil {
-		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -8564,56 +13724,50 @@ func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.WorkflowType != nil {
-		w, err = v.WorkflowType.ToWire()
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.PreviousStartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.PreviousStartedEventId)), error(nil)
+	if v.DecisionFinishEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionFinishEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.StartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.History != nil {
-		w, err = v.History.ToWire()
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
+func (v *ResetWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
@@ -8626,8 +13780,10 @@ func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
 				}
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowType, err = _WorkflowType_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
 				if err != nil {
 					return err
 				}
@@ -8636,30 +13792,25 @@ func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.PreviousStartedEventId = &x
+				v.DecisionFinishEventId = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedEventId = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 60:
-			if field.Value.Type() == wire.TStruct {
-				v.History, err = _History_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 70:
 			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
@@ -8669,75 +13820,128 @@ func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *PollForDecisionTaskResponse) String() string {
+func (v *ResetWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [7]string
+	var fields [6]string
 	i := 0
-	if v.TaskToken != nil {
-		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
 	if v.WorkflowExecution != nil {
 		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
-	if v.WorkflowType != nil {
-		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
-		i++
-	}
-	if v.PreviousStartedEventId != nil {
-		fields[i] = fmt.Sprintf("PreviousStartedEventId: %v", *(v.PreviousStartedEventId))
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
 		i++
 	}
-	if v.StartedEventId != nil {
-		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
+	if v.DecisionFinishEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionFinishEventId: %v", *(v.DecisionFinishEventId))
 		i++
 	}
-	if v.History != nil {
-		fields[i] = fmt.Sprintf("History: %v", v.History)
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
 		i++
 	}
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
-	return fmt.Sprintf("PollForDecisionTaskResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ResetWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *PollForDecisionTaskResponse) Equals(rhs *PollForDecisionTaskResponse) bool {
-	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
+func (v *ResetWorkflowExecutionRequest) Equals(rhs *ResetWorkflowExecutionRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
 	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
-	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.PreviousStartedEventId, rhs.PreviousStartedEventId) {
+	if !_I64_EqualsPtr(v.DecisionFinishEventId, rhs.DecisionFinishEventId) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
 		return false
 	}
-	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && v.History.Equals(rhs.History))) {
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+	return true
+}
+
+type ResetWorkflowExecutionResponse struct {
+	RunId *string `json:"runId,omitempty"`
+}
+
+func (v *ResetWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *ResetWorkflowExecutionResponse) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *ResetWorkflowExecutionResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [1]string
+	i := 0
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+		i++
+	}
+	return fmt.Sprintf("ResetWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *ResetWorkflowExecutionResponse) Equals(rhs *ResetWorkflowExecutionResponse) bool {
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
 		return false
 	}
 	return true
 }
 
-type RecordActivityTaskHeartbeatRequest struct {
+type RespondActivityTaskCanceledRequest struct {
 	TaskToken []byte  `json:"taskToken"`
 	Details   []byte  `json:"details"`
 	Identity  *string `json:"identity,omitempty"`
 }
 
-func (v *RecordActivityTaskHeartbeatRequest) ToWire() (wire.Value, error) {
+func (v *RespondActivityTaskCanceledRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [3]wire.Field
 		i      int = 0
@@ -8771,7 +13975,7 @@ func (v *RecordActivityTaskHeartbeatRequest) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *RecordActivityTaskHeartbeatRequest) FromWire(w wire.Value) error {
+func (v *RespondActivityTaskCanceledRequest) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
@@ -8803,7 +14007,7 @@ func (v *RecordActivityTaskHeartbeatRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *RecordActivityTaskHeartbeatRequest) String() string {
+func (v *RespondActivityTaskCanceledRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -8821,10 +14025,10 @@ func (v *RecordActivityTaskHeartbeatRequest) String() string {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
-	return fmt.Sprintf("RecordActivityTaskHeartbeatRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondActivityTaskCanceledRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *RecordActivityTaskHeartbeatRequest) Equals(rhs *RecordActivityTaskHeartbeatRequest) bool {
+func (v *RespondActivityTaskCanceledRequest) Equals(rhs *RespondActivityTaskCanceledRequest) bool {
 	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
 		return false
 	}
@@ -8837,113 +14041,69 @@ func (v *RecordActivityTaskHeartbeatRequest) Equals(rhs *RecordActivityTaskHeart
 	return true
 }
 
-type RecordActivityTaskHeartbeatResponse struct {
-	CancelRequested *bool `json:"cancelRequested,omitempty"`
+type RespondActivityTaskCompletedRequest struct {
+	TaskToken []byte  `json:"taskToken"`
+	Result    []byte  `json:"result"`
+	Identity  *string `json:"identity,omitempty"`
 }
 
-func (v *RecordActivityTaskHeartbeatResponse) ToWire() (wire.Value, error) {
+func (v *RespondActivityTaskCompletedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.CancelRequested != nil {
-		w, err = wire.NewValueBool(*(v.CancelRequested)), error(nil)
+	if v.TaskToken != nil {
+		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func (v *RecordActivityTaskHeartbeatResponse) FromWire(w wire.Value) error {
-	var err error
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.CancelRequested = &x
-				if err != nil {
-					return err
-				}
-			}
-		}
-	}
-	return nil
-}
-
-func (v *RecordActivityTaskHeartbeatResponse) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-	var fields [1]string
-	i := 0
-	if v.CancelRequested != nil {
-		fields[i] = fmt.Sprintf("CancelRequested: %v", *(v.CancelRequested))
-		i++
-	}
-	return fmt.Sprintf("RecordActivityTaskHeartbeatResponse{%v}", strings.Join(fields[:i], ", "))
-}
-
-func (v *RecordActivityTaskHeartbeatResponse) Equals(rhs *RecordActivityTaskHeartbeatResponse) bool {
-	if !_Bool_EqualsPtr(v.CancelRequested, rhs.CancelRequested) {
-		return false
-	}
-	return true
-}
-
-type RecordMarkerDecisionAttributes struct {
-	MarkerName *string `json:"markerName,omitempty"`
-	Details    []byte  `json:"details"`
-}
-
-func (v *RecordMarkerDecisionAttributes) ToWire() (wire.Value, error) {
-	var (
-		fields [2]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-	if v.MarkerName != nil {
-		w, err = wire.NewValueString(*(v.MarkerName)), error(nil)
+	if v.Result != nil {
+		w, err = wire.NewValueBinary(v.Result), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *RecordMarkerDecisionAttributes) FromWire(w wire.Value) error {
+func (v *RespondActivityTaskCompletedRequest) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.MarkerName = &x
+				v.TaskToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 			}
 		case 20:
 			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
+				v.Result, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
@@ -8953,100 +14113,96 @@ func (v *RecordMarkerDecisionAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *RecordMarkerDecisionAttributes) String() string {
+func (v *RespondActivityTaskCompletedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [2]string
+	var fields [3]string
 	i := 0
-	if v.MarkerName != nil {
-		fields[i] = fmt.Sprintf("MarkerName: %v", *(v.MarkerName))
+	if v.TaskToken != nil {
+		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
 		i++
 	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+	if v.Result != nil {
+		fields[i] = fmt.Sprintf("Result: %v", v.Result)
 		i++
 	}
-	return fmt.Sprintf("RecordMarkerDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+	return fmt.Sprintf("RespondActivityTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *RecordMarkerDecisionAttributes) Equals(rhs *RecordMarkerDecisionAttributes) bool {
-	if !_String_EqualsPtr(v.MarkerName, rhs.MarkerName) {
+func (v *RespondActivityTaskCompletedRequest) Equals(rhs *RespondActivityTaskCompletedRequest) bool {
+	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
 		return false
 	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+	if !((v.Result == nil && rhs.Result == nil) || (v.Result != nil && rhs.Result != nil && bytes.Equal(v.Result, rhs.Result))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
 	return true
 }
 
-type RegisterDomainRequest struct {
-	Name                                   *string `json:"name,omitempty"`
-	Description                            *string `json:"description,omitempty"`
-	OwnerEmail                             *string `json:"ownerEmail,omitempty"`
-	WorkflowExecutionRetentionPeriodInDays *int32  `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
-	EmitMetric                             *bool   `json:"emitMetric,omitempty"`
+type RespondActivityTaskFailedRequest struct {
+	TaskToken []byte  `json:"taskToken"`
+	Reason    *string `json:"reason,omitempty"`
+	Details   []byte  `json:"details"`
+	Identity  *string `json:"identity,omitempty"`
 }
 
-func (v *RegisterDomainRequest) ToWire() (wire.Value, error) {
+func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.TaskToken != nil {
+		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Description != nil {
-		w, err = wire.NewValueString(*(v.Description)), error(nil)
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.OwnerEmail != nil {
-		w, err = wire.NewValueString(*(v.OwnerEmail)), error(nil)
+	if v.Details != nil {
+		w, err = wire.NewValueBinary(v.Details), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		w, err = wire.NewValueI32(*(v.WorkflowExecutionRetentionPeriodInDays)), error(nil)
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.EmitMetric != nil {
-		w, err = wire.NewValueBool(*(v.EmitMetric)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *RegisterDomainRequest) FromWire(w wire.Value) error {
+func (v *RespondActivityTaskFailedRequest) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
+			if field.Value.Type() == wire.TBinary {
+				v.TaskToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -9055,34 +14211,23 @@ func (v *RegisterDomainRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Description = &x
+				v.Reason = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 30:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.OwnerEmail = &x
+				v.Details, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 			}
 		case 40:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.WorkflowExecutionRetentionPeriodInDays = &x
-				if err != nil {
-					return err
-				}
-			}
-		case 50:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.EmitMetric = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
@@ -9092,181 +14237,201 @@ func (v *RegisterDomainRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *RegisterDomainRequest) String() string {
+func (v *RespondActivityTaskFailedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [5]string
+	var fields [4]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
-		i++
-	}
-	if v.Description != nil {
-		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
+	if v.TaskToken != nil {
+		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
 		i++
 	}
-	if v.OwnerEmail != nil {
-		fields[i] = fmt.Sprintf("OwnerEmail: %v", *(v.OwnerEmail))
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
 		i++
 	}
-	if v.WorkflowExecutionRetentionPeriodInDays != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionRetentionPeriodInDays: %v", *(v.WorkflowExecutionRetentionPeriodInDays))
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
 		i++
 	}
-	if v.EmitMetric != nil {
-		fields[i] = fmt.Sprintf("EmitMetric: %v", *(v.EmitMetric))
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
-	return fmt.Sprintf("RegisterDomainRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondActivityTaskFailedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *RegisterDomainRequest) Equals(rhs *RegisterDomainRequest) bool {
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
-		return false
-	}
-	if !_String_EqualsPtr(v.Description, rhs.Description) {
+func (v *RespondActivityTaskFailedRequest) Equals(rhs *RespondActivityTaskFailedRequest) bool {
+	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.OwnerEmail, rhs.OwnerEmail) {
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.WorkflowExecutionRetentionPeriodInDays, rhs.WorkflowExecutionRetentionPeriodInDays) {
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.EmitMetric, rhs.EmitMetric) {
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
 	return true
 }
 
-type RequestCancelActivityTaskDecisionAttributes struct {
-	ActivityId *string `json:"activityId,omitempty"`
+type RespondDecisionTaskCompletedRequest struct {
+	TaskToken        []byte                     `json:"taskToken"`
+	Decisions        []*Decision                `json:"decisions"`
+	ExecutionContext []byte                     `json:"executionContext"`
+	Identity         *string                    `json:"identity,omitempty"`
+	StickyAttributes *StickyExecutionAttributes `json:"stickyAttributes,omitempty"`
 }
 
-func (v *RequestCancelActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
-	var (
-		fields [1]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-	if v.ActivityId != nil {
-		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
+type _List_Decision_ValueList []*Decision
+
+func (v _List_Decision_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func (v *RequestCancelActivityTaskDecisionAttributes) FromWire(w wire.Value) error {
-	var err error
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ActivityId = &x
-				if err != nil {
-					return err
-				}
-			}
+		err = f(w)
+		if err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-func (v *RequestCancelActivityTaskDecisionAttributes) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-	var fields [1]string
-	i := 0
-	if v.ActivityId != nil {
-		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
-		i++
-	}
-	return fmt.Sprintf("RequestCancelActivityTaskDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+func (v _List_Decision_ValueList) Size() int {
+	return len(v)
 }
 
-func (v *RequestCancelActivityTaskDecisionAttributes) Equals(rhs *RequestCancelActivityTaskDecisionAttributes) bool {
-	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
-		return false
-	}
-	return true
+func (_List_Decision_ValueList) ValueType() wire.Type {
+	return wire.TStruct
 }
 
-type RequestCancelActivityTaskFailedEventAttributes struct {
-	ActivityId                   *string `json:"activityId,omitempty"`
-	Cause                        *string `json:"cause,omitempty"`
-	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
+func (_List_Decision_ValueList) Close() {
 }
 
-func (v *RequestCancelActivityTaskFailedEventAttributes) ToWire() (wire.Value, error) {
+func (v *RespondDecisionTaskCompletedRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.ActivityId != nil {
-		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
+	if v.TaskToken != nil {
+		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Cause != nil {
-		w, err = wire.NewValueString(*(v.Cause)), error(nil)
+	if v.Decisions != nil {
+		w, err = wire.NewValueList(_List_Decision_ValueList(v.Decisions)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+	if v.ExecutionContext != nil {
+		w, err = wire.NewValueBinary(v.ExecutionContext), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.StickyAttributes != nil {
+		w, err = v.StickyAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *RequestCancelActivityTaskFailedEventAttributes) FromWire(w wire.Value) error {
+func _Decision_Read(w wire.Value) (*Decision, error) {
+	var v Decision
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Decision_Read(l wire.ValueList) ([]*Decision, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+	o := make([]*Decision, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Decision_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _StickyExecutionAttributes_Read(w wire.Value) (*StickyExecutionAttributes, error) {
+	var v StickyExecutionAttributes
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ActivityId = &x
+				v.TaskToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 			}
 		case 20:
+			if field.Value.Type() == wire.TList {
+				v.Decisions, err = _List_Decision_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				v.ExecutionContext, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
+		case 40:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Cause = &x
+				v.Identity = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionTaskCompletedEventId = &x
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.StickyAttributes, err = _StickyExecutionAttributes_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -9276,80 +14441,107 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) FromWire(w wire.Value)
 	return nil
 }
 
-func (v *RequestCancelActivityTaskFailedEventAttributes) String() string {
+func (v *RespondDecisionTaskCompletedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [3]string
+	var fields [5]string
 	i := 0
-	if v.ActivityId != nil {
-		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
+	if v.TaskToken != nil {
+		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
 		i++
 	}
-	if v.Cause != nil {
-		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
+	if v.Decisions != nil {
+		fields[i] = fmt.Sprintf("Decisions: %v", v.Decisions)
 		i++
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+	if v.ExecutionContext != nil {
+		fields[i] = fmt.Sprintf("ExecutionContext: %v", v.ExecutionContext)
 		i++
 	}
-	return fmt.Sprintf("RequestCancelActivityTaskFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+	if v.StickyAttributes != nil {
+		fields[i] = fmt.Sprintf("StickyAttributes: %v", v.StickyAttributes)
+		i++
+	}
+	return fmt.Sprintf("RespondDecisionTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Decision_Equals(lhs, rhs []*Decision) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
 }
 
-func (v *RequestCancelActivityTaskFailedEventAttributes) Equals(rhs *RequestCancelActivityTaskFailedEventAttributes) bool {
-	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
+func (v *RespondDecisionTaskCompletedRequest) Equals(rhs *RespondDecisionTaskCompletedRequest) bool {
+	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Cause, rhs.Cause) {
+	if !((v.Decisions == nil && rhs.Decisions == nil) || (v.Decisions != nil && rhs.Decisions != nil && _List_Decision_Equals(v.Decisions, rhs.Decisions))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+	if !((v.ExecutionContext == nil && rhs.ExecutionContext == nil) || (v.ExecutionContext != nil && rhs.ExecutionContext != nil && bytes.Equal(v.ExecutionContext, rhs.ExecutionContext))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+	if !((v.StickyAttributes == nil && rhs.StickyAttributes == nil) || (v.StickyAttributes != nil && rhs.StickyAttributes != nil && v.StickyAttributes.Equals(rhs.StickyAttributes))) {
 		return false
 	}
 	return true
 }
 
-type RequestCancelExternalWorkflowExecutionDecisionAttributes struct {
-	Domain     *string `json:"domain,omitempty"`
-	WorkflowId *string `json:"workflowId,omitempty"`
-	RunId      *string `json:"runId,omitempty"`
-	Control    []byte  `json:"control"`
+type RespondQueryTaskCompletedRequest struct {
+	TaskToken     []byte                  `json:"taskToken"`
+	CompletedType *QueryTaskCompletedType `json:"completedType,omitempty"`
+	QueryResult   []byte                  `json:"queryResult"`
+	ErrorMessage  *string                 `json:"errorMessage,omitempty"`
 }
 
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
+func (v *RespondQueryTaskCompletedRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.TaskToken != nil {
+		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowId != nil {
-		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
+	if v.CompletedType != nil {
+		w, err = v.CompletedType.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+	if v.QueryResult != nil {
+		w, err = wire.NewValueBinary(v.QueryResult), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.Control != nil {
-		w, err = wire.NewValueBinary(v.Control), error(nil)
+	if v.ErrorMessage != nil {
+		w, err = wire.NewValueString(*(v.ErrorMessage)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -9359,40 +14551,44 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) ToWire() (wir
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) error {
+func _QueryTaskCompletedType_Read(w wire.Value) (QueryTaskCompletedType, error) {
+	var v QueryTaskCompletedType
+	err := v.FromWire(w)
+	return v, err
+}
+
+func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+				v.TaskToken, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowId = &x
+			if field.Value.Type() == wire.TI32 {
+				var x QueryTaskCompletedType
+				x, err = _QueryTaskCompletedType_Read(field.Value)
+				v.CompletedType = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 30:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunId = &x
+				v.QueryResult, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 			}
 		case 40:
 			if field.Value.Type() == wire.TBinary {
-				v.Control, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ErrorMessage = &x
 				if err != nil {
 					return err
 				}
@@ -9402,105 +14598,141 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) FromWire(w wi
 	return nil
 }
 
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) String() string {
+func (v *RespondQueryTaskCompletedRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 	var fields [4]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.TaskToken != nil {
+		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
 		i++
 	}
-	if v.WorkflowId != nil {
-		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+	if v.CompletedType != nil {
+		fields[i] = fmt.Sprintf("CompletedType: %v", *(v.CompletedType))
 		i++
 	}
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+	if v.QueryResult != nil {
+		fields[i] = fmt.Sprintf("QueryResult: %v", v.QueryResult)
 		i++
 	}
-	if v.Control != nil {
-		fields[i] = fmt.Sprintf("Control: %v", v.Control)
+	if v.ErrorMessage != nil {
+		fields[i] = fmt.Sprintf("ErrorMessage: %v", *(v.ErrorMessage))
 		i++
 	}
-	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RespondQueryTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionDecisionAttributes) bool {
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+func _QueryTaskCompletedType_EqualsPtr(lhs, rhs *QueryTaskCompletedType) bool {
+	if lhs != nil && rhs != nil {
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompletedRequest) bool {
+	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
+	if !_QueryTaskCompletedType_EqualsPtr(v.CompletedType, rhs.CompletedType) {
 		return false
 	}
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+	if !((v.QueryResult == nil && rhs.QueryResult == nil) || (v.QueryResult != nil && rhs.QueryResult != nil && bytes.Equal(v.QueryResult, rhs.QueryResult))) {
 		return false
 	}
-	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
+	if !_String_EqualsPtr(v.ErrorMessage, rhs.ErrorMessage) {
 		return false
 	}
 	return true
 }
 
-type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
-	Cause                        *CancelExternalWorkflowExecutionFailedCause `json:"cause,omitempty"`
-	DecisionTaskCompletedEventId *int64                                      `json:"decisionTaskCompletedEventId,omitempty"`
-	Domain                       *string                                     `json:"domain,omitempty"`
-	WorkflowExecution            *WorkflowExecution                          `json:"workflowExecution,omitempty"`
-	InitiatedEventId             *int64                                      `json:"initiatedEventId,omitempty"`
-	Control                      []byte                                      `json:"control"`
+type RetryPolicy struct {
+	InitialIntervalInSeconds    *int32   `json:"initialIntervalInSeconds,omitempty"`
+	BackoffCoefficient          *float64 `json:"backoffCoefficient,omitempty"`
+	MaximumIntervalInSeconds    *int32   `json:"maximumIntervalInSeconds,omitempty"`
+	MaximumAttempts             *int32   `json:"maximumAttempts,omitempty"`
+	NonRetriableErrorReasons    []string `json:"nonRetriableErrorReasons"`
+	ExpirationIntervalInSeconds *int32   `json:"expirationIntervalInSeconds,omitempty"`
 }
 
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) ToWire() (wire.Value, error) {
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {
+}
+
+func (v *RetryPolicy) ToWire() (wire.Value, error) {
 	var (
 		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.Cause != nil {
-		w, err = v.Cause.ToWire()
+	if v.InitialIntervalInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.InitialIntervalInSeconds)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+	if v.BackoffCoefficient != nil {
+		w, err = wire.NewValueDouble(*(v.BackoffCoefficient)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.MaximumIntervalInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.MaximumIntervalInSeconds)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.MaximumAttempts != nil {
+		w, err = wire.NewValueI32(*(v.MaximumAttempts)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.InitiatedEventId != nil {
-		w, err = wire.NewValueI64(*(v.InitiatedEventId)), error(nil)
+	if v.NonRetriableErrorReasons != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.NonRetriableErrorReasons)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.Control != nil {
-		w, err = wire.NewValueBinary(v.Control), error(nil)
+	if v.ExpirationIntervalInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ExpirationIntervalInSeconds)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -9510,62 +14742,75 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) ToWire() (
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _CancelExternalWorkflowExecutionFailedCause_Read(w wire.Value) (CancelExternalWorkflowExecutionFailedCause, error) {
-	var v CancelExternalWorkflowExecutionFailedCause
-	err := v.FromWire(w)
-	return v, err
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
 }
 
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) FromWire(w wire.Value) error {
+func (v *RetryPolicy) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TI32 {
-				var x CancelExternalWorkflowExecutionFailedCause
-				x, err = _CancelExternalWorkflowExecutionFailedCause_Read(field.Value)
-				v.Cause = &x
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.InitialIntervalInSeconds = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionTaskCompletedEventId = &x
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.BackoffCoefficient = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumIntervalInSeconds = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumAttempts = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.InitiatedEventId = &x
+			if field.Value.Type() == wire.TList {
+				v.NonRetriableErrorReasons, err = _List_String_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 			}
 		case 60:
-			if field.Value.Type() == wire.TBinary {
-				v.Control, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ExpirationIntervalInSeconds = &x
 				if err != nil {
 					return err
 				}
@@ -9575,133 +14820,207 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) FromWire(w
 	return nil
 }
 
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) String() string {
+func (v *RetryPolicy) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 	var fields [6]string
 	i := 0
-	if v.Cause != nil {
-		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
+	if v.InitialIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("InitialIntervalInSeconds: %v", *(v.InitialIntervalInSeconds))
 		i++
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+	if v.BackoffCoefficient != nil {
+		fields[i] = fmt.Sprintf("BackoffCoefficient: %v", *(v.BackoffCoefficient))
 		i++
 	}
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.MaximumIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("MaximumIntervalInSeconds: %v", *(v.MaximumIntervalInSeconds))
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+	if v.MaximumAttempts != nil {
+		fields[i] = fmt.Sprintf("MaximumAttempts: %v", *(v.MaximumAttempts))
 		i++
 	}
-	if v.InitiatedEventId != nil {
-		fields[i] = fmt.Sprintf("InitiatedEventId: %v", *(v.InitiatedEventId))
+	if v.NonRetriableErrorReasons != nil {
+		fields[i] = fmt.Sprintf("NonRetriableErrorReasons: %v", v.NonRetriableErrorReasons)
 		i++
 	}
-	if v.Control != nil {
-		fields[i] = fmt.Sprintf("Control: %v", v.Control)
+	if v.ExpirationIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("ExpirationIntervalInSeconds: %v", *(v.ExpirationIntervalInSeconds))
 		i++
 	}
-	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RetryPolicy{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _CancelExternalWorkflowExecutionFailedCause_EqualsPtr(lhs, rhs *CancelExternalWorkflowExecutionFailedCause) bool {
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
 	if lhs != nil && rhs != nil {
 		x := *lhs
 		y := *rhs
-		return x.Equals(y)
+		return (x == y)
 	}
 	return lhs == nil && rhs == nil
 }
 
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionFailedEventAttributes) bool {
-	if !_CancelExternalWorkflowExecutionFailedCause_EqualsPtr(v.Cause, rhs.Cause) {
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *RetryPolicy) Equals(rhs *RetryPolicy) bool {
+	if !_I32_EqualsPtr(v.InitialIntervalInSeconds, rhs.InitialIntervalInSeconds) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+	if !_Double_EqualsPtr(v.BackoffCoefficient, rhs.BackoffCoefficient) {
 		return false
 	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+	if !_I32_EqualsPtr(v.MaximumIntervalInSeconds, rhs.MaximumIntervalInSeconds) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.InitiatedEventId, rhs.InitiatedEventId) {
+	if !_I32_EqualsPtr(v.MaximumAttempts, rhs.MaximumAttempts) {
 		return false
 	}
-	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
+	if !((v.NonRetriableErrorReasons == nil && rhs.NonRetriableErrorReasons == nil) || (v.NonRetriableErrorReasons != nil && rhs.NonRetriableErrorReasons != nil && _List_String_Equals(v.NonRetriableErrorReasons, rhs.NonRetriableErrorReasons))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ExpirationIntervalInSeconds, rhs.ExpirationIntervalInSeconds) {
 		return false
 	}
 	return true
 }
 
-type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
-	DecisionTaskCompletedEventId *int64             `json:"decisionTaskCompletedEventId,omitempty"`
-	Domain                       *string            `json:"domain,omitempty"`
-	WorkflowExecution            *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Control                      []byte             `json:"control"`
+type ScheduleActivityTaskDecisionAttributes struct {
+	ActivityId                    *string       `json:"activityId,omitempty"`
+	ActivityType                  *ActivityType `json:"activityType,omitempty"`
+	Domain                        *string       `json:"domain,omitempty"`
+	TaskList                      *TaskList     `json:"taskList,omitempty"`
+	Input                         []byte        `json:"input"`
+	ScheduleToCloseTimeoutSeconds *int32        `json:"scheduleToCloseTimeoutSeconds,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32        `json:"scheduleToStartTimeoutSeconds,omitempty"`
+	StartToCloseTimeoutSeconds    *int32        `json:"startToCloseTimeoutSeconds,omitempty"`
+	HeartbeatTimeoutSeconds       *int32        `json:"heartbeatTimeoutSeconds,omitempty"`
+	RetryPolicy                   *RetryPolicy  `json:"retryPolicy,omitempty"`
 }
 
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) ToWire() (wire.Value, error) {
+func (v *ScheduleActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [10]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.DecisionTaskCompletedEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+	if v.ActivityId != nil {
+		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ActivityType != nil {
+		w, err = v.ActivityType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 25, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Input != nil {
+		w, err = wire.NewValueBinary(v.Input), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ScheduleToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ScheduleToCloseTimeoutSeconds)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 45, Value: w}
 		i++
 	}
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ScheduleToStartTimeoutSeconds)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.StartToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.StartToCloseTimeoutSeconds)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 55, Value: w}
 		i++
 	}
-	if v.Control != nil {
-		w, err = wire.NewValueBinary(v.Control), error(nil)
+	if v.HeartbeatTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.HeartbeatTimeoutSeconds)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) FromWire(w wire.Value) error {
+func (v *ScheduleActivityTaskDecisionAttributes) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionTaskCompletedEventId = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityId = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.ActivityType, err = _ActivityType_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 25:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
@@ -9712,14 +15031,57 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) FromWir
 			}
 		case 30:
 			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				v.TaskList, err = _TaskList_Read(field.Value)
 				if err != nil {
 					return err
 				}
 			}
 		case 40:
 			if field.Value.Type() == wire.TBinary {
-				v.Control, err = field.Value.GetBinary(), error(nil)
+				v.Input, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
+		case 45:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ScheduleToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ScheduleToStartTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 55:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.StartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.HeartbeatTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryPolicy, err = _RetryPolicy_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -9729,130 +15091,181 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) FromWir
 	return nil
 }
 
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) String() string {
+func (v *ScheduleActivityTaskDecisionAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [4]string
+	var fields [10]string
 	i := 0
-	if v.DecisionTaskCompletedEventId != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+	if v.ActivityId != nil {
+		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
+		i++
+	}
+	if v.ActivityType != nil {
+		fields[i] = fmt.Sprintf("ActivityType: %v", v.ActivityType)
 		i++
 	}
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
 		i++
 	}
-	if v.Control != nil {
-		fields[i] = fmt.Sprintf("Control: %v", v.Control)
+	if v.Input != nil {
+		fields[i] = fmt.Sprintf("Input: %v", v.Input)
 		i++
 	}
-	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	if v.ScheduleToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("ScheduleToCloseTimeoutSeconds: %v", *(v.ScheduleToCloseTimeoutSeconds))
+		i++
+	}
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("ScheduleToStartTimeoutSeconds: %v", *(v.ScheduleToStartTimeoutSeconds))
+		i++
+	}
+	if v.StartToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("StartToCloseTimeoutSeconds: %v", *(v.StartToCloseTimeoutSeconds))
+		i++
+	}
+	if v.HeartbeatTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("HeartbeatTimeoutSeconds: %v", *(v.HeartbeatTimeoutSeconds))
+		i++
+	}
+	if v.RetryPolicy != nil {
+		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
+		i++
+	}
+	return fmt.Sprintf("ScheduleActivityTaskDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) bool {
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+func (v *ScheduleActivityTaskDecisionAttributes) Equals(rhs *ScheduleActivityTaskDecisionAttributes) bool {
+	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
+		return false
+	}
+	if !((v.ActivityType == nil && rhs.ActivityType == nil) || (v.ActivityType != nil && rhs.ActivityType != nil && v.ActivityType.Equals(rhs.ActivityType))) {
 		return false
 	}
 	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
 		return false
 	}
-	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
+	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ScheduleToCloseTimeoutSeconds, rhs.ScheduleToCloseTimeoutSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ScheduleToStartTimeoutSeconds, rhs.ScheduleToStartTimeoutSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.StartToCloseTimeoutSeconds, rhs.StartToCloseTimeoutSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.HeartbeatTimeoutSeconds, rhs.HeartbeatTimeoutSeconds) {
+		return false
+	}
+	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
 		return false
 	}
 	return true
 }
 
-type RequestCancelWorkflowExecutionRequest struct {
-	Domain            *string            `json:"domain,omitempty"`
-	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Identity          *string            `json:"identity,omitempty"`
-	RequestId         *string            `json:"requestId,omitempty"`
+type SearchAttributes struct {
+	IndexedFields map[string][]byte `json:"indexedFields"`
 }
 
-func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+type _Map_String_Binary_MapItemList map[string][]byte
+
+func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueBinary(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Binary_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Binary_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) Close() {
+}
+
+func (v *SearchAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.IndexedFields != nil {
+		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.IndexedFields)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+	o := make(map[string][]byte, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.RequestId != nil {
-		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		v, err := x.Value.GetBinary(), error(nil)
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
 }
 
-func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
-	var err error
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
-				if err != nil {
-					return err
-				}
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
-				if err != nil {
-					return err
-				}
-			}
-		case 40:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RequestId = &x
+func (v *SearchAttributes) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TMap {
+				v.IndexedFields, err = _Map_String_Binary_Read(field.Value.GetMap())
 				if err != nil {
 					return err
 				}
@@ -9862,207 +15275,179 @@ func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *RequestCancelWorkflowExecutionRequest) String() string {
+func (v *SearchAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [4]string
+	var fields [1]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
-		i++
-	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
-		i++
-	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
-		i++
-	}
-	if v.RequestId != nil {
-		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+	if v.IndexedFields != nil {
+		fields[i] = fmt.Sprintf("IndexedFields: %v", v.IndexedFields)
 		i++
 	}
-	return fmt.Sprintf("RequestCancelWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("SearchAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *RequestCancelWorkflowExecutionRequest) Equals(rhs *RequestCancelWorkflowExecutionRequest) bool {
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
-	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+func _Map_String_Binary_Equals(lhs, rhs map[string][]byte) bool {
+	if len(lhs) != len(rhs) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
-		return false
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !bytes.Equal(lv, rv) {
+			return false
+		}
 	}
-	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+	return true
+}
+
+func (v *SearchAttributes) Equals(rhs *SearchAttributes) bool {
+	if !((v.IndexedFields == nil && rhs.IndexedFields == nil) || (v.IndexedFields != nil && rhs.IndexedFields != nil && _Map_String_Binary_Equals(v.IndexedFields, rhs.IndexedFields))) {
 		return false
 	}
 	return true
 }
 
-type RespondActivityTaskCanceledRequest struct {
-	TaskToken []byte  `json:"taskToken"`
-	Details   []byte  `json:"details"`
-	Identity  *string `json:"identity,omitempty"`
+type ServiceBusyError struct {
+	Message string `json:"message,required"`
 }
 
-func (v *RespondActivityTaskCanceledRequest) ToWire() (wire.Value, error) {
+func (v *ServiceBusyError) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.TaskToken != nil {
-		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
+	w, err = wire.NewValueString(v.Message), error(nil)
+	if err != nil {
+		return w, err
 	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *RespondActivityTaskCanceledRequest) FromWire(w wire.Value) error {
+func (v *ServiceBusyError) FromWire(w wire.Value) error {
 	var err error
+	messageIsSet := false
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				v.TaskToken, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-			}
-		case 20:
-			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-			}
-		case 30:
+		case 1:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+				v.Message, err = field.Value.GetString(), error(nil)
 				if err != nil {
 					return err
 				}
+				messageIsSet = true
 			}
 		}
 	}
+	if !messageIsSet {
+		return errors.New("field Message of ServiceBusyError is required")
+	}
 	return nil
 }
 
-func (v *RespondActivityTaskCanceledRequest) String() string {
+func (v *ServiceBusyError) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [3]string
+	var fields [1]string
 	i := 0
-	if v.TaskToken != nil {
-		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
-		i++
-	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
-		i++
-	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
-		i++
-	}
-	return fmt.Sprintf("RespondActivityTaskCanceledRequest{%v}", strings.Join(fields[:i], ", "))
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+	return fmt.Sprintf("ServiceBusyError{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *RespondActivityTaskCanceledRequest) Equals(rhs *RespondActivityTaskCanceledRequest) bool {
-	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
-		return false
-	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+func (v *ServiceBusyError) Equals(rhs *ServiceBusyError) bool {
+	if !(v.Message == rhs.Message) {
 		return false
 	}
 	return true
 }
 
-type RespondActivityTaskCompletedRequest struct {
-	TaskToken []byte  `json:"taskToken"`
-	Result    []byte  `json:"result"`
-	Identity  *string `json:"identity,omitempty"`
+func (v *ServiceBusyError) Error() string {
+	return v.String()
 }
 
-func (v *RespondActivityTaskCompletedRequest) ToWire() (wire.Value, error) {
+type SignalExternalWorkflowExecutionDecisionAttributes struct {
+	Domain     *string            `json:"domain,omitempty"`
+	Execution  *WorkflowExecution `json:"execution,omitempty"`
+	SignalName *string            `json:"signalName,omitempty"`
+	Input      []byte             `json:"input"`
+	Control    []byte             `json:"control"`
+}
+
+func (v *SignalExternalWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.TaskToken != nil {
-		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Result != nil {
-		w, err = wire.NewValueBinary(v.Result), error(nil)
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+	if v.SignalName != nil {
+		w, err = wire.NewValueString(*(v.SignalName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.Input != nil {
+		w, err = wire.NewValueBinary(v.Input), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.Control != nil {
+		w, err = wire.NewValueBinary(v.Control), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *RespondActivityTaskCompletedRequest) FromWire(w wire.Value) error {
+func (v *SignalExternalWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				v.Result, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -10071,7 +15456,21 @@ func (v *RespondActivityTaskCompletedRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+				v.SignalName = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				v.Input, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				v.Control, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -10081,121 +15480,255 @@ func (v *RespondActivityTaskCompletedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *RespondActivityTaskCompletedRequest) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-	var fields [3]string
-	i := 0
-	if v.TaskToken != nil {
-		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
-		i++
-	}
-	if v.Result != nil {
-		fields[i] = fmt.Sprintf("Result: %v", v.Result)
-		i++
-	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
-		i++
+func (v *SignalExternalWorkflowExecutionDecisionAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [5]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.SignalName != nil {
+		fields[i] = fmt.Sprintf("SignalName: %v", *(v.SignalName))
+		i++
+	}
+	if v.Input != nil {
+		fields[i] = fmt.Sprintf("Input: %v", v.Input)
+		i++
+	}
+	if v.Control != nil {
+		fields[i] = fmt.Sprintf("Control: %v", v.Control)
+		i++
+	}
+	return fmt.Sprintf("SignalExternalWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *SignalExternalWorkflowExecutionDecisionAttributes) Equals(rhs *SignalExternalWorkflowExecutionDecisionAttributes) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.SignalName, rhs.SignalName) {
+		return false
+	}
+	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
+		return false
+	}
+	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
+		return false
+	}
+	return true
+}
+
+type SignalExternalWorkflowExecutionFailedCause int32
+
+const (
+	SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution SignalExternalWorkflowExecutionFailedCause = 0
+)
+
+func SignalExternalWorkflowExecutionFailedCause_Values() []SignalExternalWorkflowExecutionFailedCause {
+	return []SignalExternalWorkflowExecutionFailedCause{SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution}
+}
+
+func (v *SignalExternalWorkflowExecutionFailedCause) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION":
+		*v = SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "SignalExternalWorkflowExecutionFailedCause")
+	}
+}
+
+func (v SignalExternalWorkflowExecutionFailedCause) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+func (v *SignalExternalWorkflowExecutionFailedCause) FromWire(w wire.Value) error {
+	*v = (SignalExternalWorkflowExecutionFailedCause)(w.GetI32())
+	return nil
+}
+
+func (v SignalExternalWorkflowExecutionFailedCause) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION"
 	}
-	return fmt.Sprintf("RespondActivityTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("SignalExternalWorkflowExecutionFailedCause(%d)", w)
 }
 
-func (v *RespondActivityTaskCompletedRequest) Equals(rhs *RespondActivityTaskCompletedRequest) bool {
-	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
-		return false
+func (v SignalExternalWorkflowExecutionFailedCause) Equals(rhs SignalExternalWorkflowExecutionFailedCause) bool {
+	return v == rhs
+}
+
+func (v SignalExternalWorkflowExecutionFailedCause) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION\""), nil
 	}
-	if !((v.Result == nil && rhs.Result == nil) || (v.Result != nil && rhs.Result != nil && bytes.Equal(v.Result, rhs.Result))) {
-		return false
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+func (v *SignalExternalWorkflowExecutionFailedCause) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
-		return false
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "SignalExternalWorkflowExecutionFailedCause")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "SignalExternalWorkflowExecutionFailedCause")
+		}
+		*v = (SignalExternalWorkflowExecutionFailedCause)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "SignalExternalWorkflowExecutionFailedCause")
 	}
-	return true
 }
 
-type RespondActivityTaskFailedRequest struct {
-	TaskToken []byte  `json:"taskToken"`
-	Reason    *string `json:"reason,omitempty"`
-	Details   []byte  `json:"details"`
-	Identity  *string `json:"identity,omitempty"`
+type SignalExternalWorkflowExecutionFailedEventAttributes struct {
+	Cause                        *SignalExternalWorkflowExecutionFailedCause `json:"cause,omitempty"`
+	DecisionTaskCompletedEventId *int64                                      `json:"decisionTaskCompletedEventId,omitempty"`
+	Domain                       *string                                     `json:"domain,omitempty"`
+	WorkflowExecution            *WorkflowExecution                          `json:"workflowExecution,omitempty"`
+	InitiatedEventId             *int64                                      `json:"initiatedEventId,omitempty"`
+	Control                      []byte                                      `json:"control"`
 }
 
-func (v *RespondActivityTaskFailedRequest) ToWire() (wire.Value, error) {
+func (v *SignalExternalWorkflowExecutionFailedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.TaskToken != nil {
-		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+	if v.Cause != nil {
+		w, err = v.Cause.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Reason != nil {
-		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Details != nil {
-		w, err = wire.NewValueBinary(v.Details), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.InitiatedEventId != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Control != nil {
+		w, err = wire.NewValueBinary(v.Control), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *RespondActivityTaskFailedRequest) FromWire(w wire.Value) error {
+func _SignalExternalWorkflowExecutionFailedCause_Read(w wire.Value) (SignalExternalWorkflowExecutionFailedCause, error) {
+	var v SignalExternalWorkflowExecutionFailedCause
+	err := v.FromWire(w)
+	return v, err
+}
+
+func (v *SignalExternalWorkflowExecutionFailedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TI32 {
+				var x SignalExternalWorkflowExecutionFailedCause
+				x, err = _SignalExternalWorkflowExecutionFailedCause_Read(field.Value)
+				v.Cause = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Reason = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 30:
 			if field.Value.Type() == wire.TBinary {
-				v.Details, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InitiatedEventId = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 60:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+				v.Control, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -10205,170 +15738,162 @@ func (v *RespondActivityTaskFailedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *RespondActivityTaskFailedRequest) String() string {
+func (v *SignalExternalWorkflowExecutionFailedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [4]string
+	var fields [6]string
 	i := 0
-	if v.TaskToken != nil {
-		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
+	if v.Cause != nil {
+		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
 		i++
 	}
-	if v.Reason != nil {
-		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
-	if v.Details != nil {
-		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
-	return fmt.Sprintf("RespondActivityTaskFailedRequest{%v}", strings.Join(fields[:i], ", "))
+	if v.InitiatedEventId != nil {
+		fields[i] = fmt.Sprintf("InitiatedEventId: %v", *(v.InitiatedEventId))
+		i++
+	}
+	if v.Control != nil {
+		fields[i] = fmt.Sprintf("Control: %v", v.Control)
+		i++
+	}
+	return fmt.Sprintf("SignalExternalWorkflowExecutionFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *RespondActivityTaskFailedRequest) Equals(rhs *RespondActivityTaskFailedRequest) bool {
-	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
+func _SignalExternalWorkflowExecutionFailedCause_EqualsPtr(lhs, rhs *SignalExternalWorkflowExecutionFailedCause) bool {
+	if lhs != nil && rhs != nil {
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func (v *SignalExternalWorkflowExecutionFailedEventAttributes) Equals(rhs *SignalExternalWorkflowExecutionFailedEventAttributes) bool {
+	if !_SignalExternalWorkflowExecutionFailedCause_EqualsPtr(v.Cause, rhs.Cause) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
-	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && bytes.Equal(v.Details, rhs.Details))) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
-	return true
-}
-
-type RespondDecisionTaskCompletedRequest struct {
-	TaskToken        []byte      `json:"taskToken"`
-	Decisions        []*Decision `json:"decisions"`
-	ExecutionContext []byte      `json:"executionContext"`
-	Identity         *string     `json:"identity,omitempty"`
-}
-
-type _List_Decision_ValueList []*Decision
-
-func (v _List_Decision_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
+	if !_I64_EqualsPtr(v.InitiatedEventId, rhs.InitiatedEventId) {
+		return false
 	}
-	return nil
-}
-
-func (v _List_Decision_ValueList) Size() int {
-	return len(v)
-}
-
-func (_List_Decision_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
+		return false
+	}
+	return true
 }
 
-func (_List_Decision_ValueList) Close() {
+type SignalExternalWorkflowExecutionInitiatedEventAttributes struct {
+	DecisionTaskCompletedEventId *int64             `json:"decisionTaskCompletedEventId,omitempty"`
+	Domain                       *string            `json:"domain,omitempty"`
+	WorkflowExecution            *WorkflowExecution `json:"workflowExecution,omitempty"`
+	SignalName                   *string            `json:"signalName,omitempty"`
+	Input                        []byte             `json:"input"`
+	Control                      []byte             `json:"control"`
 }
 
-func (v *RespondDecisionTaskCompletedRequest) ToWire() (wire.Value, error) {
+func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.TaskToken != nil {
-		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Decisions != nil {
-		w, err = wire.NewValueList(_List_Decision_ValueList(v.Decisions)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.SignalName != nil {
+		w, err = wire.NewValueString(*(v.SignalName)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.ExecutionContext != nil {
-		w, err = wire.NewValueBinary(v.ExecutionContext), error(nil)
+	if v.Input != nil {
+		w, err = wire.NewValueBinary(v.Input), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+	if v.Control != nil {
+		w, err = wire.NewValueBinary(v.Control), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
+		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _Decision_Read(w wire.Value) (*Decision, error) {
-	var v Decision
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_Decision_Read(l wire.ValueList) ([]*Decision, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-	o := make([]*Decision, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _Decision_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
-}
-
-func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
+func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 20:
-			if field.Value.Type() == wire.TList {
-				v.Decisions, err = _List_Decision_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 30:
-			if field.Value.Type() == wire.TBinary {
-				v.ExecutionContext, err = field.Value.GetBinary(), error(nil)
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -10377,7 +15902,21 @@ func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+				v.SignalName = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				v.Input, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				v.Control, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -10387,101 +15926,106 @@ func (v *RespondDecisionTaskCompletedRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *RespondDecisionTaskCompletedRequest) String() string {
+func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [4]string
+	var fields [6]string
 	i := 0
-	if v.TaskToken != nil {
-		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
-	if v.Decisions != nil {
-		fields[i] = fmt.Sprintf("Decisions: %v", v.Decisions)
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.ExecutionContext != nil {
-		fields[i] = fmt.Sprintf("ExecutionContext: %v", v.ExecutionContext)
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+	if v.SignalName != nil {
+		fields[i] = fmt.Sprintf("SignalName: %v", *(v.SignalName))
 		i++
 	}
-	return fmt.Sprintf("RespondDecisionTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+	if v.Input != nil {
+		fields[i] = fmt.Sprintf("Input: %v", v.Input)
+		i++
+	}
+	if v.Control != nil {
+		fields[i] = fmt.Sprintf("Control: %v", v.Control)
+		i++
+	}
+	return fmt.Sprintf("SignalExternalWorkflowExecutionInitiatedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _List_Decision_Equals(lhs, rhs []*Decision) bool {
-	if len(lhs) != len(rhs) {
+func (v *SignalExternalWorkflowExecutionInitiatedEventAttributes) Equals(rhs *SignalExternalWorkflowExecutionInitiatedEventAttributes) bool {
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
 	}
-	return true
-}
-
-func (v *RespondDecisionTaskCompletedRequest) Equals(rhs *RespondDecisionTaskCompletedRequest) bool {
-	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
-	if !((v.Decisions == nil && rhs.Decisions == nil) || (v.Decisions != nil && rhs.Decisions != nil && _List_Decision_Equals(v.Decisions, rhs.Decisions))) {
+	if !_String_EqualsPtr(v.SignalName, rhs.SignalName) {
 		return false
 	}
-	if !((v.ExecutionContext == nil && rhs.ExecutionContext == nil) || (v.ExecutionContext != nil && rhs.ExecutionContext != nil && bytes.Equal(v.ExecutionContext, rhs.ExecutionContext))) {
+	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
 		return false
 	}
 	return true
 }
 
-type ScheduleActivityTaskDecisionAttributes struct {
-	ActivityId                    *string       `json:"activityId,omitempty"`
-	ActivityType                  *ActivityType `json:"activityType,omitempty"`
-	Domain                        *string       `json:"domain,omitempty"`
-	TaskList                      *TaskList     `json:"taskList,omitempty"`
-	Input                         []byte        `json:"input"`
-	ScheduleToCloseTimeoutSeconds *int32        `json:"scheduleToCloseTimeoutSeconds,omitempty"`
-	ScheduleToStartTimeoutSeconds *int32        `json:"scheduleToStartTimeoutSeconds,omitempty"`
-	StartToCloseTimeoutSeconds    *int32        `json:"startToCloseTimeoutSeconds,omitempty"`
-	HeartbeatTimeoutSeconds       *int32        `json:"heartbeatTimeoutSeconds,omitempty"`
+type SignalWithStartWorkflowExecutionRequest struct {
+	Domain                              *string                `json:"domain,omitempty"`
+	WorkflowId                          *string                `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType          `json:"workflowType,omitempty"`
+	TaskList                            *TaskList              `json:"taskList,omitempty"`
+	Input                               []byte                 `json:"input"`
+	ExecutionStartToCloseTimeoutSeconds *int32                 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                 `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            *string                `json:"identity,omitempty"`
+	RequestId                           *string                `json:"requestId,omitempty"`
+	WorkflowIdReusePolicy               *WorkflowIdReusePolicy `json:"workflowIdReusePolicy,omitempty"`
+	SignalName                          *string                `json:"signalName,omitempty"`
+	SignalInput                         []byte                 `json:"signalInput"`
+	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
 }
 
-func (v *ScheduleActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
+func (v *SignalWithStartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [9]wire.Field
+		fields [13]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
-	if v.ActivityId != nil {
-		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.ActivityType != nil {
-		w, err = v.ActivityType.ToWire()
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.WorkflowType != nil {
+		w, err = v.WorkflowType.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 25, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
 	if v.TaskList != nil {
@@ -10489,7 +16033,7 @@ func (v *ScheduleActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
 	if v.Input != nil {
@@ -10497,45 +16041,83 @@ func (v *ScheduleActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.ScheduleToCloseTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.ScheduleToCloseTimeoutSeconds)), error(nil)
+	if v.ExecutionStartToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ExecutionStartToCloseTimeoutSeconds)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 45, Value: w}
+		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-	if v.ScheduleToStartTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.ScheduleToStartTimeoutSeconds)), error(nil)
+	if v.TaskStartToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.TaskStartToCloseTimeoutSeconds)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
+		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
-	if v.StartToCloseTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.StartToCloseTimeoutSeconds)), error(nil)
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 55, Value: w}
+		fields[i] = wire.Field{ID: 80, Value: w}
 		i++
 	}
-	if v.HeartbeatTimeoutSeconds != nil {
-		w, err = wire.NewValueI32(*(v.HeartbeatTimeoutSeconds)), error(nil)
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 60, Value: w}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.WorkflowIdReusePolicy != nil {
+		w, err = v.WorkflowIdReusePolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.SignalName != nil {
+		w, err = wire.NewValueString(*(v.SignalName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.SignalInput != nil {
+		w, err = wire.NewValueBinary(v.SignalInput), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
 		i++
 	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func (v *ScheduleActivityTaskDecisionAttributes) FromWire(w wire.Value) error {
+func _WorkflowIdReusePolicy_Read(w wire.Value) (WorkflowIdReusePolicy, error) {
+	var v WorkflowIdReusePolicy
+	err := v.FromWire(w)
+	return v, err
+}
+
+func (v *SignalWithStartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
@@ -10543,73 +16125,105 @@ func (v *ScheduleActivityTaskDecisionAttributes) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ActivityId = &x
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.ActivityType, err = _ActivityType_Read(field.Value)
-				if err != nil {
-					return err
-				}
-			}
-		case 25:
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+				v.WorkflowId = &x
 				if err != nil {
 					return err
 				}
 			}
 		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowType, err = _WorkflowType_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 40:
 			if field.Value.Type() == wire.TStruct {
 				v.TaskList, err = _TaskList_Read(field.Value)
 				if err != nil {
 					return err
 				}
 			}
-		case 40:
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				v.Input, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ExecutionStartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.TaskStartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 90:
 			if field.Value.Type() == wire.TBinary {
-				v.Input, err = field.Value.GetBinary(), error(nil)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 45:
+		case 100:
 			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ScheduleToCloseTimeoutSeconds = &x
+				var x WorkflowIdReusePolicy
+				x, err = _WorkflowIdReusePolicy_Read(field.Value)
+				v.WorkflowIdReusePolicy = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 50:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ScheduleToStartTimeoutSeconds = &x
+		case 110:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SignalName = &x
 				if err != nil {
 					return err
 				}
 			}
-		case 55:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.StartToCloseTimeoutSeconds = &x
+		case 120:
+			if field.Value.Type() == wire.TBinary {
+				v.SignalInput, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 			}
-		case 60:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.HeartbeatTimeoutSeconds = &x
+		case 130:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -10619,22 +16233,22 @@ func (v *ScheduleActivityTaskDecisionAttributes) FromWire(w wire.Value) error {
 	return nil
 }
 
-func (v *ScheduleActivityTaskDecisionAttributes) String() string {
+func (v *SignalWithStartWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [9]string
+	var fields [13]string
 	i := 0
-	if v.ActivityId != nil {
-		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.ActivityType != nil {
-		fields[i] = fmt.Sprintf("ActivityType: %v", v.ActivityType)
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
 		i++
 	}
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.WorkflowType != nil {
+		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
 		i++
 	}
 	if v.TaskList != nil {
@@ -10645,33 +16259,58 @@ func (v *ScheduleActivityTaskDecisionAttributes) String() string {
 		fields[i] = fmt.Sprintf("Input: %v", v.Input)
 		i++
 	}
-	if v.ScheduleToCloseTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("ScheduleToCloseTimeoutSeconds: %v", *(v.ScheduleToCloseTimeoutSeconds))
+	if v.ExecutionStartToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("ExecutionStartToCloseTimeoutSeconds: %v", *(v.ExecutionStartToCloseTimeoutSeconds))
 		i++
 	}
-	if v.ScheduleToStartTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("ScheduleToStartTimeoutSeconds: %v", *(v.ScheduleToStartTimeoutSeconds))
+	if v.TaskStartToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("TaskStartToCloseTimeoutSeconds: %v", *(v.TaskStartToCloseTimeoutSeconds))
 		i++
 	}
-	if v.StartToCloseTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("StartToCloseTimeoutSeconds: %v", *(v.StartToCloseTimeoutSeconds))
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
-	if v.HeartbeatTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("HeartbeatTimeoutSeconds: %v", *(v.HeartbeatTimeoutSeconds))
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
 		i++
 	}
-	return fmt.Sprintf("ScheduleActivityTaskDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+	if v.WorkflowIdReusePolicy != nil {
+		fields[i] = fmt.Sprintf("WorkflowIdReusePolicy: %v", *(v.WorkflowIdReusePolicy))
+		i++
+	}
+	if v.SignalName != nil {
+		fields[i] = fmt.Sprintf("SignalName: %v", *(v.SignalName))
+		i++
+	}
+	if v.SignalInput != nil {
+		fields[i] = fmt.Sprintf("SignalInput: %v", v.SignalInput)
+		i++
+	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
+	return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-func (v *ScheduleActivityTaskDecisionAttributes) Equals(rhs *ScheduleActivityTaskDecisionAttributes) bool {
-	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
+func _WorkflowIdReusePolicy_EqualsPtr(lhs, rhs *WorkflowIdReusePolicy) bool {
+	if lhs != nil && rhs != nil {
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func (v *SignalWithStartWorkflowExecutionRequest) Equals(rhs *SignalWithStartWorkflowExecutionRequest) bool {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !((v.ActivityType == nil && rhs.ActivityType == nil) || (v.ActivityType != nil && rhs.ActivityType != nil && v.ActivityType.Equals(rhs.ActivityType))) {
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
 		return false
 	}
 	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
@@ -10680,84 +16319,33 @@ func (v *ScheduleActivityTaskDecisionAttributes) Equals(rhs *ScheduleActivityTas
 	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.ScheduleToCloseTimeoutSeconds, rhs.ScheduleToCloseTimeoutSeconds) {
+	if !_I32_EqualsPtr(v.ExecutionStartToCloseTimeoutSeconds, rhs.ExecutionStartToCloseTimeoutSeconds) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.ScheduleToStartTimeoutSeconds, rhs.ScheduleToStartTimeoutSeconds) {
+	if !_I32_EqualsPtr(v.TaskStartToCloseTimeoutSeconds, rhs.TaskStartToCloseTimeoutSeconds) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.StartToCloseTimeoutSeconds, rhs.StartToCloseTimeoutSeconds) {
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.HeartbeatTimeoutSeconds, rhs.HeartbeatTimeoutSeconds) {
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
 		return false
 	}
-	return true
-}
-
-type ServiceBusyError struct {
-	Message string `json:"message,required"`
-}
-
-func (v *ServiceBusyError) ToWire() (wire.Value, error) {
-	var (
-		fields [1]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-	w, err = wire.NewValueString(v.Message), error(nil)
-	if err != nil {
-		return w, err
-	}
-	fields[i] = wire.Field{ID: 1, Value: w}
-	i++
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func (v *ServiceBusyError) FromWire(w wire.Value) error {
-	var err error
-	messageIsSet := false
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 1:
-			if field.Value.Type() == wire.TBinary {
-				v.Message, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
-				messageIsSet = true
-			}
-		}
+	if !_WorkflowIdReusePolicy_EqualsPtr(v.WorkflowIdReusePolicy, rhs.WorkflowIdReusePolicy) {
+		return false
 	}
-	if !messageIsSet {
-		return errors.New("field Message of ServiceBusyError is required")
+	if !_String_EqualsPtr(v.SignalName, rhs.SignalName) {
+		return false
 	}
-	return nil
-}
-
-func (v *ServiceBusyError) String() string {
-	if v == nil {
-		return "<nil>"
+	if !((v.SignalInput == nil && rhs.SignalInput == nil) || (v.SignalInput != nil && rhs.SignalInput != nil && bytes.Equal(v.SignalInput, rhs.SignalInput))) {
+		return false
 	}
-	var fields [1]string
-	i := 0
-	fields[i] = fmt.Sprintf("Message: %v", v.Message)
-	i++
-	return fmt.Sprintf("ServiceBusyError{%v}", strings.Join(fields[:i], ", "))
-}
-
-func (v *ServiceBusyError) Equals(rhs *ServiceBusyError) bool {
-	if !(v.Message == rhs.Message) {
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
 		return false
 	}
 	return true
 }
 
-func (v *ServiceBusyError) Error() string {
-	return v.String()
-}
-
 type SignalWorkflowExecutionRequest struct {
 	Domain            *string            `json:"domain,omitempty"`
 	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
@@ -11850,20 +17438,25 @@ func (v *StartTimerDecisionAttributes) Equals(rhs *StartTimerDecisionAttributes)
 }
 
 type StartWorkflowExecutionRequest struct {
-	Domain                              *string       `json:"domain,omitempty"`
-	WorkflowId                          *string       `json:"workflowId,omitempty"`
-	WorkflowType                        *WorkflowType `json:"workflowType,omitempty"`
-	TaskList                            *TaskList     `json:"taskList,omitempty"`
-	Input                               []byte        `json:"input"`
-	ExecutionStartToCloseTimeoutSeconds *int32        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            *string       `json:"identity,omitempty"`
-	RequestId                           *string       `json:"requestId,omitempty"`
+	Domain                              *string                `json:"domain,omitempty"`
+	WorkflowId                          *string                `json:"workflowId,omitempty"`
+	WorkflowType                        *WorkflowType          `json:"workflowType,omitempty"`
+	TaskList                            *TaskList              `json:"taskList,omitempty"`
+	Input                               []byte                 `json:"input"`
+	ExecutionStartToCloseTimeoutSeconds *int32                 `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32                 `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            *string                `json:"identity,omitempty"`
+	RequestId                           *string                `json:"requestId,omitempty"`
+	WorkflowIdReusePolicy               *WorkflowIdReusePolicy `json:"workflowIdReusePolicy,omitempty"`
+	CronSchedule                        *string                `json:"cronSchedule,omitempty"`
+	DelayStartSeconds                   *int32                 `json:"delayStartSeconds,omitempty"`
+	RetryPolicy                         *RetryPolicy           `json:"retryPolicy,omitempty"`
+	SearchAttributes                    *SearchAttributes      `json:"searchAttributes,omitempty"`
 }
 
 func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [9]wire.Field
+		fields [14]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -11940,6 +17533,46 @@ func (v *StartWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
+	if v.WorkflowIdReusePolicy != nil {
+		w, err = v.WorkflowIdReusePolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.CronSchedule != nil {
+		w, err = wire.NewValueString(*(v.CronSchedule)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.DelayStartSeconds != nil {
+		w, err = wire.NewValueI32(*(v.DelayStartSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
@@ -12022,6 +17655,47 @@ func (v *StartWorkflowExecutionRequest) FromWire(w wire.Value) error {
 					return err
 				}
 			}
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x WorkflowIdReusePolicy
+				x, err = _WorkflowIdReusePolicy_Read(field.Value)
+				v.WorkflowIdReusePolicy = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 110:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.CronSchedule = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 120:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.DelayStartSeconds = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 130:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryPolicy, err = _RetryPolicy_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 140:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
@@ -12031,7 +17705,7 @@ func (v *StartWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [9]string
+	var fields [14]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -12057,16 +17731,36 @@ func (v *StartWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("ExecutionStartToCloseTimeoutSeconds: %v", *(v.ExecutionStartToCloseTimeoutSeconds))
 		i++
 	}
-	if v.TaskStartToCloseTimeoutSeconds != nil {
-		fields[i] = fmt.Sprintf("TaskStartToCloseTimeoutSeconds: %v", *(v.TaskStartToCloseTimeoutSeconds))
+	if v.TaskStartToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("TaskStartToCloseTimeoutSeconds: %v", *(v.TaskStartToCloseTimeoutSeconds))
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
+	if v.WorkflowIdReusePolicy != nil {
+		fields[i] = fmt.Sprintf("WorkflowIdReusePolicy: %v", *(v.WorkflowIdReusePolicy))
+		i++
+	}
+	if v.CronSchedule != nil {
+		fields[i] = fmt.Sprintf("CronSchedule: %v", *(v.CronSchedule))
+		i++
+	}
+	if v.DelayStartSeconds != nil {
+		fields[i] = fmt.Sprintf("DelayStartSeconds: %v", *(v.DelayStartSeconds))
 		i++
 	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+	if v.RetryPolicy != nil {
+		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
 		i++
 	}
-	if v.RequestId != nil {
-		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
 		i++
 	}
 	return fmt.Sprintf("StartWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
@@ -12100,6 +17794,21 @@ func (v *StartWorkflowExecutionRequest) Equals(rhs *StartWorkflowExecutionReques
 	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
 		return false
 	}
+	if !_WorkflowIdReusePolicy_EqualsPtr(v.WorkflowIdReusePolicy, rhs.WorkflowIdReusePolicy) {
+		return false
+	}
+	if !_String_EqualsPtr(v.CronSchedule, rhs.CronSchedule) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.DelayStartSeconds, rhs.DelayStartSeconds) {
+		return false
+	}
+	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
+		return false
+	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
 	return true
 }
 
@@ -12163,6 +17872,89 @@ func (v *StartWorkflowExecutionResponse) Equals(rhs *StartWorkflowExecutionRespo
 	return true
 }
 
+type StickyExecutionAttributes struct {
+	WorkerTaskList                *TaskList `json:"workerTaskList,omitempty"`
+	ScheduleToStartTimeoutSeconds *int32    `json:"scheduleToStartTimeoutSeconds,omitempty"`
+}
+
+func (v *StickyExecutionAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.WorkerTaskList != nil {
+		w, err = v.WorkerTaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.ScheduleToStartTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *StickyExecutionAttributes) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkerTaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ScheduleToStartTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *StickyExecutionAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [2]string
+	i := 0
+	if v.WorkerTaskList != nil {
+		fields[i] = fmt.Sprintf("WorkerTaskList: %v", v.WorkerTaskList)
+		i++
+	}
+	if v.ScheduleToStartTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("ScheduleToStartTimeoutSeconds: %v", *(v.ScheduleToStartTimeoutSeconds))
+		i++
+	}
+	return fmt.Sprintf("StickyExecutionAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *StickyExecutionAttributes) Equals(rhs *StickyExecutionAttributes) bool {
+	if !((v.WorkerTaskList == nil && rhs.WorkerTaskList == nil) || (v.WorkerTaskList != nil && rhs.WorkerTaskList != nil && v.WorkerTaskList.Equals(rhs.WorkerTaskList))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ScheduleToStartTimeoutSeconds, rhs.ScheduleToStartTimeoutSeconds) {
+		return false
+	}
+	return true
+}
+
 type TaskList struct {
 	Name *string `json:"name,omitempty"`
 }
@@ -12200,27 +17992,283 @@ func (v *TaskList) FromWire(w wire.Value) error {
 			}
 		}
 	}
-	return nil
+	return nil
+}
+
+func (v *TaskList) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [1]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	return fmt.Sprintf("TaskList{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *TaskList) Equals(rhs *TaskList) bool {
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	return true
+}
+
+type TaskListMetadata struct {
+	MaxTasksPerSecond *float64 `json:"maxTasksPerSecond,omitempty"`
+}
+
+func (v *TaskListMetadata) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.MaxTasksPerSecond != nil {
+		w, err = wire.NewValueDouble(*(v.MaxTasksPerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *TaskListMetadata) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.MaxTasksPerSecond = &x
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *TaskListMetadata) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [1]string
+	i := 0
+	if v.MaxTasksPerSecond != nil {
+		fields[i] = fmt.Sprintf("MaxTasksPerSecond: %v", *(v.MaxTasksPerSecond))
+		i++
+	}
+	return fmt.Sprintf("TaskListMetadata{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *TaskListMetadata) Equals(rhs *TaskListMetadata) bool {
+	if !_Double_EqualsPtr(v.MaxTasksPerSecond, rhs.MaxTasksPerSecond) {
+		return false
+	}
+	return true
+}
+
+type TaskListStatus struct {
+	BacklogCountHint *int64 `json:"backlogCountHint,omitempty"`
+	ReadLevel        *int64 `json:"readLevel,omitempty"`
+	AckLevel         *int64 `json:"ackLevel,omitempty"`
+}
+
+func (v *TaskListStatus) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.BacklogCountHint != nil {
+		w, err = wire.NewValueI64(*(v.BacklogCountHint)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ReadLevel != nil {
+		w, err = wire.NewValueI64(*(v.ReadLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.AckLevel != nil {
+		w, err = wire.NewValueI64(*(v.AckLevel)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *TaskListStatus) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.BacklogCountHint = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ReadLevel = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.AckLevel = &x
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *TaskListStatus) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [3]string
+	i := 0
+	if v.BacklogCountHint != nil {
+		fields[i] = fmt.Sprintf("BacklogCountHint: %v", *(v.BacklogCountHint))
+		i++
+	}
+	if v.ReadLevel != nil {
+		fields[i] = fmt.Sprintf("ReadLevel: %v", *(v.ReadLevel))
+		i++
+	}
+	if v.AckLevel != nil {
+		fields[i] = fmt.Sprintf("AckLevel: %v", *(v.AckLevel))
+		i++
+	}
+	return fmt.Sprintf("TaskListStatus{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *TaskListStatus) Equals(rhs *TaskListStatus) bool {
+	if !_I64_EqualsPtr(v.BacklogCountHint, rhs.BacklogCountHint) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ReadLevel, rhs.ReadLevel) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.AckLevel, rhs.AckLevel) {
+		return false
+	}
+	return true
+}
+
+type TaskListType int32
+
+const (
+	TaskListTypeDecision TaskListType = 0
+	TaskListTypeActivity TaskListType = 1
+)
+
+func TaskListType_Values() []TaskListType {
+	return []TaskListType{TaskListTypeDecision, TaskListTypeActivity}
+}
+
+func (v *TaskListType) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "DECISION":
+		*v = TaskListTypeDecision
+		return nil
+	case "ACTIVITY":
+		*v = TaskListTypeActivity
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "TaskListType")
+	}
+}
+
+func (v TaskListType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+func (v *TaskListType) FromWire(w wire.Value) error {
+	*v = (TaskListType)(w.GetI32())
+	return nil
+}
+
+func (v TaskListType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "DECISION"
+	case 1:
+		return "ACTIVITY"
+	}
+	return fmt.Sprintf("TaskListType(%d)", w)
 }
 
-func (v *TaskList) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-	var fields [1]string
-	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
-		i++
+func (v TaskListType) Equals(rhs TaskListType) bool {
+	return v == rhs
+}
+
+func (v TaskListType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"DECISION\""), nil
+	case 1:
+		return ([]byte)("\"ACTIVITY\""), nil
 	}
-	return fmt.Sprintf("TaskList{%v}", strings.Join(fields[:i], ", "))
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-func (v *TaskList) Equals(rhs *TaskList) bool {
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
-		return false
+func (v *TaskListType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "TaskListType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "TaskListType")
+		}
+		*v = (TaskListType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "TaskListType")
 	}
-	return true
 }
 
 type TerminateWorkflowExecutionRequest struct {
@@ -13089,6 +19137,147 @@ func (v *UpdateDomainResponse) Equals(rhs *UpdateDomainResponse) bool {
 	return true
 }
 
+type UpsertWorkflowSearchAttributesDecisionAttributes struct {
+	SearchAttributes *SearchAttributes `json:"searchAttributes,omitempty"`
+}
+
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [1]string
+	i := 0
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
+	return fmt.Sprintf("UpsertWorkflowSearchAttributesDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *UpsertWorkflowSearchAttributesDecisionAttributes) Equals(rhs *UpsertWorkflowSearchAttributesDecisionAttributes) bool {
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
+	return true
+}
+
+type UpsertWorkflowSearchAttributesEventAttributes struct {
+	DecisionTaskCompletedEventId *int64            `json:"decisionTaskCompletedEventId,omitempty"`
+	SearchAttributes             *SearchAttributes `json:"searchAttributes,omitempty"`
+}
+
+func (v *UpsertWorkflowSearchAttributesEventAttributes) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *UpsertWorkflowSearchAttributesEventAttributes) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *UpsertWorkflowSearchAttributesEventAttributes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [2]string
+	i := 0
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+		i++
+	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
+	return fmt.Sprintf("UpsertWorkflowSearchAttributesEventAttributes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *UpsertWorkflowSearchAttributesEventAttributes) Equals(rhs *UpsertWorkflowSearchAttributesEventAttributes) bool {
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+		return false
+	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
+	return true
+}
+
 type WorkflowExecution struct {
 	WorkflowId *string `json:"workflowId,omitempty"`
 	RunId      *string `json:"runId,omitempty"`
@@ -13705,6 +19894,89 @@ func (v *WorkflowExecutionCompletedEventAttributes) Equals(rhs *WorkflowExecutio
 	return true
 }
 
+type WorkflowExecutionConfiguration struct {
+	TaskList                       *TaskList `json:"taskList,omitempty"`
+	TaskStartToCloseTimeoutSeconds *int32    `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+}
+
+func (v *WorkflowExecutionConfiguration) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskStartToCloseTimeoutSeconds != nil {
+		w, err = wire.NewValueI32(*(v.TaskStartToCloseTimeoutSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *WorkflowExecutionConfiguration) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.TaskStartToCloseTimeoutSeconds = &x
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *WorkflowExecutionConfiguration) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [2]string
+	i := 0
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.TaskStartToCloseTimeoutSeconds != nil {
+		fields[i] = fmt.Sprintf("TaskStartToCloseTimeoutSeconds: %v", *(v.TaskStartToCloseTimeoutSeconds))
+		i++
+	}
+	return fmt.Sprintf("WorkflowExecutionConfiguration{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *WorkflowExecutionConfiguration) Equals(rhs *WorkflowExecutionConfiguration) bool {
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.TaskStartToCloseTimeoutSeconds, rhs.TaskStartToCloseTimeoutSeconds) {
+		return false
+	}
+	return true
+}
+
 type WorkflowExecutionContinuedAsNewEventAttributes struct {
 	NewExecutionRunId                   *string       `json:"newExecutionRunId,omitempty"`
 	WorkflowType                        *WorkflowType `json:"workflowType,omitempty"`
@@ -13713,11 +19985,12 @@ type WorkflowExecutionContinuedAsNewEventAttributes struct {
 	ExecutionStartToCloseTimeoutSeconds *int32        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
 	TaskStartToCloseTimeoutSeconds      *int32        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
 	DecisionTaskCompletedEventId        *int64        `json:"decisionTaskCompletedEventId,omitempty"`
+	BackoffStartIntervalInSeconds       *int32        `json:"backoffStartIntervalInSeconds,omitempty"`
 }
 
 func (v *WorkflowExecutionContinuedAsNewEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -13778,6 +20051,14 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) ToWire() (wire.Value, e
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.BackoffStartIntervalInSeconds != nil {
+		w, err = wire.NewValueI32(*(v.BackoffStartIntervalInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
@@ -13842,6 +20123,15 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) FromWire(w wire.Value)
 					return err
 				}
 			}
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.BackoffStartIntervalInSeconds = &x
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
@@ -13851,7 +20141,7 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [7]string
+	var fields [8]string
 	i := 0
 	if v.NewExecutionRunId != nil {
 		fields[i] = fmt.Sprintf("NewExecutionRunId: %v", *(v.NewExecutionRunId))
@@ -13881,6 +20171,10 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
+	if v.BackoffStartIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("BackoffStartIntervalInSeconds: %v", *(v.BackoffStartIntervalInSeconds))
+		i++
+	}
 	return fmt.Sprintf("WorkflowExecutionContinuedAsNewEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
@@ -13906,6 +20200,9 @@ func (v *WorkflowExecutionContinuedAsNewEventAttributes) Equals(rhs *WorkflowExe
 	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.BackoffStartIntervalInSeconds, rhs.BackoffStartIntervalInSeconds) {
+		return false
+	}
 	return true
 }
 
@@ -14078,17 +20375,18 @@ func (v *WorkflowExecutionFilter) Equals(rhs *WorkflowExecutionFilter) bool {
 }
 
 type WorkflowExecutionInfo struct {
-	Execution     *WorkflowExecution            `json:"execution,omitempty"`
-	Type          *WorkflowType                 `json:"type,omitempty"`
-	StartTime     *int64                        `json:"startTime,omitempty"`
-	CloseTime     *int64                        `json:"closeTime,omitempty"`
-	CloseStatus   *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
-	HistoryLength *int64                        `json:"historyLength,omitempty"`
+	Execution        *WorkflowExecution            `json:"execution,omitempty"`
+	Type             *WorkflowType                 `json:"type,omitempty"`
+	StartTime        *int64                        `json:"startTime,omitempty"`
+	CloseTime        *int64                        `json:"closeTime,omitempty"`
+	CloseStatus      *WorkflowExecutionCloseStatus `json:"closeStatus,omitempty"`
+	HistoryLength    *int64                        `json:"historyLength,omitempty"`
+	SearchAttributes *SearchAttributes             `json:"searchAttributes,omitempty"`
 }
 
 func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -14141,6 +20439,14 @@ func (v *WorkflowExecutionInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
@@ -14198,6 +20504,13 @@ func (v *WorkflowExecutionInfo) FromWire(w wire.Value) error {
 					return err
 				}
 			}
+		case 70:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
@@ -14207,7 +20520,7 @@ func (v *WorkflowExecutionInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [6]string
+	var fields [7]string
 	i := 0
 	if v.Execution != nil {
 		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
@@ -14233,6 +20546,10 @@ func (v *WorkflowExecutionInfo) String() string {
 		fields[i] = fmt.Sprintf("HistoryLength: %v", *(v.HistoryLength))
 		i++
 	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
 	return fmt.Sprintf("WorkflowExecutionInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
@@ -14255,6 +20572,9 @@ func (v *WorkflowExecutionInfo) Equals(rhs *WorkflowExecutionInfo) bool {
 	if !_I64_EqualsPtr(v.HistoryLength, rhs.HistoryLength) {
 		return false
 	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
 	return true
 }
 
@@ -14367,17 +20687,23 @@ func (v *WorkflowExecutionSignaledEventAttributes) Equals(rhs *WorkflowExecution
 }
 
 type WorkflowExecutionStartedEventAttributes struct {
-	WorkflowType                        *WorkflowType `json:"workflowType,omitempty"`
-	TaskList                            *TaskList     `json:"taskList,omitempty"`
-	Input                               []byte        `json:"input"`
-	ExecutionStartToCloseTimeoutSeconds *int32        `json:"executionStartToCloseTimeoutSeconds,omitempty"`
-	TaskStartToCloseTimeoutSeconds      *int32        `json:"taskStartToCloseTimeoutSeconds,omitempty"`
-	Identity                            *string       `json:"identity,omitempty"`
+	WorkflowType                        *WorkflowType     `json:"workflowType,omitempty"`
+	TaskList                            *TaskList         `json:"taskList,omitempty"`
+	Input                               []byte            `json:"input"`
+	ExecutionStartToCloseTimeoutSeconds *int32            `json:"executionStartToCloseTimeoutSeconds,omitempty"`
+	TaskStartToCloseTimeoutSeconds      *int32            `json:"taskStartToCloseTimeoutSeconds,omitempty"`
+	Identity                            *string           `json:"identity,omitempty"`
+	FirstDecisionTaskBackoffSeconds     *int32            `json:"firstDecisionTaskBackoffSeconds,omitempty"`
+	CronSchedule                        *string           `json:"cronSchedule,omitempty"`
+	RetryPolicy                         *RetryPolicy      `json:"retryPolicy,omitempty"`
+	Attempt                             *int32            `json:"attempt,omitempty"`
+	ExpirationTimestamp                 *int64            `json:"expirationTimestamp,omitempty"`
+	SearchAttributes                    *SearchAttributes `json:"searchAttributes,omitempty"`
 }
 
 func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [12]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -14430,6 +20756,54 @@ func (v *WorkflowExecutionStartedEventAttributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		w, err = wire.NewValueI32(*(v.FirstDecisionTaskBackoffSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.CronSchedule != nil {
+		w, err = wire.NewValueString(*(v.CronSchedule)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.RetryPolicy != nil {
+		w, err = v.RetryPolicy.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI32(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.ExpirationTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ExpirationTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.SearchAttributes != nil {
+		w, err = v.SearchAttributes.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
@@ -14485,6 +20859,56 @@ func (v *WorkflowExecutionStartedEventAttributes) FromWire(w wire.Value) error {
 					return err
 				}
 			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.FirstDecisionTaskBackoffSeconds = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.CronSchedule = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 90:
+			if field.Value.Type() == wire.TStruct {
+				v.RetryPolicy, err = _RetryPolicy_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
+		case 100:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 110:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpirationTimestamp = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 120:
+			if field.Value.Type() == wire.TStruct {
+				v.SearchAttributes, err = _SearchAttributes_Read(field.Value)
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
@@ -14494,7 +20918,7 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
-	var fields [6]string
+	var fields [12]string
 	i := 0
 	if v.WorkflowType != nil {
 		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
@@ -14520,6 +20944,30 @@ func (v *WorkflowExecutionStartedEventAttributes) String() string {
 		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
 		i++
 	}
+	if v.FirstDecisionTaskBackoffSeconds != nil {
+		fields[i] = fmt.Sprintf("FirstDecisionTaskBackoffSeconds: %v", *(v.FirstDecisionTaskBackoffSeconds))
+		i++
+	}
+	if v.CronSchedule != nil {
+		fields[i] = fmt.Sprintf("CronSchedule: %v", *(v.CronSchedule))
+		i++
+	}
+	if v.RetryPolicy != nil {
+		fields[i] = fmt.Sprintf("RetryPolicy: %v", v.RetryPolicy)
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.ExpirationTimestamp != nil {
+		fields[i] = fmt.Sprintf("ExpirationTimestamp: %v", *(v.ExpirationTimestamp))
+		i++
+	}
+	if v.SearchAttributes != nil {
+		fields[i] = fmt.Sprintf("SearchAttributes: %v", v.SearchAttributes)
+		i++
+	}
 	return fmt.Sprintf("WorkflowExecutionStartedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
@@ -14542,6 +20990,24 @@ func (v *WorkflowExecutionStartedEventAttributes) Equals(rhs *WorkflowExecutionS
 	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.FirstDecisionTaskBackoffSeconds, rhs.FirstDecisionTaskBackoffSeconds) {
+		return false
+	}
+	if !_String_EqualsPtr(v.CronSchedule, rhs.CronSchedule) {
+		return false
+	}
+	if !((v.RetryPolicy == nil && rhs.RetryPolicy == nil) || (v.RetryPolicy != nil && rhs.RetryPolicy != nil && v.RetryPolicy.Equals(rhs.RetryPolicy))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ExpirationTimestamp, rhs.ExpirationTimestamp) {
+		return false
+	}
+	if !((v.SearchAttributes == nil && rhs.SearchAttributes == nil) || (v.SearchAttributes != nil && rhs.SearchAttributes != nil && v.SearchAttributes.Equals(rhs.SearchAttributes))) {
+		return false
+	}
 	return true
 }
 
@@ -14713,6 +21179,183 @@ func (v *WorkflowExecutionTimedOutEventAttributes) Equals(rhs *WorkflowExecution
 	return true
 }
 
+type WorkflowIdReusePolicy int32
+
+const (
+	WorkflowIdReusePolicyAllowDuplicateFailedOnly WorkflowIdReusePolicy = 0
+	WorkflowIdReusePolicyAllowDuplicate           WorkflowIdReusePolicy = 1
+	WorkflowIdReusePolicyRejectDuplicate          WorkflowIdReusePolicy = 2
+)
+
+func WorkflowIdReusePolicy_Values() []WorkflowIdReusePolicy {
+	return []WorkflowIdReusePolicy{WorkflowIdReusePolicyAllowDuplicateFailedOnly, WorkflowIdReusePolicyAllowDuplicate, WorkflowIdReusePolicyRejectDuplicate}
+}
+
+func (v *WorkflowIdReusePolicy) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "ALLOW_DUPLICATE_FAILED_ONLY":
+		*v = WorkflowIdReusePolicyAllowDuplicateFailedOnly
+		return nil
+	case "ALLOW_DUPLICATE":
+		*v = WorkflowIdReusePolicyAllowDuplicate
+		return nil
+	case "REJECT_DUPLICATE":
+		*v = WorkflowIdReusePolicyRejectDuplicate
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "WorkflowIdReusePolicy")
+	}
+}
+
+func (v WorkflowIdReusePolicy) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+func (v *WorkflowIdReusePolicy) FromWire(w wire.Value) error {
+	*v = (WorkflowIdReusePolicy)(w.GetI32())
+	return nil
+}
+
+func (v WorkflowIdReusePolicy) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "ALLOW_DUPLICATE_FAILED_ONLY"
+	case 1:
+		return "ALLOW_DUPLICATE"
+	case 2:
+		return "REJECT_DUPLICATE"
+	}
+	return fmt.Sprintf("WorkflowIdReusePolicy(%d)", w)
+}
+
+func (v WorkflowIdReusePolicy) Equals(rhs WorkflowIdReusePolicy) bool {
+	return v == rhs
+}
+
+func (v WorkflowIdReusePolicy) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"ALLOW_DUPLICATE_FAILED_ONLY\""), nil
+	case 1:
+		return ([]byte)("\"ALLOW_DUPLICATE\""), nil
+	case 2:
+		return ([]byte)("\"REJECT_DUPLICATE\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+func (v *WorkflowIdReusePolicy) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "WorkflowIdReusePolicy")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "WorkflowIdReusePolicy")
+		}
+		*v = (WorkflowIdReusePolicy)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "WorkflowIdReusePolicy")
+	}
+}
+
+type WorkflowQuery struct {
+	QueryType *string `json:"queryType,omitempty"`
+	QueryArgs []byte  `json:"queryArgs"`
+}
+
+func (v *WorkflowQuery) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+	if v.QueryType != nil {
+		w, err = wire.NewValueString(*(v.QueryType)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.QueryArgs != nil {
+		w, err = wire.NewValueBinary(v.QueryArgs), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func (v *WorkflowQuery) FromWire(w wire.Value) error {
+	var err error
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.QueryType = &x
+				if err != nil {
+					return err
+				}
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.QueryArgs, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *WorkflowQuery) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	var fields [2]string
+	i := 0
+	if v.QueryType != nil {
+		fields[i] = fmt.Sprintf("QueryType: %v", *(v.QueryType))
+		i++
+	}
+	if v.QueryArgs != nil {
+		fields[i] = fmt.Sprintf("QueryArgs: %v", v.QueryArgs)
+		i++
+	}
+	return fmt.Sprintf("WorkflowQuery{%v}", strings.Join(fields[:i], ", "))
+}
+
+func (v *WorkflowQuery) Equals(rhs *WorkflowQuery) bool {
+	if !_String_EqualsPtr(v.QueryType, rhs.QueryType) {
+		return false
+	}
+	if !((v.QueryArgs == nil && rhs.QueryArgs == nil) || (v.QueryArgs != nil && rhs.QueryArgs != nil && bytes.Equal(v.QueryArgs, rhs.QueryArgs))) {
+		return false
+	}
+	return true
+}
+
 type WorkflowType struct {
 	Name *string `json:"name,omitempty"`
 }
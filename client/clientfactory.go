@@ -39,16 +39,18 @@ type rpcClientFactory struct {
 	monitor               membership.Monitor
 	metricsClient         metrics.Client
 	numberOfHistoryShards int
+	taskListPartitions    map[string]int
 }
 
 // NewRPCClientFactory creates an instance of client factory that knows how to dispatch RPC calls.
-func NewRPCClientFactory(df common.RPCFactory,
-	monitor membership.Monitor, metricsClient metrics.Client, numberOfHistoryShards int) Factory {
+func NewRPCClientFactory(df common.RPCFactory, monitor membership.Monitor, metricsClient metrics.Client,
+	numberOfHistoryShards int, taskListPartitions map[string]int) Factory {
 	return &rpcClientFactory{
 		df:                    df,
 		monitor:               monitor,
 		metricsClient:         metricsClient,
 		numberOfHistoryShards: numberOfHistoryShards,
+		taskListPartitions:    taskListPartitions,
 	}
 }
 
@@ -64,7 +66,7 @@ func (cf *rpcClientFactory) NewHistoryClient() (history.Client, error) {
 }
 
 func (cf *rpcClientFactory) NewMatchingClient() (matching.Client, error) {
-	client, err := matching.NewClient(cf.df, cf.monitor)
+	client, err := matching.NewClient(cf.df, cf.monitor, cf.taskListPartitions)
 	if err != nil {
 		return nil, err
 	}
@@ -108,6 +108,103 @@ func (c *clientImpl) GetWorkflowExecutionNextEventID(
 	return response, nil
 }
 
+func (c *clientImpl) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *h.DescribeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*workflow.DescribeWorkflowExecutionResponse, error) {
+	client, err := c.getHostForRequest(*request.Request.Execution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	var response *workflow.DescribeWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeWorkflowExecution(ctx, request)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) DescribeMutableState(
+	ctx context.Context,
+	request *h.DescribeMutableStateRequest,
+	opts ...yarpc.CallOption) (*h.DescribeMutableStateResponse, error) {
+	client, err := c.getHostForRequest(*request.Execution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	var response *h.DescribeMutableStateResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeMutableState(ctx, request)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) DescribeHistoryHost(
+	ctx context.Context,
+	request *workflow.DescribeHistoryHostRequest,
+	opts ...yarpc.CallOption) (*workflow.DescribeHistoryHostResponse, error) {
+	var client historyserviceclient.Interface
+	var err error
+	switch {
+	case request.HostAddress != nil:
+		client = c.getThriftClient(*request.HostAddress)
+	case request.ShardIdForHost != nil:
+		client, err = c.getHostForShard(int(*request.ShardIdForHost))
+	case request.ExecutionForHost != nil && request.ExecutionForHost.WorkflowId != nil:
+		client, err = c.getHostForRequest(*request.ExecutionForHost.WorkflowId)
+	default:
+		return nil, &workflow.BadRequestError{Message: "Host address, shard ID or workflow execution must be provided."}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeHistoryHost(ctx, request)
+}
+
+func (c *clientImpl) DescribeShard(
+	ctx context.Context,
+	request *workflow.DescribeShardRequest,
+	opts ...yarpc.CallOption) (*workflow.DescribeShardResponse, error) {
+	if request.ShardID == nil {
+		return nil, &workflow.BadRequestError{Message: "ShardID not set on request."}
+	}
+	client, err := c.getHostForShard(int(*request.ShardID))
+	if err != nil {
+		return nil, err
+	}
+	var response *workflow.DescribeShardResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeShard(ctx, request)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) RecordDecisionTaskStarted(
 	ctx context.Context,
 	request *h.RecordDecisionTaskStartedRequest,
@@ -299,6 +396,30 @@ func (c *clientImpl) SignalWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *h.SignalWithStartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*workflow.StartWorkflowExecutionResponse, error) {
+	client, err := c.getHostForRequest(*request.SignalWithStartRequest.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	var response *workflow.StartWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.SignalWithStartWorkflowExecution(ctx, request)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 func (c *clientImpl) TerminateWorkflowExecution(
 	ctx context.Context,
 	request *h.TerminateWorkflowExecutionRequest,
@@ -316,6 +437,30 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) ResetWorkflowExecution(
+	ctx context.Context,
+	request *h.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*workflow.ResetWorkflowExecutionResponse, error) {
+	client, err := c.getHostForRequest(*request.ResetRequest.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	var response *workflow.ResetWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ResetWorkflowExecution(ctx, request)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 func (c *clientImpl) ScheduleDecisionTask(
 	ctx context.Context,
 	request *h.ScheduleDecisionTaskRequest,
@@ -352,7 +497,11 @@ func (c *clientImpl) RecordChildExecutionCompleted(
 
 func (c *clientImpl) getHostForRequest(workflowID string) (historyserviceclient.Interface, error) {
 	key := common.WorkflowIDToHistoryShard(workflowID, c.numberOfShards)
-	host, err := c.resolver.Lookup(string(key))
+	return c.getHostForShard(key)
+}
+
+func (c *clientImpl) getHostForShard(shardID int) (historyserviceclient.Interface, error) {
+	host, err := c.resolver.Lookup(string(shardID))
 	if err != nil {
 		return nil, err
 	}
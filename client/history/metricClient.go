@@ -77,6 +77,74 @@ func (c *metricClient) GetWorkflowExecutionNextEventID(
 	return resp, err
 }
 
+func (c *metricClient) DescribeWorkflowExecution(
+	context context.Context,
+	request *h.DescribeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*shared.DescribeWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeWorkflowExecutionScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeWorkflowExecutionScope, metrics.CadenceLatency)
+	resp, err := c.client.DescribeWorkflowExecution(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeWorkflowExecutionScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) DescribeMutableState(
+	context context.Context,
+	request *h.DescribeMutableStateRequest,
+	opts ...yarpc.CallOption) (*h.DescribeMutableStateResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeMutableStateScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeMutableStateScope, metrics.CadenceLatency)
+	resp, err := c.client.DescribeMutableState(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeMutableStateScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) DescribeHistoryHost(
+	context context.Context,
+	request *shared.DescribeHistoryHostRequest,
+	opts ...yarpc.CallOption) (*shared.DescribeHistoryHostResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeHistoryHostScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeHistoryHostScope, metrics.CadenceLatency)
+	resp, err := c.client.DescribeHistoryHost(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeHistoryHostScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) DescribeShard(
+	context context.Context,
+	request *shared.DescribeShardRequest,
+	opts ...yarpc.CallOption) (*shared.DescribeShardResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeShardScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeShardScope, metrics.CadenceLatency)
+	resp, err := c.client.DescribeShard(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeShardScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) RecordDecisionTaskStarted(
 	context context.Context,
 	request *h.RecordDecisionTaskStartedRequest,
@@ -230,6 +298,23 @@ func (c *metricClient) SignalWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) SignalWithStartWorkflowExecution(
+	context context.Context,
+	request *h.SignalWithStartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*shared.StartWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientSignalWithStartWorkflowExecutionScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientSignalWithStartWorkflowExecutionScope, metrics.CadenceLatency)
+	resp, err := c.client.SignalWithStartWorkflowExecution(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientSignalWithStartWorkflowExecutionScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) TerminateWorkflowExecution(
 	context context.Context,
 	request *h.TerminateWorkflowExecutionRequest,
@@ -247,6 +332,23 @@ func (c *metricClient) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) ResetWorkflowExecution(
+	context context.Context,
+	request *h.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*shared.ResetWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientResetWorkflowExecutionScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientResetWorkflowExecutionScope, metrics.CadenceLatency)
+	resp, err := c.client.ResetWorkflowExecution(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientResetWorkflowExecutionScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) ScheduleDecisionTask(
 	context context.Context,
 	request *h.ScheduleDecisionTaskRequest,
@@ -22,6 +22,7 @@ package matching
 
 import (
 	"context"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -40,19 +41,22 @@ type clientImpl struct {
 	thriftCacheLock sync.RWMutex
 	thriftCache     map[string]matchingserviceclient.Interface
 	rpcFactory      common.RPCFactory
+	// number of partitions of each partitioned task list, keyed by task list name
+	taskListPartitions map[string]int
 }
 
 // NewClient creates a new history service TChannel client
-func NewClient(d common.RPCFactory, monitor membership.Monitor) (Client, error) {
+func NewClient(d common.RPCFactory, monitor membership.Monitor, taskListPartitions map[string]int) (Client, error) {
 	sResolver, err := monitor.GetResolver(common.MatchingServiceName)
 	if err != nil {
 		return nil, err
 	}
 
 	client := &clientImpl{
-		rpcFactory:  d,
-		resolver:    sResolver,
-		thriftCache: make(map[string]matchingserviceclient.Interface),
+		rpcFactory:         d,
+		resolver:           sResolver,
+		thriftCache:        make(map[string]matchingserviceclient.Interface),
+		taskListPartitions: taskListPartitions,
 	}
 	return client, nil
 }
@@ -61,52 +65,115 @@ func (c *clientImpl) AddActivityTask(
 	context context.Context,
 	addRequest *m.AddActivityTaskRequest,
 	opts ...yarpc.CallOption) error {
-	client, err := c.getHostForRequest(*addRequest.TaskList.Name)
+	request := *addRequest
+	request.TaskList = c.loadBalance(addRequest.TaskList, addRequest.ForwardedFrom)
+	client, err := c.getHostForRequest(*request.TaskList.Name)
 	if err != nil {
 		return err
 	}
 	ctx, cancel := c.createContext(context)
 	defer cancel()
-	return client.AddActivityTask(ctx, addRequest)
+	return client.AddActivityTask(ctx, &request)
 }
 
 func (c *clientImpl) AddDecisionTask(
 	context context.Context,
 	addRequest *m.AddDecisionTaskRequest,
 	opts ...yarpc.CallOption) error {
-	client, err := c.getHostForRequest(*addRequest.TaskList.Name)
+	request := *addRequest
+	request.TaskList = c.loadBalance(addRequest.TaskList, addRequest.ForwardedFrom)
+	client, err := c.getHostForRequest(*request.TaskList.Name)
 	if err != nil {
 		return err
 	}
 	ctx, cancel := c.createContext(context)
 	defer cancel()
-	return client.AddDecisionTask(ctx, addRequest)
+	return client.AddDecisionTask(ctx, &request)
 }
 
 func (c *clientImpl) PollForActivityTask(
 	context context.Context,
 	pollRequest *m.PollForActivityTaskRequest,
 	opts ...yarpc.CallOption) (*workflow.PollForActivityTaskResponse, error) {
-	client, err := c.getHostForRequest(*pollRequest.PollRequest.TaskList.Name)
+	request := *pollRequest
+	pollForActivityTaskRequest := *pollRequest.PollRequest
+	pollForActivityTaskRequest.TaskList = c.loadBalance(pollRequest.PollRequest.TaskList, nil)
+	request.PollRequest = &pollForActivityTaskRequest
+	client, err := c.getHostForRequest(*pollForActivityTaskRequest.TaskList.Name)
 	if err != nil {
 		return nil, err
 	}
 	ctx, cancel := c.createLongPollContext(context)
 	defer cancel()
-	return client.PollForActivityTask(ctx, pollRequest)
+	return client.PollForActivityTask(ctx, &request)
 }
 
 func (c *clientImpl) PollForDecisionTask(
 	context context.Context,
 	pollRequest *m.PollForDecisionTaskRequest,
 	opts ...yarpc.CallOption) (*m.PollForDecisionTaskResponse, error) {
-	client, err := c.getHostForRequest(*pollRequest.PollRequest.TaskList.Name)
+	request := *pollRequest
+	pollForDecisionTaskRequest := *pollRequest.PollRequest
+	pollForDecisionTaskRequest.TaskList = c.loadBalance(pollRequest.PollRequest.TaskList, nil)
+	request.PollRequest = &pollForDecisionTaskRequest
+	client, err := c.getHostForRequest(*pollForDecisionTaskRequest.TaskList.Name)
 	if err != nil {
 		return nil, err
 	}
 	ctx, cancel := c.createLongPollContext(context)
 	defer cancel()
-	return client.PollForDecisionTask(ctx, pollRequest)
+	return client.PollForDecisionTask(ctx, &request)
+}
+
+func (c *clientImpl) QueryWorkflow(
+	context context.Context,
+	queryRequest *m.QueryWorkflowRequest,
+	opts ...yarpc.CallOption) (*workflow.QueryWorkflowResponse, error) {
+	client, err := c.getHostForRequest(*queryRequest.TaskList.Name)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(context)
+	defer cancel()
+	return client.QueryWorkflow(ctx, queryRequest)
+}
+
+func (c *clientImpl) RespondQueryTaskCompleted(
+	context context.Context,
+	request *m.RespondQueryTaskCompletedRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getHostForRequest(*request.TaskList.Name)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(context)
+	defer cancel()
+	return client.RespondQueryTaskCompleted(ctx, request)
+}
+
+func (c *clientImpl) DescribeTaskList(
+	context context.Context,
+	request *m.DescribeTaskListRequest,
+	opts ...yarpc.CallOption) (*workflow.DescribeTaskListResponse, error) {
+	client, err := c.getHostForRequest(*request.DescRequest.TaskList.Name)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(context)
+	defer cancel()
+	return client.DescribeTaskList(ctx, request)
+}
+
+// loadBalance picks a random partition of a partitioned task list, which spreads adds and polls across the matching
+// hosts owning the partitions.  Tasks forwarded by a partition are sent to the task list they are addressed to, and
+// queries always go to the root partition, which keeps the name of the task list.
+func (c *clientImpl) loadBalance(taskList *workflow.TaskList, forwardedFrom *string) *workflow.TaskList {
+	numPartitions := c.taskListPartitions[*taskList.Name]
+	if numPartitions <= 1 || common.StringDefault(forwardedFrom) != "" {
+		return taskList
+	}
+	partition := rand.Intn(numPartitions)
+	return &workflow.TaskList{Name: common.StringPtr(common.TaskListPartitionName(*taskList.Name, partition))}
 }
 
 func (c *clientImpl) getHostForRequest(key string) (matchingserviceclient.Interface, error) {
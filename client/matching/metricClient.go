@@ -110,3 +110,54 @@ func (c *metricClient) PollForDecisionTask(
 
 	return resp, err
 }
+
+func (c *metricClient) QueryWorkflow(
+	context context.Context,
+	queryRequest *m.QueryWorkflowRequest,
+	opts ...yarpc.CallOption) (*workflow.QueryWorkflowResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientQueryWorkflowScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientQueryWorkflowScope, metrics.CadenceLatency)
+	resp, err := c.client.QueryWorkflow(context, queryRequest)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientQueryWorkflowScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) RespondQueryTaskCompleted(
+	context context.Context,
+	request *m.RespondQueryTaskCompletedRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientRespondQueryTaskCompletedScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientRespondQueryTaskCompletedScope, metrics.CadenceLatency)
+	err := c.client.RespondQueryTaskCompleted(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientRespondQueryTaskCompletedScope, metrics.CadenceFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) DescribeTaskList(
+	context context.Context,
+	request *m.DescribeTaskListRequest,
+	opts ...yarpc.CallOption) (*workflow.DescribeTaskListResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientDescribeTaskListScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientDescribeTaskListScope, metrics.CadenceLatency)
+	resp, err := c.client.DescribeTaskList(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientDescribeTaskListScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
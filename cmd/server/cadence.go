@@ -21,6 +21,8 @@
 package main
 
 import (
+	"fmt"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/urfave/cli"
 	"log"
@@ -49,11 +51,25 @@ func startHandler(c *cli.Context) {
 	config.Load(env, configDir, zone, &cfg)
 	log.Printf("config=\n%v\n", cfg.String())
 
-	for _, svc := range getServices(c) {
+	services := getServices(c)
+	if err := validateInMemoryStore(&cfg.Persistence, services); err != nil {
+		log.Fatal(err)
+	}
+	if err := validateAdvancedVisibility(&cfg.Persistence); err != nil {
+		log.Fatal(err)
+	}
+
+	// The services of the process share the memory store
+	var inMemoryStore *persistence.InMemoryStore
+	if cfg.Persistence.IsInMemory() {
+		inMemoryStore = persistence.NewInMemoryStore()
+	}
+
+	for _, svc := range services {
 		if _, ok := cfg.Services[svc]; !ok {
 			log.Fatalf("`%v` service missing config", svc)
 		}
-		server := newServer(svc, &cfg)
+		server := newServer(svc, &cfg, inMemoryStore)
 		server.Start()
 	}
 
@@ -88,6 +104,39 @@ func getServices(c *cli.Context) []string {
 	return tokens
 }
 
+// validateInMemoryStore returns an error if memory is the default store and not all services are given.  The memory
+// store is only shared by the services of one process.
+func validateInMemoryStore(persistenceConfig *config.Persistence, services []string) error {
+	if !persistenceConfig.IsInMemory() {
+		return nil
+	}
+	for _, required := range validServices {
+		found := false
+		for _, svc := range services {
+			if svc == required {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("`%v` store requires all services in the same process, missing %v",
+				config.StoreTypeMemory, required)
+		}
+	}
+	return nil
+}
+
+// validateAdvancedVisibility returns an error if the advanced visibility store cannot be used with the default store.
+// The embedded store keeps the index in the memory of the process and loses it on restart, so it is only consistent
+// with the executions it indexes if they are lost on restart too.
+func validateAdvancedVisibility(persistenceConfig *config.Persistence) error {
+	if persistenceConfig.AdvancedVisibilityStore == config.StoreTypeEmbedded && !persistenceConfig.IsInMemory() {
+		return fmt.Errorf("`%v` advanced visibility store is meant for tests and requires the `%v` default store",
+			config.StoreTypeEmbedded, config.StoreTypeMemory)
+	}
+	return nil
+}
+
 func isValidService(in string) bool {
 	for _, s := range validServices {
 		if s == in {
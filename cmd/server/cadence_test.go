@@ -23,6 +23,7 @@ package main
 import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/service/config"
 	"testing"
 )
 
@@ -52,3 +53,26 @@ func (s *CadenceSuite) TestIsValidService() {
 func (s *CadenceSuite) TestPath() {
 	s.Equal("foo/bar", path("foo", "bar"))
 }
+
+func (s *CadenceSuite) TestValidateInMemoryStore() {
+	memory := &config.Persistence{DefaultStore: config.StoreTypeMemory}
+	s.NoError(validateInMemoryStore(memory, validServices))
+	s.NoError(validateInMemoryStore(memory, []string{"matching", "history", "frontend"}))
+	s.Error(validateInMemoryStore(memory, []string{"history", "frontend"}))
+	s.Error(validateInMemoryStore(memory, []string{"matching"}))
+	s.NoError(validateInMemoryStore(&config.Persistence{DefaultStore: config.StoreTypeSQL}, []string{"matching"}))
+}
+
+func (s *CadenceSuite) TestValidateAdvancedVisibility() {
+	s.NoError(validateAdvancedVisibility(&config.Persistence{
+		DefaultStore:            config.StoreTypeMemory,
+		AdvancedVisibilityStore: config.StoreTypeEmbedded,
+	}))
+	s.Error(validateAdvancedVisibility(&config.Persistence{AdvancedVisibilityStore: config.StoreTypeEmbedded}))
+	s.Error(validateAdvancedVisibility(&config.Persistence{
+		DefaultStore:            config.StoreTypeSQL,
+		AdvancedVisibilityStore: config.StoreTypeEmbedded,
+	}))
+	s.NoError(validateAdvancedVisibility(&config.Persistence{AdvancedVisibilityStore: "elasticsearch"}))
+	s.NoError(validateAdvancedVisibility(&config.Persistence{}))
+}
@@ -24,7 +24,10 @@ import (
 	"log"
 	"time"
 
+	"github.com/uber-common/bark"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/service/frontend"
@@ -38,6 +41,10 @@ type (
 		cfg    *config.Config
 		doneC  chan struct{}
 		daemon common.Daemon
+		// closed when the server stops, to stop polling the dynamic config file
+		dynamicConfigDoneC chan struct{}
+		// shared by the servers of the process if memory is the default store
+		inMemoryStore *persistence.InMemoryStore
 	}
 )
 
@@ -49,11 +56,13 @@ const (
 
 // newServer returns a new instance of a daemon
 // that represents a cadence service
-func newServer(service string, cfg *config.Config) common.Daemon {
+func newServer(service string, cfg *config.Config, inMemoryStore *persistence.InMemoryStore) common.Daemon {
 	return &server{
-		cfg:   cfg,
-		name:  service,
-		doneC: make(chan struct{}),
+		cfg:                cfg,
+		name:               service,
+		doneC:              make(chan struct{}),
+		dynamicConfigDoneC: make(chan struct{}),
+		inMemoryStore:      inMemoryStore,
 	}
 }
 
@@ -71,6 +80,7 @@ func (s *server) Stop() {
 	if s.daemon == nil {
 		return
 	}
+	close(s.dynamicConfigDoneC)
 
 	select {
 	case <-s.doneC:
@@ -93,6 +103,15 @@ func (s *server) startService() common.Daemon {
 	params.Name = "cadence-" + s.name
 	params.Logger = s.cfg.Log.NewBarkLogger()
 	params.CassandraConfig = s.cfg.Cassandra
+	params.PersistenceConfig = s.cfg.Persistence
+	params.MatchingConfig = s.cfg.Matching
+	if params.PersistenceConfig.IsSQL() && params.PersistenceConfig.SQL == nil {
+		log.Fatalf("sql persistence requires the sql config")
+	}
+	params.InMemoryStore = s.inMemoryStore
+	if params.PersistenceConfig.IsInMemory() && params.InMemoryStore == nil {
+		log.Fatalf("memory persistence requires the store shared by the services")
+	}
 
 	params.RingpopFactory, err = s.cfg.Ringpop.NewFactory()
 	if err != nil {
@@ -104,15 +123,31 @@ func (s *server) startService() common.Daemon {
 	params.MetricScope = svcCfg.Metrics.NewScope()
 	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, params.Logger)
 
+	dynamicConfigClient := dynamicconfig.NewNopClient()
+	if len(s.cfg.DynamicConfigClient.Filepath) > 0 {
+		dynamicConfigClient, err = dynamicconfig.NewFileBasedClient(
+			&s.cfg.DynamicConfigClient, params.Logger, s.dynamicConfigDoneC)
+		if err != nil {
+			log.Fatalf("error creating dynamic config client: %v", err)
+		}
+	}
+	dc := dynamicconfig.NewCollection(dynamicConfigClient, params.Logger)
+
 	var daemon common.Daemon
 
 	switch s.name {
 	case frontendService:
-		daemon = frontend.NewService(&params, frontend.NewConfig())
+		serviceConfig := frontend.NewConfig(dc)
+		overrideConfig(serviceConfig, svcCfg, params.Logger)
+		daemon = frontend.NewService(&params, serviceConfig)
 	case historyService:
-		daemon = history.NewService(&params, history.NewConfig(s.cfg.Cassandra.NumHistoryShards))
+		serviceConfig := history.NewConfig(dc, s.cfg.Cassandra.NumHistoryShards)
+		overrideConfig(serviceConfig, svcCfg, params.Logger)
+		daemon = history.NewService(&params, serviceConfig)
 	case matchingService:
-		daemon = matching.NewService(&params, matching.NewConfig())
+		serviceConfig := matching.NewConfig(dc)
+		overrideConfig(serviceConfig, svcCfg, params.Logger)
+		daemon = matching.NewService(&params, serviceConfig)
 	}
 
 	go execute(daemon, s.doneC)
@@ -120,6 +155,15 @@ func (s *server) startService() common.Daemon {
 	return daemon
 }
 
+// overrideConfig applies the overrides from the config section of the service to the service config, and logs the
+// resulting config
+func overrideConfig(serviceConfig interface{}, svcCfg config.Service, logger bark.Logger) {
+	if err := config.ApplyOverrides(serviceConfig, svcCfg.Config); err != nil {
+		log.Fatalf("invalid service config: %v", err)
+	}
+	logger.WithFields(bark.Fields(config.EffectiveValues(serviceConfig))).Info("Effective service config")
+}
+
 // execute runs the daemon in a separate go routine
 func execute(d common.Daemon, doneC chan struct{}) {
 	d.Start()
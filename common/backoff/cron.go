@@ -0,0 +1,58 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backoff
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+// NoBackoff is returned for an empty cron schedule, which means the run does not have to be delayed
+const NoBackoff = time.Duration(-1)
+
+// ValidateSchedule validates a cron schedule in the standard five field format, which also accepts descriptors like
+// @hourly and @every <duration>
+func ValidateSchedule(cronSchedule string) error {
+	if _, err := cron.ParseStandard(cronSchedule); err != nil {
+		return &workflow.BadRequestError{Message: fmt.Sprintf("Invalid CronSchedule: %v.", err)}
+	}
+	return nil
+}
+
+// GetBackoffForNextSchedule returns the time to wait from now until the next fire time of the cron schedule.  It
+// returns NoBackoff if the schedule is empty or cannot be parsed.
+func GetBackoffForNextSchedule(cronSchedule string, now time.Time) time.Duration {
+	if len(cronSchedule) == 0 {
+		return NoBackoff
+	}
+
+	schedule, err := cron.ParseStandard(cronSchedule)
+	if err != nil {
+		return NoBackoff
+	}
+
+	// Fire times have second precision, round the backoff up so the next run never starts before the fire time
+	backoffInterval := schedule.Next(now).Sub(now)
+	return (backoffInterval + time.Second - 1) / time.Second * time.Second
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	CronSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+)
+
+func TestCronSuite(t *testing.T) {
+	suite.Run(t, new(CronSuite))
+}
+
+func (s *CronSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *CronSuite) TestValidateSchedule() {
+	s.NoError(ValidateSchedule("*/5 * * * *"))
+	s.NoError(ValidateSchedule("0 12 * * MON-FRI"))
+	s.NoError(ValidateSchedule("@hourly"))
+	s.NoError(ValidateSchedule("@every 90s"))
+
+	s.Error(ValidateSchedule(""))
+	s.Error(ValidateSchedule("* * *"))
+	s.Error(ValidateSchedule("61 * * * *"))
+	s.Error(ValidateSchedule("@fortnightly"))
+}
+
+func (s *CronSuite) TestGetBackoffForNextSchedule() {
+	now := time.Date(2017, time.October, 2, 10, 17, 0, 0, time.UTC)
+	s.Equal(3*time.Minute, GetBackoffForNextSchedule("*/5 * * * *", now))
+	s.Equal(43*time.Minute, GetBackoffForNextSchedule("@hourly", now))
+	s.Equal(5*time.Minute, GetBackoffForNextSchedule("*/5 * * * *", now.Add(-2*time.Minute)))
+
+	// The backoff is rounded up to the next second
+	s.Equal(3*time.Minute+time.Second, GetBackoffForNextSchedule("*/5 * * * *", now.Add(-500*time.Millisecond)))
+	s.Equal(3*time.Minute, GetBackoffForNextSchedule("*/5 * * * *", now.Add(500*time.Millisecond)))
+
+	s.Equal(NoBackoff, GetBackoffForNextSchedule("", now))
+	s.Equal(NoBackoff, GetBackoffForNextSchedule("invalid", now))
+}
@@ -25,6 +25,8 @@ const (
 	FirstEventID int64 = 1
 	// EmptyEventID is the id of the empty event
 	EmptyEventID int64 = -23
+	// EndEventID is the id past any event of a history, waiting for it means waiting for the workflow to close
+	EndEventID int64 = 1<<63 - 1
 )
 
 const (
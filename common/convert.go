@@ -102,6 +102,11 @@ func CancelExternalWorkflowExecutionFailedCausePtr(t s.CancelExternalWorkflowExe
 	return &t
 }
 
+// SignalExternalWorkflowExecutionFailedCausePtr makes a copy and returns the pointer to a SignalExternalWorkflowExecutionFailedCause.
+func SignalExternalWorkflowExecutionFailedCausePtr(t s.SignalExternalWorkflowExecutionFailedCause) *s.SignalExternalWorkflowExecutionFailedCause {
+	return &t
+}
+
 // ChildPolicyPtr makes a copy and returns the pointer to a ChildPolicy.
 func ChildPolicyPtr(t s.ChildPolicy) *s.ChildPolicy {
 	return &t
@@ -112,6 +117,21 @@ func ChildWorkflowExecutionFailedCausePtr(t s.ChildWorkflowExecutionFailedCause)
 	return &t
 }
 
+// WorkflowIDReusePolicyPtr makes a copy and returns the pointer to a WorkflowIdReusePolicy.
+func WorkflowIDReusePolicyPtr(t s.WorkflowIdReusePolicy) *s.WorkflowIdReusePolicy {
+	return &t
+}
+
+// TaskListTypePtr makes a copy and returns the pointer to a TaskListType.
+func TaskListTypePtr(t s.TaskListType) *s.TaskListType {
+	return &t
+}
+
+// HistoryEventFilterTypePtr makes a copy and returns the pointer to a HistoryEventFilterType.
+func HistoryEventFilterTypePtr(t s.HistoryEventFilterType) *s.HistoryEventFilterType {
+	return &t
+}
+
 // StringDefault returns value if string pointer is set otherwise default value of string
 func StringDefault(v *string) string {
 	var defaultString string
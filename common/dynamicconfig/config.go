@@ -0,0 +1,272 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/uber-common/bark"
+)
+
+type (
+	// Collection wraps a dynamic config client to provide typed properties with default values
+	Collection struct {
+		client Client
+		logger bark.Logger
+	}
+
+	// IntPropertyFn is a wrapper to get int property from dynamic config
+	IntPropertyFn func() int
+	// IntPropertyFnWithDomainFilter is a wrapper to get int property from dynamic config with domain as filter
+	IntPropertyFnWithDomainFilter func(domain string) int
+	// IntPropertyFnWithTaskListInfoFilters is a wrapper to get int property from dynamic config with task list name
+	// and task type as filters
+	IntPropertyFnWithTaskListInfoFilters func(taskList string, taskType int) int
+	// DurationPropertyFn is a wrapper to get duration property from dynamic config
+	DurationPropertyFn func() time.Duration
+	// DurationPropertyFnWithTaskListInfoFilters is a wrapper to get duration property from dynamic config with task
+	// list name and task type as filters
+	DurationPropertyFnWithTaskListInfoFilters func(taskList string, taskType int) time.Duration
+	// BoolPropertyFn is a wrapper to get bool property from dynamic config
+	BoolPropertyFn func() bool
+	// BoolPropertyFnWithTaskListInfoFilters is a wrapper to get bool property from dynamic config with task list name
+	// and task type as filters
+	BoolPropertyFnWithTaskListInfoFilters func(taskList string, taskType int) bool
+	// MapPropertyFn is a wrapper to get map property from dynamic config
+	MapPropertyFn func() map[string]interface{}
+)
+
+// NewCollection creates a new collection
+func NewCollection(client Client, logger bark.Logger) *Collection {
+	return &Collection{
+		client: client,
+		logger: logger,
+	}
+}
+
+// NewNopCollection creates a collection which returns the default value of every property
+func NewNopCollection() *Collection {
+	return NewCollection(NewNopClient(), bark.NewLoggerFromLogrus(logrus.New()))
+}
+
+// GetIntProperty gets property and asserts that it's an integer
+func (c *Collection) GetIntProperty(key Key, defaultValue int) IntPropertyFn {
+	return func() int {
+		return c.getInt(key, nil, defaultValue)
+	}
+}
+
+// GetIntPropertyFilteredByDomain gets property with domain filter and asserts that it's an integer
+func (c *Collection) GetIntPropertyFilteredByDomain(key Key, defaultValue int) IntPropertyFnWithDomainFilter {
+	return func(domain string) int {
+		return c.getInt(key, domainFilter(domain), defaultValue)
+	}
+}
+
+// GetIntPropertyFilteredByTaskListInfo gets property with task list info as filters and asserts that it's an integer
+func (c *Collection) GetIntPropertyFilteredByTaskListInfo(
+	key Key, defaultValue int) IntPropertyFnWithTaskListInfoFilters {
+	return func(taskList string, taskType int) int {
+		return c.getInt(key, taskListInfoFilters(taskList, taskType), defaultValue)
+	}
+}
+
+// GetDurationProperty gets property and asserts that it's a duration
+func (c *Collection) GetDurationProperty(key Key, defaultValue time.Duration) DurationPropertyFn {
+	return func() time.Duration {
+		return c.getDuration(key, nil, defaultValue)
+	}
+}
+
+// GetDurationPropertyFilteredByTaskListInfo gets property with task list info as filters and asserts that it's a
+// duration
+func (c *Collection) GetDurationPropertyFilteredByTaskListInfo(
+	key Key, defaultValue time.Duration) DurationPropertyFnWithTaskListInfoFilters {
+	return func(taskList string, taskType int) time.Duration {
+		return c.getDuration(key, taskListInfoFilters(taskList, taskType), defaultValue)
+	}
+}
+
+// GetBoolProperty gets property and asserts that it's a bool
+func (c *Collection) GetBoolProperty(key Key, defaultValue bool) BoolPropertyFn {
+	return func() bool {
+		return c.getBool(key, nil, defaultValue)
+	}
+}
+
+// GetBoolPropertyFilteredByTaskListInfo gets property with task list info as filters and asserts that it's a bool
+func (c *Collection) GetBoolPropertyFilteredByTaskListInfo(
+	key Key, defaultValue bool) BoolPropertyFnWithTaskListInfoFilters {
+	return func(taskList string, taskType int) bool {
+		return c.getBool(key, taskListInfoFilters(taskList, taskType), defaultValue)
+	}
+}
+
+// GetMapProperty gets property and asserts that it's a map
+func (c *Collection) GetMapProperty(key Key, defaultValue map[string]interface{}) MapPropertyFn {
+	return func() map[string]interface{} {
+		return c.getMap(key, nil, defaultValue)
+	}
+}
+
+func (c *Collection) getInt(key Key, filters map[Filter]interface{}, defaultValue int) int {
+	val, ok := c.getValue(key, filters)
+	if !ok {
+		return defaultValue
+	}
+	intVal, ok := val.(int)
+	if !ok {
+		c.logInvalidValue(key, val, defaultValue)
+		return defaultValue
+	}
+	return intVal
+}
+
+func (c *Collection) getDuration(key Key, filters map[Filter]interface{}, defaultValue time.Duration) time.Duration {
+	val, ok := c.getValue(key, filters)
+	if !ok {
+		return defaultValue
+	}
+	switch v := val.(type) {
+	case time.Duration:
+		return v
+	case string:
+		// Durations are written as strings like "10s" in the dynamic config source
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	c.logInvalidValue(key, val, defaultValue)
+	return defaultValue
+}
+
+func (c *Collection) getBool(key Key, filters map[Filter]interface{}, defaultValue bool) bool {
+	val, ok := c.getValue(key, filters)
+	if !ok {
+		return defaultValue
+	}
+	boolVal, ok := val.(bool)
+	if !ok {
+		c.logInvalidValue(key, val, defaultValue)
+		return defaultValue
+	}
+	return boolVal
+}
+
+func (c *Collection) getMap(key Key, filters map[Filter]interface{},
+	defaultValue map[string]interface{}) map[string]interface{} {
+	val, ok := c.getValue(key, filters)
+	if !ok {
+		return defaultValue
+	}
+	switch v := val.(type) {
+	case map[string]interface{}:
+		return v
+	case map[interface{}]interface{}:
+		// Maps are decoded with keys of any type from the yaml dynamic config file
+		mapVal := make(map[string]interface{}, len(v))
+		for k, value := range v {
+			keyStr, ok := k.(string)
+			if !ok {
+				c.logInvalidValue(key, val, defaultValue)
+				return defaultValue
+			}
+			mapVal[keyStr] = value
+		}
+		return mapVal
+	}
+	c.logInvalidValue(key, val, defaultValue)
+	return defaultValue
+}
+
+func (c *Collection) getValue(key Key, filters map[Filter]interface{}) (interface{}, bool) {
+	val, err := c.client.GetValue(key, filters)
+	if err != nil {
+		if err != ErrNotFound {
+			c.logger.WithFields(bark.Fields{
+				"key":   key.String(),
+				"error": err,
+			}).Warn("Failed to fetch key from dynamic config, using the default value")
+		}
+		return nil, false
+	}
+	return val, true
+}
+
+func (c *Collection) logInvalidValue(key Key, val interface{}, defaultValue interface{}) {
+	c.logger.WithFields(bark.Fields{
+		"key":          key.String(),
+		"value":        val,
+		"defaultValue": defaultValue,
+	}).Warn("Invalid value in dynamic config, using the default value")
+}
+
+func domainFilter(domain string) map[Filter]interface{} {
+	return map[Filter]interface{}{DomainName: domain}
+}
+
+func taskListInfoFilters(taskList string, taskType int) map[Filter]interface{} {
+	return map[Filter]interface{}{
+		TaskListName: taskList,
+		TaskType:     taskType,
+	}
+}
+
+// GetIntPropertyFn returns value as IntPropertyFn
+func GetIntPropertyFn(value int) IntPropertyFn {
+	return func() int { return value }
+}
+
+// GetIntPropertyFnFilteredByDomain returns value as IntPropertyFnWithDomainFilter
+func GetIntPropertyFnFilteredByDomain(value int) IntPropertyFnWithDomainFilter {
+	return func(domain string) int { return value }
+}
+
+// GetIntPropertyFnFilteredByTaskListInfo returns value as IntPropertyFnWithTaskListInfoFilters
+func GetIntPropertyFnFilteredByTaskListInfo(value int) IntPropertyFnWithTaskListInfoFilters {
+	return func(taskList string, taskType int) int { return value }
+}
+
+// GetDurationPropertyFn returns value as DurationPropertyFn
+func GetDurationPropertyFn(value time.Duration) DurationPropertyFn {
+	return func() time.Duration { return value }
+}
+
+// GetDurationPropertyFnFilteredByTaskListInfo returns value as DurationPropertyFnWithTaskListInfoFilters
+func GetDurationPropertyFnFilteredByTaskListInfo(value time.Duration) DurationPropertyFnWithTaskListInfoFilters {
+	return func(taskList string, taskType int) time.Duration { return value }
+}
+
+// GetBoolPropertyFn returns value as BoolPropertyFn
+func GetBoolPropertyFn(value bool) BoolPropertyFn {
+	return func() bool { return value }
+}
+
+// GetMapPropertyFn returns value as MapPropertyFn
+func GetMapPropertyFn(value map[string]interface{}) MapPropertyFn {
+	return func() map[string]interface{} { return value }
+}
+
+// GetBoolPropertyFnFilteredByTaskListInfo returns value as BoolPropertyFnWithTaskListInfoFilters
+func GetBoolPropertyFnFilteredByTaskListInfo(value bool) BoolPropertyFnWithTaskListInfoFilters {
+	return func(taskList string, taskType int) bool { return value }
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+)
+
+type (
+	configSuite struct {
+		suite.Suite
+		*require.Assertions
+		client *inMemoryClient
+		cln    *Collection
+	}
+
+	// inMemoryClient returns the value set for a key if the filters of the request include all of its filters
+	inMemoryClient struct {
+		values  map[Key]interface{}
+		filters map[Key]map[Filter]interface{}
+		err     error
+	}
+)
+
+func TestConfigSuite(t *testing.T) {
+	suite.Run(t, new(configSuite))
+}
+
+func (s *configSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.client = newInMemoryClient()
+	s.cln = NewCollection(s.client, bark.NewLoggerFromLogrus(logrus.New()))
+}
+
+func newInMemoryClient() *inMemoryClient {
+	return &inMemoryClient{
+		values:  make(map[Key]interface{}),
+		filters: make(map[Key]map[Filter]interface{}),
+	}
+}
+
+func (mc *inMemoryClient) GetValue(name Key, filters map[Filter]interface{}) (interface{}, error) {
+	if mc.err != nil {
+		return nil, mc.err
+	}
+	val, ok := mc.values[name]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	for filter, filterValue := range mc.filters[name] {
+		if filters[filter] != filterValue {
+			return nil, ErrNotFound
+		}
+	}
+	return val, nil
+}
+
+func (s *configSuite) TestGetIntProperty() {
+	value := s.cln.GetIntProperty(FrontendRPS, 10)
+	s.Equal(10, value())
+	s.client.values[FrontendRPS] = 50
+	s.Equal(50, value())
+	s.client.values[FrontendRPS] = "50"
+	s.Equal(10, value())
+}
+
+func (s *configSuite) TestGetIntPropertyFilteredByDomain() {
+	domain := "testDomain"
+	value := s.cln.GetIntPropertyFilteredByDomain(FrontendHistoryMaxPageSize, 10)
+	s.Equal(10, value(domain))
+	s.client.values[FrontendHistoryMaxPageSize] = 50
+	s.client.filters[FrontendHistoryMaxPageSize] = map[Filter]interface{}{DomainName: domain}
+	s.Equal(50, value(domain))
+	s.Equal(10, value("otherDomain"))
+}
+
+func (s *configSuite) TestGetIntPropertyFilteredByTaskListInfo() {
+	taskList := "testTaskList"
+	value := s.cln.GetIntPropertyFilteredByTaskListInfo(MatchingGetTasksBatchSize, 10)
+	s.Equal(10, value(taskList, 0))
+	s.client.values[MatchingGetTasksBatchSize] = 50
+	s.client.filters[MatchingGetTasksBatchSize] = map[Filter]interface{}{TaskListName: taskList, TaskType: 1}
+	s.Equal(50, value(taskList, 1))
+	s.Equal(10, value(taskList, 0))
+	s.Equal(10, value("otherTaskList", 1))
+}
+
+func (s *configSuite) TestGetDurationProperty() {
+	value := s.cln.GetDurationProperty(HistoryCacheTTL, time.Second)
+	s.Equal(time.Second, value())
+	s.client.values[HistoryCacheTTL] = time.Minute
+	s.Equal(time.Minute, value())
+	s.client.values[HistoryCacheTTL] = "10s"
+	s.Equal(10*time.Second, value())
+	s.client.values[HistoryCacheTTL] = "ten seconds"
+	s.Equal(time.Second, value())
+}
+
+func (s *configSuite) TestGetBoolPropertyFilteredByTaskListInfo() {
+	taskList := "testTaskList"
+	value := s.cln.GetBoolPropertyFilteredByTaskListInfo(MatchingEnableSyncMatch, true)
+	s.True(value(taskList, 0))
+	s.client.values[MatchingEnableSyncMatch] = false
+	s.client.filters[MatchingEnableSyncMatch] = map[Filter]interface{}{TaskListName: taskList}
+	s.False(value(taskList, 0))
+	s.True(value("otherTaskList", 0))
+}
+
+func (s *configSuite) TestGetMapProperty() {
+	defaultValue := map[string]interface{}{"key": "default"}
+	value := s.cln.GetMapProperty(ValidSearchAttributes, defaultValue)
+	s.Equal(defaultValue, value())
+	s.client.values[ValidSearchAttributes] = map[string]interface{}{"key": "value"}
+	s.Equal(map[string]interface{}{"key": "value"}, value())
+	s.client.values[ValidSearchAttributes] = map[interface{}]interface{}{"key": "yaml"}
+	s.Equal(map[string]interface{}{"key": "yaml"}, value())
+	s.client.values[ValidSearchAttributes] = map[interface{}]interface{}{1: "value"}
+	s.Equal(defaultValue, value())
+	s.client.values[ValidSearchAttributes] = "value"
+	s.Equal(defaultValue, value())
+}
+
+func (s *configSuite) TestClientError() {
+	value := s.cln.GetIntProperty(FrontendRPS, 10)
+	s.client.values[FrontendRPS] = 50
+	s.client.err = errors.New("client error")
+	s.Equal(10, value())
+}
+
+func (s *configSuite) TestKeyAndFilterNames() {
+	s.Equal("frontend.rps", FrontendRPS.String())
+	s.Equal("unknownKey", Key(-1).String())
+	s.Equal("taskListName", TaskListName.String())
+	s.Equal("unknownFilter", Filter(100).String())
+}
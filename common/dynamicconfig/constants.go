@@ -0,0 +1,151 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+// Key represents a key/property stored in dynamic config
+type Key int
+
+func (k Key) String() string {
+	keyName, ok := keys[k]
+	if !ok {
+		return keys[unknownKey]
+	}
+	return keyName
+}
+
+// Mapping from Key to the name of the property in the dynamic config source.  The default value of each property is
+// given by the service reading it.
+var keys = map[Key]string{
+	unknownKey: "unknownKey",
+
+	// system settings
+	ValidSearchAttributes: "system.validSearchAttributes",
+
+	// frontend settings
+	FrontendRPS:                   "frontend.rps",
+	FrontendVisibilityMaxPageSize: "frontend.visibilityMaxPageSize",
+	FrontendHistoryMaxPageSize:    "frontend.historyMaxPageSize",
+
+	// history settings
+	HistoryCacheInitialSize:           "history.cacheInitialSize",
+	HistoryCacheMaxSize:               "history.cacheMaxSize",
+	HistoryCacheTTL:                   "history.cacheTTL",
+	HistoryLongPollExpirationInterval: "history.longPollExpirationInterval",
+	TimerTaskBatchSize:                "history.timerTaskBatchSize",
+	TimerProcessorWorkerCount:         "history.timerProcessorWorkerCount",
+	TransferTaskBatchSize:             "history.transferTaskBatchSize",
+	TransferProcessorMaxPollRPS:       "history.transferProcessorMaxPollRPS",
+	TransferTaskWorkerCount:           "history.transferTaskWorkerCount",
+
+	// matching settings
+	MatchingEnableSyncMatch:                 "matching.enableSyncMatch",
+	MatchingLongPollExpirationInterval:      "matching.longPollExpirationInterval",
+	MatchingGetTasksBatchSize:               "matching.getTasksBatchSize",
+	MatchingMaxTaskBatchSize:                "matching.maxTaskBatchSize",
+	MatchingOutstandingTaskAppendsThreshold: "matching.outstandingTaskAppendsThreshold",
+	MatchingIdlePartitionTimeout:            "matching.idlePartitionTimeout",
+	MatchingQueryHandoffTimeout:             "matching.queryHandoffTimeout",
+}
+
+const (
+	unknownKey Key = iota
+
+	// key for system
+
+	// ValidSearchAttributes is the map of the valid search attributes of workflow executions to the types of their
+	// values
+	ValidSearchAttributes
+
+	// key for frontend
+
+	// FrontendRPS is the rate limit of requests accepted by a frontend host
+	FrontendRPS
+	// FrontendVisibilityMaxPageSize is the default page size of ListOpen/ListClosedWorkflowExecutions
+	FrontendVisibilityMaxPageSize
+	// FrontendHistoryMaxPageSize is the default page size of GetWorkflowExecutionHistory
+	FrontendHistoryMaxPageSize
+
+	// key for history
+
+	// HistoryCacheInitialSize is the initial size of the history cache of a shard
+	HistoryCacheInitialSize
+	// HistoryCacheMaxSize is the maximum size of the history cache of a shard
+	HistoryCacheMaxSize
+	// HistoryCacheTTL is the TTL of entries in the history cache
+	HistoryCacheTTL
+	// HistoryLongPollExpirationInterval is how long a poll waits for new history events before returning
+	HistoryLongPollExpirationInterval
+	// TimerTaskBatchSize is the number of timer tasks read from persistence at a time
+	TimerTaskBatchSize
+	// TimerProcessorWorkerCount is the number of workers processing timer tasks of a shard
+	TimerProcessorWorkerCount
+	// TransferTaskBatchSize is the number of transfer tasks read from persistence at a time
+	TransferTaskBatchSize
+	// TransferProcessorMaxPollRPS is the rate at which a shard polls persistence for transfer tasks
+	TransferProcessorMaxPollRPS
+	// TransferTaskWorkerCount is the number of workers processing transfer tasks of a shard
+	TransferTaskWorkerCount
+
+	// key for matching
+
+	// MatchingEnableSyncMatch enables handing tasks to waiting pollers without persisting them
+	MatchingEnableSyncMatch
+	// MatchingLongPollExpirationInterval is how long a poll waits for a task before returning an empty response
+	MatchingLongPollExpirationInterval
+	// MatchingGetTasksBatchSize is the number of tasks read from persistence at a time
+	MatchingGetTasksBatchSize
+	// MatchingMaxTaskBatchSize is the maximum number of tasks written to persistence at a time
+	MatchingMaxTaskBatchSize
+	// MatchingOutstandingTaskAppendsThreshold is the number of tasks which can wait to be written to persistence
+	MatchingOutstandingTaskAppendsThreshold
+	// MatchingIdlePartitionTimeout is how long a task list partition can go without pollers before its new tasks
+	// are forwarded to the root partition
+	MatchingIdlePartitionTimeout
+	// MatchingQueryHandoffTimeout is how long a query waits for a poller to pick it up before it fails
+	MatchingQueryHandoffTimeout
+)
+
+// Filter represents a filter on the dynamic config key
+type Filter int
+
+func (f Filter) String() string {
+	if f <= unknownFilter || f > TaskType {
+		return filters[unknownFilter]
+	}
+	return filters[f]
+}
+
+var filters = []string{
+	"unknownFilter",
+	"domainName",
+	"taskListName",
+	"taskType",
+}
+
+const (
+	unknownFilter Filter = iota
+	// DomainName is the domain name
+	DomainName
+	// TaskListName is the tasklist name
+	TaskListName
+	// TaskType is the task type (0:Decision, 1:Activity)
+	TaskType
+)
@@ -0,0 +1,182 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	"gopkg.in/yaml.v2"
+)
+
+var _ Client = (*fileBasedClient)(nil)
+
+const (
+	minPollInterval = 5 * time.Second
+)
+
+type (
+	// FileBasedClientConfig is the config for the file based dynamic config client.  The file is a yaml map of
+	// property name to a list of values, each of which applies when all of its constraints match the filters:
+	//
+	//   matching.longPollExpirationInterval:
+	//     - value: "30s"
+	//       constraints:
+	//         taskListName: "my-tasklist"
+	//     - value: "1m"
+	//
+	FileBasedClientConfig struct {
+		// Filepath is the path of the dynamic config file
+		Filepath string `yaml:"filepath"`
+		// PollInterval is how often the file is checked for changes
+		PollInterval time.Duration `yaml:"pollInterval"`
+	}
+
+	constrainedValue struct {
+		Value       interface{}            `yaml:"value"`
+		Constraints map[string]interface{} `yaml:"constraints"`
+	}
+
+	fileBasedClient struct {
+		values      atomic.Value // map[string][]*constrainedValue
+		lastUpdated time.Time
+		config      *FileBasedClientConfig
+		logger      bark.Logger
+		doneCh      <-chan struct{}
+	}
+)
+
+// NewFileBasedClient creates a client which reads the dynamic config from a yaml file.  The file is polled for
+// changes until doneCh is closed.
+func NewFileBasedClient(config *FileBasedClientConfig, logger bark.Logger, doneCh <-chan struct{}) (Client, error) {
+	if err := validateConfig(config); err != nil {
+		return nil, err
+	}
+
+	client := &fileBasedClient{
+		config: config,
+		logger: logger,
+		doneCh: doneCh,
+	}
+	if err := client.update(); err != nil {
+		return nil, err
+	}
+	go client.watch()
+	return client, nil
+}
+
+func (fc *fileBasedClient) GetValue(name Key, filters map[Filter]interface{}) (interface{}, error) {
+	values := fc.values.Load().(map[string][]*constrainedValue)
+	constrainedValues, ok := values[name.String()]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	// The value with the most constraints wins among the ones matching the filters
+	var match *constrainedValue
+	for _, cv := range constrainedValues {
+		if !matchFilters(cv.Constraints, filters) {
+			continue
+		}
+		if match == nil || len(cv.Constraints) > len(match.Constraints) {
+			match = cv
+		}
+	}
+	if match == nil {
+		return nil, ErrNotFound
+	}
+	return match.Value, nil
+}
+
+func (fc *fileBasedClient) watch() {
+	ticker := time.NewTicker(fc.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := fc.update(); err != nil {
+				fc.logger.WithField("error", err).Error("Failed to update dynamic config, keeping the previous values")
+			}
+		case <-fc.doneCh:
+			return
+		}
+	}
+}
+
+// update reloads the file if it was modified since it was last read
+func (fc *fileBasedClient) update() error {
+	info, err := os.Stat(fc.config.Filepath)
+	if err != nil {
+		return fmt.Errorf("dynamic config file: %v: %v", fc.config.Filepath, err)
+	}
+	if !info.ModTime().After(fc.lastUpdated) {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(fc.config.Filepath)
+	if err != nil {
+		return fmt.Errorf("dynamic config file: %v: %v", fc.config.Filepath, err)
+	}
+	values := make(map[string][]*constrainedValue)
+	if err := yaml.Unmarshal(data, values); err != nil {
+		return fmt.Errorf("dynamic config file: %v: %v", fc.config.Filepath, err)
+	}
+
+	fc.values.Store(values)
+	fc.lastUpdated = info.ModTime()
+	fc.logger.Infof("Updated dynamic config from %v", fc.config.Filepath)
+	return nil
+}
+
+func matchFilters(constraints map[string]interface{}, filters map[Filter]interface{}) bool {
+	for name, value := range constraints {
+		matched := false
+		for filter, filterValue := range filters {
+			if filter.String() == name && filterValue == value {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func validateConfig(config *FileBasedClientConfig) error {
+	if config == nil {
+		return errors.New("no config found for file based dynamic config client")
+	}
+	if len(config.Filepath) == 0 {
+		return errors.New("empty file path for file based dynamic config client")
+	}
+	if config.PollInterval < minPollInterval {
+		return fmt.Errorf("poll interval of file based dynamic config client must be at least %v", minPollInterval)
+	}
+	return nil
+}
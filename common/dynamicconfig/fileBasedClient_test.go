@@ -0,0 +1,159 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+)
+
+const testConfig = `
+frontend.rps:
+  - value: 2000
+history.cacheTTL:
+  - value: "30m"
+matching.longPollExpirationInterval:
+  - value: "10s"
+    constraints:
+      taskListName: "testTaskList"
+  - value: "20s"
+    constraints:
+      taskListName: "testTaskList"
+      taskType: 1
+  - value: "1m"
+matching.enableSyncMatch:
+  - value: false
+    constraints:
+      taskListName: "testTaskList"
+`
+
+type fileBasedClientSuite struct {
+	suite.Suite
+	*require.Assertions
+	file   *os.File
+	doneCh chan struct{}
+	client *fileBasedClient
+}
+
+func TestFileBasedClientSuite(t *testing.T) {
+	suite.Run(t, new(fileBasedClientSuite))
+}
+
+func (s *fileBasedClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	file, err := ioutil.TempFile("", "dynamicconfig")
+	s.NoError(err)
+	s.file = file
+	s.writeConfig(testConfig)
+
+	s.doneCh = make(chan struct{})
+	client, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     s.file.Name(),
+		PollInterval: time.Minute,
+	}, bark.NewLoggerFromLogrus(logrus.New()), s.doneCh)
+	s.NoError(err)
+	s.client = client.(*fileBasedClient)
+}
+
+func (s *fileBasedClientSuite) TearDownTest() {
+	close(s.doneCh)
+	s.file.Close()
+	os.Remove(s.file.Name())
+}
+
+func (s *fileBasedClientSuite) writeConfig(config string) {
+	s.NoError(ioutil.WriteFile(s.file.Name(), []byte(config), 0644))
+}
+
+func (s *fileBasedClientSuite) TestGetValue() {
+	v, err := s.client.GetValue(FrontendRPS, nil)
+	s.NoError(err)
+	s.Equal(2000, v)
+
+	v, err = s.client.GetValue(HistoryCacheTTL, nil)
+	s.NoError(err)
+	s.Equal("30m", v)
+
+	_, err = s.client.GetValue(HistoryCacheMaxSize, nil)
+	s.Equal(ErrNotFound, err)
+}
+
+func (s *fileBasedClientSuite) TestGetValueWithFilters() {
+	v, err := s.client.GetValue(MatchingLongPollExpirationInterval, taskListInfoFilters("testTaskList", 0))
+	s.NoError(err)
+	s.Equal("10s", v)
+
+	v, err = s.client.GetValue(MatchingLongPollExpirationInterval, taskListInfoFilters("testTaskList", 1))
+	s.NoError(err)
+	s.Equal("20s", v)
+
+	v, err = s.client.GetValue(MatchingLongPollExpirationInterval, taskListInfoFilters("otherTaskList", 1))
+	s.NoError(err)
+	s.Equal("1m", v)
+
+	v, err = s.client.GetValue(MatchingEnableSyncMatch, taskListInfoFilters("testTaskList", 0))
+	s.NoError(err)
+	s.Equal(false, v)
+
+	_, err = s.client.GetValue(MatchingEnableSyncMatch, taskListInfoFilters("otherTaskList", 0))
+	s.Equal(ErrNotFound, err)
+}
+
+func (s *fileBasedClientSuite) TestUpdate() {
+	s.writeConfig(`
+frontend.rps:
+  - value: 3000
+`)
+	// make sure the modification time moves past the one of the first load
+	s.NoError(os.Chtimes(s.file.Name(), time.Now(), s.client.lastUpdated.Add(time.Second)))
+	s.NoError(s.client.update())
+
+	v, err := s.client.GetValue(FrontendRPS, nil)
+	s.NoError(err)
+	s.Equal(3000, v)
+	_, err = s.client.GetValue(HistoryCacheTTL, nil)
+	s.Equal(ErrNotFound, err)
+}
+
+func (s *fileBasedClientSuite) TestUpdateInvalidFile() {
+	s.writeConfig("frontend.rps: [")
+	s.NoError(os.Chtimes(s.file.Name(), time.Now(), s.client.lastUpdated.Add(time.Second)))
+	s.Error(s.client.update())
+
+	v, err := s.client.GetValue(FrontendRPS, nil)
+	s.NoError(err)
+	s.Equal(2000, v)
+}
+
+func (s *fileBasedClientSuite) TestValidateConfig() {
+	s.Error(validateConfig(nil))
+	s.Error(validateConfig(&FileBasedClientConfig{PollInterval: time.Minute}))
+	s.Error(validateConfig(&FileBasedClientConfig{Filepath: s.file.Name(), PollInterval: time.Second}))
+	s.NoError(validateConfig(&FileBasedClientConfig{Filepath: s.file.Name(), PollInterval: time.Minute}))
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"errors"
+)
+
+var (
+	// ErrNotFound is returned by a Client when the key has no value in the dynamic config source
+	ErrNotFound = errors.New("unable to find key")
+)
+
+// Client allows fetching values from a dynamic configuration system.  The value of a key can vary with the filters,
+// in which case the client returns the value matching the filters most closely.
+type Client interface {
+	GetValue(name Key, filters map[Filter]interface{}) (interface{}, error)
+}
+
+type nopClient struct{}
+
+// NewNopClient creates a client which has no values, so the default value is used for every key
+func NewNopClient() Client {
+	return &nopClient{}
+}
+
+func (mc *nopClient) GetValue(name Key, filters map[Filter]interface{}) (interface{}, error) {
+	return nil, ErrNotFound
+}
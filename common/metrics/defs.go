@@ -149,6 +149,8 @@ const (
 	PersistenceAppendHistoryEventsScope
 	// PersistenceGetWorkflowExecutionHistoryScope tracks GetWorkflowExecutionHistory calls made by service to persistence layer
 	PersistenceGetWorkflowExecutionHistoryScope
+	// PersistenceGetWorkflowExecutionLastHistoryBatchScope tracks GetWorkflowExecutionLastHistoryBatch calls made by service to persistence layer
+	PersistenceGetWorkflowExecutionLastHistoryBatchScope
 	// PersistenceDeleteWorkflowExecutionHistoryScope tracks DeleteWorkflowExecutionHistory calls made by service to persistence layer
 	PersistenceDeleteWorkflowExecutionHistoryScope
 	// PersistenceCreateDomainScope tracks CreateDomain calls made by service to persistence layer
@@ -175,6 +177,8 @@ const (
 	HistoryClientRespondActivityTaskCanceledScope
 	// HistoryClientGetWorkflowExecutionNextEventIDScope tracks RPC calls to history service
 	HistoryClientGetWorkflowExecutionNextEventIDScope
+	// HistoryClientDescribeWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientDescribeWorkflowExecutionScope
 	// HistoryClientRecordDecisionTaskStartedScope tracks RPC calls to history service
 	HistoryClientRecordDecisionTaskStartedScope
 	// HistoryClientRecordActivityTaskStartedScope tracks RPC calls to history service
@@ -183,12 +187,22 @@ const (
 	HistoryClientRequestCancelWorkflowExecutionScope
 	// HistoryClientSignalWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientSignalWorkflowExecutionScope
+	// HistoryClientSignalWithStartWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientSignalWithStartWorkflowExecutionScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
+	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientResetWorkflowExecutionScope
 	// HistoryClientScheduleDecisionTaskScope tracks RPC calls to history service
 	HistoryClientScheduleDecisionTaskScope
 	// HistoryClientRecordChildExecutionCompletedScope tracks RPC calls to history service
 	HistoryClientRecordChildExecutionCompletedScope
+	// HistoryClientDescribeMutableStateScope tracks RPC calls to history service
+	HistoryClientDescribeMutableStateScope
+	// HistoryClientDescribeHistoryHostScope tracks RPC calls to history service
+	HistoryClientDescribeHistoryHostScope
+	// HistoryClientDescribeShardScope tracks RPC calls to history service
+	HistoryClientDescribeShardScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -197,6 +211,12 @@ const (
 	MatchingClientAddActivityTaskScope
 	// MatchingClientAddDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientAddDecisionTaskScope
+	// MatchingClientQueryWorkflowScope tracks RPC calls to matching service
+	MatchingClientQueryWorkflowScope
+	// MatchingClientRespondQueryTaskCompletedScope tracks RPC calls to matching service
+	MatchingClientRespondQueryTaskCompletedScope
+	// MatchingClientDescribeTaskListScope tracks RPC calls to matching service
+	MatchingClientDescribeTaskListScope
 
 	NumCommonScopes
 )
@@ -223,14 +243,24 @@ const (
 	FrontendGetWorkflowExecutionHistoryScope
 	// FrontendSignalWorkflowExecutionScope is the metric scope for frontend.SignalWorkflowExecution
 	FrontendSignalWorkflowExecutionScope
+	// FrontendSignalWithStartWorkflowExecutionScope is the metric scope for frontend.SignalWithStartWorkflowExecution
+	FrontendSignalWithStartWorkflowExecutionScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
 	FrontendTerminateWorkflowExecutionScope
+	// FrontendResetWorkflowExecutionScope is the metric scope for frontend.ResetWorkflowExecution
+	FrontendResetWorkflowExecutionScope
 	// FrontendRequestCancelWorkflowExecutionScope is the metric scope for frontend.RequestCancelWorkflowExecution
 	FrontendRequestCancelWorkflowExecutionScope
 	// FrontendListOpenWorkflowExecutionsScope is the metric scope for frontend.ListOpenWorkflowExecutions
 	FrontendListOpenWorkflowExecutionsScope
 	// FrontendListClosedWorkflowExecutionsScope is the metric scope for frontend.ListClosedWorkflowExecutions
 	FrontendListClosedWorkflowExecutionsScope
+	// FrontendListWorkflowExecutionsScope is the metric scope for frontend.ListWorkflowExecutions
+	FrontendListWorkflowExecutionsScope
+	// FrontendCountWorkflowExecutionsScope is the metric scope for frontend.CountWorkflowExecutions
+	FrontendCountWorkflowExecutionsScope
+	// FrontendGetSearchAttributesScope is the metric scope for frontend.GetSearchAttributes
+	FrontendGetSearchAttributesScope
 	// FrontendRegisterDomainScope is the metric scope for frontend.RegisterDomain
 	FrontendRegisterDomainScope
 	// FrontendDescribeDomainScope is the metric scope for frontend.DescribeDomain
@@ -239,6 +269,20 @@ const (
 	FrontendUpdateDomainScope
 	// FrontendDeprecateDomainScope is the metric scope for frontend.DeprecateDomain
 	FrontendDeprecateDomainScope
+	// FrontendDescribeWorkflowExecutionScope is the metric scope for frontend.DescribeWorkflowExecution
+	FrontendDescribeWorkflowExecutionScope
+	// FrontendQueryWorkflowScope is the metric scope for frontend.QueryWorkflow
+	FrontendQueryWorkflowScope
+	// FrontendRespondQueryTaskCompletedScope is the metric scope for frontend.RespondQueryTaskCompleted
+	FrontendRespondQueryTaskCompletedScope
+	// FrontendDescribeTaskListScope is the metric scope for frontend.DescribeTaskList
+	FrontendDescribeTaskListScope
+	// AdminDescribeHistoryHostScope is the metric scope for admin.DescribeHistoryHost
+	AdminDescribeHistoryHostScope
+	// AdminDescribeShardScope is the metric scope for admin.DescribeShard
+	AdminDescribeShardScope
+	// AdminDescribeMutableStateScope is the metric scope for admin.DescribeMutableState
+	AdminDescribeMutableStateScope
 
 	NumFrontendScopes
 )
@@ -259,20 +303,32 @@ const (
 	HistoryRespondActivityTaskCanceledScope
 	// HistoryGetWorkflowExecutionNextEventIDScope tracks GetWorkflowExecutionHistory API calls received by service
 	HistoryGetWorkflowExecutionNextEventIDScope
+	// HistoryDescribeWorkflowExecutionScope tracks DescribeWorkflowExecution API calls received by service
+	HistoryDescribeWorkflowExecutionScope
 	// HistoryRecordDecisionTaskStartedScope tracks RecordDecisionTaskStarted API calls received by service
 	HistoryRecordDecisionTaskStartedScope
 	// HistoryRecordActivityTaskStartedScope tracks RecordActivityTaskStarted API calls received by service
 	HistoryRecordActivityTaskStartedScope
 	// HistorySignalWorkflowExecutionScope tracks SignalWorkflowExecution API calls received by service
 	HistorySignalWorkflowExecutionScope
+	// HistorySignalWithStartWorkflowExecutionScope tracks SignalWithStartWorkflowExecution API calls received by service
+	HistorySignalWithStartWorkflowExecutionScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
+	// HistoryResetWorkflowExecutionScope tracks ResetWorkflowExecution API calls received by service
+	HistoryResetWorkflowExecutionScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
 	HistoryScheduleDecisionTaskScope
 	// HistoryRecordChildExecutionCompletedScope tracks CompleteChildExecution API calls received by service
 	HistoryRecordChildExecutionCompletedScope
 	// HistoryRequestCancelWorkflowExecutionScope tracks RequestCancelWorkflowExecution API calls received by service
 	HistoryRequestCancelWorkflowExecutionScope
+	// HistoryDescribeMutableStateScope tracks DescribeMutableState API calls received by service
+	HistoryDescribeMutableStateScope
+	// HistoryDescribeHistoryHostScope tracks DescribeHistoryHost API calls received by service
+	HistoryDescribeHistoryHostScope
+	// HistoryDescribeShardScope tracks DescribeShard API calls received by service
+	HistoryDescribeShardScope
 	// HistoryShardControllerScope is the scope used by shard controller
 	HistoryShardControllerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -287,6 +343,12 @@ const (
 	TransferTaskCancelExecutionScope
 	// TransferTaskStartChildExecutionScope is the scope used for start child execution task processing by transfer queue processor
 	TransferTaskStartChildExecutionScope
+	// TransferTaskSignalExecutionScope is the scope used for signal execution task processing by transfer queue processor
+	TransferTaskSignalExecutionScope
+	// TransferTaskRecordWorkflowStartedScope is the scope used for record workflow started task processing by transfer queue processor
+	TransferTaskRecordWorkflowStartedScope
+	// TransferTaskUpsertSearchAttributesScope is the scope used for upsert search attributes task processing by transfer queue processor
+	TransferTaskUpsertSearchAttributesScope
 	// TimerQueueProcessorScope is the scope used by all metric emitted by timer queue processor
 	TimerQueueProcessorScope
 	// TimerTaskActivityTimeoutScope is the scope used by metric emitted by timer queue processor for processing activity timeouts
@@ -299,6 +361,11 @@ const (
 	TimerTaskWorkflowTimeoutScope
 	// TimerTaskDeleteHistoryEvent is the scope used by metric emitted by timer queue processor for processing history event cleanup
 	TimerTaskDeleteHistoryEvent
+	// TimerTaskActivityRetryTimerScope is the scope used by metric emitted by timer queue processor for dispatching activity retries
+	TimerTaskActivityRetryTimerScope
+	// TimerTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for starting the
+	// first decision of a workflow after its start backoff
+	TimerTaskWorkflowBackoffTimerScope
 
 	NumHistoryScopes
 )
@@ -313,6 +380,12 @@ const (
 	MatchingAddActivityTaskScope
 	// MatchingAddDecisionTaskScope tracks AddDecisionTask API calls received by service
 	MatchingAddDecisionTaskScope
+	// MatchingQueryWorkflowScope tracks QueryWorkflow API calls received by service
+	MatchingQueryWorkflowScope
+	// MatchingRespondQueryTaskCompletedScope tracks RespondQueryTaskCompleted API calls received by service
+	MatchingRespondQueryTaskCompletedScope
+	// MatchingDescribeTaskListScope tracks DescribeTaskList API calls received by service
+	MatchingDescribeTaskListScope
 	// MatchingTaskListMgrScope is the metrics scope for matching.TaskListManager component
 	MatchingTaskListMgrScope
 
@@ -323,109 +396,145 @@ const (
 var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 	// common scope Names
 	Common: {
-		PersistenceCreateShardScope:                    {operation: "CreateShard"},
-		PersistenceGetShardScope:                       {operation: "GetShard"},
-		PersistenceUpdateShardScope:                    {operation: "UpdateShard"},
-		PersistenceCreateWorkflowExecutionScope:        {operation: "CreateWorkflowExecution"},
-		PersistenceGetWorkflowExecutionScope:           {operation: "GetWorkflowExecution"},
-		PersistenceUpdateWorkflowExecutionScope:        {operation: "UpdateWorkflowExecution"},
-		PersistenceDeleteWorkflowExecutionScope:        {operation: "DeleteWorkflowExecution"},
-		PersistenceGetCurrentExecutionScope:            {operation: "GetCurrentExecution"},
-		PersistenceGetTransferTasksScope:               {operation: "GetTransferTasks"},
-		PersistenceCompleteTransferTaskScope:           {operation: "CompleteTransferTask"},
-		PersistenceGetTimerIndexTasksScope:             {operation: "GetTimerIndexTasks"},
-		PersistenceCompleteTimerTaskScope:              {operation: "CompleteTimerTask"},
-		PersistenceCreateTaskScope:                     {operation: "CreateTask", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceGetTasksScope:                       {operation: "GetTasks", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceCompleteTaskScope:                   {operation: "CompleteTask", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceLeaseTaskListScope:                  {operation: "LeaseTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceUpdateTaskListScope:                 {operation: "UpdateTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceAppendHistoryEventsScope:            {operation: "AppendHistoryEvents", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceGetWorkflowExecutionHistoryScope:    {operation: "GetWorkflowExecutionHistory", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceDeleteWorkflowExecutionHistoryScope: {operation: "DeleteWorkflowExecutionHistory", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceCreateDomainScope:                   {operation: "CreateDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceGetDomainScope:                      {operation: "GetDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceUpdateDomainScope:                   {operation: "UpdateDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceDeleteDomainScope:                   {operation: "DeleteDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
-		PersistenceDeleteDomainByNameScope:             {operation: "DeleteDomainByName", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceCreateShardScope:                          {operation: "CreateShard"},
+		PersistenceGetShardScope:                             {operation: "GetShard"},
+		PersistenceUpdateShardScope:                          {operation: "UpdateShard"},
+		PersistenceCreateWorkflowExecutionScope:              {operation: "CreateWorkflowExecution"},
+		PersistenceGetWorkflowExecutionScope:                 {operation: "GetWorkflowExecution"},
+		PersistenceUpdateWorkflowExecutionScope:              {operation: "UpdateWorkflowExecution"},
+		PersistenceDeleteWorkflowExecutionScope:              {operation: "DeleteWorkflowExecution"},
+		PersistenceGetCurrentExecutionScope:                  {operation: "GetCurrentExecution"},
+		PersistenceGetTransferTasksScope:                     {operation: "GetTransferTasks"},
+		PersistenceCompleteTransferTaskScope:                 {operation: "CompleteTransferTask"},
+		PersistenceGetTimerIndexTasksScope:                   {operation: "GetTimerIndexTasks"},
+		PersistenceCompleteTimerTaskScope:                    {operation: "CompleteTimerTask"},
+		PersistenceCreateTaskScope:                           {operation: "CreateTask", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetTasksScope:                             {operation: "GetTasks", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceCompleteTaskScope:                         {operation: "CompleteTask", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceLeaseTaskListScope:                        {operation: "LeaseTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceUpdateTaskListScope:                       {operation: "UpdateTaskList", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceAppendHistoryEventsScope:                  {operation: "AppendHistoryEvents", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetWorkflowExecutionHistoryScope:          {operation: "GetWorkflowExecutionHistory", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetWorkflowExecutionLastHistoryBatchScope: {operation: "GetWorkflowExecutionLastHistoryBatch", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceDeleteWorkflowExecutionHistoryScope:       {operation: "DeleteWorkflowExecutionHistory", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceCreateDomainScope:                         {operation: "CreateDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetDomainScope:                            {operation: "GetDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceUpdateDomainScope:                         {operation: "UpdateDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceDeleteDomainScope:                         {operation: "DeleteDomain", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceDeleteDomainByNameScope:                   {operation: "DeleteDomainByName", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 
-		HistoryClientStartWorkflowExecutionScope:          {operation: "HistoryClientStartWorkflowExecution"},
-		HistoryClientRecordActivityTaskHeartbeatScope:     {operation: "HistoryClientRecordActivityTaskHeartbeat"},
-		HistoryClientRespondDecisionTaskCompletedScope:    {operation: "HistoryClientRespondDecisionTaskCompleted"},
-		HistoryClientRespondActivityTaskCompletedScope:    {operation: "HistoryClientRespondActivityTaskCompleted"},
-		HistoryClientRespondActivityTaskFailedScope:       {operation: "HistoryClientRespondActivityTaskFailed"},
-		HistoryClientRespondActivityTaskCanceledScope:     {operation: "HistoryClientRespondActivityTaskCanceled"},
-		HistoryClientGetWorkflowExecutionNextEventIDScope: {operation: "HistoryClientGetWorkflowExecutionNextEventId"},
-		HistoryClientRecordDecisionTaskStartedScope:       {operation: "HistoryClientRecordDecisionTaskStarted"},
-		HistoryClientRecordActivityTaskStartedScope:       {operation: "HistoryClientRecordActivityTaskStarted"},
-		HistoryClientRequestCancelWorkflowExecutionScope:  {operation: "HistoryClientRequestCancelWorkflowExecution"},
-		HistoryClientSignalWorkflowExecutionScope:         {operation: "HistoryClientSignalWorkflowExecution"},
-		HistoryClientTerminateWorkflowExecutionScope:      {operation: "HistoryClientTerminateWorkflowExecution"},
-		HistoryClientScheduleDecisionTaskScope:            {operation: "HistoryClientScheduleDecisionTask"},
-		HistoryClientRecordChildExecutionCompletedScope:   {operation: "HistoryClientRecordChildExecutionCompleted"},
-		MatchingClientPollForDecisionTaskScope:            {operation: "MatchingClientPollForDecisionTask"},
-		MatchingClientPollForActivityTaskScope:            {operation: "MatchingClientPollForActivityTask"},
-		MatchingClientAddActivityTaskScope:                {operation: "MatchingClientAddActivityTask"},
-		MatchingClientAddDecisionTaskScope:                {operation: "MatchingClientAddDecisionTask"},
+		HistoryClientStartWorkflowExecutionScope:           {operation: "HistoryClientStartWorkflowExecution"},
+		HistoryClientRecordActivityTaskHeartbeatScope:      {operation: "HistoryClientRecordActivityTaskHeartbeat"},
+		HistoryClientRespondDecisionTaskCompletedScope:     {operation: "HistoryClientRespondDecisionTaskCompleted"},
+		HistoryClientRespondActivityTaskCompletedScope:     {operation: "HistoryClientRespondActivityTaskCompleted"},
+		HistoryClientRespondActivityTaskFailedScope:        {operation: "HistoryClientRespondActivityTaskFailed"},
+		HistoryClientRespondActivityTaskCanceledScope:      {operation: "HistoryClientRespondActivityTaskCanceled"},
+		HistoryClientGetWorkflowExecutionNextEventIDScope:  {operation: "HistoryClientGetWorkflowExecutionNextEventId"},
+		HistoryClientDescribeWorkflowExecutionScope:        {operation: "HistoryClientDescribeWorkflowExecution"},
+		HistoryClientRecordDecisionTaskStartedScope:        {operation: "HistoryClientRecordDecisionTaskStarted"},
+		HistoryClientRecordActivityTaskStartedScope:        {operation: "HistoryClientRecordActivityTaskStarted"},
+		HistoryClientRequestCancelWorkflowExecutionScope:   {operation: "HistoryClientRequestCancelWorkflowExecution"},
+		HistoryClientSignalWorkflowExecutionScope:          {operation: "HistoryClientSignalWorkflowExecution"},
+		HistoryClientSignalWithStartWorkflowExecutionScope: {operation: "HistoryClientSignalWithStartWorkflowExecution"},
+		HistoryClientTerminateWorkflowExecutionScope:       {operation: "HistoryClientTerminateWorkflowExecution"},
+		HistoryClientResetWorkflowExecutionScope:           {operation: "HistoryClientResetWorkflowExecution"},
+		HistoryClientScheduleDecisionTaskScope:             {operation: "HistoryClientScheduleDecisionTask"},
+		HistoryClientRecordChildExecutionCompletedScope:    {operation: "HistoryClientRecordChildExecutionCompleted"},
+		HistoryClientDescribeMutableStateScope:             {operation: "HistoryClientDescribeMutableState"},
+		HistoryClientDescribeHistoryHostScope:              {operation: "HistoryClientDescribeHistoryHost"},
+		HistoryClientDescribeShardScope:                    {operation: "HistoryClientDescribeShard"},
+		MatchingClientPollForDecisionTaskScope:             {operation: "MatchingClientPollForDecisionTask"},
+		MatchingClientPollForActivityTaskScope:             {operation: "MatchingClientPollForActivityTask"},
+		MatchingClientAddActivityTaskScope:                 {operation: "MatchingClientAddActivityTask"},
+		MatchingClientAddDecisionTaskScope:                 {operation: "MatchingClientAddDecisionTask"},
+		MatchingClientQueryWorkflowScope:                   {operation: "MatchingClientQueryWorkflow"},
+		MatchingClientRespondQueryTaskCompletedScope:       {operation: "MatchingClientRespondQueryTaskCompleted"},
+		MatchingClientDescribeTaskListScope:                {operation: "MatchingClientDescribeTaskList"},
 	},
 	// Frontend Scope Names
 	Frontend: {
-		FrontendStartWorkflowExecutionScope:         {operation: "StartWorkflowExecution"},
-		FrontendPollForDecisionTaskScope:            {operation: "PollForDecisionTask"},
-		FrontendPollForActivityTaskScope:            {operation: "PollForActivityTask"},
-		FrontendRecordActivityTaskHeartbeatScope:    {operation: "RecordActivityTaskHeartbeat"},
-		FrontendRespondDecisionTaskCompletedScope:   {operation: "RespondDecisionTaskCompleted"},
-		FrontendRespondActivityTaskCompletedScope:   {operation: "RespondActivityTaskCompleted"},
-		FrontendRespondActivityTaskFailedScope:      {operation: "RespondActivityTaskFailed"},
-		FrontendRespondActivityTaskCanceledScope:    {operation: "RespondActivityTaskCanceled"},
-		FrontendGetWorkflowExecutionHistoryScope:    {operation: "GetWorkflowExecutionHistory"},
-		FrontendSignalWorkflowExecutionScope:        {operation: "SignalWorkflowExecution"},
-		FrontendTerminateWorkflowExecutionScope:     {operation: "TerminateWorkflowExecution"},
-		FrontendRequestCancelWorkflowExecutionScope: {operation: "RequestCancelWorkflowExecution"},
-		FrontendListOpenWorkflowExecutionsScope:     {operation: "ListOpenWorkflowExecutions"},
-		FrontendListClosedWorkflowExecutionsScope:   {operation: "ListClosedWorkflowExecutions"},
-		FrontendRegisterDomainScope:                 {operation: "RegisterDomain"},
-		FrontendDescribeDomainScope:                 {operation: "DescribeDomain"},
-		FrontendUpdateDomainScope:                   {operation: "UpdateDomain"},
-		FrontendDeprecateDomainScope:                {operation: "DeprecateDomain"},
+		FrontendStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
+		FrontendPollForDecisionTaskScope:              {operation: "PollForDecisionTask"},
+		FrontendPollForActivityTaskScope:              {operation: "PollForActivityTask"},
+		FrontendRecordActivityTaskHeartbeatScope:      {operation: "RecordActivityTaskHeartbeat"},
+		FrontendRespondDecisionTaskCompletedScope:     {operation: "RespondDecisionTaskCompleted"},
+		FrontendRespondActivityTaskCompletedScope:     {operation: "RespondActivityTaskCompleted"},
+		FrontendRespondActivityTaskFailedScope:        {operation: "RespondActivityTaskFailed"},
+		FrontendRespondActivityTaskCanceledScope:      {operation: "RespondActivityTaskCanceled"},
+		FrontendGetWorkflowExecutionHistoryScope:      {operation: "GetWorkflowExecutionHistory"},
+		FrontendSignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
+		FrontendSignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
+		FrontendTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
+		FrontendResetWorkflowExecutionScope:           {operation: "ResetWorkflowExecution"},
+		FrontendRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
+		FrontendListOpenWorkflowExecutionsScope:       {operation: "ListOpenWorkflowExecutions"},
+		FrontendListClosedWorkflowExecutionsScope:     {operation: "ListClosedWorkflowExecutions"},
+		FrontendListWorkflowExecutionsScope:           {operation: "ListWorkflowExecutions"},
+		FrontendCountWorkflowExecutionsScope:          {operation: "CountWorkflowExecutions"},
+		FrontendGetSearchAttributesScope:              {operation: "GetSearchAttributes"},
+		FrontendRegisterDomainScope:                   {operation: "RegisterDomain"},
+		FrontendDescribeDomainScope:                   {operation: "DescribeDomain"},
+		FrontendUpdateDomainScope:                     {operation: "UpdateDomain"},
+		FrontendDeprecateDomainScope:                  {operation: "DeprecateDomain"},
+		FrontendDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
+		FrontendQueryWorkflowScope:                    {operation: "QueryWorkflow"},
+		FrontendRespondQueryTaskCompletedScope:        {operation: "RespondQueryTaskCompleted"},
+		FrontendDescribeTaskListScope:                 {operation: "DescribeTaskList"},
+		AdminDescribeHistoryHostScope:                 {operation: "DescribeHistoryHost"},
+		AdminDescribeShardScope:                       {operation: "DescribeShard"},
+		AdminDescribeMutableStateScope:                {operation: "DescribeMutableState"},
 	},
 	// History Scope Names
 	History: {
-		HistoryStartWorkflowExecutionScope:          {operation: "StartWorkflowExecution"},
-		HistoryRecordActivityTaskHeartbeatScope:     {operation: "RecordActivityTaskHeartbeat"},
-		HistoryRespondDecisionTaskCompletedScope:    {operation: "RespondDecisionTaskCompleted"},
-		HistoryRespondActivityTaskCompletedScope:    {operation: "RespondActivityTaskCompleted"},
-		HistoryRespondActivityTaskFailedScope:       {operation: "RespondActivityTaskFailed"},
-		HistoryRespondActivityTaskCanceledScope:     {operation: "RespondActivityTaskCanceled"},
-		HistoryGetWorkflowExecutionNextEventIDScope: {operation: "GetWorkflowExecutionNextEventIDScope"},
-		HistoryRecordDecisionTaskStartedScope:       {operation: "RecordDecisionTaskStarted"},
-		HistoryRecordActivityTaskStartedScope:       {operation: "RecordActivityTaskStarted"},
-		HistorySignalWorkflowExecutionScope:         {operation: "SignalWorkflowExecution"},
-		HistoryTerminateWorkflowExecutionScope:      {operation: "TerminateWorkflowExecution"},
-		HistoryScheduleDecisionTaskScope:            {operation: "ScheduleDecisionTask"},
-		HistoryRecordChildExecutionCompletedScope:   {operation: "RecordChildExecutionCompleted"},
-		HistoryRequestCancelWorkflowExecutionScope:  {operation: "RequestCancelWorkflowExecution"},
-		HistoryShardControllerScope:                 {operation: "ShardController"},
-		TransferQueueProcessorScope:                 {operation: "TransferQueueProcessor"},
-		TransferTaskActivityScope:                   {operation: "TransferTaskActivity"},
-		TransferTaskDecisionScope:                   {operation: "TransferTaskDecision"},
-		TransferTaskDeleteExecutionScope:            {operation: "TransferTaskDeleteExecution"},
-		TransferTaskCancelExecutionScope:            {operation: "TransferTaskCancelExecution"},
-		TransferTaskStartChildExecutionScope:        {operation: "TransferTaskStartChildExecution"},
-		TimerQueueProcessorScope:                    {operation: "TimerQueueProcessor"},
-		TimerTaskActivityTimeoutScope:               {operation: "TimerTaskActivityTimeout"},
-		TimerTaskDecisionTimeoutScope:               {operation: "TimerTaskDecisionTimeout"},
-		TimerTaskUserTimerScope:                     {operation: "TimerTaskUserTimer"},
-		TimerTaskWorkflowTimeoutScope:               {operation: "TimerTaskWorkflowTimeout"},
-		TimerTaskDeleteHistoryEvent:                 {operation: "TimerTaskDeleteHistoryEvent"},
+		HistoryStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
+		HistoryRecordActivityTaskHeartbeatScope:      {operation: "RecordActivityTaskHeartbeat"},
+		HistoryRespondDecisionTaskCompletedScope:     {operation: "RespondDecisionTaskCompleted"},
+		HistoryRespondActivityTaskCompletedScope:     {operation: "RespondActivityTaskCompleted"},
+		HistoryRespondActivityTaskFailedScope:        {operation: "RespondActivityTaskFailed"},
+		HistoryRespondActivityTaskCanceledScope:      {operation: "RespondActivityTaskCanceled"},
+		HistoryGetWorkflowExecutionNextEventIDScope:  {operation: "GetWorkflowExecutionNextEventIDScope"},
+		HistoryDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
+		HistoryRecordDecisionTaskStartedScope:        {operation: "RecordDecisionTaskStarted"},
+		HistoryRecordActivityTaskStartedScope:        {operation: "RecordActivityTaskStarted"},
+		HistorySignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
+		HistorySignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
+		HistoryTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
+		HistoryResetWorkflowExecutionScope:           {operation: "ResetWorkflowExecution"},
+		HistoryScheduleDecisionTaskScope:             {operation: "ScheduleDecisionTask"},
+		HistoryRecordChildExecutionCompletedScope:    {operation: "RecordChildExecutionCompleted"},
+		HistoryRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
+		HistoryDescribeMutableStateScope:             {operation: "DescribeMutableState"},
+		HistoryDescribeHistoryHostScope:              {operation: "DescribeHistoryHost"},
+		HistoryDescribeShardScope:                    {operation: "DescribeShard"},
+		HistoryShardControllerScope:                  {operation: "ShardController"},
+		TransferQueueProcessorScope:                  {operation: "TransferQueueProcessor"},
+		TransferTaskActivityScope:                    {operation: "TransferTaskActivity"},
+		TransferTaskDecisionScope:                    {operation: "TransferTaskDecision"},
+		TransferTaskDeleteExecutionScope:             {operation: "TransferTaskDeleteExecution"},
+		TransferTaskCancelExecutionScope:             {operation: "TransferTaskCancelExecution"},
+		TransferTaskStartChildExecutionScope:         {operation: "TransferTaskStartChildExecution"},
+		TransferTaskSignalExecutionScope:             {operation: "TransferTaskSignalExecution"},
+		TransferTaskRecordWorkflowStartedScope:       {operation: "TransferTaskRecordWorkflowStarted"},
+		TransferTaskUpsertSearchAttributesScope:      {operation: "TransferTaskUpsertSearchAttributes"},
+		TimerQueueProcessorScope:                     {operation: "TimerQueueProcessor"},
+		TimerTaskActivityTimeoutScope:                {operation: "TimerTaskActivityTimeout"},
+		TimerTaskDecisionTimeoutScope:                {operation: "TimerTaskDecisionTimeout"},
+		TimerTaskUserTimerScope:                      {operation: "TimerTaskUserTimer"},
+		TimerTaskWorkflowTimeoutScope:                {operation: "TimerTaskWorkflowTimeout"},
+		TimerTaskDeleteHistoryEvent:                  {operation: "TimerTaskDeleteHistoryEvent"},
+		TimerTaskActivityRetryTimerScope:             {operation: "TimerTaskActivityRetryTimer"},
+		TimerTaskWorkflowBackoffTimerScope:           {operation: "TimerTaskWorkflowBackoffTimer"},
 	},
 	// Matching Scope Names
 	Matching: {
-		MatchingPollForDecisionTaskScope: {operation: "PollForDecisionTask"},
-		MatchingPollForActivityTaskScope: {operation: "PollForActivityTask"},
-		MatchingAddActivityTaskScope:     {operation: "AddActivityTask"},
-		MatchingAddDecisionTaskScope:     {operation: "AddDecisionTask"},
-		MatchingTaskListMgrScope:         {operation: "TaskListMgr"},
+		MatchingPollForDecisionTaskScope:       {operation: "PollForDecisionTask"},
+		MatchingPollForActivityTaskScope:       {operation: "PollForActivityTask"},
+		MatchingAddActivityTaskScope:           {operation: "AddActivityTask"},
+		MatchingAddDecisionTaskScope:           {operation: "AddDecisionTask"},
+		MatchingTaskListMgrScope:               {operation: "TaskListMgr"},
+		MatchingQueryWorkflowScope:             {operation: "QueryWorkflow"},
+		MatchingRespondQueryTaskCompletedScope: {operation: "RespondQueryTaskCompleted"},
+		MatchingDescribeTaskListScope:          {operation: "DescribeTaskList"},
 	},
 }
 
@@ -440,6 +549,7 @@ const (
 	CadenceErrExecutionAlreadyStartedCounter
 	CadenceErrDomainAlreadyExistsCounter
 	CadenceErrCancellationAlreadyRequestedCounter
+	CadenceErrQueryFailedCounter
 	PersistenceRequests
 	PersistenceFailures
 	PersistenceLatency
@@ -470,6 +580,8 @@ const (
 	DecisionTypeCancelExternalWorkflowCounter
 	DecisionTypeChildWorkflowCounter
 	DecisionTypeContinueAsNewCounter
+	DecisionTypeSignalExternalWorkflowCounter
+	DecisionTypeUpsertSearchAttributesCounter
 	MultipleCompletionDecisionsCounter
 	FailedDecisionsCounter
 	StaleMutableStateCounter
@@ -503,6 +615,8 @@ const (
 	LeaseRequestCounter
 	LeaseFailureCounter
 	ConditionFailedErrorCounter
+	ForwardedTaskCounter
+	PollThrottledCounter
 )
 
 // MetricDefs record the metrics for all services
@@ -517,6 +631,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CadenceErrExecutionAlreadyStartedCounter:      {metricName: "cadence.errors.execution-already-started", metricType: Counter},
 		CadenceErrDomainAlreadyExistsCounter:          {metricName: "cadence.errors.domain-already-exists", metricType: Counter},
 		CadenceErrCancellationAlreadyRequestedCounter: {metricName: "cadence.errors.cancellation-already-requested", metricType: Counter},
+		CadenceErrQueryFailedCounter:                  {metricName: "cadence.errors.query-failed", metricType: Counter},
 		PersistenceRequests:                           {metricName: "persistence.requests", metricType: Counter},
 		PersistenceFailures:                           {metricName: "persistence.errors", metricType: Counter},
 		PersistenceLatency:                            {metricName: "persistence.latency", metricType: Timer},
@@ -544,6 +659,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DecisionTypeCancelExternalWorkflowCounter: {metricName: "cancel-external-workflow-decision", metricType: Counter},
 		DecisionTypeContinueAsNewCounter:          {metricName: "continue-as-new-decision", metricType: Counter},
 		DecisionTypeChildWorkflowCounter:          {metricName: "child-workflow-decision", metricType: Counter},
+		DecisionTypeSignalExternalWorkflowCounter: {metricName: "signal-external-workflow-decision", metricType: Counter},
+		DecisionTypeUpsertSearchAttributesCounter: {metricName: "upsert-search-attributes-decision", metricType: Counter},
 		MultipleCompletionDecisionsCounter:        {metricName: "multiple-completion-decisions", metricType: Counter},
 		FailedDecisionsCounter:                    {metricName: "failed-decisions", metricType: Counter},
 		StaleMutableStateCounter:                  {metricName: "stale-mutable-state", metricType: Counter},
@@ -575,6 +692,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		LeaseRequestCounter:         {metricName: "lease.requests"},
 		LeaseFailureCounter:         {metricName: "lease.failures"},
 		ConditionFailedErrorCounter: {metricName: "condition-failed-errors"},
+		ForwardedTaskCounter:        {metricName: "tasks.forwarded"},
+		PollThrottledCounter:        {metricName: "poll.throttled"},
 	},
 }
 
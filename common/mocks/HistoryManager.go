@@ -85,4 +85,28 @@ func (_m *HistoryManager) GetWorkflowExecutionHistory(
 	return r0, r1
 }
 
+// GetWorkflowExecutionLastHistoryBatch provides a mock function with given fields: request
+func (_m *HistoryManager) GetWorkflowExecutionLastHistoryBatch(
+	request *persistence.GetWorkflowExecutionLastHistoryBatchRequest) (*persistence.GetWorkflowExecutionLastHistoryBatchResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.GetWorkflowExecutionLastHistoryBatchResponse
+	if rf, ok := ret.Get(0).(func(*persistence.GetWorkflowExecutionLastHistoryBatchRequest) *persistence.GetWorkflowExecutionLastHistoryBatchResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetWorkflowExecutionLastHistoryBatchResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.GetWorkflowExecutionLastHistoryBatchRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 var _ persistence.HistoryManager = (*HistoryManager)(nil)
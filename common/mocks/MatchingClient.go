@@ -108,3 +108,63 @@ func (_m *MatchingClient) PollForDecisionTask(ctx context.Context,
 
 	return r0, r1
 }
+
+// QueryWorkflow provides a mock function with given fields: ctx, queryRequest
+func (_m *MatchingClient) QueryWorkflow(ctx context.Context, queryRequest *matching.QueryWorkflowRequest, opts ...yarpc.CallOption) (*shared.QueryWorkflowResponse, error) {
+	ret := _m.Called(ctx, queryRequest)
+
+	var r0 *shared.QueryWorkflowResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *matching.QueryWorkflowRequest) *shared.QueryWorkflowResponse); ok {
+		r0 = rf(ctx, queryRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.QueryWorkflowResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *matching.QueryWorkflowRequest) error); ok {
+		r1 = rf(ctx, queryRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RespondQueryTaskCompleted provides a mock function with given fields: ctx, request
+func (_m *MatchingClient) RespondQueryTaskCompleted(ctx context.Context, request *matching.RespondQueryTaskCompletedRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *matching.RespondQueryTaskCompletedRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTaskList provides a mock function with given fields: ctx, request
+func (_m *MatchingClient) DescribeTaskList(ctx context.Context, request *matching.DescribeTaskListRequest, opts ...yarpc.CallOption) (*shared.DescribeTaskListResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.DescribeTaskListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *matching.DescribeTaskListRequest) *shared.DescribeTaskListResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeTaskListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *matching.DescribeTaskListRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
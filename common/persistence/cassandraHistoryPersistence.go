@@ -44,8 +44,17 @@ const (
 		`WHERE domain_id = ? ` +
 		`AND workflow_id = ? ` +
 		`AND run_id = ? ` +
+		`AND first_event_id >= ? ` +
 		`AND first_event_id < ?`
 
+	templateGetWorkflowExecutionLastHistoryBatch = `SELECT data, data_encoding, data_version FROM events ` +
+		`WHERE domain_id = ? ` +
+		`AND workflow_id = ? ` +
+		`AND run_id = ? ` +
+		`AND first_event_id < ? ` +
+		`ORDER BY first_event_id DESC ` +
+		`LIMIT 1`
+
 	templateDeleteWorkflowExecutionHistory = `DELETE FROM events ` +
 		`WHERE domain_id = ? ` +
 		`AND workflow_id = ? ` +
@@ -145,6 +154,7 @@ func (h *cassandraHistoryPersistence) GetWorkflowExecutionHistory(request *GetWo
 		request.DomainID,
 		*execution.WorkflowId,
 		*execution.RunId,
+		request.FirstEventID,
 		request.NextEventID)
 
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
@@ -183,6 +193,32 @@ func (h *cassandraHistoryPersistence) GetWorkflowExecutionHistory(request *GetWo
 	return response, nil
 }
 
+func (h *cassandraHistoryPersistence) GetWorkflowExecutionLastHistoryBatch(
+	request *GetWorkflowExecutionLastHistoryBatchRequest) (*GetWorkflowExecutionLastHistoryBatchResponse, error) {
+	execution := request.Execution
+	query := h.session.Query(templateGetWorkflowExecutionLastHistoryBatch,
+		request.DomainID,
+		*execution.WorkflowId,
+		*execution.RunId,
+		request.NextEventID)
+
+	response := &GetWorkflowExecutionLastHistoryBatchResponse{}
+	history := &response.Events
+	if err := query.Scan(&history.Data, &history.EncodingType, &history.Version); err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Workflow execution history not found.  WorkflowId: %v, RunId: %v",
+					*execution.WorkflowId, *execution.RunId),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetWorkflowExecutionLastHistoryBatch operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
 func (h *cassandraHistoryPersistence) DeleteWorkflowExecutionHistory(
 	request *DeleteWorkflowExecutionHistoryRequest) error {
 	execution := request.Execution
@@ -113,6 +113,77 @@ func (s *historyPersistenceSuite) TestGetHistoryEvents() {
 	s.Equal(events, history[0].Data)
 }
 
+func (s *historyPersistenceSuite) TestGetHistoryEventsFromFirstEventID() {
+	domainID := "5e3ad8a9-6c2e-4c6b-8ac6-1c4f7a7a7ef5"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("get-history-events-from-first-event-id-test"),
+		RunId:      common.StringPtr("0c4e5d61-1a6f-4a59-9b7e-7b5b5b9b2a43"),
+	}
+
+	events := []*SerializedHistoryEventBatch{
+		NewSerializedHistoryEventBatch([]byte("event1;event2"), common.EncodingTypeJSON, 1),
+		NewSerializedHistoryEventBatch([]byte("event3"), common.EncodingTypeJSON, 1),
+		NewSerializedHistoryEventBatch([]byte("event4;event5"), common.EncodingTypeJSON, 1),
+	}
+	s.Nil(s.AppendHistoryEvents(domainID, workflowExecution, 1, 1, 1, events[0], false))
+	s.Nil(s.AppendHistoryEvents(domainID, workflowExecution, 3, 1, 1, events[1], false))
+	s.Nil(s.AppendHistoryEvents(domainID, workflowExecution, 4, 1, 1, events[2], false))
+
+	response, err := s.HistoryMgr.GetWorkflowExecutionHistory(&GetWorkflowExecutionHistoryRequest{
+		DomainID:     domainID,
+		Execution:    workflowExecution,
+		FirstEventID: 3,
+		NextEventID:  6,
+		PageSize:     10,
+	})
+	s.Nil(err)
+	s.Equal(2, len(response.Events))
+	s.Equal(events[1].Data, response.Events[0].Data)
+	s.Equal(events[2].Data, response.Events[1].Data)
+}
+
+func (s *historyPersistenceSuite) TestGetLastHistoryBatch() {
+	domainID := "8f5b3f3c-2d5e-4b7e-9f0a-3c1d2b4a5e6f"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("get-last-history-batch-test"),
+		RunId:      common.StringPtr("6b1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e"),
+	}
+
+	_, err0 := s.HistoryMgr.GetWorkflowExecutionLastHistoryBatch(&GetWorkflowExecutionLastHistoryBatchRequest{
+		DomainID:    domainID,
+		Execution:   workflowExecution,
+		NextEventID: 6,
+	})
+	s.IsType(&gen.EntityNotExistsError{}, err0)
+
+	events := []*SerializedHistoryEventBatch{
+		NewSerializedHistoryEventBatch([]byte("event1;event2"), common.EncodingTypeJSON, 1),
+		NewSerializedHistoryEventBatch([]byte("event3"), common.EncodingTypeJSON, 1),
+		NewSerializedHistoryEventBatch([]byte("event4;event5"), common.EncodingTypeJSON, 1),
+	}
+	s.Nil(s.AppendHistoryEvents(domainID, workflowExecution, 1, 1, 1, events[0], false))
+	s.Nil(s.AppendHistoryEvents(domainID, workflowExecution, 3, 1, 1, events[1], false))
+	s.Nil(s.AppendHistoryEvents(domainID, workflowExecution, 4, 1, 1, events[2], false))
+
+	response, err1 := s.HistoryMgr.GetWorkflowExecutionLastHistoryBatch(&GetWorkflowExecutionLastHistoryBatchRequest{
+		DomainID:    domainID,
+		Execution:   workflowExecution,
+		NextEventID: 6,
+	})
+	s.Nil(err1)
+	s.Equal(events[2].Data, response.Events.Data)
+	s.Equal(common.EncodingTypeJSON, response.Events.EncodingType)
+
+	// Batches at or beyond nextEventID are not part of the history yet
+	response, err2 := s.HistoryMgr.GetWorkflowExecutionLastHistoryBatch(&GetWorkflowExecutionLastHistoryBatchRequest{
+		DomainID:    domainID,
+		Execution:   workflowExecution,
+		NextEventID: 4,
+	})
+	s.Nil(err2)
+	s.Equal(events[1].Data, response.Events.Data)
+}
+
 func (s *historyPersistenceSuite) TestDeleteHistoryEvents() {
 	domainID := "373de9d6-e41e-42d4-bee9-9e06968e4d0d"
 	workflowExecution := gen.WorkflowExecution{
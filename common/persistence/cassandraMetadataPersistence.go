@@ -42,7 +42,11 @@ const (
 
 	templateDomainConfigType = `{` +
 		`retention: ?, ` +
-		`emit_metric: ?` +
+		`emit_metric: ?, ` +
+		`start_workflow_rps: ?, ` +
+		`signal_workflow_rps: ?, ` +
+		`poll_rps: ?, ` +
+		`history_read_rps: ?` +
 		`}`
 
 	templateCreateDomainQuery = `INSERT INTO domains (` +
@@ -54,12 +58,14 @@ const (
 		`VALUES(?, ` + templateDomainType + `, ` + templateDomainConfigType + `) IF NOT EXISTS`
 
 	templateGetDomainQuery = `SELECT domain.id, domain.name, domain.status, domain.description, domain.owner_email, ` +
-		`config.retention, config.emit_metric ` +
+		`config.retention, config.emit_metric, config.start_workflow_rps, config.signal_workflow_rps, config.poll_rps, ` +
+		`config.history_read_rps ` +
 		`FROM domains ` +
 		`WHERE id = ?`
 
 	templateGetDomainByNameQuery = `SELECT domain.id, domain.name, domain.status, domain.description, ` +
-		`domain.owner_email, config.retention, config.emit_metric ` +
+		`domain.owner_email, config.retention, config.emit_metric, config.start_workflow_rps, ` +
+		`config.signal_workflow_rps, config.poll_rps, config.history_read_rps ` +
 		`FROM domains_by_name ` +
 		`WHERE name = ?`
 
@@ -126,7 +132,11 @@ func (m *cassandraMetadataPersistence) CreateDomain(request *CreateDomainRequest
 		request.Description,
 		request.OwnerEmail,
 		request.Retention,
-		request.EmitMetric).Exec(); err != nil {
+		request.EmitMetric,
+		0,
+		0,
+		0,
+		0).Exec(); err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("CreateDomain operation failed. Inserting into domains table. Error: %v", err),
 		}
@@ -140,7 +150,11 @@ func (m *cassandraMetadataPersistence) CreateDomain(request *CreateDomainRequest
 		request.Description,
 		request.OwnerEmail,
 		request.Retention,
-		request.EmitMetric)
+		request.EmitMetric,
+		0,
+		0,
+		0,
+		0)
 
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
@@ -192,7 +206,11 @@ func (m *cassandraMetadataPersistence) GetDomain(request *GetDomainRequest) (*Ge
 			&info.Description,
 			&info.OwnerEmail,
 			&config.Retention,
-			&config.EmitMetric)
+			&config.EmitMetric,
+			&config.StartWorkflowRPS,
+			&config.SignalWorkflowRPS,
+			&config.PollRPS,
+			&config.HistoryReadRPS)
 	} else if len(request.Name) > 0 {
 		query = m.session.Query(templateGetDomainByNameQuery,
 			request.Name)
@@ -203,7 +221,11 @@ func (m *cassandraMetadataPersistence) GetDomain(request *GetDomainRequest) (*Ge
 			&info.Description,
 			&info.OwnerEmail,
 			&config.Retention,
-			&config.EmitMetric)
+			&config.EmitMetric,
+			&config.StartWorkflowRPS,
+			&config.SignalWorkflowRPS,
+			&config.PollRPS,
+			&config.HistoryReadRPS)
 	} else {
 		return nil, &workflow.BadRequestError{
 			Message: "GetDomain operation failed.  Both ID and Name are empty.",
@@ -246,6 +268,10 @@ func (m *cassandraMetadataPersistence) UpdateDomain(request *UpdateDomainRequest
 		request.Info.OwnerEmail,
 		request.Config.Retention,
 		request.Config.EmitMetric,
+		request.Config.StartWorkflowRPS,
+		request.Config.SignalWorkflowRPS,
+		request.Config.PollRPS,
+		request.Config.HistoryReadRPS,
 		request.Info.ID)
 
 	batch.Query(templateUpdateDomainByNameQuery,
@@ -256,6 +282,10 @@ func (m *cassandraMetadataPersistence) UpdateDomain(request *UpdateDomainRequest
 		request.Info.OwnerEmail,
 		request.Config.Retention,
 		request.Config.EmitMetric,
+		request.Config.StartWorkflowRPS,
+		request.Config.SignalWorkflowRPS,
+		request.Config.PollRPS,
+		request.Config.HistoryReadRPS,
 		request.Info.Name)
 
 	if err := m.session.ExecuteBatch(batch); err != nil {
@@ -145,6 +145,10 @@ func (m *metadataPersistenceSuite) TestGetDomain() {
 	m.Equal(owner, resp2.Info.OwnerEmail)
 	m.Equal(retention, resp2.Config.Retention)
 	m.Equal(emitMetric, resp2.Config.EmitMetric)
+	m.Equal(int32(0), resp2.Config.StartWorkflowRPS)
+	m.Equal(int32(0), resp2.Config.SignalWorkflowRPS)
+	m.Equal(int32(0), resp2.Config.PollRPS)
+	m.Equal(int32(0), resp2.Config.HistoryReadRPS)
 
 	resp3, err3 := m.GetDomain("", name)
 	m.Nil(err3)
@@ -194,6 +198,10 @@ func (m *metadataPersistenceSuite) TestUpdateDomain() {
 	updatedOwner := "owner-updated"
 	updatedRetention := int32(20)
 	updatedEmitMetric := false
+	updatedStartWorkflowRPS := int32(10)
+	updatedSignalWorkflowRPS := int32(20)
+	updatedPollRPS := int32(30)
+	updatedHistoryReadRPS := int32(40)
 
 	err3 := m.UpdateDomain(
 		&DomainInfo{
@@ -204,8 +212,12 @@ func (m *metadataPersistenceSuite) TestUpdateDomain() {
 			OwnerEmail:  updatedOwner,
 		},
 		&DomainConfig{
-			Retention:  updatedRetention,
-			EmitMetric: updatedEmitMetric,
+			Retention:         updatedRetention,
+			EmitMetric:        updatedEmitMetric,
+			StartWorkflowRPS:  updatedStartWorkflowRPS,
+			SignalWorkflowRPS: updatedSignalWorkflowRPS,
+			PollRPS:           updatedPollRPS,
+			HistoryReadRPS:    updatedHistoryReadRPS,
 		})
 
 	m.Nil(err3)
@@ -220,6 +232,10 @@ func (m *metadataPersistenceSuite) TestUpdateDomain() {
 	m.Equal(updatedOwner, resp4.Info.OwnerEmail)
 	m.Equal(updatedRetention, resp4.Config.Retention)
 	m.Equal(updatedEmitMetric, resp4.Config.EmitMetric)
+	m.Equal(updatedStartWorkflowRPS, resp4.Config.StartWorkflowRPS)
+	m.Equal(updatedSignalWorkflowRPS, resp4.Config.SignalWorkflowRPS)
+	m.Equal(updatedPollRPS, resp4.Config.PollRPS)
+	m.Equal(updatedHistoryReadRPS, resp4.Config.HistoryReadRPS)
 
 	resp5, err5 := m.GetDomain("", name)
 	m.Nil(err5)
@@ -231,6 +247,10 @@ func (m *metadataPersistenceSuite) TestUpdateDomain() {
 	m.Equal(updatedOwner, resp5.Info.OwnerEmail)
 	m.Equal(updatedRetention, resp5.Config.Retention)
 	m.Equal(updatedEmitMetric, resp5.Config.EmitMetric)
+	m.Equal(updatedStartWorkflowRPS, resp5.Config.StartWorkflowRPS)
+	m.Equal(updatedSignalWorkflowRPS, resp5.Config.SignalWorkflowRPS)
+	m.Equal(updatedPollRPS, resp5.Config.PollRPS)
+	m.Equal(updatedHistoryReadRPS, resp5.Config.HistoryReadRPS)
 }
 
 func (m *metadataPersistenceSuite) TestDeleteDomain() {
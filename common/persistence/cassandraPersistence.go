@@ -124,7 +124,13 @@ const (
 		`decision_request_id: ?, ` +
 		`decision_timeout: ?, ` +
 		`cancel_requested: ?, ` +
-		`cancel_request_id: ?` +
+		`cancel_request_id: ?, ` +
+		`cron_schedule: ?, ` +
+		`attempt: ?, ` +
+		`has_retry_policy: ?, ` +
+		`sticky_task_list: ?, ` +
+		`sticky_schedule_to_start_timeout: ?, ` +
+		`search_attributes: ?` +
 		`}`
 
 	templateTransferTaskType = `{` +
@@ -148,7 +154,8 @@ const (
 		`task_id: ?, ` +
 		`type: ?, ` +
 		`timeout_type: ?, ` +
-		`event_id: ?` +
+		`event_id: ?, ` +
+		`schedule_attempt: ?` +
 		`}`
 
 	templateActivityInfoType = `{` +
@@ -168,7 +175,8 @@ const (
 		`cancel_requested: ?, ` +
 		`cancel_request_id: ?, ` +
 		`last_hb_updated_time: ?, ` +
-		`timer_task_status: ?` +
+		`timer_task_status: ?, ` +
+		`attempt: ?` +
 		`}`
 
 	templateTimerInfoType = `{` +
@@ -191,6 +199,14 @@ const (
 		`cancel_request_id: ?` +
 		`}`
 
+	templateSignalInfoType = `{` +
+		`initiated_id: ?, ` +
+		`signal_request_id: ?, ` +
+		`signal_name: ?, ` +
+		`input: ?, ` +
+		`control: ?` +
+		`}`
+
 	templateTaskListType = `{` +
 		`domain_id: ?, ` +
 		`name: ?, ` +
@@ -231,7 +247,7 @@ const (
 		`IF range_id = ?`
 
 	templateUpdateCurrentWorkflowExecutionQuery = `UPDATE executions ` +
-		`SET current_run_id = ?, execution = {run_id: ?, create_request_id: ?}` +
+		`SET current_run_id = ?, execution = {run_id: ?, create_request_id: ?, state: ?, close_status: ?}` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
 		`and domain_id = ? ` +
@@ -240,9 +256,12 @@ const (
 		`and visibility_ts = ? ` +
 		`and task_id = ? `
 
+	templateUpdateCurrentWorkflowExecutionForNewQuery = templateUpdateCurrentWorkflowExecutionQuery +
+		`IF current_run_id = ?`
+
 	templateCreateWorkflowExecutionQuery = `INSERT INTO executions (` +
 		`shard_id, type, domain_id, workflow_id, run_id, visibility_ts, task_id, current_run_id, execution) ` +
-		`VALUES(?, ?, ?, ?, ?, ?, ?, ?, {run_id: ?, create_request_id: ?}) IF NOT EXISTS`
+		`VALUES(?, ?, ?, ?, ?, ?, ?, ?, {run_id: ?, create_request_id: ?, state: ?, close_status: ?}) IF NOT EXISTS`
 
 	templateCreateWorkflowExecutionQuery2 = `INSERT INTO executions (` +
 		`shard_id, domain_id, workflow_id, run_id, type, execution, next_event_id, visibility_ts, task_id) ` +
@@ -267,7 +286,8 @@ const (
 		`and task_id = ? ` +
 		`IF range_id = ?`
 
-	templateGetWorkflowExecutionQuery = `SELECT execution, activity_map, timer_map, child_executions_map, request_cancel_map ` +
+	templateGetWorkflowExecutionQuery = `SELECT execution, activity_map, timer_map, child_executions_map, ` +
+		`request_cancel_map, signal_map ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -277,7 +297,7 @@ const (
 		`and visibility_ts = ? ` +
 		`and task_id = ?`
 
-	templateGetCurrentExecutionQuery = `SELECT current_run_id ` +
+	templateGetCurrentExecutionQuery = `SELECT current_run_id, execution ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -342,6 +362,17 @@ const (
 		`and task_id = ? ` +
 		`IF next_event_id = ?`
 
+	templateUpdateSignalInfoQuery = `UPDATE executions ` +
+		`SET signal_map[ ? ] =` + templateSignalInfoType + ` ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? ` +
+		`IF next_event_id = ?`
+
 	templateDeleteActivityInfoQuery = `DELETE activity_map[ ? ] ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -386,14 +417,16 @@ const (
 		`and task_id = ? ` +
 		`IF next_event_id = ?`
 
-	templateDeleteWorkflowExecutionQuery = `DELETE FROM executions ` +
+	templateDeleteSignalInfoQuery = `DELETE signal_map[ ? ] ` +
+		`FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
 		`and domain_id = ? ` +
 		`and workflow_id = ? ` +
 		`and run_id = ? ` +
 		`and visibility_ts = ? ` +
-		`and task_id = ? `
+		`and task_id = ? ` +
+		`IF next_event_id = ?`
 
 	templateDeleteWorkflowExecutionMutableStateQuery = `DELETE FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -786,6 +819,17 @@ func (d *cassandraPersistence) CreateWorkflowExecution(request *CreateWorkflowEx
 						RunId:          common.StringPtr(fmt.Sprintf("%v", execution["run_id"])),
 					}
 				}
+
+				if currentRunID, ok := previous["current_run_id"].(gocql.UUID); ok &&
+					currentRunID.String() != request.PreviousRunID {
+					// CreateWorkflowExecution failed because the current execution is no longer the previous run
+					msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v, rangeID: %v, columns: (%v)",
+						*request.Execution.WorkflowId, currentRunID.String(), request.RangeID, strings.Join(columns, ","))
+					return nil, &workflow.WorkflowExecutionAlreadyStartedError{
+						Message: common.StringPtr(msg),
+						RunId:   common.StringPtr(currentRunID.String()),
+					}
+				}
 			}
 
 			previous = make(map[string]interface{})
@@ -818,6 +862,8 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 			*request.Execution.RunId,
 			*request.Execution.RunId,
 			request.RequestID,
+			WorkflowStateCreated,
+			WorkflowCloseStatusNone,
 			d.shardID,
 			rowTypeExecution,
 			request.DomainID,
@@ -825,6 +871,21 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 			permanentRunID,
 			defaultVisibilityTimestamp,
 			rowTypeExecutionTaskID)
+	} else if request.PreviousRunID != "" {
+		batch.Query(templateUpdateCurrentWorkflowExecutionForNewQuery,
+			*request.Execution.RunId,
+			*request.Execution.RunId,
+			request.RequestID,
+			WorkflowStateCreated,
+			WorkflowCloseStatusNone,
+			d.shardID,
+			rowTypeExecution,
+			request.DomainID,
+			*request.Execution.WorkflowId,
+			permanentRunID,
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID,
+			request.PreviousRunID)
 	} else {
 		batch.Query(templateCreateWorkflowExecutionQuery,
 			d.shardID,
@@ -836,7 +897,9 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 			rowTypeExecutionTaskID,
 			*request.Execution.RunId,
 			*request.Execution.RunId,
-			request.RequestID)
+			request.RequestID,
+			WorkflowStateCreated,
+			WorkflowCloseStatusNone)
 	}
 
 	parentDomainID := emptyDomainID
@@ -881,6 +944,12 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 		request.DecisionStartToCloseTimeout,
 		false,
 		"",
+		request.CronSchedule,
+		request.Attempt,
+		request.HasRetryPolicy,
+		"",
+		0,
+		request.SearchAttributes,
 		request.NextEventID,
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID)
@@ -952,6 +1021,14 @@ func (d *cassandraPersistence) GetWorkflowExecution(request *GetWorkflowExecutio
 	}
 	state.RequestCancelInfos = requestCancelInfos
 
+	signalInfos := make(map[int64]*SignalInfo)
+	sMap := result["signal_map"].(map[int64]map[string]interface{})
+	for key, value := range sMap {
+		info := createSignalInfo(value)
+		signalInfos[key] = info
+	}
+	state.SignalInfos = signalInfos
+
 	return &GetWorkflowExecutionResponse{State: state}, nil
 }
 
@@ -986,6 +1063,12 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 		executionInfo.DecisionTimeout,
 		executionInfo.CancelRequested,
 		executionInfo.CancelRequestID,
+		executionInfo.CronSchedule,
+		executionInfo.Attempt,
+		executionInfo.HasRetryPolicy,
+		executionInfo.StickyTaskList,
+		executionInfo.StickyScheduleToStartTimeout,
+		executionInfo.SearchAttributes,
 		executionInfo.NextEventID,
 		d.shardID,
 		rowTypeExecution,
@@ -1014,14 +1097,25 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 	d.updateRequestCancelInfos(batch, request.UpsertRequestCancelInfos, request.DeleteRequestCancelInfo,
 		executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID, request.Condition, request.RangeID)
 
+	d.updateSignalInfos(batch, request.UpsertSignalInfos, request.DeleteSignalInfo,
+		executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID, request.Condition, request.RangeID)
+
 	if request.ContinueAsNew != nil {
 		startReq := request.ContinueAsNew
 		d.CreateWorkflowExecutionWithinBatch(startReq, batch, cqlNowTimestamp)
 		d.createTransferTasks(batch, startReq.TransferTasks, startReq.DomainID, *startReq.Execution.WorkflowId,
 			*startReq.Execution.RunId, cqlNowTimestamp)
+		d.createTimerTasks(batch, startReq.TimerTasks, nil, startReq.DomainID, *startReq.Execution.WorkflowId,
+			*startReq.Execution.RunId, cqlNowTimestamp)
 	} else if request.CloseExecution {
-		// Delete WorkflowExecution row representing current execution
-		batch.Query(templateDeleteWorkflowExecutionQuery,
+		// The row representing current execution is kept with the close status of the run, which is used to
+		// enforce the WorkflowIdReusePolicy of later starts
+		batch.Query(templateUpdateCurrentWorkflowExecutionQuery,
+			executionInfo.RunID,
+			executionInfo.RunID,
+			executionInfo.CreateRequestID,
+			executionInfo.State,
+			executionInfo.CloseStatus,
 			d.shardID,
 			rowTypeExecution,
 			executionInfo.DomainID,
@@ -1159,8 +1253,8 @@ func (d *cassandraPersistence) GetCurrentExecution(request *GetCurrentExecutionR
 		defaultVisibilityTimestamp,
 		rowTypeExecutionTaskID)
 
-	var currentRunID string
-	if err := query.Scan(&currentRunID); err != nil {
+	result := make(map[string]interface{})
+	if err := query.MapScan(result); err != nil {
 		if err == gocql.ErrNotFound {
 			return nil, &workflow.EntityNotExistsError{
 				Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v",
@@ -1177,7 +1271,14 @@ func (d *cassandraPersistence) GetCurrentExecution(request *GetCurrentExecutionR
 		}
 	}
 
-	return &GetCurrentExecutionResponse{RunID: currentRunID}, nil
+	currentRunID := result["current_run_id"].(gocql.UUID).String()
+	executionInfo := createWorkflowExecutionInfo(result["execution"].(map[string]interface{}))
+	return &GetCurrentExecutionResponse{
+		RunID:          currentRunID,
+		StartRequestID: executionInfo.CreateRequestID,
+		State:          executionInfo.State,
+		CloseStatus:    executionInfo.CloseStatus,
+	}, nil
 }
 
 func (d *cassandraPersistence) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
@@ -1616,6 +1717,12 @@ func (d *cassandraPersistence) createTransferTasks(batch *gocql.Batch, transferT
 			targetRunID = task.(*CancelExecutionTask).TargetRunID
 			scheduleID = task.(*CancelExecutionTask).ScheduleID
 
+		case TransferTaskTypeSignalExecution:
+			targetDomainID = task.(*SignalExecutionTask).TargetDomainID
+			targetWorkflowID = task.(*SignalExecutionTask).TargetWorkflowID
+			targetRunID = task.(*SignalExecutionTask).TargetRunID
+			scheduleID = task.(*SignalExecutionTask).InitiatedID
+
 		case TransferTaskTypeStartChildExecution:
 			targetDomainID = task.(*StartChildExecutionTask).TargetDomainID
 			targetWorkflowID = task.(*StartChildExecutionTask).TargetWorkflowID
@@ -1648,12 +1755,14 @@ func (d *cassandraPersistence) createTimerTasks(batch *gocql.Batch, timerTasks [
 
 	for _, task := range timerTasks {
 		var eventID int64
+		var attempt int64
 
 		timeoutType := 0
 
 		switch task.GetType() {
 		case TaskTypeDecisionTimeout:
 			eventID = task.(*DecisionTimeoutTask).EventID
+			timeoutType = task.(*DecisionTimeoutTask).TimeoutType
 
 		case TaskTypeActivityTimeout:
 			eventID = task.(*ActivityTimeoutTask).EventID
@@ -1661,6 +1770,10 @@ func (d *cassandraPersistence) createTimerTasks(batch *gocql.Batch, timerTasks [
 
 		case TaskTypeUserTimer:
 			eventID = task.(*UserTimerTask).EventID
+
+		case TaskTypeActivityRetryTimer:
+			eventID = task.(*ActivityRetryTimerTask).EventID
+			attempt = int64(task.(*ActivityRetryTimerTask).Attempt)
 		}
 
 		ts := common.UnixNanoToCQLTimestamp(GetVisibilityTSFrom(task).UnixNano())
@@ -1679,6 +1792,7 @@ func (d *cassandraPersistence) createTimerTasks(batch *gocql.Batch, timerTasks [
 			task.GetType(),
 			timeoutType,
 			eventID,
+			attempt,
 			ts,
 			task.GetTaskID())
 	}
@@ -1719,6 +1833,7 @@ func (d *cassandraPersistence) updateActivityInfos(batch *gocql.Batch, activityI
 			a.CancelRequestID,
 			a.LastHeartBeatUpdatedTime,
 			a.TimerTaskStatus,
+			a.Attempt,
 			d.shardID,
 			rowTypeExecution,
 			domainID,
@@ -1846,6 +1961,42 @@ func (d *cassandraPersistence) updateRequestCancelInfos(batch *gocql.Batch, requ
 	}
 }
 
+func (d *cassandraPersistence) updateSignalInfos(batch *gocql.Batch, signalInfos []*SignalInfo,
+	deleteInfo *int64, domainID, workflowID, runID string, condition int64, rangeID int64) {
+
+	for _, c := range signalInfos {
+		batch.Query(templateUpdateSignalInfoQuery,
+			c.InitiatedID,
+			c.InitiatedID,
+			c.SignalRequestID,
+			c.SignalName,
+			c.Input,
+			c.Control,
+			d.shardID,
+			rowTypeExecution,
+			domainID,
+			workflowID,
+			runID,
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID,
+			condition)
+	}
+
+	// deleteInfo is the initiatedID for SignalInfo being deleted
+	if deleteInfo != nil {
+		batch.Query(templateDeleteSignalInfoQuery,
+			*deleteInfo,
+			d.shardID,
+			rowTypeExecution,
+			domainID,
+			workflowID,
+			runID,
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID,
+			condition)
+	}
+}
+
 func createShardInfo(result map[string]interface{}) *ShardInfo {
 	info := &ShardInfo{}
 	for k, v := range result {
@@ -1924,6 +2075,18 @@ func createWorkflowExecutionInfo(result map[string]interface{}) *WorkflowExecuti
 			info.CancelRequested = v.(bool)
 		case "cancel_request_id":
 			info.CancelRequestID = v.(string)
+		case "cron_schedule":
+			info.CronSchedule = v.(string)
+		case "attempt":
+			info.Attempt = int32(v.(int))
+		case "has_retry_policy":
+			info.HasRetryPolicy = v.(bool)
+		case "sticky_task_list":
+			info.StickyTaskList = v.(string)
+		case "sticky_schedule_to_start_timeout":
+			info.StickyScheduleToStartTimeout = int32(v.(int))
+		case "search_attributes":
+			info.SearchAttributes = v.(map[string][]byte)
 		}
 	}
 
@@ -1998,6 +2161,8 @@ func createActivityInfo(result map[string]interface{}) *ActivityInfo {
 			info.LastHeartBeatUpdatedTime = v.(time.Time)
 		case "timer_task_status":
 			info.TimerTaskStatus = int32(v.(int))
+		case "attempt":
+			info.Attempt = int32(v.(int))
 		}
 	}
 
@@ -2055,6 +2220,26 @@ func createRequestCancelInfo(result map[string]interface{}) *RequestCancelInfo {
 	return info
 }
 
+func createSignalInfo(result map[string]interface{}) *SignalInfo {
+	info := &SignalInfo{}
+	for k, v := range result {
+		switch k {
+		case "initiated_id":
+			info.InitiatedID = v.(int64)
+		case "signal_request_id":
+			info.SignalRequestID = v.(gocql.UUID).String()
+		case "signal_name":
+			info.SignalName = v.(string)
+		case "input":
+			info.Input = v.([]byte)
+		case "control":
+			info.Control = v.([]byte)
+		}
+	}
+
+	return info
+}
+
 func createTaskInfo(result map[string]interface{}) *TaskInfo {
 	info := &TaskInfo{}
 	for k, v := range result {
@@ -2093,6 +2278,8 @@ func createTimerTaskInfo(result map[string]interface{}) *TimerTaskInfo {
 			info.TimeoutType = v.(int)
 		case "event_id":
 			info.EventID = v.(int64)
+		case "schedule_attempt":
+			info.ScheduleAttempt = v.(int64)
 		}
 	}
 
@@ -2135,6 +2322,12 @@ func GetVisibilityTSFrom(task Task) time.Time {
 
 	case TaskTypeDeleteHistoryEvent:
 		return task.(*DeleteHistoryEventTask).VisibilityTimestamp
+
+	case TaskTypeActivityRetryTimer:
+		return task.(*ActivityRetryTimerTask).VisibilityTimestamp
+
+	case TaskTypeWorkflowBackoffTimer:
+		return task.(*WorkflowBackoffTimerTask).VisibilityTimestamp
 	}
 	return time.Time{}
 }
@@ -2156,5 +2349,11 @@ func SetVisibilityTSFrom(task Task, t time.Time) {
 
 	case TaskTypeDeleteHistoryEvent:
 		task.(*DeleteHistoryEventTask).VisibilityTimestamp = t
+
+	case TaskTypeActivityRetryTimer:
+		task.(*ActivityRetryTimerTask).VisibilityTimestamp = t
+
+	case TaskTypeWorkflowBackoffTimer:
+		task.(*WorkflowBackoffTimerTask).VisibilityTimestamp = t
 	}
 }
@@ -22,6 +22,7 @@ package persistence
 
 import (
 	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -116,6 +117,69 @@ func (s *cassandraPersistenceSuite) TestPersistenceStartWorkflow() {
 	s.IsType(&ShardOwnershipLostError{}, err2)
 }
 
+func (s *cassandraPersistenceSuite) TestPersistenceStartWorkflowConcurrent() {
+	domainID := "3c5a7bb8-2bc2-4a9f-8b15-0ad33b1d1a36"
+	workflowID := "start-workflow-concurrent-test"
+	concurrency := 10
+
+	requests := make([]*CreateWorkflowExecutionRequest, concurrency)
+	for i := range requests {
+		requests[i] = &CreateWorkflowExecutionRequest{
+			RequestID: uuid.New(),
+			DomainID:  domainID,
+			Execution: gen.WorkflowExecution{
+				WorkflowId: common.StringPtr(workflowID),
+				RunId:      common.StringPtr(uuid.New()),
+			},
+			TaskList:             "queue1",
+			WorkflowTypeName:     "wType",
+			DecisionTimeoutValue: 13,
+			NextEventID:          int64(3),
+			LastProcessedEvent:   0,
+			RangeID:              s.ShardInfo.RangeID,
+			TransferTasks: []Task{
+				&DecisionTask{
+					TaskID:     s.GetNextSequenceNumber(),
+					DomainID:   domainID,
+					TaskList:   "queue1",
+					ScheduleID: int64(2),
+				},
+			},
+			DecisionScheduleID:          int64(2),
+			DecisionStartedID:           common.EmptyEventID,
+			DecisionStartToCloseTimeout: 1,
+		}
+	}
+
+	errs := make([]error, concurrency)
+	var wg sync.WaitGroup
+	for i := range requests {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = s.WorkflowMgr.CreateWorkflowExecution(requests[i])
+		}(i)
+	}
+	wg.Wait()
+
+	// Exactly one start wins, and all others report the run it started
+	var winner *CreateWorkflowExecutionRequest
+	for i, err := range errs {
+		if err == nil {
+			s.Nil(winner, "Expected a single workflow creation to succeed.")
+			winner = requests[i]
+		}
+	}
+	s.NotNil(winner)
+	for _, err := range errs {
+		if err != nil {
+			startedErr, ok := err.(*gen.WorkflowExecutionAlreadyStartedError)
+			s.True(ok, "Unexpected error: %v", err)
+			s.Equal(*winner.Execution.RunId, *startedErr.RunId)
+		}
+	}
+}
+
 func (s *cassandraPersistenceSuite) TestGetWorkflow() {
 	domainID := "8f27f02b-ce22-4fd9-941b-65e1131b0bb5"
 	workflowExecution := gen.WorkflowExecution{
@@ -238,7 +302,7 @@ func (s *cassandraPersistenceSuite) TestUpdateWorkflow() {
 	failedUpdatedInfo2 := copyWorkflowExecutionInfo(info1)
 	failedUpdatedInfo2.NextEventID = int64(6)
 	failedUpdatedInfo2.LastProcessedEvent = int64(3)
-	err5 := s.UpdateWorkflowExecutionWithRangeID(updatedInfo, []int64{int64(5)}, nil, int64(12345), int64(5), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	err5 := s.UpdateWorkflowExecutionWithRangeID(updatedInfo, []int64{int64(5)}, nil, int64(12345), int64(5), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	s.NotNil(err5, "expected non nil error.")
 	s.IsType(&ShardOwnershipLostError{}, err5)
 	log.Errorf("Conditional update failed with error: %v", err5)
@@ -327,18 +391,30 @@ func (s *cassandraPersistenceSuite) TestGetCurrentWorkflow() {
 	updatedInfo1 := copyWorkflowExecutionInfo(info0.ExecutionInfo)
 	updatedInfo1.NextEventID = int64(6)
 	updatedInfo1.LastProcessedEvent = int64(2)
+	updatedInfo1.State = WorkflowStateCompleted
+	updatedInfo1.CloseStatus = WorkflowCloseStatusFailed
 	err3 := s.UpdateWorkflowExecutionAndDelete(updatedInfo1, int64(3))
 	s.Nil(err3, "No error expected.")
 
-	_, err4 := s.GetCurrentWorkflow(domainID, *workflowExecution.WorkflowId)
-	s.NotNil(err4, "No error expected.")
-	s.IsType(&gen.EntityNotExistsError{}, err4)
+	current, err4 := s.WorkflowMgr.GetCurrentExecution(&GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: *workflowExecution.WorkflowId,
+	})
+	s.Nil(err4, "No error expected.")
+	s.Equal(*workflowExecution.RunId, current.RunID)
+	s.Equal(WorkflowStateCompleted, current.State)
+	s.Equal(WorkflowCloseStatusFailed, current.CloseStatus)
 
 	workflowExecution2 := gen.WorkflowExecution{
 		WorkflowId: common.StringPtr("get-current-workflow-test"),
 		RunId:      common.StringPtr("c3ff4bc6-de18-4643-83b2-037a33f45322"),
 	}
-	task1, err5 := s.CreateWorkflowExecution(domainID, workflowExecution2, "queue1", "wType", 13, nil, 3, 0, 2, nil)
+	_, err5 := s.CreateWorkflowExecution(domainID, workflowExecution2, "queue1", "wType", 13, nil, 3, 0, 2, nil)
+	s.NotNil(err5, "Expected workflow already started error.")
+	s.IsType(&gen.WorkflowExecutionAlreadyStartedError{}, err5)
+
+	task1, err5 := s.CreateWorkflowExecutionWithPreviousRun(domainID, workflowExecution2, *workflowExecution.RunId,
+		"queue1", "wType", 13, nil, 3, 0, 2, nil)
 	s.Nil(err5, "No error expected.")
 	s.NotEmpty(task1, "Expected non empty task identifier.")
 
@@ -441,8 +517,9 @@ func (s *cassandraPersistenceSuite) TestTransferTasksThroughUpdate() {
 		WorkflowId: common.StringPtr("get-transfer-tasks-through-update-test"),
 		RunId:      common.StringPtr("2a038c8f-b575-4151-8d2c-d443e999ab5a"),
 	}
-	_, err6 := s.GetCurrentWorkflow(domainID, "get-transfer-tasks-through-update-test")
-	s.NotNil(err6, "Entity exist error expected.")
+	runID, err6 := s.GetCurrentWorkflow(domainID, "get-transfer-tasks-through-update-test")
+	s.Nil(err6, "No error expected.")
+	s.Equal(*workflowExecution.RunId, runID)
 
 	tasks3, err7 := s.GetTransferTasks(1)
 	s.Nil(err7, "No error expected.")
@@ -460,7 +537,8 @@ func (s *cassandraPersistenceSuite) TestTransferTasksThroughUpdate() {
 	err9 := s.CompleteTransferTask(task3.TaskID)
 	s.Nil(err9)
 
-	_, err10 := s.CreateWorkflowExecution(domainID, newExecution, "queue1", "wType", 13, nil, 3, 0, 2, nil)
+	_, err10 := s.CreateWorkflowExecutionWithPreviousRun(domainID, newExecution, *workflowExecution.RunId, "queue1",
+		"wType", 13, nil, 3, 0, 2, nil)
 	s.Nil(err10, "No error expected.")
 }
 
@@ -514,6 +592,57 @@ func (s *cassandraPersistenceSuite) TestCancelTransferTaskTasks() {
 	s.Nil(err)
 }
 
+func (s *cassandraPersistenceSuite) TestSignalTransferTaskTasks() {
+	domainID := "49ce7f6b-b1a5-4a2f-bb0e-9bb1b7fe8e23"
+	workflowExecution := gen.WorkflowExecution{WorkflowId: common.StringPtr("signal-transfer-task-test"),
+		RunId: common.StringPtr("3f1d2b7c-8ba1-4cb0-9d37-0e3d19a1f6e4")}
+
+	task0, err := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 13, nil, 3, 0, 2, nil)
+	s.Nil(err, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	taskD, err := s.GetTransferTasks(1)
+	s.Equal(1, len(taskD), "Expected 1 decision task.")
+	err = s.CompleteTransferTask(taskD[0].TaskID)
+	s.Nil(err)
+
+	state0, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	info0 := state0.ExecutionInfo
+	s.NotNil(info0, "Valid Workflow info expected.")
+	updatedInfo := copyWorkflowExecutionInfo(info0)
+
+	targetDomainID := "f2bfaab6-7e8b-4fac-9a62-17da8d37becb"
+	targetWorkflowID := "target-workflow_id"
+	targetRunID := "0d00698f-08e1-4d36-a3e2-3bf109f5d2d6"
+	transferTasks := []Task{&SignalExecutionTask{
+		TaskID:           s.GetNextSequenceNumber(),
+		TargetDomainID:   targetDomainID,
+		TargetWorkflowID: targetWorkflowID,
+		TargetRunID:      targetRunID,
+		InitiatedID:      1,
+	}}
+	err1 = s.UpdateWorkflowExecutionWithTransferTasks(updatedInfo, int64(3), transferTasks, nil)
+	s.Nil(err1, "No error expected.")
+
+	tasks, err := s.GetTransferTasks(1)
+	s.Nil(err, "No error expected.")
+	s.NotNil(tasks, "expected valid list of tasks.")
+	s.Equal(1, len(tasks), "Expected 1 signal task.")
+	task := tasks[0]
+	s.Equal(TransferTaskTypeSignalExecution, task.TaskType)
+	s.Equal(domainID, task.DomainID)
+	s.Equal(*workflowExecution.WorkflowId, task.WorkflowID)
+	s.Equal(*workflowExecution.RunId, task.RunID)
+	s.Equal(targetDomainID, task.TargetDomainID)
+	s.Equal(targetWorkflowID, task.TargetWorkflowID)
+	s.Equal(targetRunID, task.TargetRunID)
+	s.Equal(int64(1), task.ScheduleID)
+
+	err = s.CompleteTransferTask(task.TaskID)
+	s.Nil(err)
+}
+
 func (s *cassandraPersistenceSuite) TestCreateTask() {
 	domainID := "11adbd1b-f164-4ea7-b2f3-2e857a5048f1"
 	workflowExecution := gen.WorkflowExecution{WorkflowId: common.StringPtr("create-task-test"),
@@ -640,16 +769,26 @@ func (s *cassandraPersistenceSuite) TestTimerTasks() {
 	updatedInfo := copyWorkflowExecutionInfo(info0)
 	updatedInfo.NextEventID = int64(5)
 	updatedInfo.LastProcessedEvent = int64(2)
-	tasks := []Task{&DecisionTimeoutTask{time.Now(), 1, 2}, &WorkflowTimeoutTask{time.Now(), 2}, &DeleteHistoryEventTask{time.Now(), 3}}
+	decisionTimeoutTask := &DecisionTimeoutTask{
+		VisibilityTimestamp: time.Now(),
+		TaskID:              1,
+		EventID:             2,
+		TimeoutType:         int(gen.TimeoutTypeStartToClose),
+	}
+	tasks := []Task{decisionTimeoutTask, &WorkflowTimeoutTask{time.Now(), 2}, &DeleteHistoryEventTask{time.Now(), 3},
+		&ActivityRetryTimerTask{time.Now(), 4, 3, 2}}
 	err2 := s.UpdateWorkflowExecution(updatedInfo, []int64{int64(4)}, nil, int64(3), tasks, nil, nil, nil, nil, nil)
 	s.Nil(err2, "No error expected.")
 
 	timerTasks, err1 := s.GetTimerIndexTasks()
 	s.Nil(err1, "No error expected.")
 	s.NotNil(timerTasks, "expected valid list of tasks.")
-	s.Equal(3, len(timerTasks))
+	s.Equal(4, len(timerTasks))
 	s.Equal(TaskTypeWorkflowTimeout, timerTasks[1].TaskType)
 	s.Equal(TaskTypeDeleteHistoryEvent, timerTasks[2].TaskType)
+	s.Equal(TaskTypeActivityRetryTimer, timerTasks[3].TaskType)
+	s.Equal(int64(3), timerTasks[3].EventID)
+	s.Equal(int64(2), timerTasks[3].ScheduleAttempt)
 
 	deleteTimerTask := &DecisionTimeoutTask{VisibilityTimestamp: timerTasks[0].VisibilityTimestamp, TaskID: timerTasks[0].TaskID}
 	err2 = s.UpdateWorkflowExecution(updatedInfo, nil, nil, int64(5), nil, deleteTimerTask, nil, nil, nil, nil)
@@ -661,6 +800,9 @@ func (s *cassandraPersistenceSuite) TestTimerTasks() {
 	err2 = s.CompleteTimerTask(timerTasks[2].VisibilityTimestamp, timerTasks[2].TaskID)
 	s.Nil(err2, "No error expected.")
 
+	err2 = s.CompleteTimerTask(timerTasks[3].VisibilityTimestamp, timerTasks[3].TaskID)
+	s.Nil(err2, "No error expected.")
+
 	timerTasks2, err2 := s.GetTimerIndexTasks()
 	s.Nil(err2, "No error expected.")
 	s.Empty(timerTasks2, "expected empty task list.")
@@ -700,6 +842,7 @@ func (s *cassandraPersistenceSuite) TestWorkflowMutableState_Activities() {
 			HeartbeatTimeout:         4,
 			LastHeartBeatUpdatedTime: currentTime,
 			TimerTaskStatus:          1,
+			Attempt:                  3,
 		}}
 	err2 := s.UpdateWorkflowExecution(updatedInfo, []int64{int64(4)}, nil, int64(3), nil, nil, activityInfos, nil, nil, nil)
 	s.Nil(err2, "No error expected.")
@@ -723,6 +866,7 @@ func (s *cassandraPersistenceSuite) TestWorkflowMutableState_Activities() {
 	s.Equal(int32(4), ai.HeartbeatTimeout)
 	s.Equal(currentTime.Unix(), ai.LastHeartBeatUpdatedTime.Unix())
 	s.Equal(int32(1), ai.TimerTaskStatus)
+	s.Equal(int32(3), ai.Attempt)
 
 	err2 = s.UpdateWorkflowExecution(updatedInfo, nil, nil, int64(5), nil, nil, nil, common.Int64Ptr(1), nil, nil)
 	s.Nil(err2, "No error expected.")
@@ -886,6 +1030,59 @@ func (s *cassandraPersistenceSuite) TestWorkflowMutableState_RequestCancel() {
 	s.Equal(0, len(state.RequestCancelInfos))
 }
 
+func (s *cassandraPersistenceSuite) TestWorkflowMutableState_Signal() {
+	domainID := "4ed4fb34-4e2c-4ee4-a4c5-ac4c7f1d0ba6"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-workflow-mutable-signal-test"),
+		RunId:      common.StringPtr("2b1d4b82-5b58-4f4b-b4a5-9c0e14c6e09e"),
+	}
+
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "taskList", "wType", 13, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	state0, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	info0 := state0.ExecutionInfo
+	s.NotNil(info0, "Valid Workflow info expected.")
+
+	updatedInfo := copyWorkflowExecutionInfo(info0)
+	updatedInfo.NextEventID = int64(5)
+	updatedInfo.LastProcessedEvent = int64(2)
+	signalRequestID := uuid.New()
+	signalInfos := []*SignalInfo{
+		{
+			InitiatedID:     1,
+			SignalRequestID: signalRequestID,
+			SignalName:      "my-signal",
+			Input:           []byte("test signal input"),
+			Control:         []byte("test signal control"),
+		}}
+	err2 := s.UpsertSignalInfoState(updatedInfo, int64(3), signalInfos)
+	s.Nil(err2, "No error expected.")
+
+	state, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	s.NotNil(state, "expected valid state.")
+	s.Equal(1, len(state.SignalInfos))
+	si, ok := state.SignalInfos[1]
+	s.True(ok)
+	s.NotNil(si)
+	s.Equal(int64(1), si.InitiatedID)
+	s.Equal(signalRequestID, si.SignalRequestID)
+	s.Equal("my-signal", si.SignalName)
+	s.Equal([]byte("test signal input"), si.Input)
+	s.Equal([]byte("test signal control"), si.Control)
+
+	err2 = s.DeleteSignalState(updatedInfo, int64(5), int64(1))
+	s.Nil(err2, "No error expected.")
+
+	state, err1 = s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	s.NotNil(state, "expected valid state.")
+	s.Equal(0, len(state.SignalInfos))
+}
+
 func (s *cassandraPersistenceSuite) TestWorkflowMutableStateInfo() {
 	domainID := "9ed8818b-3090-4160-9f21-c6b70e64d2dd"
 	workflowExecution := gen.WorkflowExecution{
@@ -905,6 +1102,11 @@ func (s *cassandraPersistenceSuite) TestWorkflowMutableStateInfo() {
 	updatedInfo := copyWorkflowExecutionInfo(info0)
 	updatedInfo.NextEventID = int64(5)
 	updatedInfo.LastProcessedEvent = int64(2)
+	updatedInfo.Attempt = 2
+	updatedInfo.HasRetryPolicy = true
+	updatedInfo.StickyTaskList = "sticky-tl"
+	updatedInfo.StickyScheduleToStartTimeout = 5
+	updatedInfo.SearchAttributes = map[string][]byte{"CustomKeywordField": []byte(`"keyword"`)}
 
 	err2 := s.UpdateWorkflowExecution(updatedInfo, []int64{int64(4)}, nil, int64(3), nil, nil, nil, nil, nil, nil)
 	s.Nil(err2, "No error expected.")
@@ -915,6 +1117,11 @@ func (s *cassandraPersistenceSuite) TestWorkflowMutableStateInfo() {
 	s.NotNil(state.ExecutionInfo, "expected valid MS Info state.")
 	s.Equal(updatedInfo.NextEventID, state.ExecutionInfo.NextEventID)
 	s.Equal(updatedInfo.State, state.ExecutionInfo.State)
+	s.Equal(int32(2), state.ExecutionInfo.Attempt)
+	s.True(state.ExecutionInfo.HasRetryPolicy)
+	s.Equal("sticky-tl", state.ExecutionInfo.StickyTaskList)
+	s.Equal(int32(5), state.ExecutionInfo.StickyScheduleToStartTimeout)
+	s.Equal(map[string][]byte{"CustomKeywordField": []byte(`"keyword"`)}, state.ExecutionInfo.SearchAttributes)
 }
 
 func (s *cassandraPersistenceSuite) TestContinueAsNew() {
@@ -962,28 +1169,77 @@ func (s *cassandraPersistenceSuite) TestContinueAsNew() {
 	s.Equal(*newWorkflowExecution.RunId, newRunID)
 }
 
+func (s *cassandraPersistenceSuite) TestContinueAsNewWithCronSchedule() {
+	domainID := "4e2b1a5c-0a0f-4f7e-9d36-b2f3f5dbe9a1"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("continue-as-new-cron-workflow-test"),
+		RunId:      common.StringPtr("0d8c5b9e-5b1f-4c43-8d0a-3bb5b0e0c7f4"),
+	}
+
+	_, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 13, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+
+	state0, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	info0 := state0.ExecutionInfo
+	continueAsNewInfo := copyWorkflowExecutionInfo(info0)
+	continueAsNewInfo.State = WorkflowStateCompleted
+	continueAsNewInfo.NextEventID = int64(5)
+	continueAsNewInfo.LastProcessedEvent = int64(2)
+
+	newWorkflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("continue-as-new-cron-workflow-test"),
+		RunId:      common.StringPtr("e6b7e0b4-8c44-4f0e-a7a5-3f5c4c4d0a9b"),
+	}
+	err2 := s.ContinueAsNewExecutionWithBackoff(continueAsNewInfo, info0.NextEventID, newWorkflowExecution, int64(2),
+		"@hourly", time.Now().Add(time.Minute))
+	s.Nil(err2, "No error expected.")
+
+	newExecutionState, err3 := s.GetWorkflowExecutionInfo(domainID, newWorkflowExecution)
+	s.Nil(err3)
+	newExecutionInfo := newExecutionState.ExecutionInfo
+	s.Equal(WorkflowStateCreated, newExecutionInfo.State)
+	s.Equal("@hourly", newExecutionInfo.CronSchedule)
+	s.Equal(common.EmptyEventID, newExecutionInfo.DecisionScheduleID)
+
+	timerTasks, err4 := s.GetTimerIndexTasks()
+	s.Nil(err4, "No error expected.")
+	s.Equal(1, len(timerTasks))
+	s.Equal(TaskTypeWorkflowBackoffTimer, timerTasks[0].TaskType)
+	s.Equal(*newWorkflowExecution.RunId, timerTasks[0].RunID)
+
+	err5 := s.CompleteTimerTask(timerTasks[0].VisibilityTimestamp, timerTasks[0].TaskID)
+	s.Nil(err5, "No error expected.")
+}
+
 func copyWorkflowExecutionInfo(sourceInfo *WorkflowExecutionInfo) *WorkflowExecutionInfo {
 	return &WorkflowExecutionInfo{
-		DomainID:             sourceInfo.DomainID,
-		WorkflowID:           sourceInfo.WorkflowID,
-		RunID:                sourceInfo.RunID,
-		ParentDomainID:       sourceInfo.ParentDomainID,
-		ParentWorkflowID:     sourceInfo.ParentWorkflowID,
-		ParentRunID:          sourceInfo.ParentRunID,
-		InitiatedID:          sourceInfo.InitiatedID,
-		CompletionEvent:      sourceInfo.CompletionEvent,
-		TaskList:             sourceInfo.TaskList,
-		WorkflowTypeName:     sourceInfo.WorkflowTypeName,
-		DecisionTimeoutValue: sourceInfo.DecisionTimeoutValue,
-		ExecutionContext:     sourceInfo.ExecutionContext,
-		State:                sourceInfo.State,
-		NextEventID:          sourceInfo.NextEventID,
-		LastProcessedEvent:   sourceInfo.LastProcessedEvent,
-		LastUpdatedTimestamp: sourceInfo.LastUpdatedTimestamp,
-		CreateRequestID:      sourceInfo.CreateRequestID,
-		DecisionScheduleID:   sourceInfo.DecisionScheduleID,
-		DecisionStartedID:    sourceInfo.DecisionStartedID,
-		DecisionRequestID:    sourceInfo.DecisionRequestID,
-		DecisionTimeout:      sourceInfo.DecisionTimeout,
+		DomainID:                     sourceInfo.DomainID,
+		WorkflowID:                   sourceInfo.WorkflowID,
+		RunID:                        sourceInfo.RunID,
+		ParentDomainID:               sourceInfo.ParentDomainID,
+		ParentWorkflowID:             sourceInfo.ParentWorkflowID,
+		ParentRunID:                  sourceInfo.ParentRunID,
+		InitiatedID:                  sourceInfo.InitiatedID,
+		CompletionEvent:              sourceInfo.CompletionEvent,
+		TaskList:                     sourceInfo.TaskList,
+		WorkflowTypeName:             sourceInfo.WorkflowTypeName,
+		DecisionTimeoutValue:         sourceInfo.DecisionTimeoutValue,
+		ExecutionContext:             sourceInfo.ExecutionContext,
+		State:                        sourceInfo.State,
+		NextEventID:                  sourceInfo.NextEventID,
+		LastProcessedEvent:           sourceInfo.LastProcessedEvent,
+		LastUpdatedTimestamp:         sourceInfo.LastUpdatedTimestamp,
+		CreateRequestID:              sourceInfo.CreateRequestID,
+		DecisionScheduleID:           sourceInfo.DecisionScheduleID,
+		DecisionStartedID:            sourceInfo.DecisionStartedID,
+		DecisionRequestID:            sourceInfo.DecisionRequestID,
+		DecisionTimeout:              sourceInfo.DecisionTimeout,
+		CronSchedule:                 sourceInfo.CronSchedule,
+		Attempt:                      sourceInfo.Attempt,
+		HasRetryPolicy:               sourceInfo.HasRetryPolicy,
+		StickyTaskList:               sourceInfo.StickyTaskList,
+		StickyScheduleToStartTimeout: sourceInfo.StickyScheduleToStartTimeout,
+		SearchAttributes:             sourceInfo.SearchAttributes,
 	}
 }
@@ -37,6 +37,11 @@ const (
 	defaultCloseTTLSeconds = 86400
 )
 
+// errQueryNotSupported is returned for the query based APIs by visibility stores which only support the fixed filters
+var errQueryNotSupported = &workflow.BadRequestError{
+	Message: "Listing or counting workflow executions by query requires an advanced visibility store.",
+}
+
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO open_executions (` +
 		`domain_id, domain_partition, workflow_id, run_id, start_time, workflow_type_name) ` +
@@ -506,6 +511,16 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
 	return response, nil
 }
 
+func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByFilters(
+	request *ListWorkflowExecutionsByFiltersRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutionsByFilters("ListOpenWorkflowExecutionsByFilters", request, false)
+}
+
+func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByFilters(
+	request *ListWorkflowExecutionsByFiltersRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutionsByFilters("ListClosedWorkflowExecutionsByFilters", request, true)
+}
+
 func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
 	request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
 	execution := request.Execution
@@ -546,6 +561,97 @@ func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
 	}, nil
 }
 
+// UpsertWorkflowExecution is a no-op since the search attributes of executions are not stored
+func (v *cassandraVisibilityPersistence) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
+	return nil
+}
+
+func (v *cassandraVisibilityPersistence) ListWorkflowExecutions(
+	request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error) {
+	return nil, errQueryNotSupported
+}
+
+func (v *cassandraVisibilityPersistence) CountWorkflowExecutions(
+	request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	return nil, errQueryNotSupported
+}
+
+// listWorkflowExecutionsByFilters reads one page of the executions in the start time window which match the most
+// selective filter of the request, and leaves out those which do not match the other filters.  A page can thus hold
+// fewer executions than the page size, or none, while there are more pages.
+func (v *cassandraVisibilityPersistence) listWorkflowExecutionsByFilters(operation string,
+	request *ListWorkflowExecutionsByFiltersRequest, closed bool) (*ListWorkflowExecutionsResponse, error) {
+	args := []interface{}{
+		request.DomainUUID,
+		domainPartition,
+		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
+		common.UnixNanoToCQLTimestamp(request.LatestStartTime),
+	}
+
+	var template string
+	if request.WorkflowID != "" {
+		template = templateGetOpenWorkflowExecutionsByID
+		if closed {
+			template = templateGetClosedWorkflowExecutionsByID
+		}
+		args = append(args, request.WorkflowID)
+	} else if request.WorkflowTypeName != "" {
+		template = templateGetOpenWorkflowExecutionsByType
+		if closed {
+			template = templateGetClosedWorkflowExecutionsByType
+		}
+		args = append(args, request.WorkflowTypeName)
+	} else if closed && request.Status != nil {
+		template = templateGetClosedWorkflowExecutionsByStatus
+		args = append(args, *request.Status)
+	} else {
+		template = templateGetOpenWorkflowExecutions
+		if closed {
+			template = templateGetClosedWorkflowExecutions
+		}
+	}
+
+	readRecord := readOpenWorkflowExecutionRecord
+	if closed {
+		readRecord = readClosedWorkflowExecutionRecord
+	}
+
+	query := v.session.Query(template, args...).Consistency(v.lowConslevel)
+	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
+	if iter == nil {
+		// TODO: should return a bad request error if the token is invalid
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed.  Not able to create query iterator.", operation),
+		}
+	}
+
+	response := &ListWorkflowExecutionsResponse{}
+	response.Executions = make([]*workflow.WorkflowExecutionInfo, 0)
+	wfexecution, has := readRecord(iter)
+	for has {
+		if request.matches(wfexecution) {
+			response.Executions = append(response.Executions, wfexecution)
+		}
+		wfexecution, has = readRecord(iter)
+	}
+
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("%v operation failed. Error: %v", operation, err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed. Error: %v", operation, err),
+		}
+	}
+
+	return response, nil
+}
+
 func readOpenWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExecutionInfo, bool) {
 	var workflowID string
 	var runID gocql.UUID
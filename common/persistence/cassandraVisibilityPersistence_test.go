@@ -405,6 +405,120 @@ func (s *visibilityPersistenceSuite) TestFilteringByCloseStatus() {
 	s.Equal(workflowExecution2.WorkflowId, resp.Executions[0].Execution.WorkflowId)
 }
 
+func (s *visibilityPersistenceSuite) TestFilteringByMultipleFilters() {
+	testDomainUUID := uuid.New()
+	startTime := time.Now().UnixNano()
+
+	// Create 3 closed executions and an open one, two runs of the same workflow have the same type
+	closedExecutions := []struct {
+		execution gen.WorkflowExecution
+		typeName  string
+		status    gen.WorkflowExecutionCloseStatus
+	}{
+		{
+			execution: gen.WorkflowExecution{
+				WorkflowId: common.StringPtr("visibility-filtering-test1"),
+				RunId:      common.StringPtr("3c8cd2a1-6f32-4dcc-bf9c-4b2b3b3e0f11"),
+			},
+			typeName: "visibility-workflow-1",
+			status:   gen.WorkflowExecutionCloseStatusCompleted,
+		},
+		{
+			execution: gen.WorkflowExecution{
+				WorkflowId: common.StringPtr("visibility-filtering-test1"),
+				RunId:      common.StringPtr("8a0f2f9e-2b1d-4c52-9d4f-0f4e6f2c7b22"),
+			},
+			typeName: "visibility-workflow-1",
+			status:   gen.WorkflowExecutionCloseStatusFailed,
+		},
+		{
+			execution: gen.WorkflowExecution{
+				WorkflowId: common.StringPtr("visibility-filtering-test2"),
+				RunId:      common.StringPtr("d6b4c0a8-91e3-4b7a-8f6d-5e2a1c9b3d33"),
+			},
+			typeName: "visibility-workflow-2",
+			status:   gen.WorkflowExecutionCloseStatusFailed,
+		},
+	}
+	for _, e := range closedExecutions {
+		err0 := s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+			DomainUUID:       testDomainUUID,
+			Execution:        e.execution,
+			WorkflowTypeName: e.typeName,
+			StartTimestamp:   startTime,
+		})
+		s.Nil(err0)
+		err1 := s.VisibilityMgr.RecordWorkflowExecutionClosed(&RecordWorkflowExecutionClosedRequest{
+			DomainUUID:       testDomainUUID,
+			Execution:        e.execution,
+			WorkflowTypeName: e.typeName,
+			StartTimestamp:   startTime,
+			CloseTimestamp:   time.Now().UnixNano(),
+			Status:           e.status,
+		})
+		s.Nil(err1)
+	}
+
+	openExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-filtering-test1"),
+		RunId:      common.StringPtr("5f7e9a3b-0c4d-4e8f-a1b2-c3d4e5f6a744"),
+	}
+	err2 := s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        openExecution,
+		WorkflowTypeName: "visibility-workflow-2",
+		StartTimestamp:   startTime,
+	})
+	s.Nil(err2)
+
+	baseReq := ListWorkflowExecutionsRequest{
+		DomainUUID:        testDomainUUID,
+		PageSize:          10,
+		EarliestStartTime: startTime,
+		LatestStartTime:   startTime,
+	}
+	failed := gen.WorkflowExecutionCloseStatusFailed
+
+	// List closed by type and status
+	resp, err3 := s.VisibilityMgr.ListClosedWorkflowExecutionsByFilters(&ListWorkflowExecutionsByFiltersRequest{
+		ListWorkflowExecutionsRequest: baseReq,
+		WorkflowTypeName:              "visibility-workflow-1",
+		Status:                        &failed,
+	})
+	s.Nil(err3)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(closedExecutions[1].execution.RunId, resp.Executions[0].Execution.RunId)
+
+	// List closed by workflow ID and status
+	resp, err4 := s.VisibilityMgr.ListClosedWorkflowExecutionsByFilters(&ListWorkflowExecutionsByFiltersRequest{
+		ListWorkflowExecutionsRequest: baseReq,
+		WorkflowID:                    "visibility-filtering-test1",
+		Status:                        &failed,
+	})
+	s.Nil(err4)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(closedExecutions[1].execution.RunId, resp.Executions[0].Execution.RunId)
+
+	// List closed by workflow ID and type, which no execution matches
+	resp, err5 := s.VisibilityMgr.ListClosedWorkflowExecutionsByFilters(&ListWorkflowExecutionsByFiltersRequest{
+		ListWorkflowExecutionsRequest: baseReq,
+		WorkflowID:                    "visibility-filtering-test2",
+		WorkflowTypeName:              "visibility-workflow-1",
+	})
+	s.Nil(err5)
+	s.Equal(0, len(resp.Executions))
+
+	// List open by workflow ID and type
+	resp, err6 := s.VisibilityMgr.ListOpenWorkflowExecutionsByFilters(&ListWorkflowExecutionsByFiltersRequest{
+		ListWorkflowExecutionsRequest: baseReq,
+		WorkflowID:                    "visibility-filtering-test1",
+		WorkflowTypeName:              "visibility-workflow-2",
+	})
+	s.Nil(err6)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(openExecution.RunId, resp.Executions[0].Execution.RunId)
+}
+
 func (s *visibilityPersistenceSuite) TestGetClosedExecution() {
 	testDomainUUID := uuid.New()
 
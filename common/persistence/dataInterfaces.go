@@ -66,6 +66,9 @@ const (
 	TransferTaskTypeDeleteExecution
 	TransferTaskTypeCancelExecution
 	TransferTaskTypeStartChildExecution
+	TransferTaskTypeSignalExecution
+	TransferTaskTypeRecordWorkflowStarted
+	TransferTaskTypeUpsertWorkflowSearchAttributes
 )
 
 // Types of timers
@@ -75,6 +78,8 @@ const (
 	TaskTypeUserTimer
 	TaskTypeWorkflowTimeout
 	TaskTypeDeleteHistoryEvent
+	TaskTypeActivityRetryTimer
+	TaskTypeWorkflowBackoffTimer
 )
 
 type (
@@ -137,6 +142,13 @@ type (
 		DecisionTimeout      int32
 		CancelRequested      bool
 		CancelRequestID      string
+		CronSchedule         string
+		Attempt              int32
+		HasRetryPolicy       bool
+		SearchAttributes     map[string][]byte
+		// for sticky execution
+		StickyTaskList               string
+		StickyScheduleToStartTimeout int32
 	}
 
 	// TransferTaskInfo describes a transfer task
@@ -163,6 +175,7 @@ type (
 		TaskType            int
 		TimeoutType         int
 		EventID             int64
+		ScheduleAttempt     int64
 	}
 
 	// TaskListInfo describes a state of a task list implementation.
@@ -212,6 +225,18 @@ type (
 		TaskID int64
 	}
 
+	// RecordWorkflowStartedTask identifies a transfer task for recording the start of an execution whose first
+	// decision is not the second event of its history in visibility
+	RecordWorkflowStartedTask struct {
+		TaskID int64
+	}
+
+	// UpsertWorkflowSearchAttributesTask identifies a transfer task for updating the search attributes of an open
+	// execution in visibility
+	UpsertWorkflowSearchAttributesTask struct {
+		TaskID int64
+	}
+
 	// DeleteHistoryEventTask identifies a timer task for deletion of history events of completed execution.
 	DeleteHistoryEventTask struct {
 		VisibilityTimestamp time.Time
@@ -223,6 +248,7 @@ type (
 		VisibilityTimestamp time.Time
 		TaskID              int64
 		EventID             int64
+		TimeoutType         int
 	}
 
 	// WorkflowTimeoutTask identifies a timeout task.
@@ -240,6 +266,15 @@ type (
 		ScheduleID       int64
 	}
 
+	// SignalExecutionTask identifies a transfer task for signal of an external execution
+	SignalExecutionTask struct {
+		TaskID           int64
+		TargetDomainID   string
+		TargetWorkflowID string
+		TargetRunID      string
+		InitiatedID      int64
+	}
+
 	// StartChildExecutionTask identifies a transfer task for starting child execution
 	StartChildExecutionTask struct {
 		TaskID           int64
@@ -263,12 +298,28 @@ type (
 		EventID             int64
 	}
 
+	// ActivityRetryTimerTask identifies a timer task to dispatch the next attempt of an activity.
+	ActivityRetryTimerTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		EventID             int64
+		Attempt             int32
+	}
+
+	// WorkflowBackoffTimerTask identifies a timer task to schedule the first decision of a run which is delayed by a
+	// backoff, like the runs of a workflow with a cron schedule.
+	WorkflowBackoffTimerTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+	}
+
 	// WorkflowMutableState indicates workflow related state
 	WorkflowMutableState struct {
 		ActivitInfos        map[int64]*ActivityInfo
 		TimerInfos          map[string]*TimerInfo
 		ChildExecutionInfos map[int64]*ChildExecutionInfo
 		RequestCancelInfos  map[int64]*RequestCancelInfo
+		SignalInfos         map[int64]*SignalInfo
 		ExecutionInfo       *WorkflowExecutionInfo
 	}
 
@@ -291,6 +342,7 @@ type (
 		CancelRequestID          int64
 		LastHeartBeatUpdatedTime time.Time
 		TimerTaskStatus          int32
+		Attempt                  int32
 	}
 
 	// TimerInfo details - metadata about user timer info.
@@ -316,6 +368,15 @@ type (
 		CancelRequestID string
 	}
 
+	// SignalInfo has details for pending external workflow signals
+	SignalInfo struct {
+		InitiatedID     int64
+		SignalRequestID string
+		SignalName      string
+		Input           []byte
+		Control         []byte
+	}
+
 	// CreateShardRequest is used to create a shard in executions table
 	CreateShardRequest struct {
 		ShardInfo *ShardInfo
@@ -358,6 +419,13 @@ type (
 		DecisionStartedID           int64
 		DecisionStartToCloseTimeout int32
 		ContinueAsNew               bool
+		CronSchedule                string
+		Attempt                     int32
+		HasRetryPolicy              bool
+		SearchAttributes            map[string][]byte
+		// PreviousRunID is the closed run the new run replaces as the current execution of the workflow.  The
+		// request fails with WorkflowExecutionAlreadyStartedError if the current execution is a different run.
+		PreviousRunID string
 	}
 
 	// CreateWorkflowExecutionResponse is the response to CreateWorkflowExecutionRequest
@@ -384,7 +452,10 @@ type (
 
 	// GetCurrentExecutionResponse is the response to GetCurrentExecution
 	GetCurrentExecutionResponse struct {
-		RunID string
+		RunID          string
+		StartRequestID string
+		State          int
+		CloseStatus    int
 	}
 
 	// UpdateWorkflowExecutionRequest is used to update a workflow execution
@@ -407,6 +478,8 @@ type (
 		DeleteChildExecutionInfo  *int64
 		UpsertRequestCancelInfos  []*RequestCancelInfo
 		DeleteRequestCancelInfo   *int64
+		UpsertSignalInfos         []*SignalInfo
+		DeleteSignalInfo          *int64
 	}
 
 	// DeleteWorkflowExecutionRequest is used to delete a workflow execution
@@ -538,6 +611,8 @@ type (
 	GetWorkflowExecutionHistoryRequest struct {
 		DomainID  string
 		Execution workflow.WorkflowExecution
+		// Get the history events from FirstEventID.  Inclusive.  Zero reads from the start of the history.
+		FirstEventID int64
 		// Get the history events upto NextEventID.  Not Inclusive.
 		NextEventID int64
 		// Maximum number of history append transactions per page
@@ -555,6 +630,20 @@ type (
 		NextPageToken []byte
 	}
 
+	// GetWorkflowExecutionLastHistoryBatchRequest is used to retrieve the last batch of history events of a
+	// workflow execution
+	GetWorkflowExecutionLastHistoryBatchRequest struct {
+		DomainID  string
+		Execution workflow.WorkflowExecution
+		// Get the last batch of history events before NextEventID.  Not Inclusive.
+		NextEventID int64
+	}
+
+	// GetWorkflowExecutionLastHistoryBatchResponse is the response to GetWorkflowExecutionLastHistoryBatchRequest
+	GetWorkflowExecutionLastHistoryBatchResponse struct {
+		Events SerializedHistoryEventBatch
+	}
+
 	// DeleteWorkflowExecutionHistoryRequest is used to delete workflow execution history
 	DeleteWorkflowExecutionHistoryRequest struct {
 		DomainID  string
@@ -574,6 +663,11 @@ type (
 	DomainConfig struct {
 		Retention  int32
 		EmitMetric bool
+		// quotas on the requests per second of the domain, by class of API.  Zero means no quota.
+		StartWorkflowRPS  int32
+		SignalWorkflowRPS int32
+		PollRPS           int32
+		HistoryReadRPS    int32
 	}
 
 	// CreateDomainRequest is used to create the domain
@@ -670,6 +764,10 @@ type (
 		// GetWorkflowExecutionHistory retrieves the paginated list of history events for given execution
 		GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse,
 			error)
+		// GetWorkflowExecutionLastHistoryBatch retrieves the last history append transaction of given execution,
+		// which holds the close event once the execution is closed
+		GetWorkflowExecutionLastHistoryBatch(request *GetWorkflowExecutionLastHistoryBatchRequest) (
+			*GetWorkflowExecutionLastHistoryBatchResponse, error)
 		DeleteWorkflowExecutionHistory(request *DeleteWorkflowExecutionHistoryRequest) error
 	}
 
@@ -745,6 +843,36 @@ func (a *DeleteExecutionTask) SetTaskID(id int64) {
 	a.TaskID = id
 }
 
+// GetType returns the type of the record workflow started task
+func (a *RecordWorkflowStartedTask) GetType() int {
+	return TransferTaskTypeRecordWorkflowStarted
+}
+
+// GetTaskID returns the sequence ID of the record workflow started task
+func (a *RecordWorkflowStartedTask) GetTaskID() int64 {
+	return a.TaskID
+}
+
+// SetTaskID sets the sequence ID of the record workflow started task
+func (a *RecordWorkflowStartedTask) SetTaskID(id int64) {
+	a.TaskID = id
+}
+
+// GetType returns the type of the upsert search attributes task
+func (a *UpsertWorkflowSearchAttributesTask) GetType() int {
+	return TransferTaskTypeUpsertWorkflowSearchAttributes
+}
+
+// GetTaskID returns the sequence ID of the upsert search attributes task
+func (a *UpsertWorkflowSearchAttributesTask) GetTaskID() int64 {
+	return a.TaskID
+}
+
+// SetTaskID sets the sequence ID of the upsert search attributes task
+func (a *UpsertWorkflowSearchAttributesTask) SetTaskID(id int64) {
+	a.TaskID = id
+}
+
 // GetType returns the type of the delete execution task
 func (a *DeleteHistoryEventTask) GetType() int {
 	return TaskTypeDeleteHistoryEvent
@@ -835,6 +963,56 @@ func (u *UserTimerTask) SetVisibilityTimestamp(t time.Time) {
 	u.VisibilityTimestamp = t
 }
 
+// GetType returns the type of the retry timer task
+func (r *ActivityRetryTimerTask) GetType() int {
+	return TaskTypeActivityRetryTimer
+}
+
+// GetTaskID returns the sequence ID of the retry timer task.
+func (r *ActivityRetryTimerTask) GetTaskID() int64 {
+	return r.TaskID
+}
+
+// SetTaskID sets the sequence ID of the retry timer task.
+func (r *ActivityRetryTimerTask) SetTaskID(id int64) {
+	r.TaskID = id
+}
+
+// GetVisibilityTimestamp gets the visibility time stamp
+func (r *ActivityRetryTimerTask) GetVisibilityTimestamp() time.Time {
+	return r.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp gets the visibility time stamp
+func (r *ActivityRetryTimerTask) SetVisibilityTimestamp(t time.Time) {
+	r.VisibilityTimestamp = t
+}
+
+// GetType returns the type of the backoff timer task
+func (r *WorkflowBackoffTimerTask) GetType() int {
+	return TaskTypeWorkflowBackoffTimer
+}
+
+// GetTaskID returns the sequence ID of the backoff timer task.
+func (r *WorkflowBackoffTimerTask) GetTaskID() int64 {
+	return r.TaskID
+}
+
+// SetTaskID sets the sequence ID of the backoff timer task.
+func (r *WorkflowBackoffTimerTask) SetTaskID(id int64) {
+	r.TaskID = id
+}
+
+// GetVisibilityTimestamp gets the visibility time stamp
+func (r *WorkflowBackoffTimerTask) GetVisibilityTimestamp() time.Time {
+	return r.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp gets the visibility time stamp
+func (r *WorkflowBackoffTimerTask) SetVisibilityTimestamp(t time.Time) {
+	r.VisibilityTimestamp = t
+}
+
 // GetType returns the type of the timeout task.
 func (u *WorkflowTimeoutTask) GetType() int {
 	return TaskTypeWorkflowTimeout
@@ -875,6 +1053,21 @@ func (u *CancelExecutionTask) SetTaskID(id int64) {
 	u.TaskID = id
 }
 
+// GetType returns the type of the signal transfer task
+func (u *SignalExecutionTask) GetType() int {
+	return TransferTaskTypeSignalExecution
+}
+
+// GetTaskID returns the sequence ID of the signal transfer task.
+func (u *SignalExecutionTask) GetTaskID() int64 {
+	return u.TaskID
+}
+
+// SetTaskID sets the sequence ID of the signal transfer task.
+func (u *SignalExecutionTask) SetTaskID(id int64) {
+	u.TaskID = id
+}
+
 // GetType returns the type of the cancel transfer task
 func (u *StartChildExecutionTask) GetType() int {
 	return TransferTaskTypeStartChildExecution
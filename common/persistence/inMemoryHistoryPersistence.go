@@ -0,0 +1,186 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	inMemoryHistoryKey struct {
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
+	inMemoryHistoryBatch struct {
+		rangeID       int64
+		transactionID int64
+		events        SerializedHistoryEventBatch
+	}
+
+	inMemoryHistoryPersistence struct {
+		store  *InMemoryStore
+		logger bark.Logger
+	}
+)
+
+// NewInMemoryHistoryPersistence is used to create an instance of HistoryManager implementation
+func NewInMemoryHistoryPersistence(store *InMemoryStore, logger bark.Logger) (HistoryManager, error) {
+	return &inMemoryHistoryPersistence{store: store, logger: logger}, nil
+}
+
+// Close releases the resources held by this object
+func (h *inMemoryHistoryPersistence) Close() {
+}
+
+func (h *inMemoryHistoryPersistence) AppendHistoryEvents(request *AppendHistoryEventsRequest) error {
+	h.store.lock.Lock()
+	defer h.store.lock.Unlock()
+
+	key := newInMemoryHistoryKey(request.DomainID, request.Execution)
+	batches, ok := h.store.events[key]
+	if !ok {
+		batches = make(map[int64]*inMemoryHistoryBatch)
+		h.store.events[key] = batches
+	}
+
+	existing, exists := batches[request.FirstEventID]
+	if request.Overwrite {
+		// Overwrite is only allowed by a writer holding the same or a newer range for the shard, and only once per
+		// transaction
+		if !exists || existing.rangeID > request.RangeID || existing.transactionID >= request.TransactionID {
+			return &ConditionFailedError{
+				Msg: "Failed to append history events.",
+			}
+		}
+	} else if exists {
+		return &ConditionFailedError{
+			Msg: "Failed to append history events.",
+		}
+	}
+
+	data := make([]byte, len(request.Events.Data))
+	copy(data, request.Events.Data)
+	batches[request.FirstEventID] = &inMemoryHistoryBatch{
+		rangeID:       request.RangeID,
+		transactionID: request.TransactionID,
+		events: SerializedHistoryEventBatch{
+			EncodingType: request.Events.EncodingType,
+			Version:      request.Events.Version,
+			Data:         data,
+		},
+	}
+
+	return nil
+}
+
+func (h *inMemoryHistoryPersistence) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (
+	*GetWorkflowExecutionHistoryResponse, error) {
+	offset, err := getPageTokenOffset(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	h.store.lock.Lock()
+	defer h.store.lock.Unlock()
+
+	execution := request.Execution
+	batches := h.store.events[newInMemoryHistoryKey(request.DomainID, execution)]
+
+	var firstEventIDs []int64
+	for firstEventID := range batches {
+		if firstEventID >= request.FirstEventID && firstEventID < request.NextEventID {
+			firstEventIDs = append(firstEventIDs, firstEventID)
+		}
+	}
+	sort.Sort(int64s(firstEventIDs))
+
+	end := len(firstEventIDs)
+	if request.PageSize > 0 && offset+request.PageSize < end {
+		end = offset + request.PageSize
+	}
+
+	response := &GetWorkflowExecutionHistoryResponse{}
+	for i := offset; i < end; i++ {
+		response.Events = append(response.Events, batches[firstEventIDs[i]].events)
+	}
+	response.NextPageToken = newOffsetPageToken(end, len(firstEventIDs))
+
+	if len(response.Events) == 0 {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution history not found.  WorkflowId: %v, RunId: %v",
+				*execution.WorkflowId, *execution.RunId),
+		}
+	}
+
+	return response, nil
+}
+
+func (h *inMemoryHistoryPersistence) GetWorkflowExecutionLastHistoryBatch(
+	request *GetWorkflowExecutionLastHistoryBatchRequest) (*GetWorkflowExecutionLastHistoryBatchResponse, error) {
+	h.store.lock.Lock()
+	defer h.store.lock.Unlock()
+
+	execution := request.Execution
+	batches := h.store.events[newInMemoryHistoryKey(request.DomainID, execution)]
+
+	var last *inMemoryHistoryBatch
+	lastFirstEventID := int64(0)
+	for firstEventID, batch := range batches {
+		if firstEventID < request.NextEventID && (last == nil || firstEventID > lastFirstEventID) {
+			last = batch
+			lastFirstEventID = firstEventID
+		}
+	}
+
+	if last == nil {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution history not found.  WorkflowId: %v, RunId: %v",
+				*execution.WorkflowId, *execution.RunId),
+		}
+	}
+
+	return &GetWorkflowExecutionLastHistoryBatchResponse{Events: last.events}, nil
+}
+
+func (h *inMemoryHistoryPersistence) DeleteWorkflowExecutionHistory(
+	request *DeleteWorkflowExecutionHistoryRequest) error {
+	h.store.lock.Lock()
+	defer h.store.lock.Unlock()
+
+	delete(h.store.events, newInMemoryHistoryKey(request.DomainID, request.Execution))
+
+	return nil
+}
+
+func newInMemoryHistoryKey(domainID string, execution workflow.WorkflowExecution) inMemoryHistoryKey {
+	return inMemoryHistoryKey{
+		domainID:   domainID,
+		workflowID: *execution.WorkflowId,
+		runID:      *execution.RunId,
+	}
+}
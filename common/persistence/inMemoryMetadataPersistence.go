@@ -0,0 +1,155 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+
+	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	inMemoryDomain struct {
+		info   DomainInfo
+		config DomainConfig
+	}
+
+	inMemoryMetadataPersistence struct {
+		store  *InMemoryStore
+		logger bark.Logger
+	}
+)
+
+// NewInMemoryMetadataPersistence is used to create an instance of MetadataManager implementation
+func NewInMemoryMetadataPersistence(store *InMemoryStore, logger bark.Logger) (MetadataManager, error) {
+	return &inMemoryMetadataPersistence{store: store, logger: logger}, nil
+}
+
+// Close releases the resources held by this object
+func (m *inMemoryMetadataPersistence) Close() {
+}
+
+// Domains are kept in two maps, by ID and by name, to match the domains and domains_by_name tables used by the
+// Cassandra implementation.  DeleteDomain and DeleteDomainByName only remove the entry from their own map.
+func (m *inMemoryMetadataPersistence) CreateDomain(request *CreateDomainRequest) (*CreateDomainResponse, error) {
+	m.store.lock.Lock()
+	defer m.store.lock.Unlock()
+
+	if existing, ok := m.store.domainsByName[request.Name]; ok {
+		return nil, &workflow.DomainAlreadyExistsError{
+			Message: fmt.Sprintf("Domain already exists.  DomainId: %v", existing.info.ID),
+		}
+	}
+
+	domainUUID := uuid.New()
+	domain := &inMemoryDomain{
+		info: DomainInfo{
+			ID:          domainUUID,
+			Name:        request.Name,
+			Status:      request.Status,
+			Description: request.Description,
+			OwnerEmail:  request.OwnerEmail,
+		},
+		config: DomainConfig{
+			Retention:  request.Retention,
+			EmitMetric: request.EmitMetric,
+		},
+	}
+	m.store.domains[domainUUID] = domain
+	m.store.domainsByName[request.Name] = domain
+
+	return &CreateDomainResponse{ID: domainUUID}, nil
+}
+
+func (m *inMemoryMetadataPersistence) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
+	var domain *inMemoryDomain
+	var ok bool
+	var d string
+
+	m.store.lock.Lock()
+	defer m.store.lock.Unlock()
+
+	if len(request.ID) > 0 {
+		if len(request.Name) > 0 {
+			return nil, &workflow.BadRequestError{
+				Message: "GetDomain operation failed.  Both ID and Name specified in request.",
+			}
+		}
+
+		d = request.ID
+		domain, ok = m.store.domains[request.ID]
+	} else if len(request.Name) > 0 {
+		d = request.Name
+		domain, ok = m.store.domainsByName[request.Name]
+	} else {
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name are empty.",
+		}
+	}
+
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Domain %s does not exist.", d),
+		}
+	}
+
+	info := domain.info
+	config := domain.config
+	return &GetDomainResponse{
+		Info:   &info,
+		Config: &config,
+	}, nil
+}
+
+func (m *inMemoryMetadataPersistence) UpdateDomain(request *UpdateDomainRequest) error {
+	m.store.lock.Lock()
+	defer m.store.lock.Unlock()
+
+	domain := &inMemoryDomain{
+		info:   *request.Info,
+		config: *request.Config,
+	}
+	m.store.domains[request.Info.ID] = domain
+	m.store.domainsByName[request.Info.Name] = domain
+
+	return nil
+}
+
+func (m *inMemoryMetadataPersistence) DeleteDomain(request *DeleteDomainRequest) error {
+	m.store.lock.Lock()
+	defer m.store.lock.Unlock()
+
+	delete(m.store.domains, request.ID)
+
+	return nil
+}
+
+func (m *inMemoryMetadataPersistence) DeleteDomainByName(request *DeleteDomainByNameRequest) error {
+	m.store.lock.Lock()
+	defer m.store.lock.Unlock()
+
+	delete(m.store.domainsByName, request.Name)
+
+	return nil
+}
@@ -0,0 +1,888 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	// InMemoryStore holds all the rows backing the in-memory persistence managers.  Managers created on top of the
+	// same store observe each other's writes, the same way the Cassandra managers share a keyspace.
+	InMemoryStore struct {
+		lock              sync.Mutex
+		shards            map[int]*ShardInfo
+		currentExecutions map[inMemoryCurrentExecutionKey]*inMemoryCurrentExecution
+		executions        map[inMemoryExecutionKey]*WorkflowMutableState
+		transferTasks     map[int]map[int64]*TransferTaskInfo
+		timerTasks        map[int]map[inMemoryTimerTaskKey]*TimerTaskInfo
+		taskLists         map[inMemoryTaskListKey]*TaskListInfo
+		tasks             map[inMemoryTaskListKey]map[int64]*inMemoryTask
+		events            map[inMemoryHistoryKey]map[int64]*inMemoryHistoryBatch
+		domains           map[string]*inMemoryDomain
+		domainsByName     map[string]*inMemoryDomain
+		openExecutions    map[inMemoryVisibilityKey]*inMemoryVisibilityRecord
+		closedExecutions  map[inMemoryVisibilityKey]*inMemoryVisibilityRecord
+	}
+
+	inMemoryCurrentExecutionKey struct {
+		shardID    int
+		domainID   string
+		workflowID string
+	}
+
+	inMemoryCurrentExecution struct {
+		runID           string
+		createRequestID string
+		state           int
+		closeStatus     int
+	}
+
+	inMemoryExecutionKey struct {
+		shardID    int
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
+	inMemoryTimerTaskKey struct {
+		visibilityTS int64
+		taskID       int64
+	}
+
+	inMemoryTaskListKey struct {
+		domainID string
+		name     string
+		taskType int
+	}
+
+	inMemoryTask struct {
+		info   *TaskInfo
+		expiry time.Time
+	}
+
+	inMemoryPersistence struct {
+		store   *InMemoryStore
+		shardID int
+		logger  bark.Logger
+	}
+
+	timerTaskInfosByVisibilityTS []*TimerTaskInfo
+
+	int64s []int64
+)
+
+// NewInMemoryStore creates an empty store to be shared by the in-memory persistence managers
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		shards:            make(map[int]*ShardInfo),
+		currentExecutions: make(map[inMemoryCurrentExecutionKey]*inMemoryCurrentExecution),
+		executions:        make(map[inMemoryExecutionKey]*WorkflowMutableState),
+		transferTasks:     make(map[int]map[int64]*TransferTaskInfo),
+		timerTasks:        make(map[int]map[inMemoryTimerTaskKey]*TimerTaskInfo),
+		taskLists:         make(map[inMemoryTaskListKey]*TaskListInfo),
+		tasks:             make(map[inMemoryTaskListKey]map[int64]*inMemoryTask),
+		events:            make(map[inMemoryHistoryKey]map[int64]*inMemoryHistoryBatch),
+		domains:           make(map[string]*inMemoryDomain),
+		domainsByName:     make(map[string]*inMemoryDomain),
+		openExecutions:    make(map[inMemoryVisibilityKey]*inMemoryVisibilityRecord),
+		closedExecutions:  make(map[inMemoryVisibilityKey]*inMemoryVisibilityRecord),
+	}
+}
+
+// NewInMemoryShardPersistence is used to create an instance of ShardManager implementation
+func NewInMemoryShardPersistence(store *InMemoryStore, logger bark.Logger) (ShardManager, error) {
+	return &inMemoryPersistence{store: store, shardID: -1, logger: logger}, nil
+}
+
+// NewInMemoryWorkflowExecutionPersistence is used to create an instance of workflowExecutionManager implementation
+func NewInMemoryWorkflowExecutionPersistence(store *InMemoryStore, shardID int, logger bark.Logger) (ExecutionManager,
+	error) {
+	return &inMemoryPersistence{store: store, shardID: shardID, logger: logger}, nil
+}
+
+// NewInMemoryTaskPersistence is used to create an instance of TaskManager implementation
+func NewInMemoryTaskPersistence(store *InMemoryStore, logger bark.Logger) (TaskManager, error) {
+	return &inMemoryPersistence{store: store, shardID: -1, logger: logger}, nil
+}
+
+// Close releases the resources held by this object
+func (d *inMemoryPersistence) Close() {
+}
+
+func (d *inMemoryPersistence) CreateShard(request *CreateShardRequest) error {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	shardInfo := request.ShardInfo
+	if shard, ok := d.store.shards[shardInfo.ShardID]; ok {
+		return &ShardAlreadyExistError{
+			Msg: fmt.Sprintf("Shard already exists in executions table.  ShardId: %v, RangeId: %v",
+				shard.ShardID, shard.RangeID),
+		}
+	}
+
+	shard := cloneShardInfo(shardInfo)
+	shard.UpdatedAt = time.Now()
+	d.store.shards[shard.ShardID] = shard
+
+	return nil
+}
+
+func (d *inMemoryPersistence) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	shard, ok := d.store.shards[request.ShardID]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Shard not found.  ShardId: %v", request.ShardID),
+		}
+	}
+
+	return &GetShardResponse{ShardInfo: cloneShardInfo(shard)}, nil
+}
+
+func (d *inMemoryPersistence) UpdateShard(request *UpdateShardRequest) error {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	shardInfo := request.ShardInfo
+	shard, ok := d.store.shards[shardInfo.ShardID]
+	if !ok || shard.RangeID != request.PreviousRangeID {
+		actualRangeID := int64(-1)
+		if ok {
+			actualRangeID = shard.RangeID
+		}
+		return &ShardOwnershipLostError{
+			ShardID: shardInfo.ShardID,
+			Msg: fmt.Sprintf("Failed to update shard.  previous_range_id: %v, range_id: %v",
+				request.PreviousRangeID, actualRangeID),
+		}
+	}
+
+	shard = cloneShardInfo(shardInfo)
+	shard.UpdatedAt = time.Now()
+	d.store.shards[shard.ShardID] = shard
+
+	return nil
+}
+
+func (d *inMemoryPersistence) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (
+	*CreateWorkflowExecutionResponse, error) {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	if err := d.validateRangeID(request.RangeID, "Failed to create workflow execution"); err != nil {
+		return nil, err
+	}
+
+	if !request.ContinueAsNew {
+		currentKey := d.currentExecutionKey(request.DomainID, *request.Execution.WorkflowId)
+		if current, ok := d.store.currentExecutions[currentKey]; ok && current.runID != request.PreviousRunID {
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v, rangeID: %v",
+				*request.Execution.WorkflowId, current.runID, request.RangeID)
+			return nil, &workflow.WorkflowExecutionAlreadyStartedError{
+				Message:        common.StringPtr(msg),
+				StartRequestId: common.StringPtr(current.createRequestID),
+				RunId:          common.StringPtr(current.runID),
+			}
+		}
+	}
+
+	d.createWorkflowExecutionLocked(request, time.Now())
+	d.createTransferTasksLocked(request.TransferTasks, request.DomainID, *request.Execution.WorkflowId,
+		*request.Execution.RunId)
+	d.createTimerTasksLocked(request.TimerTasks, nil, request.DomainID, *request.Execution.WorkflowId,
+		*request.Execution.RunId)
+
+	return &CreateWorkflowExecutionResponse{TaskID: uuid.New()}, nil
+}
+
+func (d *inMemoryPersistence) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (
+	*GetWorkflowExecutionResponse, error) {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	execution := request.Execution
+	state, ok := d.store.executions[d.executionKey(request.DomainID, *execution.WorkflowId, *execution.RunId)]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+				*execution.WorkflowId, *execution.RunId),
+		}
+	}
+
+	return &GetWorkflowExecutionResponse{State: cloneWorkflowMutableState(state)}, nil
+}
+
+func (d *inMemoryPersistence) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) error {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	if err := d.validateRangeID(request.RangeID, "Failed to update workflow execution"); err != nil {
+		return err
+	}
+
+	executionInfo := request.ExecutionInfo
+	key := d.executionKey(executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID)
+	state, ok := d.store.executions[key]
+	if !ok {
+		return &ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to update workflow execution.  RangeID: %v, Condition: %v, execution not found",
+				request.RangeID, request.Condition),
+		}
+	}
+
+	if state.ExecutionInfo.NextEventID != request.Condition {
+		return &ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to update workflow execution.  Request Condition: %v, Actual Value: %v",
+				request.Condition, state.ExecutionInfo.NextEventID),
+		}
+	}
+
+	now := time.Now()
+	info := cloneWorkflowExecutionInfo(executionInfo)
+	info.LastUpdatedTimestamp = now
+	state.ExecutionInfo = info
+
+	d.createTransferTasksLocked(request.TransferTasks, executionInfo.DomainID, executionInfo.WorkflowID,
+		executionInfo.RunID)
+	d.createTimerTasksLocked(request.TimerTasks, request.DeleteTimerTask, executionInfo.DomainID,
+		executionInfo.WorkflowID, executionInfo.RunID)
+
+	for _, a := range request.UpsertActivityInfos {
+		state.ActivitInfos[a.ScheduleID] = cloneActivityInfo(a)
+	}
+	if request.DeleteActivityInfo != nil {
+		delete(state.ActivitInfos, *request.DeleteActivityInfo)
+	}
+
+	for _, t := range request.UpserTimerInfos {
+		state.TimerInfos[t.TimerID] = cloneTimerInfo(t)
+	}
+	for _, timerID := range request.DeleteTimerInfos {
+		delete(state.TimerInfos, timerID)
+	}
+
+	for _, c := range request.UpsertChildExecutionInfos {
+		state.ChildExecutionInfos[c.InitiatedID] = cloneChildExecutionInfo(c)
+	}
+	if request.DeleteChildExecutionInfo != nil {
+		delete(state.ChildExecutionInfos, *request.DeleteChildExecutionInfo)
+	}
+
+	for _, r := range request.UpsertRequestCancelInfos {
+		state.RequestCancelInfos[r.InitiatedID] = cloneRequestCancelInfo(r)
+	}
+	if request.DeleteRequestCancelInfo != nil {
+		delete(state.RequestCancelInfos, *request.DeleteRequestCancelInfo)
+	}
+
+	for _, si := range request.UpsertSignalInfos {
+		state.SignalInfos[si.InitiatedID] = cloneSignalInfo(si)
+	}
+	if request.DeleteSignalInfo != nil {
+		delete(state.SignalInfos, *request.DeleteSignalInfo)
+	}
+
+	if request.ContinueAsNew != nil {
+		startReq := request.ContinueAsNew
+		d.createWorkflowExecutionLocked(startReq, now)
+		d.createTransferTasksLocked(startReq.TransferTasks, startReq.DomainID, *startReq.Execution.WorkflowId,
+			*startReq.Execution.RunId)
+		d.createTimerTasksLocked(startReq.TimerTasks, nil, startReq.DomainID, *startReq.Execution.WorkflowId,
+			*startReq.Execution.RunId)
+	} else if request.CloseExecution {
+		// Keep the close status of the run on the current execution to enforce the WorkflowIdReusePolicy
+		currentKey := d.currentExecutionKey(executionInfo.DomainID, executionInfo.WorkflowID)
+		if current, ok := d.store.currentExecutions[currentKey]; ok && current.runID == executionInfo.RunID {
+			current.state = executionInfo.State
+			current.closeStatus = executionInfo.CloseStatus
+		}
+	}
+
+	return nil
+}
+
+func (d *inMemoryPersistence) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	info := request.ExecutionInfo
+	delete(d.store.executions, d.executionKey(info.DomainID, info.WorkflowID, info.RunID))
+
+	return nil
+}
+
+func (d *inMemoryPersistence) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse,
+	error) {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	current, ok := d.store.currentExecutions[d.currentExecutionKey(request.DomainID, request.WorkflowID)]
+	if !ok {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v",
+				request.WorkflowID),
+		}
+	}
+
+	return &GetCurrentExecutionResponse{
+		RunID:          current.runID,
+		StartRequestID: current.createRequestID,
+		State:          current.state,
+		CloseStatus:    current.closeStatus,
+	}, nil
+}
+
+func (d *inMemoryPersistence) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	var taskIDs []int64
+	for taskID := range d.store.transferTasks[d.shardID] {
+		if taskID > request.ReadLevel && taskID <= request.MaxReadLevel {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	sort.Sort(int64s(taskIDs))
+
+	response := &GetTransferTasksResponse{}
+	for _, taskID := range taskIDs {
+		if len(response.Tasks) == request.BatchSize {
+			break
+		}
+		t := *d.store.transferTasks[d.shardID][taskID]
+		response.Tasks = append(response.Tasks, &t)
+	}
+
+	return response, nil
+}
+
+func (d *inMemoryPersistence) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	delete(d.store.transferTasks[d.shardID], request.TaskID)
+
+	return nil
+}
+
+func (d *inMemoryPersistence) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse,
+	error) {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	minTimestamp := common.UnixNanoToCQLTimestamp(request.MinTimestamp.UnixNano())
+	maxTimestamp := common.UnixNanoToCQLTimestamp(request.MaxTimestamp.UnixNano())
+
+	var timers timerTaskInfosByVisibilityTS
+	for key, timer := range d.store.timerTasks[d.shardID] {
+		if key.visibilityTS >= minTimestamp && key.visibilityTS < maxTimestamp {
+			t := *timer
+			timers = append(timers, &t)
+		}
+	}
+	sort.Sort(timers)
+
+	if len(timers) > request.BatchSize {
+		timers = timers[:request.BatchSize]
+	}
+
+	return &GetTimerIndexTasksResponse{Timers: timers}, nil
+}
+
+func (d *inMemoryPersistence) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	delete(d.store.timerTasks[d.shardID], inMemoryTimerTaskKey{
+		visibilityTS: common.UnixNanoToCQLTimestamp(request.VisibilityTimestamp.UnixNano()),
+		taskID:       request.TaskID,
+	})
+
+	return nil
+}
+
+// From TaskManager interface
+func (d *inMemoryPersistence) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
+	if len(request.TaskList) == 0 {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("LeaseTaskList requires non empty task list"),
+		}
+	}
+
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	key := inMemoryTaskListKey{domainID: request.DomainID, name: request.TaskList, taskType: request.TaskType}
+	tl, ok := d.store.taskLists[key]
+	if !ok {
+		// First time task list is used
+		tl = &TaskListInfo{
+			DomainID: request.DomainID,
+			Name:     request.TaskList,
+			TaskType: request.TaskType,
+			RangeID:  initialRangeID - 1,
+			AckLevel: 0,
+		}
+		d.store.taskLists[key] = tl
+	}
+	tl.RangeID++
+
+	tli := *tl
+	return &LeaseTaskListResponse{TaskListInfo: &tli}, nil
+}
+
+// From TaskManager interface
+func (d *inMemoryPersistence) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	tli := request.TaskListInfo
+	key := inMemoryTaskListKey{domainID: tli.DomainID, name: tli.Name, taskType: tli.TaskType}
+	tl, ok := d.store.taskLists[key]
+	if !ok || tl.RangeID != tli.RangeID {
+		return nil, &ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to update task list. name: %v, type: %v, rangeID: %v",
+				tli.Name, tli.TaskType, tli.RangeID),
+		}
+	}
+
+	tl.AckLevel = tli.AckLevel
+
+	return &UpdateTaskListResponse{}, nil
+}
+
+// From TaskManager interface
+func (d *inMemoryPersistence) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	tli := request.TaskListInfo
+	key := inMemoryTaskListKey{domainID: tli.DomainID, name: tli.Name, taskType: tli.TaskType}
+	tl, ok := d.store.taskLists[key]
+	if !ok || tl.RangeID != tli.RangeID {
+		dbRangeID := int64(-1)
+		if ok {
+			dbRangeID = tl.RangeID
+		}
+		return nil, &ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to create task. TaskList: %v, taskListType: %v, rangeID: %v, db rangeID: %v",
+				tli.Name, tli.TaskType, tli.RangeID, dbRangeID),
+		}
+	}
+
+	tasks, ok := d.store.tasks[key]
+	if !ok {
+		tasks = make(map[int64]*inMemoryTask)
+		d.store.tasks[key] = tasks
+	}
+
+	now := time.Now()
+	for _, task := range request.Tasks {
+		t := &inMemoryTask{
+			info: &TaskInfo{
+				DomainID:   tli.DomainID,
+				WorkflowID: *task.Execution.WorkflowId,
+				RunID:      *task.Execution.RunId,
+				TaskID:     task.TaskID,
+				ScheduleID: task.Data.ScheduleID,
+			},
+		}
+		// Tasks with a schedule to start timeout expire the same way the Cassandra rows are written with a TTL
+		if task.Data.ScheduleToStartTimeout != 0 {
+			t.expiry = now.Add(time.Duration(task.Data.ScheduleToStartTimeout) * time.Second)
+		}
+		tasks[task.TaskID] = t
+	}
+
+	return &CreateTasksResponse{}, nil
+}
+
+// From TaskManager interface
+func (d *inMemoryPersistence) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	if request.ReadLevel > request.MaxReadLevel {
+		return &GetTasksResponse{}, nil
+	}
+
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	key := inMemoryTaskListKey{domainID: request.DomainID, name: request.TaskList, taskType: request.TaskType}
+	tasks := d.store.tasks[key]
+
+	now := time.Now()
+	var taskIDs []int64
+	for taskID, task := range tasks {
+		if !task.expiry.IsZero() && !now.Before(task.expiry) {
+			delete(tasks, taskID)
+			continue
+		}
+		if taskID > request.ReadLevel && taskID <= request.MaxReadLevel {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	sort.Sort(int64s(taskIDs))
+
+	response := &GetTasksResponse{}
+	for _, taskID := range taskIDs {
+		if len(response.Tasks) == request.BatchSize {
+			break
+		}
+		t := *tasks[taskID].info
+		response.Tasks = append(response.Tasks, &t)
+	}
+
+	return response, nil
+}
+
+// From TaskManager interface
+func (d *inMemoryPersistence) CompleteTask(request *CompleteTaskRequest) error {
+	d.store.lock.Lock()
+	defer d.store.lock.Unlock()
+
+	tli := request.TaskList
+	key := inMemoryTaskListKey{domainID: tli.DomainID, name: tli.Name, taskType: tli.TaskType}
+	delete(d.store.tasks[key], request.TaskID)
+
+	return nil
+}
+
+// validateRangeID mirrors the conditional lease update Cassandra adds to every execution batch.  Caller must hold
+// the store lock.
+func (d *inMemoryPersistence) validateRangeID(rangeID int64, operation string) error {
+	shard, ok := d.store.shards[d.shardID]
+	if !ok {
+		return &ConditionFailedError{
+			Msg: fmt.Sprintf("%v.  Shard not found.  ShardId: %v", operation, d.shardID),
+		}
+	}
+
+	if shard.RangeID != rangeID {
+		return &ShardOwnershipLostError{
+			ShardID: d.shardID,
+			Msg: fmt.Sprintf("%v.  Request RangeID: %v, Actual RangeID: %v",
+				operation, rangeID, shard.RangeID),
+		}
+	}
+
+	return nil
+}
+
+func (d *inMemoryPersistence) createWorkflowExecutionLocked(request *CreateWorkflowExecutionRequest, now time.Time) {
+	d.store.currentExecutions[d.currentExecutionKey(request.DomainID, *request.Execution.WorkflowId)] =
+		&inMemoryCurrentExecution{
+			runID:           *request.Execution.RunId,
+			createRequestID: request.RequestID,
+			state:           WorkflowStateCreated,
+			closeStatus:     WorkflowCloseStatusNone,
+		}
+
+	parentDomainID := emptyDomainID
+	parentWorkflowID := ""
+	parentRunID := emptyRunID
+	initiatedID := emptyInitiatedID
+	if request.ParentExecution != nil {
+		parentDomainID = request.ParentDomainID
+		parentWorkflowID = *request.ParentExecution.WorkflowId
+		parentRunID = *request.ParentExecution.RunId
+		initiatedID = request.InitiatedID
+	}
+
+	info := &WorkflowExecutionInfo{
+		DomainID:             request.DomainID,
+		WorkflowID:           *request.Execution.WorkflowId,
+		RunID:                *request.Execution.RunId,
+		ParentDomainID:       parentDomainID,
+		ParentWorkflowID:     parentWorkflowID,
+		ParentRunID:          parentRunID,
+		InitiatedID:          initiatedID,
+		TaskList:             request.TaskList,
+		WorkflowTypeName:     request.WorkflowTypeName,
+		DecisionTimeoutValue: request.DecisionTimeoutValue,
+		ExecutionContext:     request.ExecutionContext,
+		State:                WorkflowStateCreated,
+		CloseStatus:          WorkflowCloseStatusNone,
+		NextEventID:          request.NextEventID,
+		LastProcessedEvent:   request.LastProcessedEvent,
+		StartTimestamp:       now,
+		LastUpdatedTimestamp: now,
+		CreateRequestID:      request.RequestID,
+		DecisionScheduleID:   request.DecisionScheduleID,
+		DecisionStartedID:    request.DecisionStartedID,
+		DecisionRequestID:    "",
+		DecisionTimeout:      request.DecisionStartToCloseTimeout,
+		CancelRequested:      false,
+		CancelRequestID:      "",
+		CronSchedule:         request.CronSchedule,
+		Attempt:              request.Attempt,
+		HasRetryPolicy:       request.HasRetryPolicy,
+		SearchAttributes:     cloneSearchAttributes(request.SearchAttributes),
+	}
+
+	d.store.executions[d.executionKey(request.DomainID, *request.Execution.WorkflowId, *request.Execution.RunId)] =
+		&WorkflowMutableState{
+			ExecutionInfo:       info,
+			ActivitInfos:        make(map[int64]*ActivityInfo),
+			TimerInfos:          make(map[string]*TimerInfo),
+			ChildExecutionInfos: make(map[int64]*ChildExecutionInfo),
+			RequestCancelInfos:  make(map[int64]*RequestCancelInfo),
+			SignalInfos:         make(map[int64]*SignalInfo),
+		}
+}
+
+func (d *inMemoryPersistence) createTransferTasksLocked(transferTasks []Task, domainID, workflowID, runID string) {
+	tasks, ok := d.store.transferTasks[d.shardID]
+	if !ok {
+		tasks = make(map[int64]*TransferTaskInfo)
+		d.store.transferTasks[d.shardID] = tasks
+	}
+
+	for _, task := range transferTasks {
+		var taskList string
+		var scheduleID int64
+		targetDomainID := domainID
+		targetWorkflowID := transferTaskTransferTargetWorkflowID
+		targetRunID := transferTaskTypeTransferTargetRunID
+
+		switch task.GetType() {
+		case TransferTaskTypeActivityTask:
+			targetDomainID = task.(*ActivityTask).DomainID
+			taskList = task.(*ActivityTask).TaskList
+			scheduleID = task.(*ActivityTask).ScheduleID
+
+		case TransferTaskTypeDecisionTask:
+			targetDomainID = task.(*DecisionTask).DomainID
+			taskList = task.(*DecisionTask).TaskList
+			scheduleID = task.(*DecisionTask).ScheduleID
+
+		case TransferTaskTypeCancelExecution:
+			targetDomainID = task.(*CancelExecutionTask).TargetDomainID
+			targetWorkflowID = task.(*CancelExecutionTask).TargetWorkflowID
+			targetRunID = task.(*CancelExecutionTask).TargetRunID
+			scheduleID = task.(*CancelExecutionTask).ScheduleID
+
+		case TransferTaskTypeSignalExecution:
+			targetDomainID = task.(*SignalExecutionTask).TargetDomainID
+			targetWorkflowID = task.(*SignalExecutionTask).TargetWorkflowID
+			targetRunID = task.(*SignalExecutionTask).TargetRunID
+			scheduleID = task.(*SignalExecutionTask).InitiatedID
+
+		case TransferTaskTypeStartChildExecution:
+			targetDomainID = task.(*StartChildExecutionTask).TargetDomainID
+			targetWorkflowID = task.(*StartChildExecutionTask).TargetWorkflowID
+			scheduleID = task.(*StartChildExecutionTask).InitiatedID
+		}
+
+		tasks[task.GetTaskID()] = &TransferTaskInfo{
+			DomainID:         domainID,
+			WorkflowID:       workflowID,
+			RunID:            runID,
+			TaskID:           task.GetTaskID(),
+			TargetDomainID:   targetDomainID,
+			TargetWorkflowID: targetWorkflowID,
+			TargetRunID:      targetRunID,
+			TaskList:         taskList,
+			TaskType:         task.GetType(),
+			ScheduleID:       scheduleID,
+		}
+	}
+}
+
+func (d *inMemoryPersistence) createTimerTasksLocked(timerTasks []Task, deleteTimerTask Task, domainID, workflowID,
+	runID string) {
+	tasks, ok := d.store.timerTasks[d.shardID]
+	if !ok {
+		tasks = make(map[inMemoryTimerTaskKey]*TimerTaskInfo)
+		d.store.timerTasks[d.shardID] = tasks
+	}
+
+	for _, task := range timerTasks {
+		var eventID int64
+		var attempt int64
+
+		timeoutType := 0
+
+		switch task.GetType() {
+		case TaskTypeDecisionTimeout:
+			eventID = task.(*DecisionTimeoutTask).EventID
+			timeoutType = task.(*DecisionTimeoutTask).TimeoutType
+
+		case TaskTypeActivityTimeout:
+			eventID = task.(*ActivityTimeoutTask).EventID
+			timeoutType = task.(*ActivityTimeoutTask).TimeoutType
+
+		case TaskTypeUserTimer:
+			eventID = task.(*UserTimerTask).EventID
+
+		case TaskTypeActivityRetryTimer:
+			eventID = task.(*ActivityRetryTimerTask).EventID
+			attempt = int64(task.(*ActivityRetryTimerTask).Attempt)
+		}
+
+		// Timestamps are kept at the same millisecond precision Cassandra stores them with
+		ts := common.UnixNanoToCQLTimestamp(GetVisibilityTSFrom(task).UnixNano())
+		tasks[inMemoryTimerTaskKey{visibilityTS: ts, taskID: task.GetTaskID()}] = &TimerTaskInfo{
+			DomainID:            domainID,
+			WorkflowID:          workflowID,
+			RunID:               runID,
+			VisibilityTimestamp: time.Unix(0, common.CQLTimestampToUnixNano(ts)),
+			TaskID:              task.GetTaskID(),
+			TaskType:            task.GetType(),
+			TimeoutType:         timeoutType,
+			EventID:             eventID,
+			ScheduleAttempt:     attempt,
+		}
+	}
+
+	if deleteTimerTask != nil {
+		ts := common.UnixNanoToCQLTimestamp(GetVisibilityTSFrom(deleteTimerTask).UnixNano())
+		delete(tasks, inMemoryTimerTaskKey{visibilityTS: ts, taskID: deleteTimerTask.GetTaskID()})
+	}
+}
+
+func (d *inMemoryPersistence) currentExecutionKey(domainID, workflowID string) inMemoryCurrentExecutionKey {
+	return inMemoryCurrentExecutionKey{shardID: d.shardID, domainID: domainID, workflowID: workflowID}
+}
+
+func (d *inMemoryPersistence) executionKey(domainID, workflowID, runID string) inMemoryExecutionKey {
+	return inMemoryExecutionKey{shardID: d.shardID, domainID: domainID, workflowID: workflowID, runID: runID}
+}
+
+func cloneShardInfo(info *ShardInfo) *ShardInfo {
+	result := *info
+	return &result
+}
+
+func cloneWorkflowExecutionInfo(info *WorkflowExecutionInfo) *WorkflowExecutionInfo {
+	result := *info
+	result.SearchAttributes = cloneSearchAttributes(info.SearchAttributes)
+	return &result
+}
+
+// cloneSearchAttributes copies the map of search attributes, which is updated in place by the mutable state
+func cloneSearchAttributes(searchAttributes map[string][]byte) map[string][]byte {
+	if searchAttributes == nil {
+		return nil
+	}
+	result := make(map[string][]byte, len(searchAttributes))
+	for key, value := range searchAttributes {
+		result[key] = value
+	}
+	return result
+}
+
+func cloneActivityInfo(info *ActivityInfo) *ActivityInfo {
+	result := *info
+	return &result
+}
+
+func cloneTimerInfo(info *TimerInfo) *TimerInfo {
+	result := *info
+	return &result
+}
+
+func cloneChildExecutionInfo(info *ChildExecutionInfo) *ChildExecutionInfo {
+	result := *info
+	return &result
+}
+
+func cloneRequestCancelInfo(info *RequestCancelInfo) *RequestCancelInfo {
+	result := *info
+	return &result
+}
+
+func cloneSignalInfo(info *SignalInfo) *SignalInfo {
+	result := *info
+	return &result
+}
+
+func cloneWorkflowMutableState(state *WorkflowMutableState) *WorkflowMutableState {
+	result := &WorkflowMutableState{
+		ExecutionInfo:       cloneWorkflowExecutionInfo(state.ExecutionInfo),
+		ActivitInfos:        make(map[int64]*ActivityInfo),
+		TimerInfos:          make(map[string]*TimerInfo),
+		ChildExecutionInfos: make(map[int64]*ChildExecutionInfo),
+		RequestCancelInfos:  make(map[int64]*RequestCancelInfo),
+		SignalInfos:         make(map[int64]*SignalInfo),
+	}
+	for k, v := range state.ActivitInfos {
+		result.ActivitInfos[k] = cloneActivityInfo(v)
+	}
+	for k, v := range state.TimerInfos {
+		result.TimerInfos[k] = cloneTimerInfo(v)
+	}
+	for k, v := range state.ChildExecutionInfos {
+		result.ChildExecutionInfos[k] = cloneChildExecutionInfo(v)
+	}
+	for k, v := range state.RequestCancelInfos {
+		result.RequestCancelInfos[k] = cloneRequestCancelInfo(v)
+	}
+	for k, v := range state.SignalInfos {
+		result.SignalInfos[k] = cloneSignalInfo(v)
+	}
+
+	return result
+}
+
+// Len implements sort.Interface
+func (t timerTaskInfosByVisibilityTS) Len() int {
+	return len(t)
+}
+
+// Swap implements sort.Interface.
+func (t timerTaskInfosByVisibilityTS) Swap(i, j int) {
+	t[i], t[j] = t[j], t[i]
+}
+
+// Less implements sort.Interface
+func (t timerTaskInfosByVisibilityTS) Less(i, j int) bool {
+	if t[i].VisibilityTimestamp.Equal(t[j].VisibilityTimestamp) {
+		return t[i].TaskID < t[j].TaskID
+	}
+	return t[i].VisibilityTimestamp.Before(t[j].VisibilityTimestamp)
+}
+
+// Len implements sort.Interface
+func (s int64s) Len() int {
+	return len(s)
+}
+
+// Swap implements sort.Interface.
+func (s int64s) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// Less implements sort.Interface
+func (s int64s) Less(i, j int) bool {
+	return s[i] < s[j]
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+// The suites below run the same test cases as the Cassandra suites, against a TestBase backed by an InMemoryStore.
+type (
+	inMemoryShardPersistenceSuite struct {
+		shardPersistenceSuite
+	}
+
+	inMemoryExecutionPersistenceSuite struct {
+		cassandraPersistenceSuite
+	}
+
+	inMemoryHistoryPersistenceSuite struct {
+		historyPersistenceSuite
+	}
+
+	inMemoryMetadataPersistenceSuite struct {
+		metadataPersistenceSuite
+	}
+
+	inMemoryVisibilityPersistenceSuite struct {
+		visibilityPersistenceSuite
+	}
+)
+
+func TestInMemoryShardPersistenceSuite(t *testing.T) {
+	s := new(inMemoryShardPersistenceSuite)
+	suite.Run(t, s)
+}
+
+func (s *inMemoryShardPersistenceSuite) SetupSuite() {
+	s.SetupInMemoryWorkflowStore()
+}
+
+func TestInMemoryExecutionPersistenceSuite(t *testing.T) {
+	s := new(inMemoryExecutionPersistenceSuite)
+	suite.Run(t, s)
+}
+
+func (s *inMemoryExecutionPersistenceSuite) SetupSuite() {
+	s.SetupInMemoryWorkflowStore()
+}
+
+func TestInMemoryHistoryPersistenceSuite(t *testing.T) {
+	s := new(inMemoryHistoryPersistenceSuite)
+	suite.Run(t, s)
+}
+
+func (s *inMemoryHistoryPersistenceSuite) SetupSuite() {
+	s.SetupInMemoryWorkflowStore()
+}
+
+func TestInMemoryMetadataPersistenceSuite(t *testing.T) {
+	s := new(inMemoryMetadataPersistenceSuite)
+	suite.Run(t, s)
+}
+
+func (s *inMemoryMetadataPersistenceSuite) SetupSuite() {
+	s.SetupInMemoryWorkflowStore()
+}
+
+func TestInMemoryVisibilityPersistenceSuite(t *testing.T) {
+	s := new(inMemoryVisibilityPersistenceSuite)
+	suite.Run(t, s)
+}
+
+func (s *inMemoryVisibilityPersistenceSuite) SetupSuite() {
+	s.SetupInMemoryWorkflowStore()
+}
+
+// TestListWorkflowExecutionsByQuery only runs against the in-memory store, since the other visibility stores do not
+// support queries
+func (s *inMemoryVisibilityPersistenceSuite) TestListWorkflowExecutionsByQuery() {
+	domainID := uuid.New()
+	startTime := time.Now().Add(-time.Minute).UnixNano()
+	open := gen.WorkflowExecution{WorkflowId: common.StringPtr("query-open"), RunId: common.StringPtr(uuid.New())}
+	closed := gen.WorkflowExecution{WorkflowId: common.StringPtr("query-closed"), RunId: common.StringPtr(uuid.New())}
+
+	err := s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       domainID,
+		Execution:        open,
+		WorkflowTypeName: "query-workflow",
+		StartTimestamp:   startTime,
+		SearchAttributes: map[string][]byte{"CustomKeywordField": []byte(`"first"`)},
+	})
+	s.Nil(err)
+	err = s.VisibilityMgr.UpsertWorkflowExecution(&UpsertWorkflowExecutionRequest{
+		DomainUUID:       domainID,
+		Execution:        open,
+		WorkflowTypeName: "query-workflow",
+		StartTimestamp:   startTime,
+		SearchAttributes: map[string][]byte{"CustomKeywordField": []byte(`"second"`), "CustomIntField": []byte(`5`)},
+	})
+	s.Nil(err)
+
+	err = s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       domainID,
+		Execution:        closed,
+		WorkflowTypeName: "query-workflow",
+		StartTimestamp:   startTime + int64(time.Second),
+	})
+	s.Nil(err)
+	err = s.VisibilityMgr.RecordWorkflowExecutionClosed(&RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       domainID,
+		Execution:        closed,
+		WorkflowTypeName: "query-workflow",
+		StartTimestamp:   startTime + int64(time.Second),
+		CloseTimestamp:   time.Now().UnixNano(),
+		Status:           gen.WorkflowExecutionCloseStatusCompleted,
+		HistoryLength:    3,
+		SearchAttributes: map[string][]byte{"CustomIntField": []byte(`10`)},
+	})
+	s.Nil(err)
+
+	resp, err := s.VisibilityMgr.ListWorkflowExecutions(&ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: domainID,
+		PageSize:   1,
+		Query:      "WorkflowType = 'query-workflow'",
+	})
+	s.Nil(err)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(closed.RunId, resp.Executions[0].Execution.RunId)
+	s.Equal(gen.WorkflowExecutionCloseStatusCompleted, *resp.Executions[0].CloseStatus)
+	s.NotEmpty(resp.NextPageToken)
+
+	resp, err = s.VisibilityMgr.ListWorkflowExecutions(&ListWorkflowExecutionsByQueryRequest{
+		DomainUUID:    domainID,
+		PageSize:      1,
+		Query:         "WorkflowType = 'query-workflow'",
+		NextPageToken: resp.NextPageToken,
+	})
+	s.Nil(err)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(open.RunId, resp.Executions[0].Execution.RunId)
+	s.Nil(resp.Executions[0].CloseStatus)
+	s.Equal([]byte(`"second"`), resp.Executions[0].SearchAttributes.IndexedFields["CustomKeywordField"])
+	s.Empty(resp.NextPageToken)
+
+	count, err := s.VisibilityMgr.CountWorkflowExecutions(&CountWorkflowExecutionsRequest{
+		DomainUUID: domainID,
+		Query:      "CustomIntField >= 5 AND CloseStatus IS NULL",
+	})
+	s.Nil(err)
+	s.Equal(int64(1), count.Count)
+
+	count, err = s.VisibilityMgr.CountWorkflowExecutions(&CountWorkflowExecutionsRequest{
+		DomainUUID: domainID,
+		Query:      "CustomIntField > 5 OR CustomKeywordField = 'first'",
+	})
+	s.Nil(err)
+	s.Equal(int64(1), count.Count)
+
+	_, err = s.VisibilityMgr.CountWorkflowExecutions(&CountWorkflowExecutionsRequest{
+		DomainUUID: domainID,
+		Query:      "WorkflowType =",
+	})
+	s.IsType(&gen.BadRequestError{}, err)
+}
+
+func (s *inMemoryVisibilityPersistenceSuite) TestNamedVisibilityIndex() {
+	logger := bark.NewLoggerFromLogrus(log.New())
+	_, err := NewVisibilityManagerWithNamedIndex(s.VisibilityMgr, "unregistered", logger)
+	s.Error(err)
+
+	indexStore := NewInMemoryStore()
+	RegisterVisibilityIndex("test-index", func(logger bark.Logger) (VisibilityManager, error) {
+		return NewInMemoryVisibilityPersistence(indexStore, logger)
+	})
+	visibilityMgr, err := NewVisibilityManagerWithNamedIndex(s.VisibilityMgr, "test-index", logger)
+	s.Nil(err)
+
+	domainID := uuid.New()
+	execution := gen.WorkflowExecution{WorkflowId: common.StringPtr("named-index"), RunId: common.StringPtr(uuid.New())}
+	err = visibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       domainID,
+		Execution:        execution,
+		WorkflowTypeName: "named-index-workflow",
+		StartTimestamp:   time.Now().UnixNano(),
+	})
+	s.Nil(err)
+
+	// the record is written to the registered index, which serves the query
+	index, err := NewInMemoryVisibilityPersistence(indexStore, logger)
+	s.Nil(err)
+	count, err := index.CountWorkflowExecutions(&CountWorkflowExecutionsRequest{
+		DomainUUID: domainID,
+		Query:      "WorkflowType = 'named-index-workflow'",
+	})
+	s.Nil(err)
+	s.Equal(int64(1), count.Count)
+	count, err = visibilityMgr.CountWorkflowExecutions(&CountWorkflowExecutionsRequest{
+		DomainUUID: domainID,
+		Query:      "WorkflowType = 'named-index-workflow'",
+	})
+	s.Nil(err)
+	s.Equal(int64(1), count.Count)
+}
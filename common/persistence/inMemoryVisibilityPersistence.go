@@ -0,0 +1,424 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	// inMemoryVisibilityKey mirrors the primary key of the open_executions and closed_executions tables
+	inMemoryVisibilityKey struct {
+		domainID  string
+		startTime int64
+		runID     string
+	}
+
+	inMemoryVisibilityRecord struct {
+		workflowID       string
+		runID            string
+		workflowTypeName string
+		startTime        int64
+		closeTime        int64
+		status           workflow.WorkflowExecutionCloseStatus
+		historyLength    int64
+		expiry           time.Time
+		closed           bool
+		searchAttributes map[string][]byte
+	}
+
+	inMemoryVisibilityRecords []*inMemoryVisibilityRecord
+
+	inMemoryVisibilityPersistence struct {
+		store  *InMemoryStore
+		logger bark.Logger
+	}
+)
+
+// NewInMemoryVisibilityPersistence is used to create an instance of VisibilityManager implementation
+func NewInMemoryVisibilityPersistence(store *InMemoryStore, logger bark.Logger) (VisibilityManager, error) {
+	return &inMemoryVisibilityPersistence{store: store, logger: logger}, nil
+}
+
+// Close releases the resources held by this object
+func (v *inMemoryVisibilityPersistence) Close() {
+}
+
+func (v *inMemoryVisibilityPersistence) RecordWorkflowExecutionStarted(
+	request *RecordWorkflowExecutionStartedRequest) error {
+	v.store.lock.Lock()
+	defer v.store.lock.Unlock()
+
+	startTime := common.UnixNanoToCQLTimestamp(request.StartTimestamp)
+	key := inMemoryVisibilityKey{domainID: request.DomainUUID, startTime: startTime, runID: *request.Execution.RunId}
+	v.store.openExecutions[key] = &inMemoryVisibilityRecord{
+		workflowID:       *request.Execution.WorkflowId,
+		runID:            *request.Execution.RunId,
+		workflowTypeName: request.WorkflowTypeName,
+		startTime:        startTime,
+		searchAttributes: cloneSearchAttributes(request.SearchAttributes),
+	}
+
+	return nil
+}
+
+func (v *inMemoryVisibilityPersistence) RecordWorkflowExecutionClosed(
+	request *RecordWorkflowExecutionClosedRequest) error {
+	v.store.lock.Lock()
+	defer v.store.lock.Unlock()
+
+	startTime := common.UnixNanoToCQLTimestamp(request.StartTimestamp)
+	key := inMemoryVisibilityKey{domainID: request.DomainUUID, startTime: startTime, runID: *request.Execution.RunId}
+
+	// First, remove execution from the open records
+	delete(v.store.openExecutions, key)
+
+	// Next, add a closed record which is kept for the retention period of the domain
+	retention := request.RetentionSeconds
+	if retention == 0 {
+		retention = defaultCloseTTLSeconds
+	}
+
+	v.store.closedExecutions[key] = &inMemoryVisibilityRecord{
+		workflowID:       *request.Execution.WorkflowId,
+		runID:            *request.Execution.RunId,
+		workflowTypeName: request.WorkflowTypeName,
+		startTime:        startTime,
+		closeTime:        common.UnixNanoToCQLTimestamp(request.CloseTimestamp),
+		status:           request.Status,
+		historyLength:    request.HistoryLength,
+		expiry:           time.Now().Add(time.Duration(retention) * time.Second),
+		closed:           true,
+		searchAttributes: cloneSearchAttributes(request.SearchAttributes),
+	}
+
+	return nil
+}
+
+func (v *inMemoryVisibilityPersistence) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
+	v.store.lock.Lock()
+	defer v.store.lock.Unlock()
+
+	startTime := common.UnixNanoToCQLTimestamp(request.StartTimestamp)
+	key := inMemoryVisibilityKey{domainID: request.DomainUUID, startTime: startTime, runID: *request.Execution.RunId}
+
+	// The upsert is dropped once the execution is closed, since the closed record has the final search attributes
+	if _, ok := v.store.closedExecutions[key]; ok {
+		return nil
+	}
+
+	v.store.openExecutions[key] = &inMemoryVisibilityRecord{
+		workflowID:       *request.Execution.WorkflowId,
+		runID:            *request.Execution.RunId,
+		workflowTypeName: request.WorkflowTypeName,
+		startTime:        startTime,
+		searchAttributes: cloneSearchAttributes(request.SearchAttributes),
+	}
+
+	return nil
+}
+
+func (v *inMemoryVisibilityPersistence) ListOpenWorkflowExecutions(
+	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions(request, false, func(r *inMemoryVisibilityRecord) bool {
+		return true
+	})
+}
+
+func (v *inMemoryVisibilityPersistence) ListClosedWorkflowExecutions(
+	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions(request, true, func(r *inMemoryVisibilityRecord) bool {
+		return true
+	})
+}
+
+func (v *inMemoryVisibilityPersistence) ListOpenWorkflowExecutionsByType(
+	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, false,
+		func(r *inMemoryVisibilityRecord) bool {
+			return r.workflowTypeName == request.WorkflowTypeName
+		})
+}
+
+func (v *inMemoryVisibilityPersistence) ListClosedWorkflowExecutionsByType(
+	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, true,
+		func(r *inMemoryVisibilityRecord) bool {
+			return r.workflowTypeName == request.WorkflowTypeName
+		})
+}
+
+func (v *inMemoryVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(
+	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, false,
+		func(r *inMemoryVisibilityRecord) bool {
+			return r.workflowID == request.WorkflowID
+		})
+}
+
+func (v *inMemoryVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowID(
+	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, true,
+		func(r *inMemoryVisibilityRecord) bool {
+			return r.workflowID == request.WorkflowID
+		})
+}
+
+func (v *inMemoryVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
+	request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, true,
+		func(r *inMemoryVisibilityRecord) bool {
+			return r.status == request.Status
+		})
+}
+
+func (v *inMemoryVisibilityPersistence) ListOpenWorkflowExecutionsByFilters(
+	request *ListWorkflowExecutionsByFiltersRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, false,
+		func(r *inMemoryVisibilityRecord) bool {
+			return r.matchesFilters(request)
+		})
+}
+
+func (v *inMemoryVisibilityPersistence) ListClosedWorkflowExecutionsByFilters(
+	request *ListWorkflowExecutionsByFiltersRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions(&request.ListWorkflowExecutionsRequest, true,
+		func(r *inMemoryVisibilityRecord) bool {
+			return r.matchesFilters(request)
+		})
+}
+
+func (v *inMemoryVisibilityPersistence) GetClosedWorkflowExecution(
+	request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	v.store.lock.Lock()
+	defer v.store.lock.Unlock()
+
+	execution := request.Execution
+	now := time.Now()
+	for key, record := range v.store.closedExecutions {
+		if key.domainID == request.DomainUUID && record.workflowID == *execution.WorkflowId &&
+			record.runID == *execution.RunId && now.Before(record.expiry) {
+			return &GetClosedWorkflowExecutionResponse{
+				Execution: record.toWorkflowExecutionInfo(),
+			}, nil
+		}
+	}
+
+	return nil, &workflow.EntityNotExistsError{
+		Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+			*execution.WorkflowId, *execution.RunId),
+	}
+}
+
+func (v *inMemoryVisibilityPersistence) ListWorkflowExecutions(
+	request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error) {
+	offset, err := getPageTokenOffset(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := v.queryWorkflowExecutions(request.DomainUUID, request.Query)
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(records)
+
+	return v.getPage(records, offset, request.PageSize), nil
+}
+
+func (v *inMemoryVisibilityPersistence) CountWorkflowExecutions(
+	request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	records, err := v.queryWorkflowExecutions(request.DomainUUID, request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CountWorkflowExecutionsResponse{Count: int64(len(records))}, nil
+}
+
+// queryWorkflowExecutions returns the open and closed records of the domain which match the query, in no order
+func (v *inMemoryVisibilityPersistence) queryWorkflowExecutions(domainID string,
+	query string) (inMemoryVisibilityRecords, error) {
+	parsedQuery, err := parseVisibilityQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	v.store.lock.Lock()
+	defer v.store.lock.Unlock()
+
+	now := time.Now()
+	var records inMemoryVisibilityRecords
+	for _, table := range []map[inMemoryVisibilityKey]*inMemoryVisibilityRecord{
+		v.store.openExecutions, v.store.closedExecutions} {
+		for key, record := range table {
+			if key.domainID != domainID || (record.closed && !now.Before(record.expiry)) {
+				continue
+			}
+			if parsedQuery.matches(record.getQueryAttribute) {
+				records = append(records, record)
+			}
+		}
+	}
+
+	return records, nil
+}
+
+// listWorkflowExecutions returns the page of matching records ordered the same way as the clustering order of the
+// visibility tables, which is by start time descending.
+func (v *inMemoryVisibilityPersistence) listWorkflowExecutions(request *ListWorkflowExecutionsRequest, closed bool,
+	filter func(r *inMemoryVisibilityRecord) bool) (*ListWorkflowExecutionsResponse, error) {
+	offset, err := getPageTokenOffset(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	v.store.lock.Lock()
+	defer v.store.lock.Unlock()
+
+	table := v.store.openExecutions
+	if closed {
+		table = v.store.closedExecutions
+	}
+
+	earliestStartTime := common.UnixNanoToCQLTimestamp(request.EarliestStartTime)
+	latestStartTime := common.UnixNanoToCQLTimestamp(request.LatestStartTime)
+	now := time.Now()
+	var records inMemoryVisibilityRecords
+	for key, record := range table {
+		if key.domainID != request.DomainUUID || key.startTime < earliestStartTime || key.startTime > latestStartTime {
+			continue
+		}
+		if closed && !now.Before(record.expiry) {
+			continue
+		}
+		if filter(record) {
+			records = append(records, record)
+		}
+	}
+	sort.Sort(records)
+
+	return v.getPage(records, offset, request.PageSize), nil
+}
+
+// getPage returns the page of the sorted records which starts at the offset
+func (v *inMemoryVisibilityPersistence) getPage(records inMemoryVisibilityRecords, offset,
+	pageSize int) *ListWorkflowExecutionsResponse {
+	end := len(records)
+	if pageSize > 0 && offset+pageSize < end {
+		end = offset + pageSize
+	}
+
+	response := &ListWorkflowExecutionsResponse{}
+	response.Executions = make([]*workflow.WorkflowExecutionInfo, 0)
+	for i := offset; i < end; i++ {
+		response.Executions = append(response.Executions, records[i].toWorkflowExecutionInfo())
+	}
+	response.NextPageToken = newOffsetPageToken(end, len(records))
+
+	return response
+}
+
+func (r *inMemoryVisibilityRecord) toWorkflowExecutionInfo() *workflow.WorkflowExecutionInfo {
+	record := &workflow.WorkflowExecutionInfo{}
+	record.Execution = &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(r.workflowID),
+		RunId:      common.StringPtr(r.runID),
+	}
+	record.StartTime = common.Int64Ptr(common.CQLTimestampToUnixNano(r.startTime))
+	record.Type = &workflow.WorkflowType{Name: common.StringPtr(r.workflowTypeName)}
+	if r.closed {
+		status := r.status
+		record.CloseTime = common.Int64Ptr(common.CQLTimestampToUnixNano(r.closeTime))
+		record.CloseStatus = &status
+		record.HistoryLength = common.Int64Ptr(r.historyLength)
+	}
+	if len(r.searchAttributes) > 0 {
+		record.SearchAttributes = &workflow.SearchAttributes{IndexedFields: cloneSearchAttributes(r.searchAttributes)}
+	}
+
+	return record
+}
+
+// getQueryAttribute returns the value of the attribute of the record for matching visibility queries
+func (r *inMemoryVisibilityRecord) getQueryAttribute(name string) (interface{}, bool) {
+	switch name {
+	case VisibilityQueryWorkflowID:
+		return r.workflowID, true
+	case VisibilityQueryRunID:
+		return r.runID, true
+	case VisibilityQueryWorkflowType:
+		return r.workflowTypeName, true
+	case VisibilityQueryStartTime:
+		return common.CQLTimestampToUnixNano(r.startTime), true
+	case VisibilityQueryCloseTime:
+		return common.CQLTimestampToUnixNano(r.closeTime), r.closed
+	case VisibilityQueryCloseStatus:
+		return r.status.String(), r.closed
+	case VisibilityQueryHistoryLength:
+		return r.historyLength, r.closed
+	}
+
+	data, ok := r.searchAttributes[name]
+	if !ok {
+		return nil, false
+	}
+	return decodeVisibilityQueryValue(data)
+}
+
+// matchesFilters returns whether the record matches all the filters of the request
+func (r *inMemoryVisibilityRecord) matchesFilters(request *ListWorkflowExecutionsByFiltersRequest) bool {
+	if request.WorkflowID != "" && r.workflowID != request.WorkflowID {
+		return false
+	}
+	if request.WorkflowTypeName != "" && r.workflowTypeName != request.WorkflowTypeName {
+		return false
+	}
+	if request.Status != nil && r.closed && r.status != *request.Status {
+		return false
+	}
+	return true
+}
+
+// Len implements sort.Interface
+func (r inMemoryVisibilityRecords) Len() int {
+	return len(r)
+}
+
+// Swap implements sort.Interface.
+func (r inMemoryVisibilityRecords) Swap(i, j int) {
+	r[i], r[j] = r[j], r[i]
+}
+
+// Less implements sort.Interface
+func (r inMemoryVisibilityRecords) Less(i, j int) bool {
+	if r[i].startTime == r[j].startTime {
+		return r[i].runID < r[j].runID
+	}
+	return r[i].startTime > r[j].startTime
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/uber-common/bark"
+)
+
+type (
+	// indexedVisibilityPersistence is a VisibilityManager which keeps an advanced visibility store, the index, next to
+	// the visibility store with the fixed filters.  Records are written to both stores, and the query based APIs are
+	// served by the index.
+	indexedVisibilityPersistence struct {
+		VisibilityManager
+		index VisibilityManager
+	}
+
+	// VisibilityIndexFactory creates the index of an advanced visibility store
+	VisibilityIndexFactory func(logger bark.Logger) (VisibilityManager, error)
+)
+
+// embeddedVisibilityIndexName is the name of the advanced visibility store which keeps the index in memory
+const embeddedVisibilityIndexName = "embedded"
+
+var (
+	embeddedVisibilityIndexOnce  sync.Once
+	embeddedVisibilityIndexStore *InMemoryStore
+
+	visibilityIndexFactoriesLock sync.RWMutex
+	visibilityIndexFactories     = map[string]VisibilityIndexFactory{
+		embeddedVisibilityIndexName: newEmbeddedVisibilityIndex,
+	}
+)
+
+// RegisterVisibilityIndex makes an advanced visibility store available under the given name, which is then selected
+// by the advancedVisibilityStore persistence config.  Stores which durably index the executions are registered by the
+// binary that links them in; only the embedded store, which is meant for tests, is built in.
+func RegisterVisibilityIndex(name string, factory VisibilityIndexFactory) {
+	visibilityIndexFactoriesLock.Lock()
+	defer visibilityIndexFactoriesLock.Unlock()
+	visibilityIndexFactories[name] = factory
+}
+
+// NewVisibilityManagerWithIndex returns a VisibilityManager which serves the query based APIs from the index, and
+// everything else from visibilityMgr
+func NewVisibilityManagerWithIndex(visibilityMgr VisibilityManager, index VisibilityManager) VisibilityManager {
+	return &indexedVisibilityPersistence{VisibilityManager: visibilityMgr, index: index}
+}
+
+// NewVisibilityManagerWithNamedIndex returns a VisibilityManager which serves the query based APIs from the index of
+// the advanced visibility store registered under the given name, and everything else from visibilityMgr
+func NewVisibilityManagerWithNamedIndex(visibilityMgr VisibilityManager, name string, logger bark.Logger) (
+	VisibilityManager, error) {
+	visibilityIndexFactoriesLock.RLock()
+	factory, ok := visibilityIndexFactories[name]
+	visibilityIndexFactoriesLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown advanced visibility store: %v", name)
+	}
+
+	index, err := factory(logger)
+	if err != nil {
+		return nil, err
+	}
+	return NewVisibilityManagerWithIndex(visibilityMgr, index), nil
+}
+
+// newEmbeddedVisibilityIndex returns an advanced visibility store which is kept in the memory of the process.  All
+// the services of the process share the same index, so it is only complete when the frontend and history services
+// run in the same process, and it is lost on restart while the executions it indexed are not.  It is meant for tests
+// and development clusters on the memory store, which loses the executions on restart too.
+func newEmbeddedVisibilityIndex(logger bark.Logger) (VisibilityManager, error) {
+	embeddedVisibilityIndexOnce.Do(func() {
+		embeddedVisibilityIndexStore = NewInMemoryStore()
+	})
+	return NewInMemoryVisibilityPersistence(embeddedVisibilityIndexStore, logger)
+}
+
+// Close releases the resources held by both stores
+func (v *indexedVisibilityPersistence) Close() {
+	v.VisibilityManager.Close()
+	v.index.Close()
+}
+
+func (v *indexedVisibilityPersistence) RecordWorkflowExecutionStarted(
+	request *RecordWorkflowExecutionStartedRequest) error {
+	if err := v.VisibilityManager.RecordWorkflowExecutionStarted(request); err != nil {
+		return err
+	}
+	return v.index.RecordWorkflowExecutionStarted(request)
+}
+
+func (v *indexedVisibilityPersistence) RecordWorkflowExecutionClosed(
+	request *RecordWorkflowExecutionClosedRequest) error {
+	if err := v.VisibilityManager.RecordWorkflowExecutionClosed(request); err != nil {
+		return err
+	}
+	return v.index.RecordWorkflowExecutionClosed(request)
+}
+
+func (v *indexedVisibilityPersistence) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
+	if err := v.VisibilityManager.UpsertWorkflowExecution(request); err != nil {
+		return err
+	}
+	return v.index.UpsertWorkflowExecution(request)
+}
+
+func (v *indexedVisibilityPersistence) ListWorkflowExecutions(
+	request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.index.ListWorkflowExecutions(request)
+}
+
+func (v *indexedVisibilityPersistence) CountWorkflowExecutions(
+	request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	return v.index.CountWorkflowExecutions(request)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"strconv"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+// newOffsetPageToken encodes the offset of the next page into an opaque token, for the persistence implementations
+// which page by offset rather than by a native cursor.  An empty token means there are no more pages to read.
+func newOffsetPageToken(offset, total int) []byte {
+	if offset >= total {
+		return []byte{}
+	}
+	return []byte(strconv.Itoa(offset))
+}
+
+func getPageTokenOffset(token []byte) (int, error) {
+	if len(token) == 0 {
+		return 0, nil
+	}
+
+	offset, err := strconv.Atoi(string(token))
+	if err != nil || offset < 0 {
+		return 0, &workflow.BadRequestError{
+			Message: fmt.Sprintf("Invalid next page token: %v", token),
+		}
+	}
+
+	return offset, nil
+}
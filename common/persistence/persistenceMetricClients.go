@@ -422,6 +422,22 @@ func (p *historyPersistenceClient) GetWorkflowExecutionHistory(
 	return response, err
 }
 
+func (p *historyPersistenceClient) GetWorkflowExecutionLastHistoryBatch(
+	request *GetWorkflowExecutionLastHistoryBatchRequest) (*GetWorkflowExecutionLastHistoryBatchResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetWorkflowExecutionLastHistoryBatchScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetWorkflowExecutionLastHistoryBatchScope,
+		metrics.PersistenceLatency)
+	response, err := p.persistence.GetWorkflowExecutionLastHistoryBatch(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetWorkflowExecutionLastHistoryBatchScope, err)
+	}
+
+	return response, err
+}
+
 func (p *historyPersistenceClient) DeleteWorkflowExecutionHistory(
 	request *DeleteWorkflowExecutionHistoryRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteWorkflowExecutionHistoryScope, metrics.PersistenceRequests)
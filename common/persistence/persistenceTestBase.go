@@ -21,8 +21,12 @@
 package persistence
 
 import (
+	"fmt"
+	"io/ioutil"
 	"math"
 	"math/rand"
+	"os"
+	"regexp"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -62,6 +66,13 @@ type (
 		Datacenter      string
 		DropKeySpace    bool
 		SchemaDir       string
+		// InMemory backs the test base with an InMemoryStore instead of a Cassandra keyspace
+		InMemory bool
+		// SQLDriverName backs the test base with a sql database instead of a Cassandra keyspace
+		SQLDriverName string
+		// SQLDataSourceName is the database the schema is deployed to.  A temporary database file is created if it
+		// is empty and the driver is sqlite3.
+		SQLDataSourceName string
 	}
 
 	// TestBase wraps the base setup needed to create workflows over persistence layer.
@@ -77,6 +88,16 @@ type (
 		TaskIDGenerator     TransferTaskIDGenerator
 		readLevel           int64
 		CassandraTestCluster
+		SQLTestDatabase
+	}
+
+	// SQLTestDatabase allows executing sql operations in testing.
+	SQLTestDatabase struct {
+		driverName     string
+		dataSourceName string
+		db             *sqlDB
+		// file is the temporary sqlite database file, removed on tear down
+		file string
 	}
 
 	// CassandraTestCluster allows executing cassandra operations in testing.
@@ -92,6 +113,17 @@ type (
 		logger    bark.Logger
 	}
 
+	testInMemoryExecutionMgrFactory struct {
+		store  *InMemoryStore
+		logger bark.Logger
+	}
+
+	testSQLExecutionMgrFactory struct {
+		driverName     string
+		dataSourceName string
+		logger         bark.Logger
+	}
+
 	testTransferTaskIDGenerator struct {
 		seqNum int64
 	}
@@ -112,6 +144,29 @@ func (f *testExecutionMgrFactory) CreateExecutionManager(shardID int) (Execution
 		shardID, f.logger)
 }
 
+func newTestInMemoryExecutionMgrFactory(store *InMemoryStore, logger bark.Logger) ExecutionManagerFactory {
+	return &testInMemoryExecutionMgrFactory{
+		store:  store,
+		logger: logger,
+	}
+}
+
+func (f *testInMemoryExecutionMgrFactory) CreateExecutionManager(shardID int) (ExecutionManager, error) {
+	return NewInMemoryWorkflowExecutionPersistence(f.store, shardID, f.logger)
+}
+
+func newTestSQLExecutionMgrFactory(driverName, dataSourceName string, logger bark.Logger) ExecutionManagerFactory {
+	return &testSQLExecutionMgrFactory{
+		driverName:     driverName,
+		dataSourceName: dataSourceName,
+		logger:         logger,
+	}
+}
+
+func (f *testSQLExecutionMgrFactory) CreateExecutionManager(shardID int) (ExecutionManager, error) {
+	return NewSQLWorkflowExecutionPersistence(f.driverName, f.dataSourceName, shardID, f.logger)
+}
+
 func (g *testTransferTaskIDGenerator) GetNextTransferTaskID() (int64, error) {
 	return atomic.AddInt64(&g.seqNum, 1), nil
 }
@@ -119,9 +174,36 @@ func (g *testTransferTaskIDGenerator) GetNextTransferTaskID() (int64, error) {
 // SetupWorkflowStoreWithOptions to setup workflow test base
 func (s *TestBase) SetupWorkflowStoreWithOptions(options TestBaseOptions) {
 	log := bark.NewLoggerFromLogrus(log.New())
+	shardID := 0
+	if options.InMemory {
+		s.setupInMemoryPersistence(shardID, log)
+	} else if options.SQLDriverName != "" {
+		s.setupSQLPersistence(options, shardID, log)
+	} else {
+		s.setupCassandraPersistence(options, shardID, log)
+	}
+
+	s.TaskIDGenerator = &testTransferTaskIDGenerator{}
+
+	// Create a shard for test
+	s.readLevel = 0
+	s.ShardInfo = &ShardInfo{
+		ShardID:          shardID,
+		RangeID:          0,
+		TransferAckLevel: 0,
+	}
+
+	err1 := s.ShardMgr.CreateShard(&CreateShardRequest{
+		ShardInfo: s.ShardInfo,
+	})
+	if err1 != nil {
+		log.Fatal(err1)
+	}
+}
+
+func (s *TestBase) setupCassandraPersistence(options TestBaseOptions, shardID int, log bark.Logger) {
 	// Setup Workflow keyspace and deploy schema for tests
 	s.CassandraTestCluster.setupTestCluster(options.KeySpace, options.DropKeySpace, options.SchemaDir)
-	shardID := 0
 	var err error
 	s.ShardMgr, err = NewCassandraShardPersistence(options.ClusterHost, options.ClusterPort, options.ClusterUser,
 		options.ClusterPassword, options.Datacenter, s.CassandraTestCluster.keyspace, log)
@@ -158,22 +240,71 @@ func (s *TestBase) SetupWorkflowStoreWithOptions(options TestBaseOptions) {
 	if err != nil {
 		log.Fatal(err)
 	}
+}
 
-	s.TaskIDGenerator = &testTransferTaskIDGenerator{}
-
-	// Create a shard for test
-	s.readLevel = 0
-	s.ShardInfo = &ShardInfo{
-		ShardID:          shardID,
-		RangeID:          0,
-		TransferAckLevel: 0,
+func (s *TestBase) setupInMemoryPersistence(shardID int, log bark.Logger) {
+	store := NewInMemoryStore()
+	var err error
+	s.ShardMgr, err = NewInMemoryShardPersistence(store, log)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.ExecutionMgrFactory = newTestInMemoryExecutionMgrFactory(store, log)
+	// Create an ExecutionManager for the shard for use in unit tests
+	s.WorkflowMgr, err = s.ExecutionMgrFactory.CreateExecutionManager(shardID)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.TaskMgr, err = NewInMemoryTaskPersistence(store, log)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.HistoryMgr, err = NewInMemoryHistoryPersistence(store, log)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.MetadataManager, err = NewInMemoryMetadataPersistence(store, log)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.VisibilityMgr, err = NewInMemoryVisibilityPersistence(store, log)
+	if err != nil {
+		log.Fatal(err)
 	}
+}
 
-	err1 := s.ShardMgr.CreateShard(&CreateShardRequest{
-		ShardInfo: s.ShardInfo,
-	})
-	if err1 != nil {
-		log.Fatal(err1)
+func (s *TestBase) setupSQLPersistence(options TestBaseOptions, shardID int, log bark.Logger) {
+	// Setup the database and deploy schema for tests
+	s.SQLTestDatabase.setupTestDatabase(options.SQLDriverName, options.SQLDataSourceName, options.SchemaDir)
+	driverName := s.SQLTestDatabase.driverName
+	dataSourceName := s.SQLTestDatabase.dataSourceName
+
+	var err error
+	s.ShardMgr, err = NewSQLShardPersistence(driverName, dataSourceName, log)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.ExecutionMgrFactory = newTestSQLExecutionMgrFactory(driverName, dataSourceName, log)
+	// Create an ExecutionManager for the shard for use in unit tests
+	s.WorkflowMgr, err = s.ExecutionMgrFactory.CreateExecutionManager(shardID)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.TaskMgr, err = NewSQLTaskPersistence(driverName, dataSourceName, log)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.HistoryMgr, err = NewSQLHistoryPersistence(driverName, dataSourceName, log)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.MetadataManager, err = NewSQLMetadataPersistence(driverName, dataSourceName, log)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.VisibilityMgr, err = NewSQLVisibilityPersistence(driverName, dataSourceName, log)
+	if err != nil {
+		log.Fatal(err)
 	}
 }
 
@@ -215,10 +346,19 @@ func (s *TestBase) UpdateShard(updatedInfo *ShardInfo, previousRangeID int64) er
 func (s *TestBase) CreateWorkflowExecution(domainID string, workflowExecution workflow.WorkflowExecution, taskList,
 	wType string, decisionTimeout int32, executionContext []byte, nextEventID int64, lastProcessedEventID int64,
 	decisionScheduleID int64, timerTasks []Task) (string, error) {
+	return s.CreateWorkflowExecutionWithPreviousRun(domainID, workflowExecution, "", taskList, wType, decisionTimeout,
+		executionContext, nextEventID, lastProcessedEventID, decisionScheduleID, timerTasks)
+}
+
+// CreateWorkflowExecutionWithPreviousRun is a utility method to create a workflow execution replacing a closed run
+func (s *TestBase) CreateWorkflowExecutionWithPreviousRun(domainID string, workflowExecution workflow.WorkflowExecution,
+	previousRunID, taskList, wType string, decisionTimeout int32, executionContext []byte, nextEventID int64,
+	lastProcessedEventID int64, decisionScheduleID int64, timerTasks []Task) (string, error) {
 	response, err := s.WorkflowMgr.CreateWorkflowExecution(&CreateWorkflowExecutionRequest{
 		RequestID:            uuid.New(),
 		DomainID:             domainID,
 		Execution:            workflowExecution,
+		PreviousRunID:        previousRunID,
 		TaskList:             taskList,
 		WorkflowTypeName:     wType,
 		DecisionTimeoutValue: decisionTimeout,
@@ -405,6 +545,36 @@ func (s *TestBase) ContinueAsNewExecution(updatedInfo *WorkflowExecutionInfo, co
 	})
 }
 
+// ContinueAsNewExecutionWithBackoff is a utility method to continue a workflow as a new run with a cron schedule, whose
+// first decision is scheduled by a backoff timer
+func (s *TestBase) ContinueAsNewExecutionWithBackoff(updatedInfo *WorkflowExecutionInfo, condition int64,
+	newExecution workflow.WorkflowExecution, nextEventID int64, cronSchedule string, backoffTimestamp time.Time) error {
+	return s.WorkflowMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+		ExecutionInfo: updatedInfo,
+		Condition:     condition,
+		RangeID:       s.ShardInfo.RangeID,
+		ContinueAsNew: &CreateWorkflowExecutionRequest{
+			RequestID:            uuid.New(),
+			DomainID:             updatedInfo.DomainID,
+			Execution:            newExecution,
+			TaskList:             updatedInfo.TaskList,
+			WorkflowTypeName:     updatedInfo.WorkflowTypeName,
+			DecisionTimeoutValue: updatedInfo.DecisionTimeoutValue,
+			NextEventID:          nextEventID,
+			LastProcessedEvent:   common.EmptyEventID,
+			RangeID:              s.ShardInfo.RangeID,
+			TimerTasks: []Task{&WorkflowBackoffTimerTask{
+				VisibilityTimestamp: backoffTimestamp,
+				TaskID:              s.GetNextSequenceNumber(),
+			}},
+			DecisionScheduleID: common.EmptyEventID,
+			DecisionStartedID:  common.EmptyEventID,
+			ContinueAsNew:      true,
+			CronSchedule:       cronSchedule,
+		},
+	})
+}
+
 // UpdateWorkflowExecution is a utility method to update workflow execution
 func (s *TestBase) UpdateWorkflowExecution(updatedInfo *WorkflowExecutionInfo, decisionScheduleIDs []int64,
 	activityScheduleIDs []int64, condition int64, timerTasks []Task, deleteTimerTask Task,
@@ -412,7 +582,7 @@ func (s *TestBase) UpdateWorkflowExecution(updatedInfo *WorkflowExecutionInfo, d
 	upsertTimerInfos []*TimerInfo, deleteTimerInfos []string) error {
 	return s.UpdateWorkflowExecutionWithRangeID(updatedInfo, decisionScheduleIDs, activityScheduleIDs,
 		s.ShardInfo.RangeID, condition, timerTasks, deleteTimerTask, upsertActivityInfos, deleteActivityInfo,
-		upsertTimerInfos, deleteTimerInfos, nil, nil, nil, nil)
+		upsertTimerInfos, deleteTimerInfos, nil, nil, nil, nil, nil, nil)
 }
 
 // UpdateWorkflowExecutionAndDelete is a utility method to update workflow execution
@@ -439,7 +609,7 @@ func (s *TestBase) UpsertChildExecutionsState(updatedInfo *WorkflowExecutionInfo
 	upsertChildInfos []*ChildExecutionInfo) error {
 	return s.UpdateWorkflowExecutionWithRangeID(updatedInfo, nil, nil,
 		s.ShardInfo.RangeID, condition, nil, nil, nil, nil,
-		nil, nil, upsertChildInfos, nil, nil, nil)
+		nil, nil, upsertChildInfos, nil, nil, nil, nil, nil)
 }
 
 // UpsertRequestCancelState is a utility method to update mutable state of workflow execution
@@ -447,7 +617,15 @@ func (s *TestBase) UpsertRequestCancelState(updatedInfo *WorkflowExecutionInfo,
 	upsertCancelInfos []*RequestCancelInfo) error {
 	return s.UpdateWorkflowExecutionWithRangeID(updatedInfo, nil, nil,
 		s.ShardInfo.RangeID, condition, nil, nil, nil, nil,
-		nil, nil, nil, nil, upsertCancelInfos, nil)
+		nil, nil, nil, nil, upsertCancelInfos, nil, nil, nil)
+}
+
+// UpsertSignalInfoState is a utility method to update mutable state of workflow execution
+func (s *TestBase) UpsertSignalInfoState(updatedInfo *WorkflowExecutionInfo, condition int64,
+	upsertSignalInfos []*SignalInfo) error {
+	return s.UpdateWorkflowExecutionWithRangeID(updatedInfo, nil, nil,
+		s.ShardInfo.RangeID, condition, nil, nil, nil, nil,
+		nil, nil, nil, nil, nil, nil, upsertSignalInfos, nil)
 }
 
 // DeleteChildExecutionsState is a utility method to delete child execution from mutable state
@@ -455,7 +633,7 @@ func (s *TestBase) DeleteChildExecutionsState(updatedInfo *WorkflowExecutionInfo
 	deleteChildInfo int64) error {
 	return s.UpdateWorkflowExecutionWithRangeID(updatedInfo, nil, nil,
 		s.ShardInfo.RangeID, condition, nil, nil, nil, nil,
-		nil, nil, nil, &deleteChildInfo, nil, nil)
+		nil, nil, nil, &deleteChildInfo, nil, nil, nil, nil)
 }
 
 // DeleteCancelState is a utility method to delete request cancel state from mutable state
@@ -463,7 +641,15 @@ func (s *TestBase) DeleteCancelState(updatedInfo *WorkflowExecutionInfo, conditi
 	deleteCancelInfo int64) error {
 	return s.UpdateWorkflowExecutionWithRangeID(updatedInfo, nil, nil,
 		s.ShardInfo.RangeID, condition, nil, nil, nil, nil,
-		nil, nil, nil, nil, nil, &deleteCancelInfo)
+		nil, nil, nil, nil, nil, &deleteCancelInfo, nil, nil)
+}
+
+// DeleteSignalState is a utility method to delete signal info from mutable state
+func (s *TestBase) DeleteSignalState(updatedInfo *WorkflowExecutionInfo, condition int64,
+	deleteSignalInfo int64) error {
+	return s.UpdateWorkflowExecutionWithRangeID(updatedInfo, nil, nil,
+		s.ShardInfo.RangeID, condition, nil, nil, nil, nil,
+		nil, nil, nil, nil, nil, nil, nil, &deleteSignalInfo)
 }
 
 // UpdateWorkflowExecutionWithRangeID is a utility method to update workflow execution
@@ -471,7 +657,8 @@ func (s *TestBase) UpdateWorkflowExecutionWithRangeID(updatedInfo *WorkflowExecu
 	activityScheduleIDs []int64, rangeID, condition int64, timerTasks []Task, deleteTimerTask Task,
 	upsertActivityInfos []*ActivityInfo, deleteActivityInfo *int64, upsertTimerInfos []*TimerInfo,
 	deleteTimerInfos []string, upsertChildInfos []*ChildExecutionInfo, deleteChildInfo *int64,
-	upsertCancelInfos []*RequestCancelInfo, deleteCancelInfo *int64) error {
+	upsertCancelInfos []*RequestCancelInfo, deleteCancelInfo *int64, upsertSignalInfos []*SignalInfo,
+	deleteSignalInfo *int64) error {
 	transferTasks := []Task{}
 	for _, decisionScheduleID := range decisionScheduleIDs {
 		transferTasks = append(transferTasks, &DecisionTask{
@@ -504,6 +691,8 @@ func (s *TestBase) UpdateWorkflowExecutionWithRangeID(updatedInfo *WorkflowExecu
 		DeleteChildExecutionInfo:  deleteChildInfo,
 		UpsertRequestCancelInfos:  upsertCancelInfos,
 		DeleteRequestCancelInfo:   deleteCancelInfo,
+		UpsertSignalInfos:         upsertSignalInfos,
+		DeleteSignalInfo:          deleteSignalInfo,
 	})
 }
 
@@ -544,6 +733,19 @@ func (s *TestBase) UpdateWorkflowExecutionForRequestCancel(
 	})
 }
 
+// UpdateWorkflowExecutionForSignal is a utility method to update workflow execution
+func (s *TestBase) UpdateWorkflowExecutionForSignal(
+	updatedInfo *WorkflowExecutionInfo, condition int64, transferTasks []Task,
+	upsertSignalInfos []*SignalInfo) error {
+	return s.WorkflowMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+		ExecutionInfo:     updatedInfo,
+		TransferTasks:     transferTasks,
+		Condition:         condition,
+		UpsertSignalInfos: upsertSignalInfos,
+		RangeID:           s.ShardInfo.RangeID,
+	})
+}
+
 // DeleteWorkflowExecution is a utility method to delete a workflow execution
 func (s *TestBase) DeleteWorkflowExecution(info *WorkflowExecutionInfo) error {
 	return s.WorkflowMgr.DeleteWorkflowExecution(&DeleteWorkflowExecutionRequest{
@@ -744,9 +946,31 @@ func (s *TestBase) SetupWorkflowStore() {
 	})
 }
 
+// SetupInMemoryWorkflowStore to setup workflow test base without an external database
+func (s *TestBase) SetupInMemoryWorkflowStore() {
+	s.SetupWorkflowStoreWithOptions(TestBaseOptions{
+		InMemory: true,
+	})
+}
+
+// SetupSQLiteWorkflowStore to setup workflow test base on a temporary SQLite database.  The sqlite3 driver has to be
+// registered by the caller.
+func (s *TestBase) SetupSQLiteWorkflowStore() {
+	s.SetupWorkflowStoreWithOptions(TestBaseOptions{
+		SchemaDir:     testSchemaDir,
+		SQLDriverName: SQLDriverSQLite,
+	})
+}
+
 // TearDownWorkflowStore to cleanup
 func (s *TestBase) TearDownWorkflowStore() {
-	s.CassandraTestCluster.tearDownTestCluster()
+	// Nothing to clean up when the test base is backed by an in-memory store
+	if s.CassandraTestCluster.session != nil {
+		s.CassandraTestCluster.tearDownTestCluster()
+	}
+	if s.SQLTestDatabase.db != nil {
+		s.SQLTestDatabase.tearDownTestDatabase()
+	}
 }
 
 // GetNextSequenceNumber generates a unique sequence number for can be used for transfer queue taskId
@@ -850,6 +1074,66 @@ func (s *CassandraTestCluster) loadVisibilitySchema(fileNames []string, schemaDi
 	}
 }
 
+func (s *SQLTestDatabase) setupTestDatabase(driverName, dataSourceName, schemaDir string) {
+	if dataSourceName == "" {
+		if driverName != SQLDriverSQLite {
+			log.Fatalf("A data source name is required for sql driver %v", driverName)
+		}
+
+		f, err := ioutil.TempFile("", "cadence-test")
+		if err != nil {
+			log.Fatal(err)
+		}
+		f.Close()
+		s.file = f.Name()
+		// Transactions need to take the write lock of the database when they begin, see sqlDialect
+		dataSourceName = fmt.Sprintf("file:%v?_txlock=immediate&_busy_timeout=10000", s.file)
+	}
+
+	db, err := openSQLDB(driverName, dataSourceName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.driverName = driverName
+	s.dataSourceName = dataSourceName
+	s.db = db
+
+	dialectDir := "mysql"
+	if driverName == SQLDriverPostgres {
+		dialectDir = "postgres"
+	}
+	if schemaDir == "" {
+		schemaDir = "."
+	}
+	s.loadSchema(fmt.Sprintf("%v/schema/%v/cadence/schema.sql", schemaDir, dialectDir))
+	s.loadSchema(fmt.Sprintf("%v/schema/%v/visibility/schema.sql", schemaDir, dialectDir))
+}
+
+func (s *SQLTestDatabase) tearDownTestDatabase() {
+	s.db.Close()
+	if s.file != "" {
+		os.Remove(s.file)
+	}
+}
+
+var sqlCommentRegexp = regexp.MustCompile(`--.*`)
+
+func (s *SQLTestDatabase) loadSchema(fileName string) {
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, stmt := range strings.Split(sqlCommentRegexp.ReplaceAllString(string(content), ""), ";") {
+		if strings.TrimSpace(stmt) == "" {
+			continue
+		}
+		if _, err := s.db.exec(stmt); err != nil {
+			log.Fatalf("Failed to load schema %v: %v", fileName, err)
+		}
+	}
+}
+
 func validateTimeRange(t time.Time, expectedDuration time.Duration) bool {
 	currentTime := time.Now()
 	diff := time.Duration(currentTime.UnixNano() - t.UnixNano())
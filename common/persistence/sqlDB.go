@@ -0,0 +1,238 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	// Drivers for the supported sql dialects.  The sqlite3 driver requires cgo and has to be registered by the
+	// binary or test using it.
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+// Supported sql driver names
+const (
+	SQLDriverMySQL    = "mysql"
+	SQLDriverPostgres = "postgres"
+	SQLDriverSQLite   = "sqlite3"
+)
+
+// Errors the databases return when a transaction races a concurrent one to insert the same row
+const (
+	mysqlErrDupEntry            = 1062
+	mysqlErrLockDeadlock        = 1213
+	postgresErrUniqueViolation  = "23505"
+	postgresErrDeadlockDetected = "40P01"
+)
+
+type (
+	// sqlDialect captures the differences between the supported databases.  All queries are written with '?'
+	// placeholders and rebound for the target database before execution.
+	sqlDialect struct {
+		driverName string
+		// lockForUpdate is appended to a select to take an exclusive row lock until the transaction ends
+		lockForUpdate string
+		// lockForShare is appended to a select to take a shared row lock until the transaction ends
+		lockForShare string
+	}
+
+	sqlDB struct {
+		db      *sql.DB
+		dialect *sqlDialect
+	}
+
+	sqlTx struct {
+		tx      *sql.Tx
+		dialect *sqlDialect
+	}
+)
+
+func newSQLDialect(driverName string) (*sqlDialect, error) {
+	switch driverName {
+	case SQLDriverMySQL:
+		return &sqlDialect{driverName: driverName, lockForUpdate: " FOR UPDATE", lockForShare: " LOCK IN SHARE MODE"}, nil
+	case SQLDriverPostgres:
+		return &sqlDialect{driverName: driverName, lockForUpdate: " FOR UPDATE", lockForShare: " FOR SHARE"}, nil
+	case SQLDriverSQLite:
+		// SQLite has no row level locks.  The data source must set _txlock=immediate so every transaction takes
+		// the database write lock when it begins, which gives the same guarantees.
+		return &sqlDialect{driverName: driverName}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported sql driver: %v", driverName)
+}
+
+// rebind converts the '?' placeholders of a query to the positional parameters used by postgres
+func (d *sqlDialect) rebind(query string) string {
+	if d.driverName != SQLDriverPostgres {
+		return query
+	}
+
+	parts := strings.Split(query, "?")
+	var result []string
+	for i, part := range parts {
+		result = append(result, part)
+		if i < len(parts)-1 {
+			result = append(result, "$"+strconv.Itoa(i+1))
+		}
+	}
+	return strings.Join(result, "")
+}
+
+func openSQLDB(driverName, dataSourceName string) (*sqlDB, error) {
+	dialect, err := newSQLDialect(driverName)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open(driverName, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &sqlDB{db: db, dialect: dialect}, nil
+}
+
+func (s *sqlDB) Close() {
+	if s.db != nil {
+		s.db.Close()
+	}
+}
+
+func (s *sqlDB) exec(query string, args ...interface{}) (sql.Result, error) {
+	return s.db.Exec(s.dialect.rebind(query), args...)
+}
+
+func (s *sqlDB) query(query string, args ...interface{}) (*sql.Rows, error) {
+	return s.db.Query(s.dialect.rebind(query), args...)
+}
+
+func (s *sqlDB) queryRow(query string, args ...interface{}) *sql.Row {
+	return s.db.QueryRow(s.dialect.rebind(query), args...)
+}
+
+// txn runs fn within a transaction, which is committed if fn succeeds and rolled back otherwise.  Errors returned by
+// fn are passed through unchanged.
+func (s *sqlDB) txn(operation string, fn func(tx *sqlTx) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed.  Failed to start transaction. Error: %v", operation, err),
+		}
+	}
+
+	if err := fn(&sqlTx{tx: tx, dialect: s.dialect}); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed.  Failed to commit transaction. Error: %v", operation, err),
+		}
+	}
+
+	return nil
+}
+
+func (t *sqlTx) exec(query string, args ...interface{}) (sql.Result, error) {
+	return t.tx.Exec(t.dialect.rebind(query), args...)
+}
+
+func (t *sqlTx) query(query string, args ...interface{}) (*sql.Rows, error) {
+	return t.tx.Query(t.dialect.rebind(query), args...)
+}
+
+func (t *sqlTx) queryRow(query string, args ...interface{}) *sql.Row {
+	return t.tx.QueryRow(t.dialect.rebind(query), args...)
+}
+
+// forUpdate returns the query with an exclusive row lock clause for the dialect
+func (t *sqlTx) forUpdate(query string) string {
+	return query + t.dialect.lockForUpdate
+}
+
+// forShare returns the query with a shared row lock clause for the dialect
+func (t *sqlTx) forShare(query string) string {
+	return query + t.dialect.lockForShare
+}
+
+// sqlTimestamp converts a time to the millisecond precision timestamp stored in the database, which is the same
+// precision timestamps have in Cassandra
+func sqlTimestamp(t time.Time) int64 {
+	return t.Unix()*1000 + int64(t.Nanosecond())/int64(time.Millisecond)
+}
+
+func fromSQLTimestamp(ts int64) time.Time {
+	return time.Unix(ts/1000, (ts%1000)*int64(time.Millisecond))
+}
+
+// sqlSearchAttributes encodes the search attributes of an execution into the JSON blob stored in the database.  An
+// execution without search attributes is stored as NULL.
+func sqlSearchAttributes(searchAttributes map[string][]byte) ([]byte, error) {
+	if len(searchAttributes) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(searchAttributes)
+}
+
+func fromSQLSearchAttributes(data []byte) (map[string][]byte, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var searchAttributes map[string][]byte
+	if err := json.Unmarshal(data, &searchAttributes); err != nil {
+		return nil, err
+	}
+	return searchAttributes, nil
+}
+
+// isConflictError returns true if err is a unique key violation or a deadlock, which is how the database fails the
+// loser of two transactions inserting the same row.  The transaction is aborted, so it has to be retried to read the
+// row written by the winner.
+func isConflictError(err error) bool {
+	switch e := err.(type) {
+	case *mysql.MySQLError:
+		return e.Number == mysqlErrDupEntry || e.Number == mysqlErrLockDeadlock
+	case *pq.Error:
+		return e.Code == postgresErrUniqueViolation || e.Code == postgresErrDeadlockDetected
+	}
+	return false
+}
+
+func sqlInternalError(operation string, err error) error {
+	return &workflow.InternalServiceError{
+		Message: fmt.Sprintf("%v operation failed. Error: %v", operation, err),
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+const (
+	sqlTemplateGetHistoryEventsTxQuery = `SELECT range_id, tx_id FROM events ` +
+		`WHERE domain_id = ? AND workflow_id = ? AND run_id = ? AND first_event_id = ?`
+
+	sqlTemplateAppendHistoryEventsQuery = `INSERT INTO events (` +
+		`domain_id, workflow_id, run_id, first_event_id, range_id, tx_id, data, data_encoding, data_version) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlTemplateOverwriteHistoryEventsQuery = `UPDATE events ` +
+		`SET range_id = ?, tx_id = ?, data = ?, data_encoding = ?, data_version = ? ` +
+		`WHERE domain_id = ? AND workflow_id = ? AND run_id = ? AND first_event_id = ?`
+
+	sqlTemplateGetWorkflowExecutionHistoryQuery = `SELECT data, data_encoding, data_version FROM events ` +
+		`WHERE domain_id = ? AND workflow_id = ? AND run_id = ? AND first_event_id >= ? AND first_event_id < ? ` +
+		`ORDER BY first_event_id LIMIT ? OFFSET ?`
+
+	sqlTemplateGetWorkflowExecutionLastHistoryBatchQuery = `SELECT data, data_encoding, data_version FROM events ` +
+		`WHERE domain_id = ? AND workflow_id = ? AND run_id = ? AND first_event_id < ? ` +
+		`ORDER BY first_event_id DESC LIMIT 1`
+
+	sqlTemplateDeleteWorkflowExecutionHistoryQuery = `DELETE FROM events ` +
+		`WHERE domain_id = ? AND workflow_id = ? AND run_id = ?`
+)
+
+type (
+	sqlHistoryPersistence struct {
+		db     *sqlDB
+		logger bark.Logger
+	}
+)
+
+// NewSQLHistoryPersistence is used to create an instance of HistoryManager implementation
+func NewSQLHistoryPersistence(driverName, dataSourceName string, logger bark.Logger) (HistoryManager, error) {
+	db, err := openSQLDB(driverName, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlHistoryPersistence{db: db, logger: logger}, nil
+}
+
+// Close gracefully releases the resources held by this object
+func (h *sqlHistoryPersistence) Close() {
+	if h.db != nil {
+		h.db.Close()
+	}
+}
+
+func (h *sqlHistoryPersistence) AppendHistoryEvents(request *AppendHistoryEventsRequest) error {
+	domainID := request.DomainID
+	workflowID := *request.Execution.WorkflowId
+	runID := *request.Execution.RunId
+
+	return h.db.txn("AppendHistoryEvents", func(tx *sqlTx) error {
+		var rangeID, txID int64
+		err := tx.queryRow(tx.forUpdate(sqlTemplateGetHistoryEventsTxQuery), domainID, workflowID, runID,
+			request.FirstEventID).Scan(&rangeID, &txID)
+		if err != nil && err != sql.ErrNoRows {
+			return sqlInternalError("AppendHistoryEvents", err)
+		}
+		exists := err == nil
+
+		if request.Overwrite {
+			// Overwrite is only allowed by a writer holding the same or a newer range for the shard, and only once
+			// per transaction
+			if !exists || rangeID > request.RangeID || txID >= request.TransactionID {
+				return &ConditionFailedError{
+					Msg: "Failed to append history events.",
+				}
+			}
+
+			_, err = tx.exec(sqlTemplateOverwriteHistoryEventsQuery,
+				request.RangeID,
+				request.TransactionID,
+				request.Events.Data,
+				request.Events.EncodingType,
+				request.Events.Version,
+				domainID,
+				workflowID,
+				runID,
+				request.FirstEventID)
+		} else {
+			if exists {
+				return &ConditionFailedError{
+					Msg: "Failed to append history events.",
+				}
+			}
+
+			_, err = tx.exec(sqlTemplateAppendHistoryEventsQuery,
+				domainID,
+				workflowID,
+				runID,
+				request.FirstEventID,
+				request.RangeID,
+				request.TransactionID,
+				request.Events.Data,
+				request.Events.EncodingType,
+				request.Events.Version)
+		}
+		if err != nil {
+			return sqlInternalError("AppendHistoryEvents", err)
+		}
+
+		return nil
+	})
+}
+
+func (h *sqlHistoryPersistence) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (
+	*GetWorkflowExecutionHistoryResponse, error) {
+	offset, err := getPageTokenOffset(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// One extra row is read to find out if there is another page
+	limit := math.MaxInt32
+	if request.PageSize > 0 {
+		limit = request.PageSize + 1
+	}
+
+	execution := request.Execution
+	rows, err := h.db.query(sqlTemplateGetWorkflowExecutionHistoryQuery,
+		request.DomainID,
+		*execution.WorkflowId,
+		*execution.RunId,
+		request.FirstEventID,
+		request.NextEventID,
+		limit,
+		offset)
+	if err != nil {
+		return nil, sqlInternalError("GetWorkflowExecutionHistory", err)
+	}
+	defer rows.Close()
+
+	response := &GetWorkflowExecutionHistoryResponse{}
+	for rows.Next() {
+		var history SerializedHistoryEventBatch
+		if err := rows.Scan(&history.Data, &history.EncodingType, &history.Version); err != nil {
+			return nil, sqlInternalError("GetWorkflowExecutionHistory", err)
+		}
+		response.Events = append(response.Events, history)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, sqlInternalError("GetWorkflowExecutionHistory", err)
+	}
+
+	if len(response.Events) == 0 {
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("Workflow execution history not found.  WorkflowId: %v, RunId: %v",
+				*execution.WorkflowId, *execution.RunId),
+		}
+	}
+
+	total := offset + len(response.Events)
+	if request.PageSize > 0 && len(response.Events) > request.PageSize {
+		response.Events = response.Events[:request.PageSize]
+	}
+	response.NextPageToken = newOffsetPageToken(offset+len(response.Events), total)
+
+	return response, nil
+}
+
+func (h *sqlHistoryPersistence) GetWorkflowExecutionLastHistoryBatch(
+	request *GetWorkflowExecutionLastHistoryBatchRequest) (*GetWorkflowExecutionLastHistoryBatchResponse, error) {
+	execution := request.Execution
+	response := &GetWorkflowExecutionLastHistoryBatchResponse{}
+	history := &response.Events
+	err := h.db.queryRow(sqlTemplateGetWorkflowExecutionLastHistoryBatchQuery,
+		request.DomainID,
+		*execution.WorkflowId,
+		*execution.RunId,
+		request.NextEventID).Scan(&history.Data, &history.EncodingType, &history.Version)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Workflow execution history not found.  WorkflowId: %v, RunId: %v",
+					*execution.WorkflowId, *execution.RunId),
+			}
+		}
+		return nil, sqlInternalError("GetWorkflowExecutionLastHistoryBatch", err)
+	}
+
+	return response, nil
+}
+
+func (h *sqlHistoryPersistence) DeleteWorkflowExecutionHistory(
+	request *DeleteWorkflowExecutionHistoryRequest) error {
+	execution := request.Execution
+	if _, err := h.db.exec(sqlTemplateDeleteWorkflowExecutionHistoryQuery,
+		request.DomainID,
+		*execution.WorkflowId,
+		*execution.RunId); err != nil {
+		return sqlInternalError("DeleteWorkflowExecutionHistory", err)
+	}
+
+	return nil
+}
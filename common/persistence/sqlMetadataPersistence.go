@@ -0,0 +1,232 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+const (
+	sqlTemplateDomainColumns = `id, name, status, description, owner_email, retention, emit_metric`
+
+	// the quotas of a domain are left at their defaults when the domain is created
+	sqlTemplateDomainQuotaColumns = `start_workflow_rps, signal_workflow_rps, poll_rps, history_read_rps`
+
+	sqlTemplateCreateDomainQuery = `INSERT INTO domains (` + sqlTemplateDomainColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	sqlTemplateCreateDomainByNameQuery = `INSERT INTO domains_by_name (` + sqlTemplateDomainColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	sqlTemplateGetDomainQuery = `SELECT ` + sqlTemplateDomainColumns + `, ` + sqlTemplateDomainQuotaColumns +
+		` FROM domains WHERE id = ?`
+
+	sqlTemplateGetDomainByNameQuery = `SELECT ` + sqlTemplateDomainColumns + `, ` + sqlTemplateDomainQuotaColumns +
+		` FROM domains_by_name WHERE name = ?`
+
+	sqlTemplateUpdateDomainQuery = `UPDATE domains ` +
+		`SET name = ?, status = ?, description = ?, owner_email = ?, retention = ?, emit_metric = ?, ` +
+		`start_workflow_rps = ?, signal_workflow_rps = ?, poll_rps = ?, history_read_rps = ? ` +
+		`WHERE id = ?`
+
+	sqlTemplateUpdateDomainByNameQuery = `UPDATE domains_by_name ` +
+		`SET id = ?, status = ?, description = ?, owner_email = ?, retention = ?, emit_metric = ?, ` +
+		`start_workflow_rps = ?, signal_workflow_rps = ?, poll_rps = ?, history_read_rps = ? ` +
+		`WHERE name = ?`
+
+	sqlTemplateDeleteDomainQuery = `DELETE FROM domains WHERE id = ?`
+
+	sqlTemplateDeleteDomainByNameQuery = `DELETE FROM domains_by_name WHERE name = ?`
+)
+
+type (
+	sqlMetadataPersistence struct {
+		db     *sqlDB
+		logger bark.Logger
+	}
+)
+
+// NewSQLMetadataPersistence is used to create an instance of MetadataManager implementation
+func NewSQLMetadataPersistence(driverName, dataSourceName string, logger bark.Logger) (MetadataManager, error) {
+	db, err := openSQLDB(driverName, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlMetadataPersistence{db: db, logger: logger}, nil
+}
+
+// Close releases the resources held by this object
+func (m *sqlMetadataPersistence) Close() {
+	if m.db != nil {
+		m.db.Close()
+	}
+}
+
+// Domains are kept in two tables, by ID and by name, the same way as the Cassandra implementation.  Unlike Cassandra
+// both rows are written in a single transaction, so there are no orphaned rows to clean up.
+func (m *sqlMetadataPersistence) CreateDomain(request *CreateDomainRequest) (*CreateDomainResponse, error) {
+	domainUUID := uuid.New()
+	err := m.db.txn("CreateDomain", func(tx *sqlTx) error {
+		var existingID string
+		err := tx.queryRow(tx.forUpdate(`SELECT id FROM domains_by_name WHERE name = ?`), request.Name).Scan(
+			&existingID)
+		if err == nil {
+			return &workflow.DomainAlreadyExistsError{
+				Message: fmt.Sprintf("Domain already exists.  DomainId: %v", existingID),
+			}
+		}
+		if err != sql.ErrNoRows {
+			return sqlInternalError("CreateDomain", err)
+		}
+
+		for _, query := range []string{sqlTemplateCreateDomainQuery, sqlTemplateCreateDomainByNameQuery} {
+			if _, err := tx.exec(query,
+				domainUUID,
+				request.Name,
+				request.Status,
+				request.Description,
+				request.OwnerEmail,
+				request.Retention,
+				request.EmitMetric); err != nil {
+				return sqlInternalError("CreateDomain", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateDomainResponse{ID: domainUUID}, nil
+}
+
+func (m *sqlMetadataPersistence) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
+	var row *sql.Row
+	var d string
+	if len(request.ID) > 0 {
+		if len(request.Name) > 0 {
+			return nil, &workflow.BadRequestError{
+				Message: "GetDomain operation failed.  Both ID and Name specified in request.",
+			}
+		}
+
+		d = request.ID
+		row = m.db.queryRow(sqlTemplateGetDomainQuery, request.ID)
+	} else if len(request.Name) > 0 {
+		d = request.Name
+		row = m.db.queryRow(sqlTemplateGetDomainByNameQuery, request.Name)
+	} else {
+		return nil, &workflow.BadRequestError{
+			Message: "GetDomain operation failed.  Both ID and Name are empty.",
+		}
+	}
+
+	info := &DomainInfo{}
+	config := &DomainConfig{}
+	err := row.Scan(
+		&info.ID,
+		&info.Name,
+		&info.Status,
+		&info.Description,
+		&info.OwnerEmail,
+		&config.Retention,
+		&config.EmitMetric,
+		&config.StartWorkflowRPS,
+		&config.SignalWorkflowRPS,
+		&config.PollRPS,
+		&config.HistoryReadRPS)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Domain %s does not exist.", d),
+			}
+		}
+
+		return nil, sqlInternalError("GetDomain", err)
+	}
+
+	return &GetDomainResponse{
+		Info:   info,
+		Config: config,
+	}, nil
+}
+
+func (m *sqlMetadataPersistence) UpdateDomain(request *UpdateDomainRequest) error {
+	info := request.Info
+	config := request.Config
+	return m.db.txn("UpdateDomain", func(tx *sqlTx) error {
+		if _, err := tx.exec(sqlTemplateUpdateDomainQuery,
+			info.Name,
+			info.Status,
+			info.Description,
+			info.OwnerEmail,
+			config.Retention,
+			config.EmitMetric,
+			config.StartWorkflowRPS,
+			config.SignalWorkflowRPS,
+			config.PollRPS,
+			config.HistoryReadRPS,
+			info.ID); err != nil {
+			return sqlInternalError("UpdateDomain", err)
+		}
+
+		if _, err := tx.exec(sqlTemplateUpdateDomainByNameQuery,
+			info.ID,
+			info.Status,
+			info.Description,
+			info.OwnerEmail,
+			config.Retention,
+			config.EmitMetric,
+			config.StartWorkflowRPS,
+			config.SignalWorkflowRPS,
+			config.PollRPS,
+			config.HistoryReadRPS,
+			info.Name); err != nil {
+			return sqlInternalError("UpdateDomain", err)
+		}
+
+		return nil
+	})
+}
+
+func (m *sqlMetadataPersistence) DeleteDomain(request *DeleteDomainRequest) error {
+	if _, err := m.db.exec(sqlTemplateDeleteDomainQuery, request.ID); err != nil {
+		return sqlInternalError("DeleteDomain", err)
+	}
+
+	return nil
+}
+
+func (m *sqlMetadataPersistence) DeleteDomainByName(request *DeleteDomainByNameRequest) error {
+	if _, err := m.db.exec(sqlTemplateDeleteDomainByNameQuery, request.Name); err != nil {
+		return sqlInternalError("DeleteDomainByName", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,1384 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+const (
+	sqlTemplateShardColumns = `shard_id, owner, range_id, stolen_since_renew, updated_at, transfer_ack_level, ` +
+		`timer_ack_level`
+
+	sqlTemplateCreateShardQuery = `INSERT INTO shards (` + sqlTemplateShardColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	sqlTemplateGetShardQuery = `SELECT ` + sqlTemplateShardColumns + ` FROM shards WHERE shard_id = ?`
+
+	sqlTemplateGetShardRangeIDQuery = `SELECT range_id FROM shards WHERE shard_id = ?`
+
+	sqlTemplateUpdateShardQuery = `UPDATE shards SET owner = ?, range_id = ?, stolen_since_renew = ?, updated_at = ?, ` +
+		`transfer_ack_level = ?, timer_ack_level = ? WHERE shard_id = ?`
+
+	sqlTemplateGetCurrentExecutionQuery = `SELECT run_id, create_request_id, state, close_status ` +
+		`FROM current_executions WHERE shard_id = ? AND domain_id = ? AND workflow_id = ?`
+
+	sqlTemplateCreateCurrentExecutionQuery = `INSERT INTO current_executions ` +
+		`(shard_id, domain_id, workflow_id, run_id, create_request_id, state, close_status) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	sqlTemplateUpdateCurrentExecutionQuery = `UPDATE current_executions SET run_id = ?, create_request_id = ?, ` +
+		`state = ?, close_status = ? WHERE shard_id = ? AND domain_id = ? AND workflow_id = ?`
+
+	sqlTemplateCloseCurrentExecutionQuery = `UPDATE current_executions SET state = ?, close_status = ? ` +
+		`WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
+
+	sqlTemplateExecutionColumns = `domain_id, workflow_id, run_id, parent_domain_id, parent_workflow_id, parent_run_id, ` +
+		`initiated_id, completion_event, task_list, workflow_type_name, decision_timeout_value, execution_context, ` +
+		`state, close_status, next_event_id, last_processed_event, start_time, last_updated_time, ` +
+		`create_request_id, decision_schedule_id, decision_started_id, decision_request_id, decision_timeout, ` +
+		`cancel_requested, cancel_request_id, cron_schedule, attempt, has_retry_policy, sticky_task_list, ` +
+		`sticky_schedule_to_start_timeout, search_attributes`
+
+	sqlTemplateCreateExecutionQuery = `INSERT INTO executions (shard_id, ` + sqlTemplateExecutionColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlTemplateExecutionKeyCondition = ` WHERE shard_id = ? AND domain_id = ? AND workflow_id = ? AND run_id = ?`
+
+	sqlTemplateGetExecutionQuery = `SELECT ` + sqlTemplateExecutionColumns + ` FROM executions` +
+		sqlTemplateExecutionKeyCondition
+
+	sqlTemplateGetExecutionNextEventIDQuery = `SELECT next_event_id FROM executions` + sqlTemplateExecutionKeyCondition
+
+	sqlTemplateUpdateExecutionQuery = `UPDATE executions SET parent_domain_id = ?, parent_workflow_id = ?, ` +
+		`parent_run_id = ?, initiated_id = ?, completion_event = ?, task_list = ?, workflow_type_name = ?, ` +
+		`decision_timeout_value = ?, execution_context = ?, state = ?, close_status = ?, next_event_id = ?, ` +
+		`last_processed_event = ?, start_time = ?, last_updated_time = ?, create_request_id = ?, ` +
+		`decision_schedule_id = ?, decision_started_id = ?, decision_request_id = ?, decision_timeout = ?, ` +
+		`cancel_requested = ?, cancel_request_id = ?, cron_schedule = ?, attempt = ?, has_retry_policy = ?, ` +
+		`sticky_task_list = ?, sticky_schedule_to_start_timeout = ?, search_attributes = ?` +
+		sqlTemplateExecutionKeyCondition
+
+	sqlTemplateDeleteExecutionQuery = `DELETE FROM executions` + sqlTemplateExecutionKeyCondition
+
+	sqlTemplateActivityInfoColumns = `schedule_id, scheduled_event, scheduled_time, started_id, started_event, ` +
+		`started_time, activity_id, request_id, details, schedule_to_start_timeout, schedule_to_close_timeout, ` +
+		`start_to_close_timeout, heartbeat_timeout, cancel_requested, cancel_request_id, ` +
+		`last_heartbeat_updated_time, timer_task_status, attempt`
+
+	sqlTemplateCreateActivityInfoQuery = `INSERT INTO activity_info_maps ` +
+		`(shard_id, domain_id, workflow_id, run_id, ` + sqlTemplateActivityInfoColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlTemplateGetActivityInfosQuery = `SELECT ` + sqlTemplateActivityInfoColumns + ` FROM activity_info_maps` +
+		sqlTemplateExecutionKeyCondition
+
+	sqlTemplateDeleteActivityInfoQuery = `DELETE FROM activity_info_maps` + sqlTemplateExecutionKeyCondition +
+		` AND schedule_id = ?`
+
+	sqlTemplateDeleteActivityInfosQuery = `DELETE FROM activity_info_maps` + sqlTemplateExecutionKeyCondition
+
+	sqlTemplateTimerInfoColumns = `timer_id, started_id, expiry_time, task_id`
+
+	sqlTemplateCreateTimerInfoQuery = `INSERT INTO timer_info_maps ` +
+		`(shard_id, domain_id, workflow_id, run_id, ` + sqlTemplateTimerInfoColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlTemplateGetTimerInfosQuery = `SELECT ` + sqlTemplateTimerInfoColumns + ` FROM timer_info_maps` +
+		sqlTemplateExecutionKeyCondition
+
+	sqlTemplateDeleteTimerInfoQuery = `DELETE FROM timer_info_maps` + sqlTemplateExecutionKeyCondition +
+		` AND timer_id = ?`
+
+	sqlTemplateDeleteTimerInfosQuery = `DELETE FROM timer_info_maps` + sqlTemplateExecutionKeyCondition
+
+	sqlTemplateChildExecutionInfoColumns = `initiated_id, initiated_event, started_id, started_event, create_request_id`
+
+	sqlTemplateCreateChildExecutionInfoQuery = `INSERT INTO child_execution_info_maps ` +
+		`(shard_id, domain_id, workflow_id, run_id, ` + sqlTemplateChildExecutionInfoColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlTemplateGetChildExecutionInfosQuery = `SELECT ` + sqlTemplateChildExecutionInfoColumns +
+		` FROM child_execution_info_maps` + sqlTemplateExecutionKeyCondition
+
+	sqlTemplateDeleteChildExecutionInfoQuery = `DELETE FROM child_execution_info_maps` + sqlTemplateExecutionKeyCondition +
+		` AND initiated_id = ?`
+
+	sqlTemplateDeleteChildExecutionInfosQuery = `DELETE FROM child_execution_info_maps` + sqlTemplateExecutionKeyCondition
+
+	sqlTemplateRequestCancelInfoColumns = `initiated_id, cancel_request_id`
+
+	sqlTemplateCreateRequestCancelInfoQuery = `INSERT INTO request_cancel_info_maps ` +
+		`(shard_id, domain_id, workflow_id, run_id, ` + sqlTemplateRequestCancelInfoColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?)`
+
+	sqlTemplateGetRequestCancelInfosQuery = `SELECT ` + sqlTemplateRequestCancelInfoColumns +
+		` FROM request_cancel_info_maps` + sqlTemplateExecutionKeyCondition
+
+	sqlTemplateDeleteRequestCancelInfoQuery = `DELETE FROM request_cancel_info_maps` + sqlTemplateExecutionKeyCondition +
+		` AND initiated_id = ?`
+
+	sqlTemplateDeleteRequestCancelInfosQuery = `DELETE FROM request_cancel_info_maps` + sqlTemplateExecutionKeyCondition
+
+	sqlTemplateSignalInfoColumns = `initiated_id, signal_request_id, signal_name, input, control`
+
+	sqlTemplateCreateSignalInfoQuery = `INSERT INTO signal_info_maps ` +
+		`(shard_id, domain_id, workflow_id, run_id, ` + sqlTemplateSignalInfoColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlTemplateGetSignalInfosQuery = `SELECT ` + sqlTemplateSignalInfoColumns +
+		` FROM signal_info_maps` + sqlTemplateExecutionKeyCondition
+
+	sqlTemplateDeleteSignalInfoQuery = `DELETE FROM signal_info_maps` + sqlTemplateExecutionKeyCondition +
+		` AND initiated_id = ?`
+
+	sqlTemplateDeleteSignalInfosQuery = `DELETE FROM signal_info_maps` + sqlTemplateExecutionKeyCondition
+
+	sqlTemplateTransferTaskColumns = `domain_id, workflow_id, run_id, task_id, target_domain_id, target_workflow_id, ` +
+		`target_run_id, task_list, type, schedule_id`
+
+	sqlTemplateCreateTransferTaskQuery = `INSERT INTO transfer_tasks (shard_id, ` + sqlTemplateTransferTaskColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlTemplateGetTransferTasksQuery = `SELECT ` + sqlTemplateTransferTaskColumns + ` FROM transfer_tasks ` +
+		`WHERE shard_id = ? AND task_id > ? AND task_id <= ? ORDER BY task_id LIMIT ?`
+
+	sqlTemplateCompleteTransferTaskQuery = `DELETE FROM transfer_tasks WHERE shard_id = ? AND task_id = ?`
+
+	sqlTemplateTimerTaskColumns = `domain_id, workflow_id, run_id, visibility_ts, task_id, type, timeout_type, ` +
+		`event_id, schedule_attempt`
+
+	sqlTemplateCreateTimerTaskQuery = `INSERT INTO timer_tasks (shard_id, ` + sqlTemplateTimerTaskColumns + `) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlTemplateGetTimerTasksQuery = `SELECT ` + sqlTemplateTimerTaskColumns + ` FROM timer_tasks ` +
+		`WHERE shard_id = ? AND visibility_ts >= ? AND visibility_ts < ? ORDER BY visibility_ts, task_id LIMIT ?`
+
+	sqlTemplateCompleteTimerTaskQuery = `DELETE FROM timer_tasks WHERE shard_id = ? AND visibility_ts = ? AND task_id = ?`
+
+	sqlTemplateTaskListKeyCondition = ` WHERE domain_id = ? AND name = ? AND task_type = ?`
+
+	sqlTemplateGetTaskListQuery = `SELECT range_id, ack_level FROM task_lists` + sqlTemplateTaskListKeyCondition
+
+	sqlTemplateCreateTaskListQuery = `INSERT INTO task_lists (domain_id, name, task_type, range_id, ack_level) ` +
+		`VALUES (?, ?, ?, ?, ?)`
+
+	sqlTemplateUpdateTaskListQuery = `UPDATE task_lists SET range_id = ?, ack_level = ?` + sqlTemplateTaskListKeyCondition
+
+	sqlTemplateCreateTaskQuery = `INSERT INTO tasks (domain_id, task_list_name, task_list_type, task_id, workflow_id, ` +
+		`run_id, schedule_id, expiry_ts) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlTemplateGetTasksQuery = `SELECT task_id, workflow_id, run_id, schedule_id FROM tasks ` +
+		`WHERE domain_id = ? AND task_list_name = ? AND task_list_type = ? AND task_id > ? AND task_id <= ? ` +
+		`AND (expiry_ts = 0 OR expiry_ts > ?) ORDER BY task_id LIMIT ?`
+
+	sqlTemplateCompleteTaskQuery = `DELETE FROM tasks ` +
+		`WHERE domain_id = ? AND task_list_name = ? AND task_list_type = ? AND task_id = ?`
+
+	// sqlCreateWorkflowExecutionAttempts is the number of times creating an execution is tried when a concurrent
+	// start of the same workflow inserts its current execution row first
+	sqlCreateWorkflowExecutionAttempts = 3
+)
+
+// errCurrentExecutionConflict is returned within a transaction creating an execution when a concurrent start of the
+// same workflow inserted the current execution row after it was found missing
+var errCurrentExecutionConflict = errors.New("current execution created concurrently")
+
+type (
+	sqlPersistence struct {
+		db      *sqlDB
+		shardID int
+		logger  bark.Logger
+	}
+
+	// sqlRowScanner is implemented by both *sql.Row and *sql.Rows
+	sqlRowScanner interface {
+		Scan(dest ...interface{}) error
+	}
+)
+
+// NewSQLShardPersistence is used to create an instance of ShardManager implementation
+func NewSQLShardPersistence(driverName, dataSourceName string, logger bark.Logger) (ShardManager, error) {
+	db, err := openSQLDB(driverName, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlPersistence{db: db, shardID: -1, logger: logger}, nil
+}
+
+// NewSQLWorkflowExecutionPersistence is used to create an instance of workflowExecutionManager implementation
+func NewSQLWorkflowExecutionPersistence(driverName, dataSourceName string, shardID int, logger bark.Logger) (
+	ExecutionManager, error) {
+	db, err := openSQLDB(driverName, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlPersistence{db: db, shardID: shardID, logger: logger}, nil
+}
+
+// NewSQLTaskPersistence is used to create an instance of TaskManager implementation
+func NewSQLTaskPersistence(driverName, dataSourceName string, logger bark.Logger) (TaskManager, error) {
+	db, err := openSQLDB(driverName, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlPersistence{db: db, shardID: -1, logger: logger}, nil
+}
+
+// Close releases the underlying resources held by this object
+func (d *sqlPersistence) Close() {
+	if d.db != nil {
+		d.db.Close()
+	}
+}
+
+func (d *sqlPersistence) CreateShard(request *CreateShardRequest) error {
+	shardInfo := request.ShardInfo
+	return d.db.txn("CreateShard", func(tx *sqlTx) error {
+		var rangeID int64
+		err := tx.queryRow(tx.forUpdate(sqlTemplateGetShardRangeIDQuery), shardInfo.ShardID).Scan(&rangeID)
+		if err == nil {
+			return &ShardAlreadyExistError{
+				Msg: fmt.Sprintf("Shard already exists in executions table.  ShardId: %v, RangeId: %v",
+					shardInfo.ShardID, rangeID),
+			}
+		}
+		if err != sql.ErrNoRows {
+			return sqlInternalError("CreateShard", err)
+		}
+
+		_, err = tx.exec(sqlTemplateCreateShardQuery,
+			shardInfo.ShardID,
+			shardInfo.Owner,
+			shardInfo.RangeID,
+			shardInfo.StolenSinceRenew,
+			sqlTimestamp(time.Now()),
+			shardInfo.TransferAckLevel,
+			sqlTimestamp(shardInfo.TimerAckLevel))
+		if err != nil {
+			return sqlInternalError("CreateShard", err)
+		}
+
+		return nil
+	})
+}
+
+func (d *sqlPersistence) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	var updatedAt, timerAckLevel int64
+	info := &ShardInfo{}
+	err := d.db.queryRow(sqlTemplateGetShardQuery, request.ShardID).Scan(
+		&info.ShardID,
+		&info.Owner,
+		&info.RangeID,
+		&info.StolenSinceRenew,
+		&updatedAt,
+		&info.TransferAckLevel,
+		&timerAckLevel)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Shard not found.  ShardId: %v", request.ShardID),
+			}
+		}
+
+		return nil, sqlInternalError("GetShard", err)
+	}
+	info.UpdatedAt = fromSQLTimestamp(updatedAt)
+	info.TimerAckLevel = fromSQLTimestamp(timerAckLevel)
+
+	return &GetShardResponse{ShardInfo: info}, nil
+}
+
+func (d *sqlPersistence) UpdateShard(request *UpdateShardRequest) error {
+	shardInfo := request.ShardInfo
+	return d.db.txn("UpdateShard", func(tx *sqlTx) error {
+		var rangeID int64
+		err := tx.queryRow(tx.forUpdate(sqlTemplateGetShardRangeIDQuery), shardInfo.ShardID).Scan(&rangeID)
+		if err != nil && err != sql.ErrNoRows {
+			return sqlInternalError("UpdateShard", err)
+		}
+		if err == sql.ErrNoRows || rangeID != request.PreviousRangeID {
+			actualRangeID := int64(-1)
+			if err == nil {
+				actualRangeID = rangeID
+			}
+			return &ShardOwnershipLostError{
+				ShardID: shardInfo.ShardID,
+				Msg: fmt.Sprintf("Failed to update shard.  previous_range_id: %v, range_id: %v",
+					request.PreviousRangeID, actualRangeID),
+			}
+		}
+
+		_, err = tx.exec(sqlTemplateUpdateShardQuery,
+			shardInfo.Owner,
+			shardInfo.RangeID,
+			shardInfo.StolenSinceRenew,
+			sqlTimestamp(time.Now()),
+			shardInfo.TransferAckLevel,
+			sqlTimestamp(shardInfo.TimerAckLevel),
+			shardInfo.ShardID)
+		if err != nil {
+			return sqlInternalError("UpdateShard", err)
+		}
+
+		return nil
+	})
+}
+
+func (d *sqlPersistence) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (
+	*CreateWorkflowExecutionResponse, error) {
+	var err error
+	for attempt := 0; attempt < sqlCreateWorkflowExecutionAttempts; attempt++ {
+		err = d.db.txn("CreateWorkflowExecution", func(tx *sqlTx) error {
+			if err := d.lockShard(tx, request.RangeID, "Failed to create workflow execution"); err != nil {
+				return err
+			}
+
+			if err := d.createWorkflowExecution(tx, request, time.Now()); err != nil {
+				return err
+			}
+
+			if err := d.createTransferTasks(tx, request.TransferTasks, request.DomainID,
+				*request.Execution.WorkflowId, *request.Execution.RunId); err != nil {
+				return err
+			}
+
+			return d.createTimerTasks(tx, request.TimerTasks, nil, request.DomainID, *request.Execution.WorkflowId,
+				*request.Execution.RunId)
+		})
+		// On a conflict, try again to read the current execution row written by the concurrent start, which fails
+		// this request with WorkflowExecutionAlreadyStartedError
+		if err != errCurrentExecutionConflict {
+			break
+		}
+	}
+	if err != nil {
+		if err == errCurrentExecutionConflict {
+			return nil, sqlInternalError("CreateWorkflowExecution", err)
+		}
+		return nil, err
+	}
+
+	return &CreateWorkflowExecutionResponse{TaskID: uuid.New()}, nil
+}
+
+func (d *sqlPersistence) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (
+	*GetWorkflowExecutionResponse, error) {
+	execution := request.Execution
+	domainID := request.DomainID
+	workflowID := *execution.WorkflowId
+	runID := *execution.RunId
+
+	state := &WorkflowMutableState{}
+	err := d.db.txn("GetWorkflowExecution", func(tx *sqlTx) error {
+		info, err := scanWorkflowExecutionInfo(tx.queryRow(sqlTemplateGetExecutionQuery, d.shardID, domainID, workflowID,
+			runID))
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return &workflow.EntityNotExistsError{
+					Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+						workflowID, runID),
+				}
+			}
+
+			return sqlInternalError("GetWorkflowExecution", err)
+		}
+		state.ExecutionInfo = info
+
+		if state.ActivitInfos, err = d.getActivityInfos(tx, domainID, workflowID, runID); err != nil {
+			return sqlInternalError("GetWorkflowExecution", err)
+		}
+		if state.TimerInfos, err = d.getTimerInfos(tx, domainID, workflowID, runID); err != nil {
+			return sqlInternalError("GetWorkflowExecution", err)
+		}
+		if state.ChildExecutionInfos, err = d.getChildExecutionInfos(tx, domainID, workflowID, runID); err != nil {
+			return sqlInternalError("GetWorkflowExecution", err)
+		}
+		if state.RequestCancelInfos, err = d.getRequestCancelInfos(tx, domainID, workflowID, runID); err != nil {
+			return sqlInternalError("GetWorkflowExecution", err)
+		}
+		if state.SignalInfos, err = d.getSignalInfos(tx, domainID, workflowID, runID); err != nil {
+			return sqlInternalError("GetWorkflowExecution", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetWorkflowExecutionResponse{State: state}, nil
+}
+
+func (d *sqlPersistence) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) error {
+	executionInfo := request.ExecutionInfo
+	domainID := executionInfo.DomainID
+	workflowID := executionInfo.WorkflowID
+	runID := executionInfo.RunID
+
+	searchAttributes, err := sqlSearchAttributes(executionInfo.SearchAttributes)
+	if err != nil {
+		return sqlInternalError("UpdateWorkflowExecution", err)
+	}
+
+	return d.db.txn("UpdateWorkflowExecution", func(tx *sqlTx) error {
+		if err := d.lockShard(tx, request.RangeID, "Failed to update workflow execution"); err != nil {
+			return err
+		}
+
+		// The row lock on the execution makes the next_event_id check and the update below atomic
+		var nextEventID int64
+		err := tx.queryRow(tx.forUpdate(sqlTemplateGetExecutionNextEventIDQuery), d.shardID, domainID, workflowID,
+			runID).Scan(&nextEventID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return &ConditionFailedError{
+					Msg: fmt.Sprintf("Failed to update workflow execution.  RangeID: %v, Condition: %v, "+
+						"execution not found", request.RangeID, request.Condition),
+				}
+			}
+
+			return sqlInternalError("UpdateWorkflowExecution", err)
+		}
+
+		if nextEventID != request.Condition {
+			return &ConditionFailedError{
+				Msg: fmt.Sprintf("Failed to update workflow execution.  Request Condition: %v, Actual Value: %v",
+					request.Condition, nextEventID),
+			}
+		}
+
+		_, err = tx.exec(sqlTemplateUpdateExecutionQuery,
+			executionInfo.ParentDomainID,
+			executionInfo.ParentWorkflowID,
+			executionInfo.ParentRunID,
+			executionInfo.InitiatedID,
+			executionInfo.CompletionEvent,
+			executionInfo.TaskList,
+			executionInfo.WorkflowTypeName,
+			executionInfo.DecisionTimeoutValue,
+			executionInfo.ExecutionContext,
+			executionInfo.State,
+			executionInfo.CloseStatus,
+			executionInfo.NextEventID,
+			executionInfo.LastProcessedEvent,
+			sqlTimestamp(executionInfo.StartTimestamp),
+			sqlTimestamp(time.Now()),
+			executionInfo.CreateRequestID,
+			executionInfo.DecisionScheduleID,
+			executionInfo.DecisionStartedID,
+			executionInfo.DecisionRequestID,
+			executionInfo.DecisionTimeout,
+			executionInfo.CancelRequested,
+			executionInfo.CancelRequestID,
+			executionInfo.CronSchedule,
+			executionInfo.Attempt,
+			executionInfo.HasRetryPolicy,
+			executionInfo.StickyTaskList,
+			executionInfo.StickyScheduleToStartTimeout,
+			searchAttributes,
+			d.shardID,
+			domainID,
+			workflowID,
+			runID)
+		if err != nil {
+			return sqlInternalError("UpdateWorkflowExecution", err)
+		}
+
+		if err := d.createTransferTasks(tx, request.TransferTasks, domainID, workflowID, runID); err != nil {
+			return err
+		}
+
+		if err := d.createTimerTasks(tx, request.TimerTasks, request.DeleteTimerTask, domainID, workflowID,
+			runID); err != nil {
+			return err
+		}
+
+		if err := d.updateMutableState(tx, request); err != nil {
+			return sqlInternalError("UpdateWorkflowExecution", err)
+		}
+
+		if request.ContinueAsNew != nil {
+			startReq := request.ContinueAsNew
+			if err := d.createWorkflowExecution(tx, startReq, time.Now()); err != nil {
+				return err
+			}
+
+			if err := d.createTransferTasks(tx, startReq.TransferTasks, startReq.DomainID,
+				*startReq.Execution.WorkflowId, *startReq.Execution.RunId); err != nil {
+				return err
+			}
+
+			return d.createTimerTasks(tx, startReq.TimerTasks, nil, startReq.DomainID, *startReq.Execution.WorkflowId,
+				*startReq.Execution.RunId)
+		} else if request.CloseExecution {
+			// Keep the close status of the run on the current execution to enforce the WorkflowIdReusePolicy
+			if _, err := tx.exec(sqlTemplateCloseCurrentExecutionQuery, executionInfo.State, executionInfo.CloseStatus,
+				d.shardID, domainID, workflowID, runID); err != nil {
+				return sqlInternalError("UpdateWorkflowExecution", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (d *sqlPersistence) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	info := request.ExecutionInfo
+	return d.db.txn("DeleteWorkflowExecution", func(tx *sqlTx) error {
+		for _, query := range []string{
+			sqlTemplateDeleteActivityInfosQuery,
+			sqlTemplateDeleteTimerInfosQuery,
+			sqlTemplateDeleteChildExecutionInfosQuery,
+			sqlTemplateDeleteRequestCancelInfosQuery,
+			sqlTemplateDeleteSignalInfosQuery,
+			sqlTemplateDeleteExecutionQuery,
+		} {
+			if _, err := tx.exec(query, d.shardID, info.DomainID, info.WorkflowID, info.RunID); err != nil {
+				return sqlInternalError("DeleteWorkflowExecution", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (d *sqlPersistence) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse,
+	error) {
+	response := &GetCurrentExecutionResponse{}
+	err := d.db.queryRow(sqlTemplateGetCurrentExecutionQuery, d.shardID, request.DomainID, request.WorkflowID).Scan(
+		&response.RunID, &response.StartRequestID, &response.State, &response.CloseStatus)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v",
+					request.WorkflowID),
+			}
+		}
+
+		return nil, sqlInternalError("GetCurrentExecution", err)
+	}
+
+	return response, nil
+}
+
+func (d *sqlPersistence) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	rows, err := d.db.query(sqlTemplateGetTransferTasksQuery, d.shardID, request.ReadLevel, request.MaxReadLevel,
+		request.BatchSize)
+	if err != nil {
+		return nil, sqlInternalError("GetTransferTasks", err)
+	}
+	defer rows.Close()
+
+	response := &GetTransferTasksResponse{}
+	for rows.Next() {
+		t := &TransferTaskInfo{}
+		if err := rows.Scan(
+			&t.DomainID,
+			&t.WorkflowID,
+			&t.RunID,
+			&t.TaskID,
+			&t.TargetDomainID,
+			&t.TargetWorkflowID,
+			&t.TargetRunID,
+			&t.TaskList,
+			&t.TaskType,
+			&t.ScheduleID); err != nil {
+			return nil, sqlInternalError("GetTransferTasks", err)
+		}
+		response.Tasks = append(response.Tasks, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, sqlInternalError("GetTransferTasks", err)
+	}
+
+	return response, nil
+}
+
+func (d *sqlPersistence) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	if _, err := d.db.exec(sqlTemplateCompleteTransferTaskQuery, d.shardID, request.TaskID); err != nil {
+		return sqlInternalError("CompleteTransferTask", err)
+	}
+
+	return nil
+}
+
+func (d *sqlPersistence) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse,
+	error) {
+	rows, err := d.db.query(sqlTemplateGetTimerTasksQuery, d.shardID, sqlTimestamp(request.MinTimestamp),
+		sqlTimestamp(request.MaxTimestamp), request.BatchSize)
+	if err != nil {
+		return nil, sqlInternalError("GetTimerTasks", err)
+	}
+	defer rows.Close()
+
+	response := &GetTimerIndexTasksResponse{}
+	for rows.Next() {
+		var visibilityTS int64
+		t := &TimerTaskInfo{}
+		if err := rows.Scan(
+			&t.DomainID,
+			&t.WorkflowID,
+			&t.RunID,
+			&visibilityTS,
+			&t.TaskID,
+			&t.TaskType,
+			&t.TimeoutType,
+			&t.EventID,
+			&t.ScheduleAttempt); err != nil {
+			return nil, sqlInternalError("GetTimerTasks", err)
+		}
+		t.VisibilityTimestamp = fromSQLTimestamp(visibilityTS)
+		response.Timers = append(response.Timers, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, sqlInternalError("GetTimerTasks", err)
+	}
+
+	return response, nil
+}
+
+func (d *sqlPersistence) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	ts := sqlTimestamp(request.VisibilityTimestamp)
+	if _, err := d.db.exec(sqlTemplateCompleteTimerTaskQuery, d.shardID, ts, request.TaskID); err != nil {
+		return sqlInternalError("CompleteTimerTask", err)
+	}
+
+	return nil
+}
+
+// From TaskManager interface
+func (d *sqlPersistence) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
+	if len(request.TaskList) == 0 {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("LeaseTaskList requires non empty task list"),
+		}
+	}
+
+	tli := &TaskListInfo{
+		DomainID: request.DomainID,
+		Name:     request.TaskList,
+		TaskType: request.TaskType,
+	}
+	err := d.db.txn("LeaseTaskList", func(tx *sqlTx) error {
+		var rangeID, ackLevel int64
+		err := tx.queryRow(tx.forUpdate(sqlTemplateGetTaskListQuery), request.DomainID, request.TaskList,
+			request.TaskType).Scan(&rangeID, &ackLevel)
+		if err != nil {
+			if err != sql.ErrNoRows {
+				return sqlInternalError("LeaseTaskList", err)
+			}
+
+			// First time task list is used
+			tli.RangeID = initialRangeID
+			_, err = tx.exec(sqlTemplateCreateTaskListQuery, tli.DomainID, tli.Name, tli.TaskType, tli.RangeID, 0)
+		} else {
+			tli.RangeID = rangeID + 1
+			tli.AckLevel = ackLevel
+			_, err = tx.exec(sqlTemplateUpdateTaskListQuery, tli.RangeID, tli.AckLevel, tli.DomainID, tli.Name,
+				tli.TaskType)
+		}
+		if err != nil {
+			return sqlInternalError("LeaseTaskList", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeaseTaskListResponse{TaskListInfo: tli}, nil
+}
+
+// From TaskManager interface
+func (d *sqlPersistence) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+	tli := request.TaskListInfo
+	err := d.db.txn("UpdateTaskList", func(tx *sqlTx) error {
+		if _, err := d.lockTaskList(tx, tli); err != nil {
+			if _, ok := err.(*ConditionFailedError); ok {
+				return &ConditionFailedError{
+					Msg: fmt.Sprintf("Failed to update task list. name: %v, type: %v, rangeID: %v",
+						tli.Name, tli.TaskType, tli.RangeID),
+				}
+			}
+			return sqlInternalError("UpdateTaskList", err)
+		}
+
+		if _, err := tx.exec(sqlTemplateUpdateTaskListQuery, tli.RangeID, tli.AckLevel, tli.DomainID, tli.Name,
+			tli.TaskType); err != nil {
+			return sqlInternalError("UpdateTaskList", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateTaskListResponse{}, nil
+}
+
+// From TaskManager interface
+func (d *sqlPersistence) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	tli := request.TaskListInfo
+	err := d.db.txn("CreateTasks", func(tx *sqlTx) error {
+		if dbRangeID, err := d.lockTaskList(tx, tli); err != nil {
+			if _, ok := err.(*ConditionFailedError); ok {
+				return &ConditionFailedError{
+					Msg: fmt.Sprintf("Failed to create task. TaskList: %v, taskListType: %v, rangeID: %v, "+
+						"db rangeID: %v", tli.Name, tli.TaskType, tli.RangeID, dbRangeID),
+				}
+			}
+			return sqlInternalError("CreateTasks", err)
+		}
+
+		now := time.Now()
+		for _, task := range request.Tasks {
+			// Tasks with a schedule to start timeout expire the same way the Cassandra rows are written with a TTL
+			var expiry int64
+			if task.Data.ScheduleToStartTimeout != 0 {
+				expiry = sqlTimestamp(now.Add(time.Duration(task.Data.ScheduleToStartTimeout) * time.Second))
+			}
+
+			if _, err := tx.exec(sqlTemplateCreateTaskQuery,
+				tli.DomainID,
+				tli.Name,
+				tli.TaskType,
+				task.TaskID,
+				*task.Execution.WorkflowId,
+				*task.Execution.RunId,
+				task.Data.ScheduleID,
+				expiry); err != nil {
+				return sqlInternalError("CreateTasks", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateTasksResponse{}, nil
+}
+
+// From TaskManager interface
+func (d *sqlPersistence) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	if request.ReadLevel > request.MaxReadLevel {
+		return &GetTasksResponse{}, nil
+	}
+
+	rows, err := d.db.query(sqlTemplateGetTasksQuery,
+		request.DomainID,
+		request.TaskList,
+		request.TaskType,
+		request.ReadLevel,
+		request.MaxReadLevel,
+		sqlTimestamp(time.Now()),
+		request.BatchSize)
+	if err != nil {
+		return nil, sqlInternalError("GetTasks", err)
+	}
+	defer rows.Close()
+
+	response := &GetTasksResponse{}
+	for rows.Next() {
+		t := &TaskInfo{DomainID: request.DomainID}
+		if err := rows.Scan(&t.TaskID, &t.WorkflowID, &t.RunID, &t.ScheduleID); err != nil {
+			return nil, sqlInternalError("GetTasks", err)
+		}
+		response.Tasks = append(response.Tasks, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, sqlInternalError("GetTasks", err)
+	}
+
+	return response, nil
+}
+
+// From TaskManager interface
+func (d *sqlPersistence) CompleteTask(request *CompleteTaskRequest) error {
+	tli := request.TaskList
+	if _, err := d.db.exec(sqlTemplateCompleteTaskQuery, tli.DomainID, tli.Name, tli.TaskType,
+		request.TaskID); err != nil {
+		return sqlInternalError("CompleteTask", err)
+	}
+
+	return nil
+}
+
+// lockShard takes a shared lock on the shard row for the rest of the transaction and validates the range, which
+// gives the same fencing as the conditional lease update Cassandra adds to every execution batch.  UpdateShard
+// needs an exclusive lock on the row, so the range cannot move while a write of the previous owner is in flight.
+func (d *sqlPersistence) lockShard(tx *sqlTx, rangeID int64, operation string) error {
+	var actualRangeID int64
+	err := tx.queryRow(tx.forShare(sqlTemplateGetShardRangeIDQuery), d.shardID).Scan(&actualRangeID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &ConditionFailedError{
+				Msg: fmt.Sprintf("%v.  Shard not found.  ShardId: %v", operation, d.shardID),
+			}
+		}
+
+		return sqlInternalError(operation, err)
+	}
+
+	if actualRangeID != rangeID {
+		return &ShardOwnershipLostError{
+			ShardID: d.shardID,
+			Msg: fmt.Sprintf("%v.  Request RangeID: %v, Actual RangeID: %v",
+				operation, rangeID, actualRangeID),
+		}
+	}
+
+	return nil
+}
+
+// lockTaskList takes an exclusive lock on the task list row and returns ConditionFailedError if the task list is
+// not owned by the range of the request.  The range stored in the database is returned, -1 if the row is missing.
+func (d *sqlPersistence) lockTaskList(tx *sqlTx, tli *TaskListInfo) (int64, error) {
+	var rangeID, ackLevel int64
+	err := tx.queryRow(tx.forUpdate(sqlTemplateGetTaskListQuery), tli.DomainID, tli.Name, tli.TaskType).Scan(&rangeID,
+		&ackLevel)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return -1, &ConditionFailedError{}
+		}
+		return -1, err
+	}
+
+	if rangeID != tli.RangeID {
+		return rangeID, &ConditionFailedError{}
+	}
+
+	return rangeID, nil
+}
+
+func (d *sqlPersistence) createWorkflowExecution(tx *sqlTx, request *CreateWorkflowExecutionRequest,
+	now time.Time) error {
+	domainID := request.DomainID
+	workflowID := *request.Execution.WorkflowId
+	runID := *request.Execution.RunId
+
+	var currentRunID, currentRequestID string
+	var currentState, currentCloseStatus int
+	err := tx.queryRow(tx.forUpdate(sqlTemplateGetCurrentExecutionQuery), d.shardID, domainID, workflowID).Scan(
+		&currentRunID, &currentRequestID, &currentState, &currentCloseStatus)
+	if err != nil && err != sql.ErrNoRows {
+		return sqlInternalError("CreateWorkflowExecution", err)
+	}
+
+	if err == nil {
+		if !request.ContinueAsNew && currentRunID != request.PreviousRunID {
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v, rangeID: %v",
+				workflowID, currentRunID, request.RangeID)
+			return &workflow.WorkflowExecutionAlreadyStartedError{
+				Message:        common.StringPtr(msg),
+				StartRequestId: common.StringPtr(currentRequestID),
+				RunId:          common.StringPtr(currentRunID),
+			}
+		}
+
+		_, err = tx.exec(sqlTemplateUpdateCurrentExecutionQuery, runID, request.RequestID, WorkflowStateCreated,
+			WorkflowCloseStatusNone, d.shardID, domainID, workflowID)
+	} else {
+		// Locking a missing row does not keep a concurrent start of the workflow from inserting it first
+		_, err = tx.exec(sqlTemplateCreateCurrentExecutionQuery, d.shardID, domainID, workflowID, runID,
+			request.RequestID, WorkflowStateCreated, WorkflowCloseStatusNone)
+		if isConflictError(err) {
+			return errCurrentExecutionConflict
+		}
+	}
+	if err != nil {
+		return sqlInternalError("CreateWorkflowExecution", err)
+	}
+
+	parentDomainID := emptyDomainID
+	parentWorkflowID := ""
+	parentRunID := emptyRunID
+	initiatedID := emptyInitiatedID
+	if request.ParentExecution != nil {
+		parentDomainID = request.ParentDomainID
+		parentWorkflowID = *request.ParentExecution.WorkflowId
+		parentRunID = *request.ParentExecution.RunId
+		initiatedID = request.InitiatedID
+	}
+
+	searchAttributes, err := sqlSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return sqlInternalError("CreateWorkflowExecution", err)
+	}
+
+	_, err = tx.exec(sqlTemplateCreateExecutionQuery,
+		d.shardID,
+		domainID,
+		workflowID,
+		runID,
+		parentDomainID,
+		parentWorkflowID,
+		parentRunID,
+		initiatedID,
+		nil,
+		request.TaskList,
+		request.WorkflowTypeName,
+		request.DecisionTimeoutValue,
+		request.ExecutionContext,
+		WorkflowStateCreated,
+		WorkflowCloseStatusNone,
+		request.NextEventID,
+		request.LastProcessedEvent,
+		sqlTimestamp(now),
+		sqlTimestamp(now),
+		request.RequestID,
+		request.DecisionScheduleID,
+		request.DecisionStartedID,
+		"",
+		request.DecisionStartToCloseTimeout,
+		false,
+		"",
+		request.CronSchedule,
+		request.Attempt,
+		request.HasRetryPolicy,
+		"",
+		0,
+		searchAttributes)
+	if err != nil {
+		return sqlInternalError("CreateWorkflowExecution", err)
+	}
+
+	return nil
+}
+
+func (d *sqlPersistence) createTransferTasks(tx *sqlTx, transferTasks []Task, domainID, workflowID,
+	runID string) error {
+	for _, task := range transferTasks {
+		var taskList string
+		var scheduleID int64
+		targetDomainID := domainID
+		targetWorkflowID := transferTaskTransferTargetWorkflowID
+		targetRunID := transferTaskTypeTransferTargetRunID
+
+		switch task.GetType() {
+		case TransferTaskTypeActivityTask:
+			targetDomainID = task.(*ActivityTask).DomainID
+			taskList = task.(*ActivityTask).TaskList
+			scheduleID = task.(*ActivityTask).ScheduleID
+
+		case TransferTaskTypeDecisionTask:
+			targetDomainID = task.(*DecisionTask).DomainID
+			taskList = task.(*DecisionTask).TaskList
+			scheduleID = task.(*DecisionTask).ScheduleID
+
+		case TransferTaskTypeCancelExecution:
+			targetDomainID = task.(*CancelExecutionTask).TargetDomainID
+			targetWorkflowID = task.(*CancelExecutionTask).TargetWorkflowID
+			targetRunID = task.(*CancelExecutionTask).TargetRunID
+			scheduleID = task.(*CancelExecutionTask).ScheduleID
+
+		case TransferTaskTypeSignalExecution:
+			targetDomainID = task.(*SignalExecutionTask).TargetDomainID
+			targetWorkflowID = task.(*SignalExecutionTask).TargetWorkflowID
+			targetRunID = task.(*SignalExecutionTask).TargetRunID
+			scheduleID = task.(*SignalExecutionTask).InitiatedID
+
+		case TransferTaskTypeStartChildExecution:
+			targetDomainID = task.(*StartChildExecutionTask).TargetDomainID
+			targetWorkflowID = task.(*StartChildExecutionTask).TargetWorkflowID
+			scheduleID = task.(*StartChildExecutionTask).InitiatedID
+		}
+
+		if _, err := tx.exec(sqlTemplateCreateTransferTaskQuery,
+			d.shardID,
+			domainID,
+			workflowID,
+			runID,
+			task.GetTaskID(),
+			targetDomainID,
+			targetWorkflowID,
+			targetRunID,
+			taskList,
+			task.GetType(),
+			scheduleID); err != nil {
+			return sqlInternalError("CreateTransferTasks", err)
+		}
+	}
+
+	return nil
+}
+
+func (d *sqlPersistence) createTimerTasks(tx *sqlTx, timerTasks []Task, deleteTimerTask Task, domainID, workflowID,
+	runID string) error {
+	for _, task := range timerTasks {
+		var eventID int64
+		var attempt int64
+
+		timeoutType := 0
+
+		switch task.GetType() {
+		case TaskTypeDecisionTimeout:
+			eventID = task.(*DecisionTimeoutTask).EventID
+			timeoutType = task.(*DecisionTimeoutTask).TimeoutType
+
+		case TaskTypeActivityTimeout:
+			eventID = task.(*ActivityTimeoutTask).EventID
+			timeoutType = task.(*ActivityTimeoutTask).TimeoutType
+
+		case TaskTypeUserTimer:
+			eventID = task.(*UserTimerTask).EventID
+
+		case TaskTypeActivityRetryTimer:
+			eventID = task.(*ActivityRetryTimerTask).EventID
+			attempt = int64(task.(*ActivityRetryTimerTask).Attempt)
+		}
+
+		ts := sqlTimestamp(GetVisibilityTSFrom(task))
+		if _, err := tx.exec(sqlTemplateCreateTimerTaskQuery,
+			d.shardID,
+			domainID,
+			workflowID,
+			runID,
+			ts,
+			task.GetTaskID(),
+			task.GetType(),
+			timeoutType,
+			eventID,
+			attempt); err != nil {
+			return sqlInternalError("CreateTimerTasks", err)
+		}
+	}
+
+	if deleteTimerTask != nil {
+		ts := sqlTimestamp(GetVisibilityTSFrom(deleteTimerTask))
+		if _, err := tx.exec(sqlTemplateCompleteTimerTaskQuery, d.shardID, ts, deleteTimerTask.GetTaskID()); err != nil {
+			return sqlInternalError("CreateTimerTasks", err)
+		}
+	}
+
+	return nil
+}
+
+// updateMutableState writes the changes to the activity, timer, child execution and request cancel maps.  Upserts
+// are implemented as a delete followed by an insert, which is supported by all the dialects.
+func (d *sqlPersistence) updateMutableState(tx *sqlTx, request *UpdateWorkflowExecutionRequest) error {
+	info := request.ExecutionInfo
+	key := []interface{}{d.shardID, info.DomainID, info.WorkflowID, info.RunID}
+	withKey := func(args ...interface{}) []interface{} {
+		return append(append([]interface{}{}, key...), args...)
+	}
+
+	for _, a := range request.UpsertActivityInfos {
+		if _, err := tx.exec(sqlTemplateDeleteActivityInfoQuery, withKey(a.ScheduleID)...); err != nil {
+			return err
+		}
+		if _, err := tx.exec(sqlTemplateCreateActivityInfoQuery, withKey(
+			a.ScheduleID,
+			a.ScheduledEvent,
+			sqlTimestamp(a.ScheduledTime),
+			a.StartedID,
+			a.StartedEvent,
+			sqlTimestamp(a.StartedTime),
+			a.ActivityID,
+			a.RequestID,
+			a.Details,
+			a.ScheduleToStartTimeout,
+			a.ScheduleToCloseTimeout,
+			a.StartToCloseTimeout,
+			a.HeartbeatTimeout,
+			a.CancelRequested,
+			a.CancelRequestID,
+			sqlTimestamp(a.LastHeartBeatUpdatedTime),
+			a.TimerTaskStatus,
+			a.Attempt)...); err != nil {
+			return err
+		}
+	}
+	if request.DeleteActivityInfo != nil {
+		if _, err := tx.exec(sqlTemplateDeleteActivityInfoQuery, withKey(*request.DeleteActivityInfo)...); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range request.UpserTimerInfos {
+		if _, err := tx.exec(sqlTemplateDeleteTimerInfoQuery, withKey(t.TimerID)...); err != nil {
+			return err
+		}
+		if _, err := tx.exec(sqlTemplateCreateTimerInfoQuery, withKey(
+			t.TimerID,
+			t.StartedID,
+			sqlTimestamp(t.ExpiryTime),
+			t.TaskID)...); err != nil {
+			return err
+		}
+	}
+	for _, timerID := range request.DeleteTimerInfos {
+		if _, err := tx.exec(sqlTemplateDeleteTimerInfoQuery, withKey(timerID)...); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range request.UpsertChildExecutionInfos {
+		if _, err := tx.exec(sqlTemplateDeleteChildExecutionInfoQuery, withKey(c.InitiatedID)...); err != nil {
+			return err
+		}
+		if _, err := tx.exec(sqlTemplateCreateChildExecutionInfoQuery, withKey(
+			c.InitiatedID,
+			c.InitiatedEvent,
+			c.StartedID,
+			c.StartedEvent,
+			c.CreateRequestID)...); err != nil {
+			return err
+		}
+	}
+	if request.DeleteChildExecutionInfo != nil {
+		if _, err := tx.exec(sqlTemplateDeleteChildExecutionInfoQuery,
+			withKey(*request.DeleteChildExecutionInfo)...); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range request.UpsertRequestCancelInfos {
+		if _, err := tx.exec(sqlTemplateDeleteRequestCancelInfoQuery, withKey(r.InitiatedID)...); err != nil {
+			return err
+		}
+		if _, err := tx.exec(sqlTemplateCreateRequestCancelInfoQuery, withKey(
+			r.InitiatedID,
+			r.CancelRequestID)...); err != nil {
+			return err
+		}
+	}
+	if request.DeleteRequestCancelInfo != nil {
+		if _, err := tx.exec(sqlTemplateDeleteRequestCancelInfoQuery,
+			withKey(*request.DeleteRequestCancelInfo)...); err != nil {
+			return err
+		}
+	}
+
+	for _, si := range request.UpsertSignalInfos {
+		if _, err := tx.exec(sqlTemplateDeleteSignalInfoQuery, withKey(si.InitiatedID)...); err != nil {
+			return err
+		}
+		if _, err := tx.exec(sqlTemplateCreateSignalInfoQuery, withKey(
+			si.InitiatedID,
+			si.SignalRequestID,
+			si.SignalName,
+			si.Input,
+			si.Control)...); err != nil {
+			return err
+		}
+	}
+	if request.DeleteSignalInfo != nil {
+		if _, err := tx.exec(sqlTemplateDeleteSignalInfoQuery,
+			withKey(*request.DeleteSignalInfo)...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *sqlPersistence) getActivityInfos(tx *sqlTx, domainID, workflowID, runID string) (map[int64]*ActivityInfo,
+	error) {
+	rows, err := tx.query(sqlTemplateGetActivityInfosQuery, d.shardID, domainID, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[int64]*ActivityInfo)
+	for rows.Next() {
+		var scheduledTime, startedTime, lastHeartBeatUpdatedTime int64
+		a := &ActivityInfo{}
+		if err := rows.Scan(
+			&a.ScheduleID,
+			&a.ScheduledEvent,
+			&scheduledTime,
+			&a.StartedID,
+			&a.StartedEvent,
+			&startedTime,
+			&a.ActivityID,
+			&a.RequestID,
+			&a.Details,
+			&a.ScheduleToStartTimeout,
+			&a.ScheduleToCloseTimeout,
+			&a.StartToCloseTimeout,
+			&a.HeartbeatTimeout,
+			&a.CancelRequested,
+			&a.CancelRequestID,
+			&lastHeartBeatUpdatedTime,
+			&a.TimerTaskStatus,
+			&a.Attempt); err != nil {
+			return nil, err
+		}
+		a.ScheduledTime = fromSQLTimestamp(scheduledTime)
+		a.StartedTime = fromSQLTimestamp(startedTime)
+		a.LastHeartBeatUpdatedTime = fromSQLTimestamp(lastHeartBeatUpdatedTime)
+		result[a.ScheduleID] = a
+	}
+
+	return result, rows.Err()
+}
+
+func (d *sqlPersistence) getTimerInfos(tx *sqlTx, domainID, workflowID, runID string) (map[string]*TimerInfo,
+	error) {
+	rows, err := tx.query(sqlTemplateGetTimerInfosQuery, d.shardID, domainID, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[string]*TimerInfo)
+	for rows.Next() {
+		var expiryTime int64
+		t := &TimerInfo{}
+		if err := rows.Scan(&t.TimerID, &t.StartedID, &expiryTime, &t.TaskID); err != nil {
+			return nil, err
+		}
+		t.ExpiryTime = fromSQLTimestamp(expiryTime)
+		result[t.TimerID] = t
+	}
+
+	return result, rows.Err()
+}
+
+func (d *sqlPersistence) getChildExecutionInfos(tx *sqlTx, domainID, workflowID, runID string) (
+	map[int64]*ChildExecutionInfo, error) {
+	rows, err := tx.query(sqlTemplateGetChildExecutionInfosQuery, d.shardID, domainID, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[int64]*ChildExecutionInfo)
+	for rows.Next() {
+		c := &ChildExecutionInfo{}
+		if err := rows.Scan(&c.InitiatedID, &c.InitiatedEvent, &c.StartedID, &c.StartedEvent,
+			&c.CreateRequestID); err != nil {
+			return nil, err
+		}
+		result[c.InitiatedID] = c
+	}
+
+	return result, rows.Err()
+}
+
+func (d *sqlPersistence) getRequestCancelInfos(tx *sqlTx, domainID, workflowID, runID string) (
+	map[int64]*RequestCancelInfo, error) {
+	rows, err := tx.query(sqlTemplateGetRequestCancelInfosQuery, d.shardID, domainID, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[int64]*RequestCancelInfo)
+	for rows.Next() {
+		r := &RequestCancelInfo{}
+		if err := rows.Scan(&r.InitiatedID, &r.CancelRequestID); err != nil {
+			return nil, err
+		}
+		result[r.InitiatedID] = r
+	}
+
+	return result, rows.Err()
+}
+
+func (d *sqlPersistence) getSignalInfos(tx *sqlTx, domainID, workflowID, runID string) (
+	map[int64]*SignalInfo, error) {
+	rows, err := tx.query(sqlTemplateGetSignalInfosQuery, d.shardID, domainID, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[int64]*SignalInfo)
+	for rows.Next() {
+		si := &SignalInfo{}
+		if err := rows.Scan(&si.InitiatedID, &si.SignalRequestID, &si.SignalName, &si.Input,
+			&si.Control); err != nil {
+			return nil, err
+		}
+		result[si.InitiatedID] = si
+	}
+
+	return result, rows.Err()
+}
+
+func scanWorkflowExecutionInfo(row sqlRowScanner) (*WorkflowExecutionInfo, error) {
+	var startTime, lastUpdatedTime int64
+	var searchAttributes []byte
+	info := &WorkflowExecutionInfo{}
+	err := row.Scan(
+		&info.DomainID,
+		&info.WorkflowID,
+		&info.RunID,
+		&info.ParentDomainID,
+		&info.ParentWorkflowID,
+		&info.ParentRunID,
+		&info.InitiatedID,
+		&info.CompletionEvent,
+		&info.TaskList,
+		&info.WorkflowTypeName,
+		&info.DecisionTimeoutValue,
+		&info.ExecutionContext,
+		&info.State,
+		&info.CloseStatus,
+		&info.NextEventID,
+		&info.LastProcessedEvent,
+		&startTime,
+		&lastUpdatedTime,
+		&info.CreateRequestID,
+		&info.DecisionScheduleID,
+		&info.DecisionStartedID,
+		&info.DecisionRequestID,
+		&info.DecisionTimeout,
+		&info.CancelRequested,
+		&info.CancelRequestID,
+		&info.CronSchedule,
+		&info.Attempt,
+		&info.HasRetryPolicy,
+		&info.StickyTaskList,
+		&info.StickyScheduleToStartTimeout,
+		&searchAttributes)
+	if err != nil {
+		return nil, err
+	}
+	info.StartTimestamp = fromSQLTimestamp(startTime)
+	info.LastUpdatedTimestamp = fromSQLTimestamp(lastUpdatedTime)
+	if info.SearchAttributes, err = fromSQLSearchAttributes(searchAttributes); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
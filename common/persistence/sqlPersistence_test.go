@@ -0,0 +1,129 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3" // registers the sqlite3 driver used by the test database
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// The suites below run the same test cases as the Cassandra suites, against a TestBase backed by a SQLite database
+// deployed with the MySQL schema.
+type (
+	sqlShardPersistenceSuite struct {
+		shardPersistenceSuite
+	}
+
+	sqlExecutionPersistenceSuite struct {
+		cassandraPersistenceSuite
+	}
+
+	sqlHistoryPersistenceSuite struct {
+		historyPersistenceSuite
+	}
+
+	sqlMetadataPersistenceSuite struct {
+		metadataPersistenceSuite
+	}
+
+	sqlVisibilityPersistenceSuite struct {
+		visibilityPersistenceSuite
+	}
+)
+
+func TestSQLShardPersistenceSuite(t *testing.T) {
+	s := new(sqlShardPersistenceSuite)
+	suite.Run(t, s)
+}
+
+func (s *sqlShardPersistenceSuite) SetupSuite() {
+	s.SetupSQLiteWorkflowStore()
+}
+
+func TestSQLExecutionPersistenceSuite(t *testing.T) {
+	s := new(sqlExecutionPersistenceSuite)
+	suite.Run(t, s)
+}
+
+func (s *sqlExecutionPersistenceSuite) SetupSuite() {
+	s.SetupSQLiteWorkflowStore()
+}
+
+func TestSQLHistoryPersistenceSuite(t *testing.T) {
+	s := new(sqlHistoryPersistenceSuite)
+	suite.Run(t, s)
+}
+
+func (s *sqlHistoryPersistenceSuite) SetupSuite() {
+	s.SetupSQLiteWorkflowStore()
+}
+
+func TestSQLMetadataPersistenceSuite(t *testing.T) {
+	s := new(sqlMetadataPersistenceSuite)
+	suite.Run(t, s)
+}
+
+func (s *sqlMetadataPersistenceSuite) SetupSuite() {
+	s.SetupSQLiteWorkflowStore()
+}
+
+func TestSQLVisibilityPersistenceSuite(t *testing.T) {
+	s := new(sqlVisibilityPersistenceSuite)
+	suite.Run(t, s)
+}
+
+func (s *sqlVisibilityPersistenceSuite) SetupSuite() {
+	s.SetupSQLiteWorkflowStore()
+}
+
+func TestSQLDialectRebind(t *testing.T) {
+	query := "SELECT a FROM t WHERE b = ? AND c = ?"
+
+	d, err := newSQLDialect(SQLDriverPostgres)
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT a FROM t WHERE b = $1 AND c = $2", d.rebind(query))
+
+	d, err = newSQLDialect(SQLDriverMySQL)
+	require.NoError(t, err)
+	assert.Equal(t, query, d.rebind(query))
+
+	_, err = newSQLDialect("unknown")
+	assert.Error(t, err)
+}
+
+func TestSQLIsConflictError(t *testing.T) {
+	assert.True(t, isConflictError(&mysql.MySQLError{Number: mysqlErrDupEntry}))
+	assert.True(t, isConflictError(&mysql.MySQLError{Number: mysqlErrLockDeadlock}))
+	assert.False(t, isConflictError(&mysql.MySQLError{Number: 1146}))
+	assert.True(t, isConflictError(&pq.Error{Code: postgresErrUniqueViolation}))
+	assert.True(t, isConflictError(&pq.Error{Code: postgresErrDeadlockDetected}))
+	assert.False(t, isConflictError(&pq.Error{Code: "42P01"}))
+	assert.False(t, isConflictError(errors.New("failed")))
+	assert.False(t, isConflictError(nil))
+}
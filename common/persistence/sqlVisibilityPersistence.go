@@ -0,0 +1,366 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/uber-common/bark"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+const (
+	sqlTemplateCreateWorkflowExecutionStartedQuery = `INSERT INTO open_executions (` +
+		`domain_id, workflow_id, run_id, start_time, workflow_type_name) ` +
+		`VALUES (?, ?, ?, ?, ?)`
+
+	sqlTemplateDeleteWorkflowExecutionStartedQuery = `DELETE FROM open_executions ` +
+		`WHERE domain_id = ? AND start_time = ? AND run_id = ?`
+
+	sqlTemplateDeleteWorkflowExecutionClosedQuery = `DELETE FROM closed_executions ` +
+		`WHERE domain_id = ? AND start_time = ? AND run_id = ?`
+
+	sqlTemplateCreateWorkflowExecutionClosedQuery = `INSERT INTO closed_executions (` +
+		`domain_id, workflow_id, run_id, start_time, close_time, workflow_type_name, status, history_length, ` +
+		`expiry_ts) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	sqlTemplateOpenWorkflowExecutionsQuery = `SELECT workflow_id, run_id, start_time, workflow_type_name ` +
+		`FROM open_executions ` +
+		`WHERE domain_id = ? AND start_time >= ? AND start_time <= ?`
+
+	sqlTemplateClosedWorkflowExecutionsQuery = `SELECT workflow_id, run_id, start_time, close_time, ` +
+		`workflow_type_name, status, history_length ` +
+		`FROM closed_executions ` +
+		`WHERE domain_id = ? AND start_time >= ? AND start_time <= ? AND expiry_ts > ?`
+
+	sqlTemplateGetClosedWorkflowExecutionQuery = `SELECT workflow_id, run_id, start_time, close_time, ` +
+		`workflow_type_name, status, history_length ` +
+		`FROM closed_executions ` +
+		`WHERE domain_id = ? AND workflow_id = ? AND run_id = ? AND expiry_ts > ?`
+
+	sqlTemplateWorkflowTypeFilter = ` AND workflow_type_name = ?`
+
+	sqlTemplateWorkflowIDFilter = ` AND workflow_id = ?`
+
+	sqlTemplateCloseStatusFilter = ` AND status = ?`
+
+	// Matches the clustering order of the Cassandra visibility tables
+	sqlTemplateWorkflowExecutionsOrderBy = ` ORDER BY start_time DESC, run_id LIMIT ? OFFSET ?`
+)
+
+type (
+	sqlVisibilityPersistence struct {
+		db     *sqlDB
+		logger bark.Logger
+	}
+)
+
+// NewSQLVisibilityPersistence is used to create an instance of VisibilityManager implementation
+func NewSQLVisibilityPersistence(driverName, dataSourceName string, logger bark.Logger) (VisibilityManager, error) {
+	db, err := openSQLDB(driverName, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlVisibilityPersistence{db: db, logger: logger}, nil
+}
+
+// Close releases the resources held by this object
+func (v *sqlVisibilityPersistence) Close() {
+	if v.db != nil {
+		v.db.Close()
+	}
+}
+
+func (v *sqlVisibilityPersistence) RecordWorkflowExecutionStarted(
+	request *RecordWorkflowExecutionStartedRequest) error {
+	startTime := common.UnixNanoToCQLTimestamp(request.StartTimestamp)
+
+	return v.db.txn("RecordWorkflowExecutionStarted", func(tx *sqlTx) error {
+		// Replace the row written by a previous attempt, as the start of an execution can be recorded more than once
+		if _, err := tx.exec(sqlTemplateDeleteWorkflowExecutionStartedQuery, request.DomainUUID, startTime,
+			*request.Execution.RunId); err != nil {
+			return sqlInternalError("RecordWorkflowExecutionStarted", err)
+		}
+
+		if _, err := tx.exec(sqlTemplateCreateWorkflowExecutionStartedQuery,
+			request.DomainUUID,
+			*request.Execution.WorkflowId,
+			*request.Execution.RunId,
+			startTime,
+			request.WorkflowTypeName); err != nil {
+			return sqlInternalError("RecordWorkflowExecutionStarted", err)
+		}
+
+		return nil
+	})
+}
+
+func (v *sqlVisibilityPersistence) RecordWorkflowExecutionClosed(
+	request *RecordWorkflowExecutionClosedRequest) error {
+	startTime := common.UnixNanoToCQLTimestamp(request.StartTimestamp)
+
+	// Find how long to keep the row
+	retention := request.RetentionSeconds
+	if retention == 0 {
+		retention = defaultCloseTTLSeconds
+	}
+	expiry := sqlTimestamp(time.Now().Add(time.Duration(retention) * time.Second))
+
+	return v.db.txn("RecordWorkflowExecutionClosed", func(tx *sqlTx) error {
+		// First, remove execution from the open table
+		if _, err := tx.exec(sqlTemplateDeleteWorkflowExecutionStartedQuery, request.DomainUUID, startTime,
+			*request.Execution.RunId); err != nil {
+			return sqlInternalError("RecordWorkflowExecutionClosed", err)
+		}
+
+		// Next, add a row in the closed table, replacing the one written by a previous attempt
+		if _, err := tx.exec(sqlTemplateDeleteWorkflowExecutionClosedQuery, request.DomainUUID, startTime,
+			*request.Execution.RunId); err != nil {
+			return sqlInternalError("RecordWorkflowExecutionClosed", err)
+		}
+
+		if _, err := tx.exec(sqlTemplateCreateWorkflowExecutionClosedQuery,
+			request.DomainUUID,
+			*request.Execution.WorkflowId,
+			*request.Execution.RunId,
+			startTime,
+			common.UnixNanoToCQLTimestamp(request.CloseTimestamp),
+			request.WorkflowTypeName,
+			request.Status,
+			request.HistoryLength,
+			expiry); err != nil {
+			return sqlInternalError("RecordWorkflowExecutionClosed", err)
+		}
+
+		return nil
+	})
+}
+
+func (v *sqlVisibilityPersistence) ListOpenWorkflowExecutions(
+	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutions", request, false, "")
+}
+
+func (v *sqlVisibilityPersistence) ListClosedWorkflowExecutions(
+	request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutions", request, true, "")
+}
+
+func (v *sqlVisibilityPersistence) ListOpenWorkflowExecutionsByType(
+	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutionsByType", &request.ListWorkflowExecutionsRequest,
+		false, sqlTemplateWorkflowTypeFilter, request.WorkflowTypeName)
+}
+
+func (v *sqlVisibilityPersistence) ListClosedWorkflowExecutionsByType(
+	request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsByType", &request.ListWorkflowExecutionsRequest,
+		true, sqlTemplateWorkflowTypeFilter, request.WorkflowTypeName)
+}
+
+func (v *sqlVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(
+	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutionsByWorkflowID", &request.ListWorkflowExecutionsRequest,
+		false, sqlTemplateWorkflowIDFilter, request.WorkflowID)
+}
+
+func (v *sqlVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowID(
+	request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsByWorkflowID",
+		&request.ListWorkflowExecutionsRequest, true, sqlTemplateWorkflowIDFilter, request.WorkflowID)
+}
+
+func (v *sqlVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
+	request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsByStatus", &request.ListWorkflowExecutionsRequest,
+		true, sqlTemplateCloseStatusFilter, request.Status)
+}
+
+func (v *sqlVisibilityPersistence) ListOpenWorkflowExecutionsByFilters(
+	request *ListWorkflowExecutionsByFiltersRequest) (*ListWorkflowExecutionsResponse, error) {
+	filter, args := getSQLWorkflowExecutionsFilter(request, false)
+	return v.listWorkflowExecutions("ListOpenWorkflowExecutionsByFilters", &request.ListWorkflowExecutionsRequest,
+		false, filter, args...)
+}
+
+func (v *sqlVisibilityPersistence) ListClosedWorkflowExecutionsByFilters(
+	request *ListWorkflowExecutionsByFiltersRequest) (*ListWorkflowExecutionsResponse, error) {
+	filter, args := getSQLWorkflowExecutionsFilter(request, true)
+	return v.listWorkflowExecutions("ListClosedWorkflowExecutionsByFilters", &request.ListWorkflowExecutionsRequest,
+		true, filter, args...)
+}
+
+func (v *sqlVisibilityPersistence) GetClosedWorkflowExecution(
+	request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	execution := request.Execution
+	row := v.db.queryRow(sqlTemplateGetClosedWorkflowExecutionQuery,
+		request.DomainUUID,
+		*execution.WorkflowId,
+		*execution.RunId,
+		sqlTimestamp(time.Now()))
+
+	info, err := scanWorkflowExecutionRecord(row, true)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+					*execution.WorkflowId, *execution.RunId),
+			}
+		}
+
+		return nil, sqlInternalError("GetClosedWorkflowExecution", err)
+	}
+
+	return &GetClosedWorkflowExecutionResponse{
+		Execution: info,
+	}, nil
+}
+
+// UpsertWorkflowExecution is a no-op since the search attributes of executions are not stored
+func (v *sqlVisibilityPersistence) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
+	return nil
+}
+
+func (v *sqlVisibilityPersistence) ListWorkflowExecutions(
+	request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error) {
+	return nil, errQueryNotSupported
+}
+
+func (v *sqlVisibilityPersistence) CountWorkflowExecutions(
+	request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	return nil, errQueryNotSupported
+}
+
+// listWorkflowExecutions reads one page of open or closed executions.  The filter is appended to the where clause of
+// the query, with args holding the values for its placeholders.
+func (v *sqlVisibilityPersistence) listWorkflowExecutions(operation string, request *ListWorkflowExecutionsRequest,
+	closed bool, filter string, args ...interface{}) (*ListWorkflowExecutionsResponse, error) {
+	offset, err := getPageTokenOffset(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// One extra row is read to find out if there is another page
+	limit := math.MaxInt32
+	if request.PageSize > 0 {
+		limit = request.PageSize + 1
+	}
+
+	query := sqlTemplateOpenWorkflowExecutionsQuery
+	queryArgs := []interface{}{
+		request.DomainUUID,
+		common.UnixNanoToCQLTimestamp(request.EarliestStartTime),
+		common.UnixNanoToCQLTimestamp(request.LatestStartTime),
+	}
+	if closed {
+		query = sqlTemplateClosedWorkflowExecutionsQuery
+		queryArgs = append(queryArgs, sqlTimestamp(time.Now()))
+	}
+	queryArgs = append(queryArgs, args...)
+	queryArgs = append(queryArgs, limit, offset)
+
+	rows, err := v.db.query(query+filter+sqlTemplateWorkflowExecutionsOrderBy, queryArgs...)
+	if err != nil {
+		return nil, sqlInternalError(operation, err)
+	}
+	defer rows.Close()
+
+	response := &ListWorkflowExecutionsResponse{}
+	response.Executions = make([]*workflow.WorkflowExecutionInfo, 0)
+	for rows.Next() {
+		info, err := scanWorkflowExecutionRecord(rows, closed)
+		if err != nil {
+			return nil, sqlInternalError(operation, err)
+		}
+		response.Executions = append(response.Executions, info)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, sqlInternalError(operation, err)
+	}
+
+	total := offset + len(response.Executions)
+	if request.PageSize > 0 && len(response.Executions) > request.PageSize {
+		response.Executions = response.Executions[:request.PageSize]
+	}
+	response.NextPageToken = newOffsetPageToken(offset+len(response.Executions), total)
+
+	return response, nil
+}
+
+// getSQLWorkflowExecutionsFilter returns the filter of listWorkflowExecutions for the filters which are set on the
+// request, with the values for its placeholders
+func getSQLWorkflowExecutionsFilter(request *ListWorkflowExecutionsByFiltersRequest,
+	closed bool) (string, []interface{}) {
+	filter := ""
+	var args []interface{}
+	if request.WorkflowID != "" {
+		filter += sqlTemplateWorkflowIDFilter
+		args = append(args, request.WorkflowID)
+	}
+	if request.WorkflowTypeName != "" {
+		filter += sqlTemplateWorkflowTypeFilter
+		args = append(args, request.WorkflowTypeName)
+	}
+	if closed && request.Status != nil {
+		filter += sqlTemplateCloseStatusFilter
+		args = append(args, *request.Status)
+	}
+	return filter, args
+}
+
+func scanWorkflowExecutionRecord(row sqlRowScanner, closed bool) (*workflow.WorkflowExecutionInfo, error) {
+	var workflowID, runID, typeName string
+	var startTime, closeTime, historyLength int64
+	var status workflow.WorkflowExecutionCloseStatus
+
+	var err error
+	if closed {
+		err = row.Scan(&workflowID, &runID, &startTime, &closeTime, &typeName, &status, &historyLength)
+	} else {
+		err = row.Scan(&workflowID, &runID, &startTime, &typeName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	record := &workflow.WorkflowExecutionInfo{}
+	record.Execution = &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr(runID),
+	}
+	record.StartTime = common.Int64Ptr(common.CQLTimestampToUnixNano(startTime))
+	record.Type = &workflow.WorkflowType{Name: common.StringPtr(typeName)}
+	if closed {
+		record.CloseTime = common.Int64Ptr(common.CQLTimestampToUnixNano(closeTime))
+		record.CloseStatus = &status
+		record.HistoryLength = common.Int64Ptr(historyLength)
+	}
+
+	return record, nil
+}
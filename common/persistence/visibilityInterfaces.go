@@ -36,6 +36,7 @@ type (
 		Execution        s.WorkflowExecution
 		WorkflowTypeName string
 		StartTimestamp   int64
+		SearchAttributes map[string][]byte
 	}
 
 	// RecordWorkflowExecutionClosedRequest is used to add a record of a newly
@@ -49,6 +50,16 @@ type (
 		Status           s.WorkflowExecutionCloseStatus
 		HistoryLength    int64
 		RetentionSeconds int64
+		SearchAttributes map[string][]byte
+	}
+
+	// UpsertWorkflowExecutionRequest is used to update the search attributes of an open execution
+	UpsertWorkflowExecutionRequest struct {
+		DomainUUID       string
+		Execution        s.WorkflowExecution
+		WorkflowTypeName string
+		StartTimestamp   int64
+		SearchAttributes map[string][]byte
 	}
 
 	// ListWorkflowExecutionsRequest is used to list executions in a domain
@@ -92,6 +103,39 @@ type (
 		Status s.WorkflowExecutionCloseStatus
 	}
 
+	// ListWorkflowExecutionsByFiltersRequest is used to list executions that
+	// match all of the given filters.  An empty filter matches every execution,
+	// and the status filter is ignored when listing open executions.
+	ListWorkflowExecutionsByFiltersRequest struct {
+		ListWorkflowExecutionsRequest
+		WorkflowID       string
+		WorkflowTypeName string
+		Status           *s.WorkflowExecutionCloseStatus
+	}
+
+	// ListWorkflowExecutionsByQueryRequest is used to list the open and closed executions of a domain which match a
+	// query in the syntax described on visibilityQuery
+	ListWorkflowExecutionsByQueryRequest struct {
+		DomainUUID string
+		Query      string
+		// Maximum number of workflow executions per page
+		PageSize int
+		// Token to continue reading next page of workflow executions.
+		// Pass in empty slice for first page.
+		NextPageToken []byte
+	}
+
+	// CountWorkflowExecutionsRequest is used to count the open and closed executions of a domain which match a query
+	CountWorkflowExecutionsRequest struct {
+		DomainUUID string
+		Query      string
+	}
+
+	// CountWorkflowExecutionsResponse is the response to CountWorkflowExecutionsRequest
+	CountWorkflowExecutionsResponse struct {
+		Count int64
+	}
+
 	// GetClosedWorkflowExecutionRequest is used retrieve the record for a specific execution
 	GetClosedWorkflowExecutionRequest struct {
 		DomainUUID string
@@ -108,6 +152,7 @@ type (
 		Closeable
 		RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error
 		RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error
+		UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error
 		ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
 		ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error)
@@ -115,6 +160,24 @@ type (
 		ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error)
+		ListOpenWorkflowExecutionsByFilters(request *ListWorkflowExecutionsByFiltersRequest) (*ListWorkflowExecutionsResponse, error)
+		ListClosedWorkflowExecutionsByFilters(request *ListWorkflowExecutionsByFiltersRequest) (*ListWorkflowExecutionsResponse, error)
 		GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error)
+		ListWorkflowExecutions(request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error)
+		CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
 	}
 )
+
+// matches returns whether the execution matches all the filters of the request
+func (r *ListWorkflowExecutionsByFiltersRequest) matches(info *s.WorkflowExecutionInfo) bool {
+	if r.WorkflowID != "" && *info.Execution.WorkflowId != r.WorkflowID {
+		return false
+	}
+	if r.WorkflowTypeName != "" && *info.Type.Name != r.WorkflowTypeName {
+		return false
+	}
+	if r.Status != nil && info.CloseStatus != nil && *info.CloseStatus != *r.Status {
+		return false
+	}
+	return true
+}
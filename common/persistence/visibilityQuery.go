@@ -0,0 +1,579 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+)
+
+// Names of the attributes every execution has in visibility queries, besides its search attributes
+const (
+	VisibilityQueryWorkflowID    = "WorkflowID"
+	VisibilityQueryRunID         = "RunID"
+	VisibilityQueryWorkflowType  = "WorkflowType"
+	VisibilityQueryStartTime     = "StartTime"
+	VisibilityQueryCloseTime     = "CloseTime"
+	VisibilityQueryCloseStatus   = "CloseStatus"
+	VisibilityQueryHistoryLength = "HistoryLength"
+)
+
+type (
+	// visibilityQuery is a parsed visibility query.  A query is a SQL-like boolean expression over the attributes of
+	// an execution, for example:
+	//
+	//   WorkflowType = 'orders' AND CustomerId IN ('c1', 'c2') AND StartTime > '2018-06-07T00:00:00Z'
+	//
+	// Comparisons are =, !=, <>, <, <=, > and >=, followed by a string, number, true or false.  The other conditions
+	// are [NOT] IN (values), [NOT] BETWEEN value AND value and IS [NOT] NULL.  Conditions are combined with AND, OR,
+	// NOT and parentheses, and keywords are case insensitive.  Times are compared either as nanoseconds since epoch
+	// or as strings in RFC3339 format.  A condition on an attribute the execution does not have is false, except for
+	// IS NULL.  The empty query matches every execution.
+	visibilityQuery struct {
+		root visibilityQueryNode
+	}
+
+	// visibilityQueryAttributes returns the value of the attribute of an execution with the given name, which is a
+	// string, int64, float64 or bool, and false if the execution does not have the attribute
+	visibilityQueryAttributes func(name string) (interface{}, bool)
+
+	visibilityQueryNode interface {
+		matches(attributes visibilityQueryAttributes) bool
+	}
+
+	visibilityQueryAnd struct {
+		left  visibilityQueryNode
+		right visibilityQueryNode
+	}
+
+	visibilityQueryOr struct {
+		left  visibilityQueryNode
+		right visibilityQueryNode
+	}
+
+	visibilityQueryNot struct {
+		node visibilityQueryNode
+	}
+
+	visibilityQueryComparison struct {
+		name     string
+		operator string
+		value    interface{}
+	}
+
+	visibilityQueryIn struct {
+		name   string
+		values []interface{}
+	}
+
+	visibilityQueryIsNull struct {
+		name string
+	}
+
+	visibilityQueryToken struct {
+		// kind is one of the visibilityQueryToken* kinds
+		kind  int
+		text  string
+		value interface{}
+	}
+
+	visibilityQueryParser struct {
+		tokens []visibilityQueryToken
+		pos    int
+	}
+)
+
+const (
+	visibilityQueryTokenEnd = iota
+	visibilityQueryTokenIdentifier
+	visibilityQueryTokenKeyword
+	visibilityQueryTokenLiteral
+	visibilityQueryTokenOperator
+)
+
+var visibilityQueryKeywords = map[string]bool{
+	"AND":     true,
+	"OR":      true,
+	"NOT":     true,
+	"IN":      true,
+	"IS":      true,
+	"NULL":    true,
+	"BETWEEN": true,
+	"TRUE":    true,
+	"FALSE":   true,
+}
+
+// parseVisibilityQuery parses the query, returning a BadRequestError if it is not valid
+func parseVisibilityQuery(query string) (*visibilityQuery, error) {
+	tokens, err := tokenizeVisibilityQuery(query)
+	if err != nil {
+		return nil, newInvalidVisibilityQueryError(query, err)
+	}
+
+	parser := &visibilityQueryParser{tokens: tokens}
+	if parser.peek().kind == visibilityQueryTokenEnd {
+		return &visibilityQuery{}, nil
+	}
+
+	root, err := parser.parseOr()
+	if err == nil && parser.peek().kind != visibilityQueryTokenEnd {
+		err = fmt.Errorf("unexpected %v", parser.peek().text)
+	}
+	if err != nil {
+		return nil, newInvalidVisibilityQueryError(query, err)
+	}
+	return &visibilityQuery{root: root}, nil
+}
+
+func newInvalidVisibilityQueryError(query string, err error) error {
+	return &workflow.BadRequestError{Message: fmt.Sprintf("Invalid query %q: %v.", query, err)}
+}
+
+// matches returns true if the execution with the given attributes matches the query
+func (q *visibilityQuery) matches(attributes visibilityQueryAttributes) bool {
+	return q.root == nil || q.root.matches(attributes)
+}
+
+func (n *visibilityQueryAnd) matches(attributes visibilityQueryAttributes) bool {
+	return n.left.matches(attributes) && n.right.matches(attributes)
+}
+
+func (n *visibilityQueryOr) matches(attributes visibilityQueryAttributes) bool {
+	return n.left.matches(attributes) || n.right.matches(attributes)
+}
+
+func (n *visibilityQueryNot) matches(attributes visibilityQueryAttributes) bool {
+	return !n.node.matches(attributes)
+}
+
+func (n *visibilityQueryComparison) matches(attributes visibilityQueryAttributes) bool {
+	value, ok := attributes(n.name)
+	if !ok {
+		return false
+	}
+	result, ok := compareVisibilityQueryValues(value, n.value)
+	if !ok {
+		return false
+	}
+
+	switch n.operator {
+	case "=":
+		return result == 0
+	case "!=", "<>":
+		return result != 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	}
+	return false
+}
+
+func (n *visibilityQueryIn) matches(attributes visibilityQueryAttributes) bool {
+	value, ok := attributes(n.name)
+	if !ok {
+		return false
+	}
+	for _, v := range n.values {
+		if result, ok := compareVisibilityQueryValues(value, v); ok && result == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *visibilityQueryIsNull) matches(attributes visibilityQueryAttributes) bool {
+	_, ok := attributes(n.name)
+	return !ok
+}
+
+// compareVisibilityQueryValues returns -1, 0 or 1 if the value of an attribute is less than, equal to or greater than
+// the value in the query, and false if the values cannot be compared
+func compareVisibilityQueryValues(value, queryValue interface{}) (int, bool) {
+	switch v := value.(type) {
+	case string:
+		switch q := queryValue.(type) {
+		case string:
+			vt, vErr := time.Parse(time.RFC3339Nano, v)
+			qt, qErr := time.Parse(time.RFC3339Nano, q)
+			if vErr == nil && qErr == nil {
+				return compareInt64(vt.UnixNano(), qt.UnixNano()), true
+			}
+			return strings.Compare(v, q), true
+		case int64, float64:
+			t, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return 0, false
+			}
+			return compareVisibilityQueryValues(t.UnixNano(), queryValue)
+		}
+	case int64:
+		switch q := queryValue.(type) {
+		case int64:
+			return compareInt64(v, q), true
+		case float64:
+			return compareFloat64(float64(v), q), true
+		case string:
+			t, err := time.Parse(time.RFC3339Nano, q)
+			if err != nil {
+				return 0, false
+			}
+			return compareInt64(v, t.UnixNano()), true
+		}
+	case float64:
+		switch q := queryValue.(type) {
+		case int64:
+			return compareFloat64(v, float64(q)), true
+		case float64:
+			return compareFloat64(v, q), true
+		}
+	case bool:
+		if q, ok := queryValue.(bool); ok {
+			if v == q {
+				return 0, true
+			}
+			if q {
+				return -1, true
+			}
+			return 1, true
+		}
+	}
+	return 0, false
+}
+
+func compareInt64(a, b int64) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+func compareFloat64(a, b float64) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// decodeVisibilityQueryValue decodes the JSON encoded value of a search attribute into a value that can be compared
+// by a query, returning false for values which are not strings, numbers or booleans
+func decodeVisibilityQueryValue(data []byte) (interface{}, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, false
+	}
+
+	switch v := value.(type) {
+	case string, bool:
+		return v, true
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n, true
+		}
+		if f, err := v.Float64(); err == nil {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// parseOr parses conditions separated by OR, which binds less tightly than AND
+func (p *visibilityQueryParser) parseOr() (visibilityQueryNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptKeyword("OR") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &visibilityQueryOr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *visibilityQueryParser) parseAnd() (visibilityQueryNode, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptKeyword("AND") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &visibilityQueryAnd{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *visibilityQueryParser) parseNot() (visibilityQueryNode, error) {
+	if p.acceptKeyword("NOT") {
+		node, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &visibilityQueryNot{node: node}, nil
+	}
+	if p.acceptOperator("(") {
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.acceptOperator(")") {
+			return nil, p.unexpected(")")
+		}
+		return node, nil
+	}
+	return p.parseCondition()
+}
+
+// parseCondition parses a condition on a single attribute
+func (p *visibilityQueryParser) parseCondition() (visibilityQueryNode, error) {
+	token := p.next()
+	if token.kind != visibilityQueryTokenIdentifier {
+		p.pos--
+		return nil, p.unexpected("attribute name")
+	}
+	name := token.text
+
+	if p.acceptKeyword("IS") {
+		not := p.acceptKeyword("NOT")
+		if !p.acceptKeyword("NULL") {
+			return nil, p.unexpected("NULL")
+		}
+		var node visibilityQueryNode = &visibilityQueryIsNull{name: name}
+		if not {
+			node = &visibilityQueryNot{node: node}
+		}
+		return node, nil
+	}
+
+	not := p.acceptKeyword("NOT")
+	var node visibilityQueryNode
+	switch {
+	case p.acceptKeyword("IN"):
+		if !p.acceptOperator("(") {
+			return nil, p.unexpected("(")
+		}
+		in := &visibilityQueryIn{name: name}
+		for {
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			in.values = append(in.values, value)
+			if p.acceptOperator(")") {
+				break
+			}
+			if !p.acceptOperator(",") {
+				return nil, p.unexpected(", or )")
+			}
+		}
+		node = in
+	case p.acceptKeyword("BETWEEN"):
+		low, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		if !p.acceptKeyword("AND") {
+			return nil, p.unexpected("AND")
+		}
+		high, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		node = &visibilityQueryAnd{
+			left:  &visibilityQueryComparison{name: name, operator: ">=", value: low},
+			right: &visibilityQueryComparison{name: name, operator: "<=", value: high},
+		}
+	case !not && p.peek().kind == visibilityQueryTokenOperator && isVisibilityQueryComparison(p.peek().text):
+		operator := p.next().text
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		node = &visibilityQueryComparison{name: name, operator: operator, value: value}
+	default:
+		return nil, p.unexpected("comparison")
+	}
+
+	if not {
+		node = &visibilityQueryNot{node: node}
+	}
+	return node, nil
+}
+
+func (p *visibilityQueryParser) parseValue() (interface{}, error) {
+	token := p.next()
+	switch {
+	case token.kind == visibilityQueryTokenLiteral:
+		return token.value, nil
+	case token.kind == visibilityQueryTokenKeyword && token.text == "TRUE":
+		return true, nil
+	case token.kind == visibilityQueryTokenKeyword && token.text == "FALSE":
+		return false, nil
+	}
+	p.pos--
+	return nil, p.unexpected("value")
+}
+
+func (p *visibilityQueryParser) peek() visibilityQueryToken {
+	return p.tokens[p.pos]
+}
+
+func (p *visibilityQueryParser) next() visibilityQueryToken {
+	token := p.tokens[p.pos]
+	if token.kind != visibilityQueryTokenEnd {
+		p.pos++
+	}
+	return token
+}
+
+func (p *visibilityQueryParser) acceptKeyword(keyword string) bool {
+	if token := p.peek(); token.kind == visibilityQueryTokenKeyword && token.text == keyword {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *visibilityQueryParser) acceptOperator(operator string) bool {
+	if token := p.peek(); token.kind == visibilityQueryTokenOperator && token.text == operator {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *visibilityQueryParser) unexpected(expected string) error {
+	token := p.peek()
+	if token.kind == visibilityQueryTokenEnd {
+		return fmt.Errorf("expected %v at end of query", expected)
+	}
+	return fmt.Errorf("expected %v, got %v", expected, token.text)
+}
+
+func isVisibilityQueryComparison(operator string) bool {
+	switch operator {
+	case "=", "!=", "<>", "<", "<=", ">", ">=":
+		return true
+	}
+	return false
+}
+
+// tokenizeVisibilityQuery splits the query into tokens, which always end with a visibilityQueryTokenEnd token.
+// Strings are enclosed in single or double quotes, and a quote is escaped by doubling it.
+func tokenizeVisibilityQuery(query string) ([]visibilityQueryToken, error) {
+	var tokens []visibilityQueryToken
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '\'' || r == '"':
+			var value []rune
+			j := i + 1
+			for ; j < len(runes); j++ {
+				if runes[j] == r {
+					if j+1 < len(runes) && runes[j+1] == r {
+						j++
+					} else {
+						break
+					}
+				}
+				value = append(value, runes[j])
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated string %v", string(runes[i:]))
+			}
+			tokens = append(tokens, visibilityQueryToken{
+				kind:  visibilityQueryTokenLiteral,
+				text:  string(runes[i : j+1]),
+				value: string(value),
+			})
+			i = j + 1
+		case unicode.IsDigit(r) || ((r == '-' || r == '.') && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			j := i + 1
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || strings.ContainsRune(".eE", runes[j]) ||
+				((runes[j] == '-' || runes[j] == '+') && (runes[j-1] == 'e' || runes[j-1] == 'E'))) {
+				j++
+			}
+			text := string(runes[i:j])
+			token := visibilityQueryToken{kind: visibilityQueryTokenLiteral, text: text}
+			if n, err := strconv.ParseInt(text, 10, 64); err == nil {
+				token.value = n
+			} else if f, err := strconv.ParseFloat(text, 64); err == nil {
+				token.value = f
+			} else {
+				return nil, fmt.Errorf("invalid number %v", text)
+			}
+			tokens = append(tokens, token)
+			i = j
+		case unicode.IsLetter(r) || r == '_':
+			j := i + 1
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_') {
+				j++
+			}
+			text := string(runes[i:j])
+			if keyword := strings.ToUpper(text); visibilityQueryKeywords[keyword] {
+				tokens = append(tokens, visibilityQueryToken{kind: visibilityQueryTokenKeyword, text: keyword})
+			} else {
+				tokens = append(tokens, visibilityQueryToken{kind: visibilityQueryTokenIdentifier, text: text})
+			}
+			i = j
+		default:
+			text := string(r)
+			if i+1 < len(runes) {
+				if two := string(runes[i : i+2]); two == "!=" || two == "<>" || two == "<=" || two == ">=" {
+					text = two
+				}
+			}
+			if !isVisibilityQueryComparison(text) && !strings.Contains("(),", text) {
+				return nil, fmt.Errorf("unexpected character %v", text)
+			}
+			tokens = append(tokens, visibilityQueryToken{kind: visibilityQueryTokenOperator, text: text})
+			i += len([]rune(text))
+		}
+	}
+	return append(tokens, visibilityQueryToken{kind: visibilityQueryTokenEnd}), nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	visibilityQuerySuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+func TestVisibilityQuerySuite(t *testing.T) {
+	suite.Run(t, new(visibilityQuerySuite))
+}
+
+func (s *visibilityQuerySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *visibilityQuerySuite) TestMatches() {
+	attributes := map[string]interface{}{
+		"WorkflowType":  "orders",
+		"StartTime":     int64(1528383845000000000), // 2018-06-07T15:04:05Z
+		"CustomerId":    "c1",
+		"Amount":        int64(10),
+		"Ratio":         0.5,
+		"Priority":      true,
+		"PaymentTime":   "2018-06-07T16:00:00Z",
+		"HistoryLength": int64(3),
+	}
+	getAttribute := func(name string) (interface{}, bool) {
+		value, ok := attributes[name]
+		return value, ok
+	}
+
+	testCases := map[string]bool{
+		"":                          true,
+		"WorkflowType = 'orders'":   true,
+		`WorkflowType = "orders"`:   true,
+		"WorkflowType != 'orders'":  false,
+		"WorkflowType <> 'refunds'": true,
+		"workflowtype = 'orders'":   false,
+		"WorkflowType = 'orders' and CustomerId = 'c1'":            true,
+		"WorkflowType = 'orders' AND CustomerId = 'c2'":            false,
+		"CustomerId = 'c2' OR Amount > 5":                          true,
+		"NOT CustomerId = 'c1'":                                    false,
+		"CustomerId NOT IN ('c2', 'c3')":                           true,
+		"CustomerId IN ('c2', 'c1')":                               true,
+		"(CustomerId = 'c2' OR CustomerId = 'c3') AND Amount = 10": false,
+		"CustomerId = 'c2' OR CustomerId = 'c3' AND Amount = 10":   false,
+		"CustomerId = 'c1' OR CustomerId = 'c3' AND Amount = 11":   true,
+		"Amount BETWEEN 5 AND 10":                                  true,
+		"Amount NOT BETWEEN 5 AND 10":                              false,
+		"Amount >= 10.0 AND Ratio < 1 AND Ratio > -1":              true,
+		"Priority = true AND Priority != FALSE":                    true,
+		"StartTime > '2018-06-07T15:00:00Z'":                       true,
+		"StartTime < 1528383845000000000":                          false,
+		"StartTime <= 1528383845000000000":                         true,
+		"PaymentTime > '2018-06-07T15:30:00+00:00'":                true,
+		"PaymentTime > 1528383845000000000":                        true,
+		"CloseTime IS NULL AND CloseStatus IS NULL":                true,
+		"HistoryLength IS NOT NULL":                                true,
+		"CloseTime > 0":                                            false,
+		"NOT CloseTime > 0":                                        true,
+		"CustomerId = 10":                                          false,
+		"Name = 'it''s'":                                           false,
+	}
+
+	for query, expected := range testCases {
+		parsed, err := parseVisibilityQuery(query)
+		s.Nil(err, query)
+		s.Equal(expected, parsed.matches(getAttribute), query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestInvalidQueries() {
+	queries := []string{
+		"WorkflowType",
+		"WorkflowType = ",
+		"WorkflowType == 'orders'",
+		"WorkflowType = 'orders",
+		"WorkflowType = 'orders' AND",
+		"(WorkflowType = 'orders'",
+		"WorkflowType = 'orders')",
+		"WorkflowType IN ()",
+		"WorkflowType IN ('a' 'b')",
+		"WorkflowType IS 'orders'",
+		"Amount BETWEEN 1 OR 2",
+		"'orders' = WorkflowType",
+		"PaymentTime > StartTime",
+		"Amount = 1.2.3",
+		"Amount # 1",
+	}
+
+	for _, query := range queries {
+		_, err := parseVisibilityQuery(query)
+		s.IsType(&gen.BadRequestError{}, err, query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestDecodeValue() {
+	value, ok := decodeVisibilityQueryValue([]byte(`"text"`))
+	s.True(ok)
+	s.Equal("text", value)
+
+	value, ok = decodeVisibilityQueryValue([]byte(`12`))
+	s.True(ok)
+	s.Equal(int64(12), value)
+
+	value, ok = decodeVisibilityQueryValue([]byte(`1.5`))
+	s.True(ok)
+	s.Equal(1.5, value)
+
+	value, ok = decodeVisibilityQueryValue([]byte(`false`))
+	s.True(ok)
+	s.Equal(false, value)
+
+	_, ok = decodeVisibilityQueryValue([]byte(`["a"]`))
+	s.False(ok)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	s "github.com/uber/cadence/.gen/go/shared"
+)
+
+// indexedValueTypes maps the type names of the ValidSearchAttributes dynamic config, in lower case, to the types of
+// the search attribute values
+var indexedValueTypes = map[string]s.IndexedValueType{
+	"string":   s.IndexedValueTypeString,
+	"keyword":  s.IndexedValueTypeKeyword,
+	"int":      s.IndexedValueTypeInt,
+	"double":   s.IndexedValueTypeDouble,
+	"bool":     s.IndexedValueTypeBool,
+	"datetime": s.IndexedValueTypeDatetime,
+}
+
+// GetDefaultValidSearchAttributes returns the search attributes which are valid unless the ValidSearchAttributes
+// dynamic config is set.  It is a map of search attribute name to the type of its values.
+func GetDefaultValidSearchAttributes() map[string]interface{} {
+	return map[string]interface{}{
+		"CustomStringField":   "String",
+		"CustomKeywordField":  "Keyword",
+		"CustomIntField":      "Int",
+		"CustomDoubleField":   "Double",
+		"CustomBoolField":     "Bool",
+		"CustomDatetimeField": "Datetime",
+	}
+}
+
+// ConvertValidSearchAttributes converts the ValidSearchAttributes dynamic config into the types of the valid search
+// attributes.  Search attributes with an unknown type are left out.
+func ConvertValidSearchAttributes(validSearchAttributes map[string]interface{}) map[string]s.IndexedValueType {
+	result := make(map[string]s.IndexedValueType, len(validSearchAttributes))
+	for key, value := range validSearchAttributes {
+		if valueType, ok := getIndexedValueType(value); ok {
+			result[key] = valueType
+		}
+	}
+	return result
+}
+
+// ValidateSearchAttributes returns a BadRequestError if one of the search attributes is not valid, or its value is not
+// the JSON encoding of a value of its type.  Datetime values are strings in RFC3339 format.
+func ValidateSearchAttributes(searchAttributes *s.SearchAttributes, validSearchAttributes map[string]interface{}) error {
+	if searchAttributes == nil {
+		return nil
+	}
+
+	for key, value := range searchAttributes.IndexedFields {
+		valueType, ok := getIndexedValueType(validSearchAttributes[key])
+		if !ok {
+			return &s.BadRequestError{Message: fmt.Sprintf("Search attribute %v is not valid.", key)}
+		}
+		if !isValidSearchAttributeValue(value, valueType) {
+			return &s.BadRequestError{
+				Message: fmt.Sprintf("Value of search attribute %v is not a valid %v.", key, valueType),
+			}
+		}
+	}
+	return nil
+}
+
+func getIndexedValueType(value interface{}) (s.IndexedValueType, bool) {
+	name, ok := value.(string)
+	if !ok {
+		return s.IndexedValueTypeString, false
+	}
+	valueType, ok := indexedValueTypes[strings.ToLower(name)]
+	return valueType, ok
+}
+
+func isValidSearchAttributeValue(value []byte, valueType s.IndexedValueType) bool {
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil || decoder.More() {
+		return false
+	}
+
+	switch valueType {
+	case s.IndexedValueTypeString, s.IndexedValueTypeKeyword:
+		_, ok := decoded.(string)
+		return ok
+	case s.IndexedValueTypeInt:
+		number, ok := decoded.(json.Number)
+		if !ok {
+			return false
+		}
+		_, err := number.Int64()
+		return err == nil
+	case s.IndexedValueTypeDouble:
+		number, ok := decoded.(json.Number)
+		if !ok {
+			return false
+		}
+		_, err := number.Float64()
+		return err == nil
+	case s.IndexedValueTypeBool:
+		_, ok := decoded.(bool)
+		return ok
+	case s.IndexedValueTypeDatetime:
+		str, ok := decoded.(string)
+		if !ok {
+			return false
+		}
+		_, err := time.Parse(time.RFC3339Nano, str)
+		return err == nil
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+)
+
+type (
+	searchAttributesSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+func TestSearchAttributesSuite(t *testing.T) {
+	suite.Run(t, new(searchAttributesSuite))
+}
+
+func (s *searchAttributesSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *searchAttributesSuite) TestConvertValidSearchAttributes() {
+	validSearchAttributes := ConvertValidSearchAttributes(map[string]interface{}{
+		"CustomKeywordField": "Keyword",
+		"CustomIntField":     "INT",
+		"CustomUnknownField": "Unknown",
+		"CustomBadField":     1,
+	})
+	s.Equal(map[string]gen.IndexedValueType{
+		"CustomKeywordField": gen.IndexedValueTypeKeyword,
+		"CustomIntField":     gen.IndexedValueTypeInt,
+	}, validSearchAttributes)
+	s.Equal(len(GetDefaultValidSearchAttributes()), len(ConvertValidSearchAttributes(GetDefaultValidSearchAttributes())))
+}
+
+func (s *searchAttributesSuite) TestValidateSearchAttributes() {
+	validSearchAttributes := GetDefaultValidSearchAttributes()
+	s.Nil(ValidateSearchAttributes(nil, validSearchAttributes))
+
+	valid := map[string]string{
+		"CustomStringField":   `"some text"`,
+		"CustomKeywordField":  `"keyword"`,
+		"CustomIntField":      `10`,
+		"CustomDoubleField":   `1.5`,
+		"CustomBoolField":     `true`,
+		"CustomDatetimeField": `"2018-06-07T15:04:05Z"`,
+	}
+	for key, value := range valid {
+		err := ValidateSearchAttributes(&gen.SearchAttributes{
+			IndexedFields: map[string][]byte{key: []byte(value)},
+		}, validSearchAttributes)
+		s.Nil(err, key)
+	}
+
+	invalid := map[string]string{
+		"CustomStringField":   `10`,
+		"CustomKeywordField":  `keyword`,
+		"CustomIntField":      `1.5`,
+		"CustomDoubleField":   `"1.5"`,
+		"CustomBoolField":     `1`,
+		"CustomDatetimeField": `"yesterday"`,
+		"UnknownField":        `"value"`,
+	}
+	for key, value := range invalid {
+		err := ValidateSearchAttributes(&gen.SearchAttributes{
+			IndexedFields: map[string][]byte{key: []byte(value)},
+		}, validSearchAttributes)
+		s.IsType(&gen.BadRequestError{}, err, key)
+	}
+}
@@ -23,6 +23,7 @@ package config
 import (
 	"encoding/json"
 	"github.com/uber-go/tally/m3"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/ringpop-go/discovery"
 	"time"
 )
@@ -34,10 +35,17 @@ type (
 		Ringpop Ringpop `yaml:"ringpop"`
 		// Cassandra is the configuration for connecting to cassandra
 		Cassandra Cassandra `yaml:"cassandra"`
+		// Persistence is the configuration for choosing the persistence store
+		Persistence Persistence `yaml:"persistence"`
+		// Matching is the configuration shared by the matching service and its clients
+		Matching Matching `yaml:"matching"`
 		// Log is the logging config
 		Log Logger `yaml:"log"`
 		// Services is a map of service name to service config items
 		Services map[string]Service `yaml:"services"`
+		// DynamicConfigClient is the config for the file based dynamic config client.  Dynamic config is
+		// disabled, so every setting has its default value, if no file path is set.
+		DynamicConfigClient dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
 	}
 
 	// Service contains the service specific config items
@@ -46,6 +54,8 @@ type (
 		RPC RPC `yaml:"rpc"`
 		// Metrics is the metrics subsystem configuration
 		Metrics Metrics `yaml:"metrics"`
+		// Config overrides the defaults of the service config, keyed by the yaml tag of the field
+		Config map[string]interface{} `yaml:"config"`
 	}
 
 	// RPC contains the rpc config items
@@ -98,6 +108,40 @@ type (
 		NumHistoryShards int `yaml:"numHistoryShards" validate:"nonzero"`
 	}
 
+	// Persistence contains the config items for choosing and connecting to the persistence store
+	Persistence struct {
+		// DefaultStore is the store used for all persistence, either cassandra, sql or memory. Defaults to cassandra.
+		// The memory store keeps everything in the memory of the process, which suits a local development cluster
+		// with no external database.  It requires all services to run in one process and is lost on restart.
+		// The number of history shards is always read from the cassandra config.
+		DefaultStore string `yaml:"defaultStore" validate:"regexp=^(cassandra|sql|memory)?$"`
+		// SQL is the configuration for connecting to a sql database, required if the default store is sql
+		SQL *SQL `yaml:"sql"`
+		// AdvancedVisibilityStore is the store which indexes the search attributes of executions for listing and
+		// counting them by query.  Stores are registered by name with persistence.RegisterVisibilityIndex by the
+		// binary that links them in.  The only built in one is embedded, which is meant for tests: it keeps the index
+		// in the memory of the process and loses it on restart, so it requires the memory default store, which loses
+		// the executions along with it.  Query based APIs are not supported if no store is set.
+		AdvancedVisibilityStore string `yaml:"advancedVisibilityStore"`
+	}
+
+	// SQL contains configuration to connect to a sql database
+	SQL struct {
+		// DriverName is the name of the database/sql driver, one of mysql or postgres
+		DriverName string `yaml:"driverName" validate:"nonzero"`
+		// DataSourceName is the driver specific data source name of the cadence database
+		DataSourceName string `yaml:"dataSourceName" validate:"nonzero"`
+		// VisibilityDataSourceName is the driver specific data source name of the visibility database
+		VisibilityDataSourceName string `yaml:"visibilityDataSourceName" validate:"nonzero"`
+	}
+
+	// Matching contains the config items for partitioning task lists across matching hosts
+	Matching struct {
+		// TaskListPartitions is a map of task list name to the number of partitions of that task list.
+		// Task lists that are not in the map have a single partition.
+		TaskListPartitions map[string]int `yaml:"taskListPartitions"`
+	}
+
 	// Logger contains the config items for logger
 	Logger struct {
 		// Stdout is true if the output needs to goto standard out
@@ -138,6 +182,33 @@ type (
 	BootstrapMode int
 )
 
+// Persistence store types
+const (
+	// StoreTypeCassandra is the store type for cassandra
+	StoreTypeCassandra = "cassandra"
+	// StoreTypeSQL is the store type for a sql database
+	StoreTypeSQL = "sql"
+	// StoreTypeMemory is the store type for keeping everything in memory
+	StoreTypeMemory = "memory"
+	// StoreTypeEmbedded is the advanced visibility store type for the index kept in memory, which is meant for tests
+	StoreTypeEmbedded = "embedded"
+)
+
+// IsSQL returns true if sql is the configured default store
+func (p *Persistence) IsSQL() bool {
+	return p.DefaultStore == StoreTypeSQL
+}
+
+// IsInMemory returns true if memory is the configured default store
+func (p *Persistence) IsInMemory() bool {
+	return p.DefaultStore == StoreTypeMemory
+}
+
+// HasAdvancedVisibility returns true if an advanced visibility store is configured
+func (p *Persistence) HasAdvancedVisibility() bool {
+	return len(p.AdvancedVisibilityStore) > 0
+}
+
 // String converts the config object into a string
 func (c *Config) String() string {
 	out, _ := json.MarshalIndent(c, "", "    ")
@@ -0,0 +1,198 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/validator.v2"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// ApplyOverrides sets the fields of the service config pointed to by serviceConfig to the values in overrides, which
+// are keyed by the yaml tag of the field.  Fields without a yaml tag cannot be overridden.  A field read through
+// dynamic config is fixed to the given value, so dynamic config no longer applies to it.  Each value is checked
+// against the validate tag of its field.
+func ApplyOverrides(serviceConfig interface{}, overrides map[string]interface{}) error {
+	v := reflect.ValueOf(serviceConfig)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("service config must be a pointer to a struct, got %T", serviceConfig)
+	}
+	v = v.Elem()
+	fields := yamlFields(v.Type())
+
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		field, ok := fields[name]
+		if !ok {
+			return fmt.Errorf("unknown config field: %v", name)
+		}
+		valueType := field.Type
+		if valueType.Kind() == reflect.Func {
+			valueType = valueType.Out(0)
+		}
+		value, err := convertValue(overrides[name], valueType)
+		if err != nil {
+			return fmt.Errorf("config field %v: %v", name, err)
+		}
+		if tag := field.Tag.Get("validate"); len(tag) > 0 {
+			if err := validator.Valid(value.Interface(), tag); err != nil {
+				return fmt.Errorf("config field %v: %v", name, err)
+			}
+		}
+		if field.Type.Kind() == reflect.Func {
+			value = constantFunc(field.Type, value)
+		}
+		v.FieldByIndex(field.Index).Set(value)
+	}
+	return nil
+}
+
+// EffectiveValues returns the values of the fields of the service config pointed to by serviceConfig, keyed by the
+// yaml tag of the field.  Fields read through dynamic config have their current value, for no domain or task list.
+func EffectiveValues(serviceConfig interface{}) map[string]interface{} {
+	v := reflect.Indirect(reflect.ValueOf(serviceConfig))
+	values := make(map[string]interface{})
+	for name, field := range yamlFields(v.Type()) {
+		value := v.FieldByIndex(field.Index)
+		if value.Kind() == reflect.Func {
+			if value.IsNil() {
+				continue
+			}
+			args := make([]reflect.Value, value.Type().NumIn())
+			for i := range args {
+				args[i] = reflect.Zero(value.Type().In(i))
+			}
+			value = value.Call(args)[0]
+		}
+		if value.Type() == durationType {
+			values[name] = value.Interface().(time.Duration).String()
+		} else {
+			values[name] = value.Interface()
+		}
+	}
+	return values
+}
+
+// yamlFields returns the fields of the struct which can be overridden, keyed by their yaml tag
+func yamlFields(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+		if len(name) == 0 || name == "-" || len(field.PkgPath) > 0 {
+			continue
+		}
+		if field.Type.Kind() == reflect.Func && field.Type.NumOut() != 1 {
+			continue
+		}
+		fields[name] = field
+	}
+	return fields
+}
+
+// convertValue converts a value decoded from yaml to the given type.  Durations are written as strings like "10s".
+func convertValue(raw interface{}, t reflect.Type) (reflect.Value, error) {
+	value := reflect.New(t).Elem()
+	rv := reflect.ValueOf(raw)
+	if !rv.IsValid() {
+		return value, fmt.Errorf("missing value")
+	}
+
+	if t == durationType {
+		s, ok := raw.(string)
+		if !ok {
+			return value, fmt.Errorf("duration must be a string like 10s, got %v", raw)
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return value, err
+		}
+		value.SetInt(int64(d))
+		return value, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := toInt64(rv)
+		if !ok || value.OverflowInt(n) {
+			return value, fmt.Errorf("expected %v, got %v", t, raw)
+		}
+		value.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, ok := toInt64(rv)
+		if !ok || n < 0 || value.OverflowUint(uint64(n)) {
+			return value, fmt.Errorf("expected %v, got %v", t, raw)
+		}
+		value.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		switch rv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			value.SetFloat(rv.Float())
+		default:
+			n, ok := toInt64(rv)
+			if !ok {
+				return value, fmt.Errorf("expected %v, got %v", t, raw)
+			}
+			value.SetFloat(float64(n))
+		}
+	case reflect.Bool, reflect.String:
+		if rv.Kind() != t.Kind() {
+			return value, fmt.Errorf("expected %v, got %v", t, raw)
+		}
+		value.Set(rv.Convert(t))
+	default:
+		return value, fmt.Errorf("fields of type %v cannot be overridden", t)
+	}
+	return value, nil
+}
+
+func toInt64(rv reflect.Value) (int64, bool) {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if rv.Uint() > uint64(1<<63-1) {
+			return 0, false
+		}
+		return int64(rv.Uint()), true
+	default:
+		return 0, false
+	}
+}
+
+// constantFunc returns a function of the given type which ignores its arguments and returns value
+func constantFunc(t reflect.Type, value reflect.Value) reflect.Value {
+	result := []reflect.Value{value}
+	return reflect.MakeFunc(t, func(args []reflect.Value) []reflect.Value {
+		return result
+	})
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	OverridesSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+
+	testIntPropertyFn func() int
+
+	testTaskListPropertyFn func(taskList string, taskType int) time.Duration
+
+	testServiceConfig struct {
+		NumberOfShards int                    `yaml:"-"`
+		BatchSize      int                    `yaml:"batchSize" validate:"min=1"`
+		RangeSizeBits  uint                   `yaml:"rangeSizeBits" validate:"min=1"`
+		Interval       time.Duration          `yaml:"interval" validate:"nonzero"`
+		RetryCount     int32                  `yaml:"retryCount"`
+		EnableFeature  bool                   `yaml:"enableFeature"`
+		Rate           float64                `yaml:"rate"`
+		WorkerCount    testIntPropertyFn      `yaml:"workerCount" validate:"min=1"`
+		LongPoll       testTaskListPropertyFn `yaml:"longPoll"`
+		notExported    int
+	}
+)
+
+func TestOverridesSuite(t *testing.T) {
+	suite.Run(t, new(OverridesSuite))
+}
+
+func (s *OverridesSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func newTestServiceConfig() *testServiceConfig {
+	return &testServiceConfig{
+		NumberOfShards: 4,
+		BatchSize:      100,
+		RangeSizeBits:  20,
+		Interval:       time.Second,
+		RetryCount:     5,
+		WorkerCount:    func() int { return 10 },
+		LongPoll:       func(string, int) time.Duration { return time.Minute },
+	}
+}
+
+func (s *OverridesSuite) TestApplyOverrides() {
+	config := newTestServiceConfig()
+	err := ApplyOverrides(config, map[string]interface{}{
+		"batchSize":     200,
+		"rangeSizeBits": 22,
+		"interval":      "10s",
+		"retryCount":    3,
+		"enableFeature": true,
+		"rate":          5,
+		"workerCount":   30,
+		"longPoll":      "30s",
+	})
+	s.NoError(err)
+	s.Equal(4, config.NumberOfShards)
+	s.Equal(200, config.BatchSize)
+	s.Equal(uint(22), config.RangeSizeBits)
+	s.Equal(10*time.Second, config.Interval)
+	s.Equal(int32(3), config.RetryCount)
+	s.True(config.EnableFeature)
+	s.Equal(float64(5), config.Rate)
+	s.Equal(30, config.WorkerCount())
+	s.Equal(30*time.Second, config.LongPoll("taskList", 1))
+}
+
+func (s *OverridesSuite) TestApplyNoOverrides() {
+	config := newTestServiceConfig()
+	s.NoError(ApplyOverrides(config, nil))
+	s.Equal(newTestServiceConfig().BatchSize, config.BatchSize)
+	s.Equal(10, config.WorkerCount())
+}
+
+func (s *OverridesSuite) TestApplyOverridesInvalid() {
+	invalid := []map[string]interface{}{
+		{"unknownField": 1},
+		{"numberOfShards": 8},
+		{"notExported": 1},
+		{"batchSize": "200"},
+		{"batchSize": 0},
+		{"rangeSizeBits": -1},
+		{"interval": 10},
+		{"interval": "10 seconds"},
+		{"interval": "0s"},
+		{"retryCount": 1 << 40},
+		{"enableFeature": "true"},
+		{"workerCount": 0},
+		{"longPoll": nil},
+	}
+	for _, overrides := range invalid {
+		s.Error(ApplyOverrides(newTestServiceConfig(), overrides), "overrides: %v", overrides)
+	}
+	s.Error(ApplyOverrides(*newTestServiceConfig(), map[string]interface{}{}))
+}
+
+func (s *OverridesSuite) TestEffectiveValues() {
+	values := EffectiveValues(newTestServiceConfig())
+	s.Equal(map[string]interface{}{
+		"batchSize":     100,
+		"rangeSizeBits": uint(20),
+		"interval":      "1s",
+		"retryCount":    int32(5),
+		"enableFeature": false,
+		"rate":          float64(0),
+		"workerCount":   10,
+		"longPoll":      "1m0s",
+	}, values)
+}
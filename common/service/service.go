@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/config"
 
 	"github.com/uber-common/bark"
@@ -44,12 +45,16 @@ type (
 	// BootstrapParams holds the set of parameters
 	// needed to bootstrap a service
 	BootstrapParams struct {
-		Name            string
-		Logger          bark.Logger
-		MetricScope     tally.Scope
-		RingpopFactory  RingpopFactory
-		RPCFactory      common.RPCFactory
-		CassandraConfig config.Cassandra
+		Name              string
+		Logger            bark.Logger
+		MetricScope       tally.Scope
+		RingpopFactory    RingpopFactory
+		RPCFactory        common.RPCFactory
+		CassandraConfig   config.Cassandra
+		PersistenceConfig config.Persistence
+		MatchingConfig    config.Matching
+		// InMemoryStore is shared by the services of the process if memory is the default store
+		InMemoryStore *persistence.InMemoryStore
 	}
 
 	// RingpopFactory provides a bootstrapped ringpop
@@ -70,6 +75,7 @@ type (
 		rpcFactory             common.RPCFactory
 		clientFactory          client.Factory
 		numberOfHistoryShards  int
+		taskListPartitions     map[string]int
 		logger                 bark.Logger
 		metricsScope           tally.Scope
 		runtimeMetricsReporter *metrics.RuntimeMetricsReporter
@@ -87,6 +93,7 @@ func New(params *BootstrapParams) Service {
 		rpFactory:             params.RingpopFactory,
 		metricsScope:          params.MetricScope,
 		numberOfHistoryShards: params.CassandraConfig.NumHistoryShards,
+		taskListPartitions:    params.MatchingConfig.TaskListPartitions,
 	}
 	sVice.runtimeMetricsReporter = metrics.NewRuntimeMetricsReporter(params.MetricScope, time.Minute, sVice.logger)
 	sVice.metricsClient = metrics.NewClient(params.MetricScope, getMetricsServiceIdx(params.Name, params.Logger))
@@ -148,7 +155,7 @@ func (h *serviceImpl) Start() {
 	h.hostInfo = hostInfo
 
 	h.clientFactory = client.NewRPCClientFactory(h.rpcFactory, h.membershipMonitor, h.metricsClient,
-		h.numberOfHistoryShards)
+		h.numberOfHistoryShards, h.taskListPartitions)
 
 	// The service is now started up
 	h.logger.Info("service started")
@@ -0,0 +1,56 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// taskListPartitionPrefix is the prefix of the names of all non-root task list partitions
+const taskListPartitionPrefix = "/__cadence_sys/"
+
+// TaskListPartitionName returns the name of a partition of the task list.  Partition 0 is the root partition and keeps
+// the name of the task list, so a task list with a single partition is not affected by partitioning.
+func TaskListPartitionName(taskList string, partition int) string {
+	if partition <= 0 {
+		return taskList
+	}
+	return fmt.Sprintf("%v%v/%v", taskListPartitionPrefix, taskList, partition)
+}
+
+// RootTaskListName returns the name of the root partition of the task list partition with the given name, and whether
+// the name refers to a non-root partition.
+func RootTaskListName(name string) (string, bool) {
+	if !strings.HasPrefix(name, taskListPartitionPrefix) {
+		return name, false
+	}
+	suffix := name[len(taskListPartitionPrefix):]
+	idx := strings.LastIndex(suffix, "/")
+	if idx <= 0 {
+		return name, false
+	}
+	if partition, err := strconv.Atoi(suffix[idx+1:]); err != nil || partition <= 0 {
+		return name, false
+	}
+	return suffix[:idx], true
+}
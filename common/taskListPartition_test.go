@@ -0,0 +1,68 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	taskListPartitionSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+func TestTaskListPartitionSuite(t *testing.T) {
+	suite.Run(t, new(taskListPartitionSuite))
+}
+
+func (s *taskListPartitionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *taskListPartitionSuite) TestRootPartition() {
+	name := TaskListPartitionName("tl", 0)
+	s.Equal("tl", name)
+	root, isPartition := RootTaskListName(name)
+	s.Equal("tl", root)
+	s.False(isPartition)
+}
+
+func (s *taskListPartitionSuite) TestNonRootPartition() {
+	name := TaskListPartitionName("some/task/list", 3)
+	s.NotEqual("some/task/list", name)
+	root, isPartition := RootTaskListName(name)
+	s.Equal("some/task/list", root)
+	s.True(isPartition)
+}
+
+func (s *taskListPartitionSuite) TestInvalidPartitionNames() {
+	for _, name := range []string{"/__cadence_sys/", "/__cadence_sys//1", "/__cadence_sys/tl", "/__cadence_sys/tl/x",
+		"/__cadence_sys/tl/0", "/__cadence_sys/tl/-1"} {
+		root, isPartition := RootTaskListName(name)
+		s.Equal(name, root)
+		s.False(isPartition)
+	}
+}
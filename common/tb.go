@@ -64,6 +64,14 @@ type (
 		nextOverflowRefillTime int64
 		timeSource             TimeSource
 	}
+
+	dynamicTokenBucketImpl struct {
+		sync.RWMutex
+		rps        func() int
+		currentRPS int
+		tb         TokenBucket
+		timeSource TimeSource
+	}
 )
 
 const (
@@ -105,6 +113,20 @@ func NewTokenBucket(rps int, timeSource TimeSource) TokenBucket {
 	return tb
 }
 
+// NewDynamicTokenBucket creates and returns a token bucket
+// rate limiter whose rate is read from rps on every call,
+// so the rate can be changed while the bucket is in use.
+// Thread safe.
+func NewDynamicTokenBucket(rps func() int, timeSource TimeSource) TokenBucket {
+	currentRPS := rps()
+	return &dynamicTokenBucketImpl{
+		rps:        rps,
+		currentRPS: currentRPS,
+		tb:         NewTokenBucket(currentRPS, timeSource),
+		timeSource: timeSource,
+	}
+}
+
 // NewTokenBucketFactory creates an instance of factory used for creating TokenBucket instances
 func NewTokenBucketFactory() TokenBucketFactory {
 	return &tokenBucketFactoryImpl{}
@@ -187,3 +209,32 @@ func (tb *tokenBucketImpl) isRefillDue(now int64) bool {
 func (tb *tokenBucketImpl) isOverflowRefillDue(now int64) bool {
 	return now >= tb.nextOverflowRefillTime
 }
+
+func (tb *dynamicTokenBucketImpl) TryConsume(count int) (bool, time.Duration) {
+	return tb.bucket().TryConsume(count)
+}
+
+func (tb *dynamicTokenBucketImpl) Consume(count int, timeout time.Duration) bool {
+	return tb.bucket().Consume(count, timeout)
+}
+
+// bucket returns the token bucket for the current rate, replacing
+// the bucket if the rate changed since it was created
+func (tb *dynamicTokenBucketImpl) bucket() TokenBucket {
+	rps := tb.rps()
+	tb.RLock()
+	if rps == tb.currentRPS {
+		bucket := tb.tb
+		tb.RUnlock()
+		return bucket
+	}
+	tb.RUnlock()
+
+	tb.Lock()
+	defer tb.Unlock()
+	if rps != tb.currentRPS {
+		tb.currentRPS = rps
+		tb.tb = NewTokenBucket(rps, tb.timeSource)
+	}
+	return tb.tb
+}
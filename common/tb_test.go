@@ -104,3 +104,20 @@ func (s *TokenBucketSuite) TestLowRpsEnforced() {
 	s.Equal(3, total, "Token bucket failed to enforce limit")
 	s.Equal(3, attempts, "Token bucket gave out tokens too quickly")
 }
+
+func (s *TokenBucketSuite) TestDynamicRpsEnforced() {
+	ts := &mockTimeSource{currTime: time.Now()}
+	rps := 10
+	tb := NewDynamicTokenBucket(func() int { return rps }, ts)
+
+	ok, _ := tb.TryConsume(1)
+	s.True(ok)
+	ok, _ = tb.TryConsume(1)
+	s.False(ok, "Token bucket failed to enforce limit")
+
+	rps = 30
+	ok, _ = tb.TryConsume(3)
+	s.True(ok, "Token bucket failed to pick up the new limit")
+	ok, _ = tb.TryConsume(1)
+	s.False(ok, "Token bucket failed to enforce limit")
+}
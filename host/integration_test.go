@@ -38,6 +38,8 @@ import (
 	"encoding/binary"
 	"strconv"
 
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	wsc "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/frontend"
@@ -49,6 +51,7 @@ import (
 
 var (
 	integration = flag.Bool("integration", true, "run integration tests")
+	inMemory    = flag.Bool("inMemory", false, "run integration tests against in-memory persistence instead of cassandra")
 )
 
 const (
@@ -65,6 +68,7 @@ type (
 		foreignDomainName string
 		host              Cadence
 		engine            wsc.Interface
+		adminClient       adminserviceclient.Interface
 		logger            bark.Logger
 		suite.Suite
 		persistence.TestBase
@@ -119,6 +123,7 @@ func (s *integrationSuite) SetupTest() {
 	options.ClusterHost = "127.0.0.1"
 	options.DropKeySpace = true
 	options.SchemaDir = ".."
+	options.InMemory = *inMemory
 	s.SetupWorkflowStoreWithOptions(options)
 
 	s.setupShards()
@@ -129,6 +134,7 @@ func (s *integrationSuite) SetupTest() {
 	s.host.Start()
 
 	s.engine = s.host.GetFrontendClient()
+	s.adminClient = s.host.GetAdminClient()
 	s.domainName = "integration-test-domain"
 	s.MetadataManager.CreateDomain(&persistence.CreateDomainRequest{
 		Name:        s.domainName,
@@ -2543,6 +2549,215 @@ ListClosedLoop:
 	s.Equal(1, closedCount)
 }
 
+func (s *integrationSuite) TestAdminDescribeWorkflow() {
+	id := "integration-admin-describe-workflow-test"
+	wt := "integration-admin-describe-workflow-test-type"
+	tl := "integration-admin-describe-workflow-test-tasklist"
+	identity := "worker1"
+
+	request := &workflow.StartWorkflowExecutionRequest{
+		RequestId:    common.StringPtr(uuid.New()),
+		Domain:       common.StringPtr(s.domainName),
+		WorkflowId:   common.StringPtr(id),
+		WorkflowType: &workflow.WorkflowType{Name: common.StringPtr(wt)},
+		TaskList:     &workflow.TaskList{Name: common.StringPtr(tl)},
+		Input:        nil,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		Identity:                            common.StringPtr(identity),
+	}
+
+	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
+	s.Nil(err0)
+
+	execution := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(id),
+		RunId:      we.RunId,
+	}
+	msResp, err1 := s.adminClient.DescribeMutableState(createContext(), &admin.DescribeMutableStateRequest{
+		Domain:    common.StringPtr(s.domainName),
+		Execution: execution,
+	})
+	s.Nil(err1)
+	s.NotNil(msResp.MutableStateInCache)
+	s.NotNil(msResp.MutableStateInDatabase)
+
+	hostResp, err2 := s.adminClient.DescribeHistoryHost(createContext(), &workflow.DescribeHistoryHostRequest{
+		ExecutionForHost: execution,
+	})
+	s.Nil(err2)
+	s.Equal(int32(testNumberOfHistoryShards), *hostResp.NumberOfShards)
+	s.Equal(testNumberOfHistoryShards, len(hostResp.ShardIDs))
+
+	shardID := common.WorkflowIDToHistoryShard(id, testNumberOfHistoryShards)
+	shardResp, err3 := s.adminClient.DescribeShard(createContext(), &workflow.DescribeShardRequest{
+		ShardID: common.Int32Ptr(int32(shardID)),
+	})
+	s.Nil(err3)
+	s.Equal(int32(shardID), *shardResp.ShardID)
+	s.True(*shardResp.RangeID > 0)
+}
+
+func (s *integrationSuite) TestGetWorkflowExecutionHistory_WaitForNewEvent() {
+	id := "integration-wait-for-new-event-test"
+	wt := "integration-wait-for-new-event-test-type"
+	tl := "integration-wait-for-new-event-test-tasklist"
+	identity := "worker1"
+
+	request := &workflow.StartWorkflowExecutionRequest{
+		RequestId:    common.StringPtr(uuid.New()),
+		Domain:       common.StringPtr(s.domainName),
+		WorkflowId:   common.StringPtr(id),
+		WorkflowType: &workflow.WorkflowType{Name: common.StringPtr(wt)},
+		TaskList:     &workflow.TaskList{Name: common.StringPtr(tl)},
+		Input:        nil,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		Identity:                            common.StringPtr(identity),
+	}
+
+	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
+	s.Nil(err0)
+
+	dtHandler := func(execution *workflow.WorkflowExecution, wt *workflow.WorkflowType,
+		previousStartedEventID, startedEventID int64, history *workflow.History) ([]byte, []*workflow.Decision) {
+		return nil, []*workflow.Decision{{
+			DecisionType: common.DecisionTypePtr(workflow.DecisionTypeCompleteWorkflowExecution),
+			CompleteWorkflowExecutionDecisionAttributes: &workflow.CompleteWorkflowExecutionDecisionAttributes{
+				Result: []byte("Done."),
+			},
+		}}
+	}
+
+	poller := &taskPoller{
+		engine:          s.engine,
+		domain:          s.domainName,
+		taskList:        &workflow.TaskList{Name: common.StringPtr(tl)},
+		identity:        identity,
+		decisionHandler: dtHandler,
+		logger:          s.logger,
+	}
+
+	execution := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(id),
+		RunId:      we.RunId,
+	}
+	historyResponse, err1 := s.engine.GetWorkflowExecutionHistory(createContext(),
+		&workflow.GetWorkflowExecutionHistoryRequest{
+			Domain:          common.StringPtr(s.domainName),
+			Execution:       execution,
+			WaitForNewEvent: common.BoolPtr(true),
+		})
+	s.Nil(err1)
+	// WorkflowExecutionStarted and DecisionTaskScheduled
+	s.Equal(2, len(historyResponse.History.Events))
+	s.NotNil(historyResponse.NextPageToken)
+
+	// Complete the workflow while the next request waits for new events
+	errCh := make(chan error, 1)
+	go func() {
+		time.Sleep(time.Second)
+		errCh <- poller.pollAndProcessDecisionTask(false, false)
+	}()
+
+	events := historyResponse.History.Events
+	for historyResponse.NextPageToken != nil {
+		historyResponse, err1 = s.engine.GetWorkflowExecutionHistory(createContext(),
+			&workflow.GetWorkflowExecutionHistoryRequest{
+				Domain:          common.StringPtr(s.domainName),
+				Execution:       execution,
+				NextPageToken:   historyResponse.NextPageToken,
+				WaitForNewEvent: common.BoolPtr(true),
+			})
+		s.Nil(err1)
+		events = append(events, historyResponse.History.Events...)
+	}
+	s.Nil(<-errCh)
+
+	// DecisionTaskStarted, DecisionTaskCompleted and WorkflowExecutionCompleted
+	s.Equal(5, len(events))
+	s.Equal(workflow.EventTypeWorkflowExecutionCompleted, *events[len(events)-1].EventType)
+}
+
+func (s *integrationSuite) TestGetWorkflowExecutionHistory_CloseEventOnly() {
+	id := "integration-close-event-only-test"
+	wt := "integration-close-event-only-test-type"
+	tl := "integration-close-event-only-test-tasklist"
+	identity := "worker1"
+
+	request := &workflow.StartWorkflowExecutionRequest{
+		RequestId:                           common.StringPtr(uuid.New()),
+		Domain:                              common.StringPtr(s.domainName),
+		WorkflowId:                          common.StringPtr(id),
+		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(wt)},
+		TaskList:                            &workflow.TaskList{Name: common.StringPtr(tl)},
+		Input:                               nil,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		Identity:                            common.StringPtr(identity),
+	}
+
+	we, err0 := s.engine.StartWorkflowExecution(createContext(), request)
+	s.Nil(err0)
+
+	dtHandler := func(execution *workflow.WorkflowExecution, wt *workflow.WorkflowType,
+		previousStartedEventID, startedEventID int64, history *workflow.History) ([]byte, []*workflow.Decision) {
+		return nil, []*workflow.Decision{{
+			DecisionType: common.DecisionTypePtr(workflow.DecisionTypeCompleteWorkflowExecution),
+			CompleteWorkflowExecutionDecisionAttributes: &workflow.CompleteWorkflowExecutionDecisionAttributes{
+				Result: []byte("Done."),
+			},
+		}}
+	}
+
+	poller := &taskPoller{
+		engine:          s.engine,
+		domain:          s.domainName,
+		taskList:        &workflow.TaskList{Name: common.StringPtr(tl)},
+		identity:        identity,
+		decisionHandler: dtHandler,
+		logger:          s.logger,
+	}
+
+	execution := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(id),
+		RunId:      we.RunId,
+	}
+
+	// Without waiting there is no close event for a running workflow
+	historyResponse, err1 := s.engine.GetWorkflowExecutionHistory(createContext(),
+		&workflow.GetWorkflowExecutionHistoryRequest{
+			Domain:                 common.StringPtr(s.domainName),
+			Execution:              execution,
+			HistoryEventFilterType: common.HistoryEventFilterTypePtr(workflow.HistoryEventFilterTypeCloseEvent),
+		})
+	s.Nil(err1)
+	s.Equal(0, len(historyResponse.History.Events))
+	s.Nil(historyResponse.NextPageToken)
+
+	// Complete the workflow while the next request waits for the close event
+	errCh := make(chan error, 1)
+	go func() {
+		time.Sleep(time.Second)
+		errCh <- poller.pollAndProcessDecisionTask(false, false)
+	}()
+
+	historyResponse, err1 = s.engine.GetWorkflowExecutionHistory(createContext(),
+		&workflow.GetWorkflowExecutionHistoryRequest{
+			Domain:                 common.StringPtr(s.domainName),
+			Execution:              execution,
+			WaitForNewEvent:        common.BoolPtr(true),
+			HistoryEventFilterType: common.HistoryEventFilterTypePtr(workflow.HistoryEventFilterTypeCloseEvent),
+		})
+	s.Nil(err1)
+	s.Nil(<-errCh)
+	s.Nil(historyResponse.NextPageToken)
+	s.Equal(1, len(historyResponse.History.Events))
+	closeEvent := historyResponse.History.Events[0]
+	s.Equal(workflow.EventTypeWorkflowExecutionCompleted, *closeEvent.EventType)
+	s.Equal([]byte("Done."), closeEvent.WorkflowExecutionCompletedEventAttributes.Result)
+}
+
 func (s *integrationSuite) setupShards() {
 	// shard 0 is always created, we create additional shards if needed
 	for shardID := 1; shardID < testNumberOfHistoryShards; shardID++ {
@@ -29,9 +29,11 @@ import (
 	"errors"
 	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
 	fecli "github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/service/frontend"
@@ -53,12 +55,14 @@ type Cadence interface {
 	Start() error
 	Stop()
 	GetFrontendClient() workflowserviceclient.Interface
+	GetAdminClient() adminserviceclient.Interface
 	FrontendAddress() string
 }
 
 type (
 	cadenceImpl struct {
 		frontendHandler       *frontend.WorkflowHandler
+		adminHandler          *frontend.AdminHandler
 		matchingHandler       *matching.Handler
 		historyHandlers       []*history.Handler
 		numberOfHistoryShards int
@@ -153,6 +157,10 @@ func (c *cadenceImpl) GetFrontendClient() workflowserviceclient.Interface {
 	return fecli.New(c.frontEndService.GetDispatcher())
 }
 
+func (c *cadenceImpl) GetAdminClient() adminserviceclient.Interface {
+	return adminserviceclient.New(c.frontEndService.GetDispatcher().ClientConfig(common.FrontendServiceName))
+}
+
 func (c *cadenceImpl) startFrontend(logger bark.Logger, rpHosts []string, startWG *sync.WaitGroup) {
 	params := new(service.BootstrapParams)
 	params.Name = common.FrontendServiceName
@@ -165,11 +173,16 @@ func (c *cadenceImpl) startFrontend(logger bark.Logger, rpHosts []string, startW
 
 	c.frontEndService = service.New(params)
 	c.frontendHandler = frontend.NewWorkflowHandler(
-		c.frontEndService, frontend.NewConfig(), c.metadataMgr, c.historyMgr, c.visibilityMgr)
+		c.frontEndService, frontend.NewConfig(dynamicconfig.NewNopCollection()), c.metadataMgr, c.historyMgr, c.visibilityMgr)
 	err := c.frontendHandler.Start()
 	if err != nil {
 		c.logger.WithField("error", err).Fatal("Failed to start frontend")
 	}
+	c.adminHandler = frontend.NewAdminHandler(c.frontEndService, c.metadataMgr)
+	err = c.adminHandler.Start()
+	if err != nil {
+		c.logger.WithField("error", err).Fatal("Failed to start admin")
+	}
 	startWG.Done()
 	<-c.shutdownCh
 	c.shutdownWG.Done()
@@ -188,7 +201,8 @@ func (c *cadenceImpl) startHistory(logger bark.Logger, shardMgr persistence.Shar
 		params.RingpopFactory = newRingpopFactory(common.FrontendServiceName, rpHosts)
 		params.CassandraConfig.NumHistoryShards = c.numberOfHistoryShards
 		service := service.New(params)
-		handler := history.NewHandler(service, history.NewConfig(c.numberOfHistoryShards), shardMgr, metadataMgr,
+		config := history.NewConfig(dynamicconfig.NewNopCollection(), c.numberOfHistoryShards)
+		handler := history.NewHandler(service, config, shardMgr, metadataMgr,
 			visibilityMgr, historyMgr, executionMgrFactory)
 		handler.Start()
 		c.historyHandlers = append(c.historyHandlers, handler)
@@ -209,7 +223,7 @@ func (c *cadenceImpl) startMatching(logger bark.Logger, taskMgr persistence.Task
 	params.RingpopFactory = newRingpopFactory(common.FrontendServiceName, rpHosts)
 	params.CassandraConfig.NumHistoryShards = c.numberOfHistoryShards
 	service := service.New(params)
-	c.matchingHandler = matching.NewHandler(service, matching.NewConfig(), taskMgr)
+	c.matchingHandler = matching.NewHandler(service, matching.NewConfig(dynamicconfig.NewNopCollection()), taskMgr)
 	c.matchingHandler.Start()
 	startWG.Done()
 	<-c.shutdownCh
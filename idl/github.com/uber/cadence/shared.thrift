@@ -50,6 +50,10 @@ exception CancellationAlreadyRequestedError {
   1: required string message
 }
 
+exception QueryFailedError {
+  1: required string message
+}
+
 enum DomainStatus {
   REGISTERED,
   DEPRECATED,
@@ -75,6 +79,8 @@ enum DecisionType {
   RecordMarker,
   ContinueAsNewWorkflowExecution,
   StartChildWorkflowExecution,
+  SignalExternalWorkflowExecution,
+  UpsertWorkflowSearchAttributes,
 }
 
 enum EventType {
@@ -116,6 +122,10 @@ enum EventType {
   ChildWorkflowExecutionCanceled,
   ChildWorkflowExecutionTimedOut,
   ChildWorkflowExecutionTerminated,
+  SignalExternalWorkflowExecutionInitiated,
+  SignalExternalWorkflowExecutionFailed,
+  ExternalWorkflowExecutionSignaled,
+  UpsertWorkflowSearchAttributes,
 }
 
 enum DecisionTaskFailedCause {
@@ -131,12 +141,19 @@ enum DecisionTaskFailedCause {
   BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,
   BAD_CONTINUE_AS_NEW_ATTRIBUTES,
   START_TIMER_DUPLICATE_ID,
+  BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,
+  RESET_WORKFLOW,
+  BAD_SEARCH_ATTRIBUTES,
 }
 
 enum CancelExternalWorkflowExecutionFailedCause {
   UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,
 }
 
+enum SignalExternalWorkflowExecutionFailedCause {
+  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,
+}
+
 enum ChildWorkflowExecutionFailedCause {
   WORKFLOW_ALREADY_RUNNING,
 }
@@ -156,6 +173,51 @@ enum ChildPolicy {
   ABANDON,
 }
 
+enum WorkflowIdReusePolicy {
+  // allow a new run when the last run with the same workflow ID failed, was canceled, terminated or timed out
+  ALLOW_DUPLICATE_FAILED_ONLY,
+  // allow a new run when the last run with the same workflow ID is closed, regardless of how it closed
+  ALLOW_DUPLICATE,
+  // never allow a new run with the same workflow ID
+  REJECT_DUPLICATE,
+}
+
+enum PendingActivityState {
+  SCHEDULED,
+  STARTED,
+  CANCEL_REQUESTED,
+}
+
+enum PendingDecisionState {
+  SCHEDULED,
+  STARTED,
+}
+
+enum QueryTaskCompletedType {
+  COMPLETED,
+  FAILED,
+}
+
+enum TaskListType {
+  DECISION,
+  ACTIVITY,
+}
+
+enum HistoryEventFilterType {
+  ALL_EVENT,
+  // only the event which closed the workflow execution
+  CLOSE_EVENT,
+}
+
+enum IndexedValueType {
+  STRING,
+  KEYWORD,
+  INT,
+  DOUBLE,
+  BOOL,
+  DATETIME,
+}
+
 struct WorkflowType {
   10: optional string name
 }
@@ -173,6 +235,13 @@ struct WorkflowExecution {
   20: optional string runId
 }
 
+// SearchAttributes are the indexed attributes of a workflow execution which visibility queries can filter on.  The
+// values are JSON encoded, and the keys and the types of their values have to be valid search attributes of the
+// cluster.
+struct SearchAttributes {
+  10: optional map<string, binary> indexedFields
+}
+
 struct WorkflowExecutionInfo {
   10: optional WorkflowExecution execution
   20: optional WorkflowType type
@@ -180,6 +249,26 @@ struct WorkflowExecutionInfo {
   40: optional i64 (js.type = "Long") closeTime
   50: optional WorkflowExecutionCloseStatus closeStatus
   60: optional i64 (js.type = "Long") historyLength
+  70: optional SearchAttributes searchAttributes
+}
+
+// RetryPolicy defines how retry should be done
+struct RetryPolicy {
+  // Interval of the first retry. If coefficient is 1.0 then it is used for all retries.
+  10: optional i32 initialIntervalInSeconds
+  // Coefficient used to calculate the next retry interval. The next retry interval is previous interval multiplied
+  // by the coefficient. Must be 1 or larger.
+  20: optional double backoffCoefficient
+  // Maximum interval between retries. Exponential backoff leads to interval increase. This value is the cap of the
+  // interval. Default is 100x of initial interval.
+  30: optional i32 maximumIntervalInSeconds
+  // Maximum number of attempts. When exceeded the retries stop even if not expired yet. Zero means no limit.
+  40: optional i32 maximumAttempts
+  // Non-Retriable errors. Will stop retrying if error reason matches this list.
+  50: optional list<string> nonRetriableErrorReasons
+  // Expiration time for the whole retry process, counted from when the activity was first scheduled or the workflow
+  // was first started.
+  60: optional i32 expirationIntervalInSeconds
 }
 
 struct ScheduleActivityTaskDecisionAttributes {
@@ -192,6 +281,7 @@ struct ScheduleActivityTaskDecisionAttributes {
   50: optional i32 scheduleToStartTimeoutSeconds
   55: optional i32 startToCloseTimeoutSeconds
   60: optional i32 heartbeatTimeoutSeconds
+  70: optional RetryPolicy retryPolicy
 }
 
 struct RequestCancelActivityTaskDecisionAttributes {
@@ -227,6 +317,14 @@ struct RequestCancelExternalWorkflowExecutionDecisionAttributes {
   40: optional binary control
 }
 
+struct SignalExternalWorkflowExecutionDecisionAttributes {
+  10: optional string domain
+  20: optional WorkflowExecution execution
+  30: optional string signalName
+  40: optional binary input
+  50: optional binary control
+}
+
 struct RecordMarkerDecisionAttributes {
   10: optional string markerName
   20: optional binary details
@@ -238,6 +336,9 @@ struct ContinueAsNewWorkflowExecutionDecisionAttributes {
   30: optional binary input
   40: optional i32 executionStartToCloseTimeoutSeconds
   50: optional i32 taskStartToCloseTimeoutSeconds
+  60: optional i32 backoffStartIntervalInSeconds
+  70: optional RetryPolicy retryPolicy
+  80: optional SearchAttributes searchAttributes
 }
 
 struct StartChildWorkflowExecutionDecisionAttributes {
@@ -252,6 +353,10 @@ struct StartChildWorkflowExecutionDecisionAttributes {
   90: optional binary control
 }
 
+struct UpsertWorkflowSearchAttributesDecisionAttributes {
+  10: optional SearchAttributes searchAttributes
+}
+
 struct Decision {
   10:  optional DecisionType decisionType
   20:  optional ScheduleActivityTaskDecisionAttributes scheduleActivityTaskDecisionAttributes
@@ -265,6 +370,8 @@ struct Decision {
   80:  optional RecordMarkerDecisionAttributes recordMarkerDecisionAttributes
   90:  optional ContinueAsNewWorkflowExecutionDecisionAttributes continueAsNewWorkflowExecutionDecisionAttributes
   100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes
+  110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes
+  120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes
 }
 
 struct WorkflowExecutionStartedEventAttributes {
@@ -274,6 +381,12 @@ struct WorkflowExecutionStartedEventAttributes {
   40: optional i32 executionStartToCloseTimeoutSeconds
   50: optional i32 taskStartToCloseTimeoutSeconds
   60: optional string identity
+  70: optional i32 firstDecisionTaskBackoffSeconds
+  80: optional string cronSchedule
+  90: optional RetryPolicy retryPolicy
+  100: optional i32 attempt
+  110: optional i64 (js.type = "Long") expirationTimestamp
+  120: optional SearchAttributes searchAttributes
 }
 
 struct WorkflowExecutionCompletedEventAttributes {
@@ -299,6 +412,7 @@ struct WorkflowExecutionContinuedAsNewEventAttributes {
   50: optional i32 executionStartToCloseTimeoutSeconds
   60: optional i32 taskStartToCloseTimeoutSeconds
   70: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  80: optional i32 backoffStartIntervalInSeconds
 }
 
 struct DecisionTaskScheduledEventAttributes {
@@ -343,12 +457,14 @@ struct ActivityTaskScheduledEventAttributes {
   55: optional i32 startToCloseTimeoutSeconds
   60: optional i32 heartbeatTimeoutSeconds
   90: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  110: optional RetryPolicy retryPolicy
 }
 
 struct ActivityTaskStartedEventAttributes {
   10: optional i64 (js.type = "Long") scheduledEventId
   20: optional string identity
   30: optional string requestId
+  40: optional i32 attempt
 }
 
 struct ActivityTaskCompletedEventAttributes {
@@ -469,6 +585,36 @@ struct ExternalWorkflowExecutionCancelRequestedEventAttributes {
   30: optional WorkflowExecution workflowExecution
 }
 
+struct SignalExternalWorkflowExecutionInitiatedEventAttributes {
+  10: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  20: optional string domain
+  30: optional WorkflowExecution workflowExecution
+  40: optional string signalName
+  50: optional binary input
+  60: optional binary control
+}
+
+struct SignalExternalWorkflowExecutionFailedEventAttributes {
+  10: optional SignalExternalWorkflowExecutionFailedCause cause
+  20: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  30: optional string domain
+  40: optional WorkflowExecution workflowExecution
+  50: optional i64 (js.type = "Long") initiatedEventId
+  60: optional binary control
+}
+
+struct ExternalWorkflowExecutionSignaledEventAttributes {
+  10: optional i64 (js.type = "Long") initiatedEventId
+  20: optional string domain
+  30: optional WorkflowExecution workflowExecution
+  40: optional binary control
+}
+
+struct UpsertWorkflowSearchAttributesEventAttributes {
+  10: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  20: optional SearchAttributes searchAttributes
+}
+
 struct StartChildWorkflowExecutionInitiatedEventAttributes {
   10:  optional string domain
   20:  optional string workflowId
@@ -586,6 +732,10 @@ struct HistoryEvent {
   390: optional ChildWorkflowExecutionCanceledEventAttributes childWorkflowExecutionCanceledEventAttributes
   400: optional ChildWorkflowExecutionTimedOutEventAttributes childWorkflowExecutionTimedOutEventAttributes
   410: optional ChildWorkflowExecutionTerminatedEventAttributes childWorkflowExecutionTerminatedEventAttributes
+  420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes
+  430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes
+  440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes
+  450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes
 }
 
 struct History {
@@ -615,6 +765,10 @@ struct DomainInfo {
 struct DomainConfiguration {
   10: optional i32 workflowExecutionRetentionPeriodInDays
   20: optional bool emitMetric
+  30: optional i32 startWorkflowRPS
+  40: optional i32 signalWorkflowRPS
+  50: optional i32 pollRPS
+  60: optional i32 historyReadRPS
 }
 
 struct UpdateDomainInfo {
@@ -664,6 +818,11 @@ struct StartWorkflowExecutionRequest {
   70: optional i32 taskStartToCloseTimeoutSeconds
   80: optional string identity
   90: optional string requestId
+  100: optional WorkflowIdReusePolicy workflowIdReusePolicy
+  110: optional string cronSchedule
+  120: optional i32 delayStartSeconds
+  130: optional RetryPolicy retryPolicy
+  140: optional SearchAttributes searchAttributes
 }
 
 struct StartWorkflowExecutionResponse {
@@ -684,6 +843,7 @@ struct PollForDecisionTaskResponse {
   50: optional i64 (js.type = "Long") startedEventId
   60: optional History history
   70: optional binary nextPageToken
+  80: optional WorkflowQuery query
 }
 
 struct RespondDecisionTaskCompletedRequest {
@@ -691,12 +851,23 @@ struct RespondDecisionTaskCompletedRequest {
   20: optional list<Decision> decisions
   30: optional binary executionContext
   40: optional string identity
+  50: optional StickyExecutionAttributes stickyAttributes
+}
+
+struct StickyExecutionAttributes {
+  10: optional TaskList workerTaskList
+  20: optional i32 scheduleToStartTimeoutSeconds
+}
+
+struct TaskListMetadata {
+  10: optional double maxTasksPerSecond
 }
 
 struct PollForActivityTaskRequest {
   10: optional string domain
   20: optional TaskList taskList
   30: optional string identity
+  40: optional TaskListMetadata taskListMetadata
 }
 
 struct PollForActivityTaskResponse {
@@ -711,6 +882,7 @@ struct PollForActivityTaskResponse {
   90:  optional i64 (js.type = "Long") startedTimestamp
   100: optional i32 startToCloseTimeoutSeconds
   110: optional i32 heartbeatTimeoutSeconds
+  120: optional i32 attempt
 }
 
 struct RecordActivityTaskHeartbeatRequest {
@@ -754,6 +926,8 @@ struct GetWorkflowExecutionHistoryRequest {
   20: optional WorkflowExecution execution
   30: optional i32 maximumPageSize
   40: optional binary nextPageToken
+  50: optional bool waitForNewEvent
+  60: optional HistoryEventFilterType historyEventFilterType
 }
 
 struct GetWorkflowExecutionHistoryResponse {
@@ -769,6 +943,22 @@ struct SignalWorkflowExecutionRequest {
   50: optional string identity
 }
 
+struct SignalWithStartWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional string workflowId
+  30: optional WorkflowType workflowType
+  40: optional TaskList taskList
+  50: optional binary input
+  60: optional i32 executionStartToCloseTimeoutSeconds
+  70: optional i32 taskStartToCloseTimeoutSeconds
+  80: optional string identity
+  90: optional string requestId
+  100: optional WorkflowIdReusePolicy workflowIdReusePolicy
+  110: optional string signalName
+  120: optional binary signalInput
+  130: optional SearchAttributes searchAttributes
+}
+
 struct TerminateWorkflowExecutionRequest {
   10: optional string domain
   20: optional WorkflowExecution workflowExecution
@@ -777,6 +967,19 @@ struct TerminateWorkflowExecutionRequest {
   50: optional string identity
 }
 
+struct ResetWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string reason
+  40: optional i64 (js.type = "Long") decisionFinishEventId
+  50: optional string requestId
+  60: optional string identity
+}
+
+struct ResetWorkflowExecutionResponse {
+  10: optional string runId
+}
+
 struct ListOpenWorkflowExecutionsRequest {
   10: optional string domain
   20: optional i32 maximumPageSize
@@ -805,3 +1008,142 @@ struct ListClosedWorkflowExecutionsResponse {
   10: optional list<WorkflowExecutionInfo> executions
   20: optional binary nextPageToken
 }
+
+struct ListWorkflowExecutionsRequest {
+  10: optional string domain
+  20: optional i32 pageSize
+  30: optional binary nextPageToken
+  40: optional string query
+}
+
+struct ListWorkflowExecutionsResponse {
+  10: optional list<WorkflowExecutionInfo> executions
+  20: optional binary nextPageToken
+}
+
+struct CountWorkflowExecutionsRequest {
+  10: optional string domain
+  20: optional string query
+}
+
+struct CountWorkflowExecutionsResponse {
+  10: optional i64 count
+}
+
+struct GetSearchAttributesResponse {
+  10: optional map<string, IndexedValueType> keys
+}
+
+struct WorkflowQuery {
+  10: optional string queryType
+  20: optional binary queryArgs
+}
+
+struct QueryWorkflowRequest {
+  10: optional string domain
+  20: optional WorkflowExecution execution
+  30: optional WorkflowQuery query
+}
+
+struct QueryWorkflowResponse {
+  10: optional binary queryResult
+}
+
+struct RespondQueryTaskCompletedRequest {
+  10: optional binary taskToken
+  20: optional QueryTaskCompletedType completedType
+  30: optional binary queryResult
+  40: optional string errorMessage
+}
+
+struct DescribeWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution execution
+}
+
+struct WorkflowExecutionConfiguration {
+  10: optional TaskList taskList
+  20: optional i32 taskStartToCloseTimeoutSeconds
+}
+
+struct PendingActivityInfo {
+  10: optional string activityID
+  20: optional ActivityType activityType
+  30: optional PendingActivityState state
+  40: optional binary heartbeatDetails
+  50: optional i64 (js.type = "Long") lastHeartbeatTimestamp
+  60: optional i64 (js.type = "Long") scheduledTimestamp
+  70: optional i64 (js.type = "Long") lastStartedTimestamp
+  80: optional i32 attempt
+}
+
+struct PendingChildExecutionInfo {
+  10: optional string workflowID
+  20: optional string runID
+  30: optional string workflowTypeName
+  40: optional i64 (js.type = "Long") initiatedID
+}
+
+struct PendingDecisionInfo {
+  10: optional PendingDecisionState state
+  20: optional i64 (js.type = "Long") scheduleID
+  30: optional i64 (js.type = "Long") startedID
+  40: optional i32 startToCloseTimeoutSeconds
+}
+
+struct DescribeWorkflowExecutionResponse {
+  10: optional WorkflowExecutionConfiguration executionConfiguration
+  20: optional WorkflowExecutionInfo workflowExecutionInfo
+  30: optional list<PendingActivityInfo> pendingActivities
+  40: optional list<PendingChildExecutionInfo> pendingChildren
+  50: optional PendingDecisionInfo pendingDecision
+}
+
+struct DescribeTaskListRequest {
+  10: optional string domain
+  20: optional TaskList taskList
+  30: optional TaskListType taskListType
+}
+
+struct PollerInfo {
+  10: optional i64 (js.type = "Long") lastAccessTime
+  20: optional string identity
+}
+
+struct TaskListStatus {
+  10: optional i64 (js.type = "Long") backlogCountHint
+  20: optional i64 (js.type = "Long") readLevel
+  30: optional i64 (js.type = "Long") ackLevel
+}
+
+struct DescribeTaskListResponse {
+  10: optional list<PollerInfo> pollers
+  20: optional TaskListStatus taskListStatus
+}
+
+struct DescribeHistoryHostRequest {
+  10: optional string hostAddress
+  20: optional i32 shardIdForHost
+  30: optional WorkflowExecution executionForHost
+}
+
+struct DescribeHistoryHostResponse {
+  10: optional i32 numberOfShards
+  20: optional list<i32> shardIDs
+  30: optional i32 cacheSize
+  40: optional string shardControllerStatus
+  50: optional string address
+}
+
+struct DescribeShardRequest {
+  10: optional i32 shardID
+}
+
+struct DescribeShardResponse {
+  10: optional i32 shardID
+  20: optional string owner
+  30: optional i64 (js.type = "Long") rangeID
+  40: optional i64 (js.type = "Long") transferAckLevel
+  50: optional i64 (js.type = "Long") transferMaxReadLevel
+  60: optional i64 (js.type = "Long") timerAckLevel
+}
@@ -0,0 +1,240 @@
+-- Schema of the cadence database for MySQL.  The same schema is used for SQLite in tests.
+-- Timestamps are stored as milliseconds since epoch, which is the precision the cassandra schema has.
+
+CREATE TABLE shards (
+  shard_id            INT NOT NULL,
+  owner               VARCHAR(255) NOT NULL, -- Host identifier processing the shard
+  -- Range identifier used for generating ack ids for tasks within shard.
+  -- Also used for optimistic concurrency and all writes to a shard are conditional on this value.
+  range_id            BIGINT NOT NULL,
+  -- This field keeps track of number of times owner for a shard changes before updating range_id or ack_levels
+  stolen_since_renew  INT NOT NULL,
+  updated_at          BIGINT NOT NULL,
+  transfer_ack_level  BIGINT NOT NULL,
+  timer_ack_level     BIGINT NOT NULL,
+  PRIMARY KEY (shard_id)
+);
+
+-- Points to the latest run of a workflow id, along with its state to enforce the workflow id reuse policy
+CREATE TABLE current_executions (
+  shard_id           INT NOT NULL,
+  domain_id          VARCHAR(64) NOT NULL,
+  workflow_id        VARCHAR(255) NOT NULL,
+  run_id             VARCHAR(64) NOT NULL,
+  create_request_id  VARCHAR(64) NOT NULL,
+  state              INT NOT NULL,
+  close_status       INT NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id)
+);
+
+--- Workflow execution and mutable state ---
+CREATE TABLE executions (
+  shard_id                INT NOT NULL,
+  domain_id               VARCHAR(64) NOT NULL,
+  workflow_id             VARCHAR(255) NOT NULL,
+  run_id                  VARCHAR(64) NOT NULL,
+  parent_domain_id        VARCHAR(64) NOT NULL,   -- Domain ID of parent workflow which started the workflow execution
+  parent_workflow_id      VARCHAR(255) NOT NULL,  -- ID of parent workflow which started the workflow execution
+  parent_run_id           VARCHAR(64) NOT NULL,   -- RunID of parent workflow which started the workflow execution
+  initiated_id            BIGINT NOT NULL,        -- Initiated event ID of parent workflow which started this execution
+  completion_event        BLOB,                   -- Completion event used to communicate result to parent workflow execution
+  task_list               VARCHAR(255) NOT NULL,
+  workflow_type_name      VARCHAR(255) NOT NULL,
+  decision_timeout_value  INT NOT NULL,
+  execution_context       BLOB,
+  state                   INT NOT NULL,  -- enum WorkflowState {Created, Running, Completed}
+  close_status            INT NOT NULL,  -- enum WorkflowCloseStatus {None, Completed, Failed, Canceled, Terminated, ContinuedAsNew, TimedOut}
+  next_event_id           BIGINT NOT NULL,
+  last_processed_event    BIGINT NOT NULL,
+  start_time              BIGINT NOT NULL,
+  last_updated_time       BIGINT NOT NULL,
+  create_request_id       VARCHAR(64) NOT NULL,
+  decision_schedule_id    BIGINT NOT NULL,
+  decision_started_id     BIGINT NOT NULL,
+  decision_request_id     VARCHAR(64) NOT NULL,  -- Identifier used by matching engine for retrying history service calls for recording task is started
+  decision_timeout        INT NOT NULL,
+  cancel_requested        BOOLEAN NOT NULL,
+  cancel_request_id       VARCHAR(64) NOT NULL,
+  cron_schedule           VARCHAR(255) NOT NULL,
+  attempt                 INT NOT NULL,
+  has_retry_policy        BOOLEAN NOT NULL,
+  sticky_task_list        VARCHAR(255) NOT NULL,
+  sticky_schedule_to_start_timeout INT NOT NULL,
+  search_attributes       BLOB,                   -- JSON encoded map of search attribute name to JSON encoded value
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id)
+);
+
+CREATE TABLE transfer_tasks (
+  shard_id            INT NOT NULL,
+  task_id             BIGINT NOT NULL,
+  domain_id           VARCHAR(64) NOT NULL,   -- The domain ID that this transfer task belongs to
+  workflow_id         VARCHAR(255) NOT NULL,  -- The workflow ID that this transfer task belongs to
+  run_id              VARCHAR(64) NOT NULL,   -- The run ID that this transfer task belongs to
+  target_domain_id    VARCHAR(64) NOT NULL,   -- The external domain ID that this transfer task is doing work for.
+  target_workflow_id  VARCHAR(255) NOT NULL,  -- The external workflow ID that this transfer task is doing work for.
+  target_run_id       VARCHAR(64) NOT NULL,   -- The external run ID that this transfer task is doing work for.
+  task_list           VARCHAR(255) NOT NULL,
+  type                INT NOT NULL,  -- enum TaskType {ActivityTask, DecisionTask, DeleteExecution, CancelExecution, StartChildExecution}
+  schedule_id         BIGINT NOT NULL,
+  PRIMARY KEY (shard_id, task_id)
+);
+
+CREATE TABLE timer_tasks (
+  shard_id         INT NOT NULL,
+  visibility_ts    BIGINT NOT NULL,
+  task_id          BIGINT NOT NULL,
+  domain_id        VARCHAR(64) NOT NULL,
+  workflow_id      VARCHAR(255) NOT NULL,
+  run_id           VARCHAR(64) NOT NULL,
+  type             INT NOT NULL,  -- enum TaskType {DecisionTaskTimeout, ActivityTaskTimeout, UserTimer}
+  timeout_type     INT NOT NULL,  -- enum TimeoutType in IDL {START_TO_CLOSE, SCHEDULE_TO_START, SCHEDULE_TO_CLOSE, HEARTBEAT}
+  event_id         BIGINT NOT NULL,  -- Corresponds to event ID in history that is responsible for this timer.
+  schedule_attempt BIGINT NOT NULL,  -- Used to retry activities
+  PRIMARY KEY (shard_id, visibility_ts, task_id)
+);
+
+-- Workflow activity in progress mutable state
+CREATE TABLE activity_info_maps (
+  shard_id                     INT NOT NULL,
+  domain_id                    VARCHAR(64) NOT NULL,
+  workflow_id                  VARCHAR(255) NOT NULL,
+  run_id                       VARCHAR(64) NOT NULL,
+  schedule_id                  BIGINT NOT NULL,
+  scheduled_event              BLOB,
+  scheduled_time               BIGINT NOT NULL,
+  started_id                   BIGINT NOT NULL,
+  started_event                BLOB,
+  started_time                 BIGINT NOT NULL,
+  activity_id                  VARCHAR(255) NOT NULL,  -- Client generated unique ID for the activity.
+  request_id                   VARCHAR(64) NOT NULL,   -- Identifier used by matching engine for retrying history service calls for recording task is started
+  details                      BLOB,
+  schedule_to_start_timeout    INT NOT NULL,
+  schedule_to_close_timeout    INT NOT NULL,
+  start_to_close_timeout       INT NOT NULL,
+  heartbeat_timeout            INT NOT NULL,
+  cancel_requested             BOOLEAN NOT NULL,  -- If a cancel request is made to cancel the activity in progress.
+  cancel_request_id            BIGINT NOT NULL,   -- Event ID that identifies the cancel request.
+  last_heartbeat_updated_time  BIGINT NOT NULL,   -- Last time the heartbeat is received.
+  timer_task_status            INT NOT NULL,      -- Indicates wheter timers are created for this activity.
+  attempt                      INT NOT NULL,      -- Number of times the activity has been retried.
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, schedule_id)
+);
+
+-- User timer details
+CREATE TABLE timer_info_maps (
+  shard_id      INT NOT NULL,
+  domain_id     VARCHAR(64) NOT NULL,
+  workflow_id   VARCHAR(255) NOT NULL,
+  run_id        VARCHAR(64) NOT NULL,
+  timer_id      VARCHAR(255) NOT NULL,  -- User defined timer ID
+  started_id    BIGINT NOT NULL,        -- The event ID corresponding to timer started.
+  expiry_time   BIGINT NOT NULL,        -- Timestamp at which this timer expires or fires
+  task_id       BIGINT NOT NULL,        -- The task ID if we have one created for this timer
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, timer_id)
+);
+
+-- Child execution in progress mutable state
+CREATE TABLE child_execution_info_maps (
+  shard_id           INT NOT NULL,
+  domain_id          VARCHAR(64) NOT NULL,
+  workflow_id        VARCHAR(255) NOT NULL,
+  run_id             VARCHAR(64) NOT NULL,
+  initiated_id       BIGINT NOT NULL,
+  initiated_event    BLOB,
+  started_id         BIGINT NOT NULL,
+  started_event      BLOB,
+  create_request_id  VARCHAR(64) NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, initiated_id)
+);
+
+-- External workflow cancellation in progress mutable state
+CREATE TABLE request_cancel_info_maps (
+  shard_id           INT NOT NULL,
+  domain_id          VARCHAR(64) NOT NULL,
+  workflow_id        VARCHAR(255) NOT NULL,
+  run_id             VARCHAR(64) NOT NULL,
+  initiated_id       BIGINT NOT NULL,
+  cancel_request_id  VARCHAR(64) NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, initiated_id)
+);
+
+CREATE TABLE signal_info_maps (
+  shard_id           INT NOT NULL,
+  domain_id          VARCHAR(64) NOT NULL,
+  workflow_id        VARCHAR(255) NOT NULL,
+  run_id             VARCHAR(64) NOT NULL,
+  initiated_id       BIGINT NOT NULL,
+  signal_request_id  VARCHAR(64) NOT NULL,
+  signal_name        VARCHAR(255) NOT NULL,
+  input              BLOB,
+  control            BLOB,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, initiated_id)
+);
+
+CREATE TABLE task_lists (
+  domain_id   VARCHAR(64) NOT NULL,
+  name        VARCHAR(255) NOT NULL,
+  task_type   INT NOT NULL,     -- enum TaskRowType {ActivityTask, DecisionTask}
+  range_id    BIGINT NOT NULL,
+  ack_level   BIGINT NOT NULL,  -- task_id of the last acknowledged message
+  PRIMARY KEY (domain_id, name, task_type)
+);
+
+-- Activity or workflow task in a task list
+CREATE TABLE tasks (
+  domain_id        VARCHAR(64) NOT NULL,
+  task_list_name   VARCHAR(255) NOT NULL,
+  task_list_type   INT NOT NULL,
+  task_id          BIGINT NOT NULL,
+  workflow_id      VARCHAR(255) NOT NULL,
+  run_id           VARCHAR(64) NOT NULL,
+  schedule_id      BIGINT NOT NULL,
+  expiry_ts        BIGINT NOT NULL,  -- Time after which the task is ignored, 0 if the task does not expire
+  PRIMARY KEY (domain_id, task_list_name, task_list_type, task_id)
+);
+
+-- Workflow history, one row per batch of events appended in a transaction
+CREATE TABLE events (
+  domain_id       VARCHAR(64) NOT NULL,
+  workflow_id     VARCHAR(255) NOT NULL,
+  run_id          VARCHAR(64) NOT NULL,
+  first_event_id  BIGINT NOT NULL,
+  range_id        BIGINT NOT NULL,
+  tx_id           BIGINT NOT NULL,
+  data            MEDIUMBLOB,
+  data_encoding   VARCHAR(16) NOT NULL,
+  data_version    INT NOT NULL,
+  PRIMARY KEY (domain_id, workflow_id, run_id, first_event_id)
+);
+
+CREATE TABLE domains (
+  id           VARCHAR(64) NOT NULL,
+  name         VARCHAR(255) NOT NULL,
+  status       INT NOT NULL,  -- enum DomainStatus {Registered, Deprecated, Deleted}
+  description  VARCHAR(1024) NOT NULL,
+  owner_email  VARCHAR(255) NOT NULL,
+  retention    INT NOT NULL,
+  emit_metric  BOOLEAN NOT NULL,
+  -- quotas on the requests per second of the domain, by class of API
+  start_workflow_rps INT NOT NULL DEFAULT 0,
+  signal_workflow_rps INT NOT NULL DEFAULT 0,
+  poll_rps INT NOT NULL DEFAULT 0,
+  history_read_rps INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (id)
+);
+
+CREATE TABLE domains_by_name (
+  id           VARCHAR(64) NOT NULL,
+  name         VARCHAR(255) NOT NULL,
+  status       INT NOT NULL,  -- enum DomainStatus {Registered, Deprecated, Deleted}
+  description  VARCHAR(1024) NOT NULL,
+  owner_email  VARCHAR(255) NOT NULL,
+  retention    INT NOT NULL,
+  emit_metric  BOOLEAN NOT NULL,
+  -- quotas on the requests per second of the domain, by class of API
+  start_workflow_rps INT NOT NULL DEFAULT 0,
+  signal_workflow_rps INT NOT NULL DEFAULT 0,
+  poll_rps INT NOT NULL DEFAULT 0,
+  history_read_rps INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (name)
+);
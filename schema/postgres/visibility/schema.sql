@@ -0,0 +1,32 @@
+-- Schema of the cadence visibility database for PostgreSQL.
+-- Timestamps are stored as milliseconds since epoch, which is the precision the cassandra schema has.
+
+CREATE TABLE open_executions (
+  domain_id           VARCHAR(64) NOT NULL,
+  workflow_id         VARCHAR(255) NOT NULL,
+  run_id              VARCHAR(64) NOT NULL,
+  start_time          BIGINT NOT NULL,
+  workflow_type_name  VARCHAR(255) NOT NULL,
+  PRIMARY KEY (domain_id, start_time, run_id)
+);
+
+CREATE INDEX open_by_workflow_id ON open_executions (domain_id, workflow_id);
+CREATE INDEX open_by_type ON open_executions (domain_id, workflow_type_name);
+
+CREATE TABLE closed_executions (
+  domain_id           VARCHAR(64) NOT NULL,
+  workflow_id         VARCHAR(255) NOT NULL,
+  run_id              VARCHAR(64) NOT NULL,
+  start_time          BIGINT NOT NULL,
+  close_time          BIGINT NOT NULL,
+  status              INT NOT NULL,  -- enum WorkflowExecutionCloseStatus {COMPLETED, FAILED, CANCELED, TERMINATED, CONTINUED_AS_NEW, TIMED_OUT}
+  workflow_type_name  VARCHAR(255) NOT NULL,
+  history_length      BIGINT NOT NULL,
+  expiry_ts           BIGINT NOT NULL,  -- Rows are kept for the retention period of the domain
+  PRIMARY KEY (domain_id, start_time, run_id)
+);
+
+CREATE INDEX closed_by_workflow_id ON closed_executions (domain_id, workflow_id);
+CREATE INDEX closed_by_type ON closed_executions (domain_id, workflow_type_name);
+CREATE INDEX closed_by_status ON closed_executions (domain_id, status);
+CREATE INDEX closed_by_expiry ON closed_executions (expiry_ts);
@@ -0,0 +1,188 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uber/cadence/.gen/go/admin"
+	"github.com/uber/cadence/.gen/go/admin/adminserviceserver"
+	h "github.com/uber/cadence/.gen/go/history"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+
+	"github.com/uber-go/tally"
+)
+
+var _ adminserviceserver.Interface = (*AdminHandler)(nil)
+
+type (
+	// AdminHandler - Thrift handler inteface for admin service
+	AdminHandler struct {
+		domainCache   cache.DomainCache
+		history       history.Client
+		metricsClient metrics.Client
+		startWG       sync.WaitGroup
+		service.Service
+	}
+)
+
+var (
+	errHostNotSet    = &gen.BadRequestError{Message: "Host address, shard ID or workflow execution not set on request."}
+	errShardIDNotSet = &gen.BadRequestError{Message: "ShardID not set on request."}
+)
+
+// NewAdminHandler creates a thrift handler for the cadence admin service
+func NewAdminHandler(sVice service.Service, metadataMgr persistence.MetadataManager) *AdminHandler {
+	handler := &AdminHandler{
+		Service:     sVice,
+		domainCache: cache.NewDomainCache(metadataMgr, sVice.GetLogger()),
+	}
+	// prevent us from trying to serve requests before handler's Start() is complete
+	handler.startWG.Add(1)
+	return handler
+}
+
+// Start starts the handler. It must be called after the underlying service has been started.
+func (adh *AdminHandler) Start() error {
+	adh.Service.GetDispatcher().Register(adminserviceserver.New(adh))
+	var err error
+	adh.history, err = adh.Service.GetClientFactory().NewHistoryClient()
+	if err != nil {
+		return err
+	}
+	adh.metricsClient = adh.Service.GetMetricsClient()
+	adh.startWG.Done()
+	return nil
+}
+
+// DescribeHistoryHost returns information about the internal states of a history host
+func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context,
+	request *gen.DescribeHistoryHostRequest) (*gen.DescribeHistoryHostResponse, error) {
+
+	scope := metrics.AdminDescribeHistoryHostScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || (request.HostAddress == nil && request.ShardIdForHost == nil &&
+		request.ExecutionForHost == nil) {
+		return nil, adh.error(errHostNotSet, scope)
+	}
+
+	if request.ExecutionForHost != nil {
+		if err := validateExecution(request.ExecutionForHost); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+
+	response, err := adh.history.DescribeHistoryHost(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	return response, nil
+}
+
+// DescribeShard returns the range ID and the transfer and timer ack levels of a history shard
+func (adh *AdminHandler) DescribeShard(ctx context.Context,
+	request *gen.DescribeShardRequest) (*gen.DescribeShardResponse, error) {
+
+	scope := metrics.AdminDescribeShardScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || request.ShardID == nil {
+		return nil, adh.error(errShardIDNotSet, scope)
+	}
+
+	response, err := adh.history.DescribeShard(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	return response, nil
+}
+
+// DescribeMutableState returns the mutable state of a workflow execution, both from the history cache and from the
+// database
+func (adh *AdminHandler) DescribeMutableState(ctx context.Context,
+	request *admin.DescribeMutableStateRequest) (*admin.DescribeMutableStateResponse, error) {
+
+	scope := metrics.AdminDescribeMutableStateScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || request.Domain == nil {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	info, _, err := adh.domainCache.GetDomain(*request.Domain)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	response, err := adh.history.DescribeMutableState(ctx, &h.DescribeMutableStateRequest{
+		DomainUUID: common.StringPtr(info.ID),
+		Execution:  request.Execution,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	return &admin.DescribeMutableStateResponse{
+		MutableStateInCache:    response.MutableStateInCache,
+		MutableStateInDatabase: response.MutableStateInDatabase,
+	}, nil
+}
+
+func (adh *AdminHandler) startRequestProfile(scope int) tally.Stopwatch {
+	adh.startWG.Wait()
+	sw := adh.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	adh.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	return sw
+}
+
+func (adh *AdminHandler) error(err error, scope int) error {
+	switch err.(type) {
+	case *gen.InternalServiceError:
+		adh.metricsClient.IncCounter(scope, metrics.CadenceFailures)
+		return err
+	case *gen.BadRequestError:
+		adh.metricsClient.IncCounter(scope, metrics.CadenceErrBadRequestCounter)
+		return err
+	case *gen.EntityNotExistsError:
+		adh.metricsClient.IncCounter(scope, metrics.CadenceErrEntityNotExistsCounter)
+		return err
+	default:
+		adh.metricsClient.IncCounter(scope, metrics.CadenceFailures)
+		return &gen.InternalServiceError{Message: err.Error()}
+	}
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
@@ -60,14 +61,17 @@ type (
 		metricsClient      metrics.Client
 		startWG            sync.WaitGroup
 		rateLimiter        common.TokenBucket
+		domainRateLimiter  *domainRateLimiter
 		config             *Config
 		service.Service
 	}
 
 	getHistoryContinuationToken struct {
-		RunID            string
-		NextEventID      int64
-		PersistenceToken []byte
+		RunID             string
+		FirstEventID      int64
+		NextEventID       int64
+		IsWorkflowRunning bool
+		PersistenceToken  []byte
 	}
 )
 
@@ -81,6 +85,13 @@ var (
 	errInvalidRunID               = &gen.BadRequestError{Message: "Invalid RunId."}
 	errInvalidNextPageToken       = &gen.BadRequestError{Message: "Invalid NextPageToken."}
 	errNextPageTokenRunIDMismatch = &gen.BadRequestError{Message: "RunID in the request does not match the NextPageToken."}
+	errInvalidTaskToken           = &gen.BadRequestError{Message: "Invalid TaskToken."}
+	errQueryNotSet                = &gen.BadRequestError{Message: "WorkflowQuery is not set on request."}
+	errQueryTypeNotSet            = &gen.BadRequestError{Message: "QueryType is not set on request."}
+	errCompletedTypeNotSet        = &gen.BadRequestError{Message: "CompletedType is not set on request."}
+	errTaskListTypeNotSet         = &gen.BadRequestError{Message: "TaskListType is not set on request."}
+	errInvalidMaxTasksPerSecond   = &gen.BadRequestError{Message: "MaxTasksPerSecond must be greater than zero."}
+	errResetEventIDNotSet         = &gen.BadRequestError{Message: "DecisionFinishEventId is not set on request."}
 )
 
 // NewWorkflowHandler creates a thrift handler for the cadence service
@@ -96,7 +107,8 @@ func NewWorkflowHandler(
 		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
 		hSerializerFactory: persistence.NewHistorySerializerFactory(),
 		domainCache:        cache.NewDomainCache(metadataMgr, sVice.GetLogger()),
-		rateLimiter:        common.NewTokenBucket(config.RPS, common.NewRealTimeSource()),
+		rateLimiter:        common.NewDynamicTokenBucket(config.RPS, common.NewRealTimeSource()),
+		domainRateLimiter:  newDomainRateLimiter(common.NewRealTimeSource()),
 	}
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
@@ -237,6 +249,18 @@ func (wh *WorkflowHandler) UpdateDomain(ctx context.Context,
 		if updatedConfig.WorkflowExecutionRetentionPeriodInDays != nil {
 			config.Retention = *updatedConfig.WorkflowExecutionRetentionPeriodInDays
 		}
+		if updatedConfig.StartWorkflowRPS != nil {
+			config.StartWorkflowRPS = *updatedConfig.StartWorkflowRPS
+		}
+		if updatedConfig.SignalWorkflowRPS != nil {
+			config.SignalWorkflowRPS = *updatedConfig.SignalWorkflowRPS
+		}
+		if updatedConfig.PollRPS != nil {
+			config.PollRPS = *updatedConfig.PollRPS
+		}
+		if updatedConfig.HistoryReadRPS != nil {
+			config.HistoryReadRPS = *updatedConfig.HistoryReadRPS
+		}
 	}
 
 	err := wh.metadataMgr.UpdateDomain(&persistence.UpdateDomainRequest{
@@ -309,10 +333,18 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		return nil, err
 	}
 
-	info, _, err := wh.domainCache.GetDomain(*pollRequest.Domain)
+	if pollRequest.TaskListMetadata != nil && pollRequest.TaskListMetadata.MaxTasksPerSecond != nil &&
+		*pollRequest.TaskListMetadata.MaxTasksPerSecond <= 0 {
+		return nil, wh.error(errInvalidMaxTasksPerSecond, scope)
+	}
+
+	info, domainConfig, err := wh.domainCache.GetDomain(*pollRequest.Domain)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	if !wh.domainRateLimiter.TryConsume(info.ID, apiClassPoll, domainConfig) {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
 
 	resp, err := wh.matching.PollForActivityTask(ctx, &m.PollForActivityTaskRequest{
 		DomainUUID:  common.StringPtr(info.ID),
@@ -349,10 +381,13 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 	}
 
 	domainName := *pollRequest.Domain
-	info, _, err := wh.domainCache.GetDomain(domainName)
+	info, domainConfig, err := wh.domainCache.GetDomain(domainName)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	if !wh.domainRateLimiter.TryConsume(info.ID, apiClassPoll, domainConfig) {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
 
 	wh.Service.GetLogger().Debugf("Poll for decision. DomainName: %v, DomainID: %v", domainName, info.ID)
 
@@ -371,12 +406,19 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 	var continuation []byte
 	if matchingResp.WorkflowExecution != nil {
 		// Non-empty response. Get the history
+		firstEventID := common.FirstEventID
 		nextEventID := common.Int64Default(matchingResp.StartedEventId) + 1
+		if common.BoolDefault(matchingResp.StickyExecutionEnabled) && matchingResp.PreviousStartedEventId != nil {
+			// The worker polling its sticky task list already has the history up to the previous decision, so it
+			// only receives the events which came in since then.
+			firstEventID = *matchingResp.PreviousStartedEventId + 1
+		}
 		history, persistenceToken, err = wh.getHistory(
 			info.ID,
 			*matchingResp.WorkflowExecution,
+			firstEventID,
 			nextEventID,
-			wh.config.DefaultHistoryMaxPageSize,
+			int32(wh.config.DefaultHistoryMaxPageSize(domainName)),
 			nil)
 		if err != nil {
 			return nil, wh.error(err, scope)
@@ -394,6 +436,7 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 				persistenceToken,
 				*matchingResp.WorkflowExecution.RunId,
 				history,
+				firstEventID,
 				nextEventID)
 		if err != nil {
 			return nil, wh.error(err, scope)
@@ -412,8 +455,9 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 	sw := wh.startRequestProfile(scope)
 	defer sw.Stop()
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.rateLimiter.TryConsume(1)
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
 
 	wh.Service.GetLogger().Debug("Received RecordActivityTaskHeartbeat")
 	if heartbeatRequest.TaskToken == nil {
@@ -446,8 +490,9 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 	sw := wh.startRequestProfile(scope)
 	defer sw.Stop()
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.rateLimiter.TryConsume(1)
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
 
 	if completeRequest.TaskToken == nil {
 		return wh.error(errTaskTokenNotSet, scope)
@@ -481,8 +526,9 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	sw := wh.startRequestProfile(scope)
 	defer sw.Stop()
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.rateLimiter.TryConsume(1)
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
 
 	if failedRequest.TaskToken == nil {
 		return wh.error(errTaskTokenNotSet, scope)
@@ -517,8 +563,9 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 	sw := wh.startRequestProfile(scope)
 	defer sw.Stop()
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.rateLimiter.TryConsume(1)
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
 
 	if cancelRequest.TaskToken == nil {
 		return wh.error(errTaskTokenNotSet, scope)
@@ -553,8 +600,9 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 	sw := wh.startRequestProfile(scope)
 	defer sw.Stop()
 
-	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.rateLimiter.TryConsume(1)
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
 
 	if completeRequest.TaskToken == nil {
 		return wh.error(errTaskTokenNotSet, scope)
@@ -625,12 +673,31 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 			Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}, scope)
 	}
 
+	if startRequest.DelayStartSeconds != nil && *startRequest.DelayStartSeconds < 0 {
+		return nil, wh.error(&gen.BadRequestError{
+			Message: "A valid DelayStartSeconds is not set on request."}, scope)
+	}
+
+	if cronSchedule := common.StringDefault(startRequest.CronSchedule); cronSchedule != "" {
+		if err := backoff.ValidateSchedule(cronSchedule); err != nil {
+			return nil, wh.error(err, scope)
+		}
+	}
+
+	if err := common.ValidateSearchAttributes(startRequest.SearchAttributes,
+		wh.config.ValidSearchAttributes()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	domainName := *startRequest.Domain
 	wh.Service.GetLogger().Debugf("Start workflow execution request domain: %v", domainName)
-	info, _, err := wh.domainCache.GetDomain(domainName)
+	info, domainConfig, err := wh.domainCache.GetDomain(domainName)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	if !wh.domainRateLimiter.TryConsume(info.ID, apiClassStartWorkflow, domainConfig) {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
 
 	wh.Service.GetLogger().Debugf("Start workflow execution request domainID: %v", info.ID)
 
@@ -668,14 +735,20 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	}
 
 	if getRequest.MaximumPageSize == nil || *getRequest.MaximumPageSize == 0 {
-		getRequest.MaximumPageSize = common.Int32Ptr(wh.config.DefaultHistoryMaxPageSize)
+		getRequest.MaximumPageSize = common.Int32Ptr(int32(wh.config.DefaultHistoryMaxPageSize(*getRequest.Domain)))
 	}
 
-	info, _, err := wh.domainCache.GetDomain(*getRequest.Domain)
+	info, domainConfig, err := wh.domainCache.GetDomain(*getRequest.Domain)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	if !wh.domainRateLimiter.TryConsume(info.ID, apiClassHistoryRead, domainConfig) {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
 
+	waitForNewEvent := common.BoolDefault(getRequest.WaitForNewEvent)
+	closeEventOnly := getRequest.HistoryEventFilterType != nil &&
+		*getRequest.HistoryEventFilterType == gen.HistoryEventFilterTypeCloseEvent
 	token := &getHistoryContinuationToken{}
 	if getRequest.NextPageToken != nil {
 		token, err = deserializeGetHistoryToken(getRequest.NextPageToken)
@@ -691,8 +764,10 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 			Execution:  getRequest.Execution,
 		})
 		if err == nil {
+			token.FirstEventID = common.FirstEventID
 			token.NextEventID = *response.EventId
 			token.RunID = *response.RunId
+			token.IsWorkflowRunning = *response.IsWorkflowRunning
 		} else {
 			if _, ok := err.(*gen.EntityNotExistsError); !ok || getRequest.Execution.RunId == nil {
 				return nil, wh.error(err, scope)
@@ -706,6 +781,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 			if err != nil {
 				return nil, wh.error(err, scope)
 			}
+			token.FirstEventID = common.FirstEventID
 			token.NextEventID = *visibilityResp.Execution.HistoryLength
 			token.RunID = *visibilityResp.Execution.Execution.RunId
 		}
@@ -715,16 +791,61 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		WorkflowId: getRequest.Execution.WorkflowId,
 		RunId:      common.StringPtr(token.RunID),
 	}
-	history, persistenceToken, err :=
-		wh.getHistory(info.ID, we, token.NextEventID, *getRequest.MaximumPageSize, token.PersistenceToken)
-	if err != nil {
-		return nil, wh.error(err, scope)
+	if waitForNewEvent && token.IsWorkflowRunning && (closeEventOnly || token.FirstEventID >= token.NextEventID) {
+		// The caller has read all the events, or only wants the close event, so wait for the history to grow or
+		// the workflow to close
+		expectedNextEventID := token.NextEventID
+		if closeEventOnly {
+			expectedNextEventID = common.EndEventID
+		}
+		response, err := wh.history.GetWorkflowExecutionNextEventID(ctx, &h.GetWorkflowExecutionNextEventIDRequest{
+			DomainUUID:          common.StringPtr(info.ID),
+			Execution:           &we,
+			ExpectedNextEventId: common.Int64Ptr(expectedNextEventID),
+		})
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+		token.NextEventID = *response.EventId
+		token.IsWorkflowRunning = *response.IsWorkflowRunning
+	}
+
+	if closeEventOnly {
+		history := &gen.History{Events: []*gen.HistoryEvent{}}
+		var nextToken []byte
+		if !token.IsWorkflowRunning {
+			history, err = wh.getCloseEvent(info.ID, we, token.NextEventID)
+		} else if waitForNewEvent {
+			nextToken, err = getSerializedWaitForNewEventToken(token.RunID, token.NextEventID)
+		}
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+		return createGetWorkflowExecutionHistoryResponse(history, token.NextEventID, nextToken), nil
 	}
 
-	nextToken, err := getSerializedGetHistoryToken(persistenceToken, token.RunID, history, token.NextEventID)
+	history := &gen.History{Events: []*gen.HistoryEvent{}}
+	var persistenceToken []byte
+	if token.FirstEventID < token.NextEventID {
+		history, persistenceToken, err = wh.getHistory(info.ID, we, token.FirstEventID, token.NextEventID,
+			*getRequest.MaximumPageSize, token.PersistenceToken)
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+	}
+
+	nextToken, err := getSerializedGetHistoryToken(persistenceToken, token.RunID, history, token.FirstEventID,
+		token.NextEventID)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	if nextToken == nil && waitForNewEvent && token.IsWorkflowRunning {
+		// All the events are read but the workflow is still running, so hand out a token the caller can wait on
+		nextToken, err = getSerializedWaitForNewEventToken(token.RunID, token.NextEventID)
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+	}
 
 	return createGetWorkflowExecutionHistoryResponse(history, token.NextEventID, nextToken), nil
 }
@@ -754,10 +875,13 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
 		return wh.error(&gen.BadRequestError{Message: "SignalName is not set on request."}, scope)
 	}
 
-	info, _, err := wh.domainCache.GetDomain(*signalRequest.Domain)
+	info, domainConfig, err := wh.domainCache.GetDomain(*signalRequest.Domain)
 	if err != nil {
 		return wh.error(err, scope)
 	}
+	if !wh.domainRateLimiter.TryConsume(info.ID, apiClassSignalWorkflow, domainConfig) {
+		return wh.error(createServiceBusyError(), scope)
+	}
 
 	err = wh.history.SignalWorkflowExecution(ctx, &h.SignalWorkflowExecutionRequest{
 		DomainUUID:    common.StringPtr(info.ID),
@@ -770,6 +894,81 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// SignalWithStartWorkflowExecution is used to ensure sending signal to a workflow.
+// If the workflow is running, this results in WorkflowExecutionSignaled event being recorded in the history
+// and a decision task being created for the execution.
+// If the workflow is not running or not found, this results in WorkflowExecutionStarted and WorkflowExecutionSignaled
+// events being recorded in history, and a decision task being created for the execution
+func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
+	signalWithStartRequest *gen.SignalWithStartWorkflowExecutionRequest) (*gen.StartWorkflowExecutionResponse, error) {
+
+	scope := metrics.FrontendSignalWithStartWorkflowExecutionScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if signalWithStartRequest.Domain == nil {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if signalWithStartRequest.WorkflowId == nil || *signalWithStartRequest.WorkflowId == "" {
+		return nil, wh.error(errWorkflowIDNotSet, scope)
+	}
+
+	if signalWithStartRequest.SignalName == nil || *signalWithStartRequest.SignalName == "" {
+		return nil, wh.error(&gen.BadRequestError{Message: "SignalName is not set on request."}, scope)
+	}
+
+	if signalWithStartRequest.WorkflowType == nil ||
+		signalWithStartRequest.WorkflowType.Name == nil || *signalWithStartRequest.WorkflowType.Name == "" {
+		return nil, wh.error(&gen.BadRequestError{Message: "WorkflowType is not set on request."}, scope)
+	}
+
+	if err := wh.validateTaskList(signalWithStartRequest.TaskList, scope); err != nil {
+		return nil, err
+	}
+
+	if signalWithStartRequest.ExecutionStartToCloseTimeoutSeconds == nil ||
+		*signalWithStartRequest.ExecutionStartToCloseTimeoutSeconds <= 0 {
+		return nil, wh.error(&gen.BadRequestError{
+			Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}, scope)
+	}
+
+	if signalWithStartRequest.TaskStartToCloseTimeoutSeconds == nil ||
+		*signalWithStartRequest.TaskStartToCloseTimeoutSeconds <= 0 {
+		return nil, wh.error(&gen.BadRequestError{
+			Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}, scope)
+	}
+
+	if err := common.ValidateSearchAttributes(signalWithStartRequest.SearchAttributes,
+		wh.config.ValidSearchAttributes()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	info, domainConfig, err := wh.domainCache.GetDomain(*signalWithStartRequest.Domain)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	if !wh.domainRateLimiter.TryConsume(info.ID, apiClassStartWorkflow, domainConfig) {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	resp, err := wh.history.SignalWithStartWorkflowExecution(ctx, &h.SignalWithStartWorkflowExecutionRequest{
+		DomainUUID:             common.StringPtr(info.ID),
+		SignalWithStartRequest: signalWithStartRequest,
+	})
+	if err != nil {
+		wh.Service.GetLogger().Errorf("SignalWithStartWorkflowExecution failed. WorkflowID: %v. Error: %v",
+			*signalWithStartRequest.WorkflowId, err)
+		return nil, wh.error(err, scope)
+	}
+
+	return resp, nil
+}
+
 // TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
 // in the history and immediately terminating the execution instance.
 func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context,
@@ -807,6 +1006,52 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// ResetWorkflowExecution terminates the current run of a workflow execution and starts a new run from the history
+// before the given decision finish event
+func (wh *WorkflowHandler) ResetWorkflowExecution(ctx context.Context,
+	resetRequest *gen.ResetWorkflowExecutionRequest) (*gen.ResetWorkflowExecutionResponse, error) {
+
+	scope := metrics.FrontendResetWorkflowExecutionScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if resetRequest.Domain == nil {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecution(resetRequest.WorkflowExecution, scope); err != nil {
+		return nil, err
+	}
+
+	// Event IDs are only meaningful within a single run
+	if resetRequest.WorkflowExecution.RunId == nil || *resetRequest.WorkflowExecution.RunId == "" {
+		return nil, wh.error(errRunIDNotSet, scope)
+	}
+
+	if resetRequest.DecisionFinishEventId == nil || *resetRequest.DecisionFinishEventId <= 0 {
+		return nil, wh.error(errResetEventIDNotSet, scope)
+	}
+
+	info, _, err := wh.domainCache.GetDomain(*resetRequest.Domain)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp, err := wh.history.ResetWorkflowExecution(ctx, &h.ResetWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(info.ID),
+		ResetRequest: resetRequest,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return resp, nil
+}
+
 // RequestCancelWorkflowExecution - requests to cancel a workflow execution
 func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 	ctx context.Context,
@@ -872,13 +1117,8 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context,
 		return nil, wh.error(&gen.BadRequestError{Message: "LatestTime in StartTimeFilter is required"}, scope)
 	}
 
-	if listRequest.ExecutionFilter != nil && listRequest.TypeFilter != nil {
-		return nil, wh.error(&gen.BadRequestError{
-			Message: "Only one of ExecutionFilter or TypeFilter is allowed"}, scope)
-	}
-
 	if listRequest.MaximumPageSize == nil || *listRequest.MaximumPageSize == 0 {
-		listRequest.MaximumPageSize = common.Int32Ptr(wh.config.DefaultVisibilityMaxPageSize)
+		listRequest.MaximumPageSize = common.Int32Ptr(int32(wh.config.DefaultVisibilityMaxPageSize(*listRequest.Domain)))
 	}
 
 	domainInfo, _, err := wh.domainCache.GetDomain(*listRequest.Domain)
@@ -895,7 +1135,14 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context,
 	}
 
 	var persistenceResp *persistence.ListWorkflowExecutionsResponse
-	if listRequest.ExecutionFilter != nil {
+	if listRequest.ExecutionFilter != nil && listRequest.TypeFilter != nil {
+		persistenceResp, err = wh.visibitiltyMgr.ListOpenWorkflowExecutionsByFilters(
+			&persistence.ListWorkflowExecutionsByFiltersRequest{
+				ListWorkflowExecutionsRequest: baseReq,
+				WorkflowID:                    *listRequest.ExecutionFilter.WorkflowId,
+				WorkflowTypeName:              *listRequest.TypeFilter.Name,
+			})
+	} else if listRequest.ExecutionFilter != nil {
 		persistenceResp, err = wh.visibitiltyMgr.ListOpenWorkflowExecutionsByWorkflowID(
 			&persistence.ListWorkflowExecutionsByWorkflowIDRequest{
 				ListWorkflowExecutionsRequest: baseReq,
@@ -959,13 +1206,8 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 		filterCount++
 	}
 
-	if filterCount > 1 {
-		return nil, wh.error(&gen.BadRequestError{
-			Message: "Only one of ExecutionFilter, TypeFilter or StatusFilter is allowed"}, scope)
-	}
-
 	if listRequest.MaximumPageSize == nil || *listRequest.MaximumPageSize == 0 {
-		listRequest.MaximumPageSize = common.Int32Ptr(wh.config.DefaultVisibilityMaxPageSize)
+		listRequest.MaximumPageSize = common.Int32Ptr(int32(wh.config.DefaultVisibilityMaxPageSize(*listRequest.Domain)))
 	}
 
 	domainInfo, _, err := wh.domainCache.GetDomain(*listRequest.Domain)
@@ -982,7 +1224,19 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 	}
 
 	var persistenceResp *persistence.ListWorkflowExecutionsResponse
-	if listRequest.ExecutionFilter != nil {
+	if filterCount > 1 {
+		filtersReq := &persistence.ListWorkflowExecutionsByFiltersRequest{
+			ListWorkflowExecutionsRequest: baseReq,
+			Status:                        listRequest.StatusFilter,
+		}
+		if listRequest.ExecutionFilter != nil {
+			filtersReq.WorkflowID = *listRequest.ExecutionFilter.WorkflowId
+		}
+		if listRequest.TypeFilter != nil {
+			filtersReq.WorkflowTypeName = *listRequest.TypeFilter.Name
+		}
+		persistenceResp, err = wh.visibitiltyMgr.ListClosedWorkflowExecutionsByFilters(filtersReq)
+	} else if listRequest.ExecutionFilter != nil {
 		persistenceResp, err = wh.visibitiltyMgr.ListClosedWorkflowExecutionsByWorkflowID(
 			&persistence.ListWorkflowExecutionsByWorkflowIDRequest{
 				ListWorkflowExecutionsRequest: baseReq,
@@ -1012,8 +1266,274 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 	return resp, nil
 }
 
+// ListWorkflowExecutions - retrieves info for open and closed workflow executions in a domain which match a query
+func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context,
+	listRequest *gen.ListWorkflowExecutionsRequest) (*gen.ListWorkflowExecutionsResponse, error) {
+
+	scope := metrics.FrontendListWorkflowExecutionsScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if listRequest.Domain == nil {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if listRequest.PageSize == nil || *listRequest.PageSize == 0 {
+		listRequest.PageSize = common.Int32Ptr(int32(wh.config.DefaultVisibilityMaxPageSize(*listRequest.Domain)))
+	}
+
+	domainInfo, _, err := wh.domainCache.GetDomain(*listRequest.Domain)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	persistenceResp, err := wh.visibitiltyMgr.ListWorkflowExecutions(&persistence.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID:    domainInfo.ID,
+		Query:         common.StringDefault(listRequest.Query),
+		PageSize:      int(*listRequest.PageSize),
+		NextPageToken: listRequest.NextPageToken,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp := &gen.ListWorkflowExecutionsResponse{}
+	resp.Executions = persistenceResp.Executions
+	resp.NextPageToken = persistenceResp.NextPageToken
+	return resp, nil
+}
+
+// CountWorkflowExecutions - counts the open and closed workflow executions in a domain which match a query
+func (wh *WorkflowHandler) CountWorkflowExecutions(ctx context.Context,
+	countRequest *gen.CountWorkflowExecutionsRequest) (*gen.CountWorkflowExecutionsResponse, error) {
+
+	scope := metrics.FrontendCountWorkflowExecutionsScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if countRequest.Domain == nil {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	domainInfo, _, err := wh.domainCache.GetDomain(*countRequest.Domain)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	persistenceResp, err := wh.visibitiltyMgr.CountWorkflowExecutions(&persistence.CountWorkflowExecutionsRequest{
+		DomainUUID: domainInfo.ID,
+		Query:      common.StringDefault(countRequest.Query),
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return &gen.CountWorkflowExecutionsResponse{Count: common.Int64Ptr(persistenceResp.Count)}, nil
+}
+
+// GetSearchAttributes returns the valid search attributes and the types of their values
+func (wh *WorkflowHandler) GetSearchAttributes(ctx context.Context) (*gen.GetSearchAttributesResponse, error) {
+	scope := metrics.FrontendGetSearchAttributesScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	return &gen.GetSearchAttributesResponse{
+		Keys: common.ConvertValidSearchAttributes(wh.config.ValidSearchAttributes()),
+	}, nil
+}
+
+// DescribeWorkflowExecution returns information about the specified workflow execution.
+func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context,
+	request *gen.DescribeWorkflowExecutionRequest) (*gen.DescribeWorkflowExecutionResponse, error) {
+
+	scope := metrics.FrontendDescribeWorkflowExecutionScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if request.Domain == nil {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecution(request.Execution, scope); err != nil {
+		return nil, err
+	}
+
+	info, _, err := wh.domainCache.GetDomain(*request.Domain)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	response, err := wh.history.DescribeWorkflowExecution(ctx, &h.DescribeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(info.ID),
+		Request:    request,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return response, nil
+}
+
+// QueryWorkflow returns the result of the query run by a worker against the specified workflow execution.
+func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context,
+	queryRequest *gen.QueryWorkflowRequest) (*gen.QueryWorkflowResponse, error) {
+
+	scope := metrics.FrontendQueryWorkflowScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if queryRequest.Domain == nil {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecution(queryRequest.Execution, scope); err != nil {
+		return nil, err
+	}
+
+	if queryRequest.Query == nil {
+		return nil, wh.error(errQueryNotSet, scope)
+	}
+
+	if queryRequest.Query.QueryType == nil || *queryRequest.Query.QueryType == "" {
+		return nil, wh.error(errQueryTypeNotSet, scope)
+	}
+
+	info, _, err := wh.domainCache.GetDomain(*queryRequest.Domain)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	// The query is dispatched to the decision task list of the execution, and the run id is resolved up front so
+	// the worker loads the same run the query was accepted for
+	describeResponse, err := wh.history.DescribeWorkflowExecution(ctx, &h.DescribeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(info.ID),
+		Request: &gen.DescribeWorkflowExecutionRequest{
+			Domain:    queryRequest.Domain,
+			Execution: queryRequest.Execution,
+		},
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	matchingRequest := &gen.QueryWorkflowRequest{
+		Domain:    queryRequest.Domain,
+		Execution: describeResponse.WorkflowExecutionInfo.Execution,
+		Query:     queryRequest.Query,
+	}
+	response, err := wh.matching.QueryWorkflow(ctx, &m.QueryWorkflowRequest{
+		DomainUUID:   common.StringPtr(info.ID),
+		TaskList:     describeResponse.ExecutionConfiguration.TaskList,
+		QueryRequest: matchingRequest,
+	})
+	if err != nil {
+		wh.Service.GetLogger().Errorf("QueryWorkflow failed with error: %v", err)
+		return nil, wh.error(err, scope)
+	}
+
+	return response, nil
+}
+
+// RespondQueryTaskCompleted - responds to a query task
+func (wh *WorkflowHandler) RespondQueryTaskCompleted(ctx context.Context,
+	completeRequest *gen.RespondQueryTaskCompletedRequest) error {
+
+	scope := metrics.FrontendRespondQueryTaskCompletedScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if completeRequest.TaskToken == nil {
+		return wh.error(errTaskTokenNotSet, scope)
+	}
+	queryTaskToken, err := wh.tokenSerializer.DeserializeQueryTaskToken(completeRequest.TaskToken)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+	if queryTaskToken.DomainID == "" || queryTaskToken.TaskList == "" || queryTaskToken.TaskID == "" {
+		return wh.error(errInvalidTaskToken, scope)
+	}
+	if completeRequest.CompletedType == nil {
+		return wh.error(errCompletedTypeNotSet, scope)
+	}
+
+	err = wh.matching.RespondQueryTaskCompleted(ctx, &m.RespondQueryTaskCompletedRequest{
+		DomainUUID:       common.StringPtr(queryTaskToken.DomainID),
+		TaskList:         &gen.TaskList{Name: common.StringPtr(queryTaskToken.TaskList)},
+		TaskID:           common.StringPtr(queryTaskToken.TaskID),
+		CompletedRequest: completeRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// DescribeTaskList returns the recent pollers of a task list and the state of its backlog.
+func (wh *WorkflowHandler) DescribeTaskList(ctx context.Context,
+	request *gen.DescribeTaskListRequest) (*gen.DescribeTaskListResponse, error) {
+
+	scope := metrics.FrontendDescribeTaskListScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if request.Domain == nil {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateTaskList(request.TaskList, scope); err != nil {
+		return nil, err
+	}
+
+	if request.TaskListType == nil {
+		return nil, wh.error(errTaskListTypeNotSet, scope)
+	}
+
+	info, _, err := wh.domainCache.GetDomain(*request.Domain)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	response, err := wh.matching.DescribeTaskList(ctx, &m.DescribeTaskListRequest{
+		DomainUUID:  common.StringPtr(info.ID),
+		DescRequest: request,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return response, nil
+}
+
 func (wh *WorkflowHandler) getHistory(domainID string, execution gen.WorkflowExecution,
-	nextEventID int64, pageSize int32, nextPageToken []byte) (*gen.History, []byte, error) {
+	firstEventID, nextEventID int64, pageSize int32, nextPageToken []byte) (*gen.History, []byte, error) {
 
 	if nextPageToken == nil {
 		nextPageToken = []byte{}
@@ -1023,6 +1543,7 @@ func (wh *WorkflowHandler) getHistory(domainID string, execution gen.WorkflowExe
 	response, err := wh.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
 		DomainID:      domainID,
 		Execution:     execution,
+		FirstEventID:  firstEventID,
 		NextEventID:   nextEventID,
 		PageSize:      int(pageSize),
 		NextPageToken: nextPageToken,
@@ -1049,6 +1570,35 @@ func (wh *WorkflowHandler) getHistory(domainID string, execution gen.WorkflowExe
 	return executionHistory, nextPageToken, nil
 }
 
+// getCloseEvent returns the event which closed the workflow execution, read from the last batch of its history
+func (wh *WorkflowHandler) getCloseEvent(domainID string, execution gen.WorkflowExecution,
+	nextEventID int64) (*gen.History, error) {
+
+	response, err := wh.historyMgr.GetWorkflowExecutionLastHistoryBatch(
+		&persistence.GetWorkflowExecutionLastHistoryBatchRequest{
+			DomainID:    domainID,
+			Execution:   execution,
+			NextEventID: nextEventID,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	batch := response.Events
+	setSerializedHistoryDefaults(&batch)
+	s, _ := wh.hSerializerFactory.Get(batch.EncodingType)
+	history, err := s.Deserialize(&batch)
+	if err != nil {
+		return nil, err
+	}
+
+	executionHistory := &gen.History{Events: []*gen.HistoryEvent{}}
+	if len(history.Events) > 0 {
+		executionHistory.Events = history.Events[len(history.Events)-1:]
+	}
+	return executionHistory, nil
+}
+
 // sets the version and encoding types to defaults if they
 // are missing from persistence. This is purely for backwards
 // compatibility
@@ -1105,6 +1655,9 @@ func (wh *WorkflowHandler) error(err error, scope int) error {
 	case *gen.CancellationAlreadyRequestedError:
 		wh.metricsClient.IncCounter(scope, metrics.CadenceErrCancellationAlreadyRequestedCounter)
 		return err
+	case *gen.QueryFailedError:
+		wh.metricsClient.IncCounter(scope, metrics.CadenceErrQueryFailedCounter)
+		return err
 	default:
 		wh.metricsClient.IncCounter(scope, metrics.CadenceFailures)
 		return &gen.InternalServiceError{Message: err.Error()}
@@ -1119,14 +1672,21 @@ func (wh *WorkflowHandler) validateTaskList(t *gen.TaskList, scope int) error {
 }
 
 func (wh *WorkflowHandler) validateExecution(w *gen.WorkflowExecution, scope int) error {
+	if err := validateExecution(w); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+func validateExecution(w *gen.WorkflowExecution) error {
 	if w == nil {
-		return wh.error(errExecutionNotSet, scope)
+		return errExecutionNotSet
 	}
 	if w.WorkflowId == nil || *w.WorkflowId == "" {
-		return wh.error(errWorkflowIDNotSet, scope)
+		return errWorkflowIDNotSet
 	}
 	if w.RunId != nil && uuid.Parse(*w.RunId) == nil {
-		return wh.error(errInvalidRunID, scope)
+		return errInvalidRunID
 	}
 	return nil
 }
@@ -1159,6 +1719,10 @@ func createDomainResponse(info *persistence.DomainInfo, config *persistence.Doma
 	c := &gen.DomainConfiguration{}
 	c.EmitMetric = common.BoolPtr(config.EmitMetric)
 	c.WorkflowExecutionRetentionPeriodInDays = common.Int32Ptr(config.Retention)
+	c.StartWorkflowRPS = common.Int32Ptr(config.StartWorkflowRPS)
+	c.SignalWorkflowRPS = common.Int32Ptr(config.SignalWorkflowRPS)
+	c.PollRPS = common.Int32Ptr(config.PollRPS)
+	c.HistoryReadRPS = common.Int32Ptr(config.HistoryReadRPS)
 
 	return i, c
 }
@@ -1172,6 +1736,7 @@ func createPollForDecisionTaskResponse(
 		resp.WorkflowType = matchingResponse.WorkflowType
 		resp.PreviousStartedEventId = matchingResponse.PreviousStartedEventId
 		resp.StartedEventId = matchingResponse.StartedEventId
+		resp.Query = matchingResponse.Query
 	}
 	resp.History = history
 	resp.NextPageToken = nextPageToken
@@ -1193,7 +1758,8 @@ func deserializeGetHistoryToken(data []byte) (*getHistoryContinuationToken, erro
 	return &token, err
 }
 
-func getSerializedGetHistoryToken(persistenceToken []byte, runID string, history *gen.History, nextEventID int64) ([]byte, error) {
+func getSerializedGetHistoryToken(persistenceToken []byte, runID string, history *gen.History,
+	firstEventID, nextEventID int64) ([]byte, error) {
 	// create token if there are more events to read
 	if history == nil {
 		return nil, nil
@@ -1202,6 +1768,7 @@ func getSerializedGetHistoryToken(persistenceToken []byte, runID string, history
 	if len(persistenceToken) > 0 && len(events) > 0 && *events[len(events)-1].EventId < nextEventID-1 {
 		token := &getHistoryContinuationToken{
 			RunID:            runID,
+			FirstEventID:     firstEventID,
 			NextEventID:      nextEventID,
 			PersistenceToken: persistenceToken,
 		}
@@ -1212,6 +1779,18 @@ func getSerializedGetHistoryToken(persistenceToken []byte, runID string, history
 	return nil, nil
 }
 
+// getSerializedWaitForNewEventToken returns a token for a caller who has read all the events of a running workflow
+// execution, so it can wait for new events
+func getSerializedWaitForNewEventToken(runID string, nextEventID int64) ([]byte, error) {
+	token := &getHistoryContinuationToken{
+		RunID:             runID,
+		FirstEventID:      nextEventID,
+		NextEventID:       nextEventID,
+		IsWorkflowRunning: true,
+	}
+	return json.Marshal(token)
+}
+
 func createServiceBusyError() *gen.ServiceBusyError {
 	err := &gen.ServiceBusyError{}
 	err.Message = "Too many outstanding requests to the cadence service"
@@ -0,0 +1,113 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	apiClassStartWorkflow apiClass = iota
+	apiClassSignalWorkflow
+	apiClassPoll
+	apiClassHistoryRead
+)
+
+type (
+	// apiClass groups the frontend APIs which share a quota within a domain
+	apiClass int
+
+	domainQuotaKey struct {
+		domainID string
+		class    apiClass
+	}
+
+	domainQuota struct {
+		rps         int32
+		tokenBucket common.TokenBucket
+	}
+
+	// domainRateLimiter enforces the per-domain quotas set in the domain configuration.  Every domain gets a token
+	// bucket for each API class, so a domain exceeding its quota does not take capacity away from the other domains.
+	domainRateLimiter struct {
+		sync.RWMutex
+		quotas     map[domainQuotaKey]*domainQuota
+		timeSource common.TimeSource
+	}
+)
+
+func newDomainRateLimiter(timeSource common.TimeSource) *domainRateLimiter {
+	return &domainRateLimiter{
+		quotas:     make(map[domainQuotaKey]*domainQuota),
+		timeSource: timeSource,
+	}
+}
+
+// TryConsume returns false if the request exceeds the quota of the domain for the API class.  Requests are always
+// allowed when the domain has no quota for the class.
+func (d *domainRateLimiter) TryConsume(domainID string, class apiClass, config *persistence.DomainConfig) bool {
+	rps := getDomainQuota(config, class)
+	if rps <= 0 {
+		return true
+	}
+
+	key := domainQuotaKey{domainID: domainID, class: class}
+	d.RLock()
+	quota, ok := d.quotas[key]
+	d.RUnlock()
+	if !ok || quota.rps != rps {
+		// The quota was changed through UpdateDomain since the bucket was created
+		d.Lock()
+		quota, ok = d.quotas[key]
+		if !ok || quota.rps != rps {
+			quota = &domainQuota{
+				rps:         rps,
+				tokenBucket: common.NewTokenBucket(int(rps), d.timeSource),
+			}
+			d.quotas[key] = quota
+		}
+		d.Unlock()
+	}
+
+	ok, _ = quota.tokenBucket.TryConsume(1)
+	return ok
+}
+
+func getDomainQuota(config *persistence.DomainConfig, class apiClass) int32 {
+	if config == nil {
+		return 0
+	}
+	switch class {
+	case apiClassStartWorkflow:
+		return config.StartWorkflowRPS
+	case apiClassSignalWorkflow:
+		return config.SignalWorkflowRPS
+	case apiClassPoll:
+		return config.PollRPS
+	case apiClassHistoryRead:
+		return config.HistoryReadRPS
+	default:
+		return 0
+	}
+}
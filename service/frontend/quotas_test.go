@@ -0,0 +1,93 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	domainRateLimiterSuite struct {
+		suite.Suite
+		*require.Assertions
+		timeSource  *fixedTimeSource
+		rateLimiter *domainRateLimiter
+	}
+
+	fixedTimeSource struct {
+		now time.Time
+	}
+)
+
+func TestDomainRateLimiterSuite(t *testing.T) {
+	suite.Run(t, new(domainRateLimiterSuite))
+}
+
+func (s *domainRateLimiterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.timeSource = &fixedTimeSource{now: time.Now()}
+	s.rateLimiter = newDomainRateLimiter(s.timeSource)
+}
+
+func (ts *fixedTimeSource) Now() time.Time {
+	return ts.now
+}
+
+func (s *domainRateLimiterSuite) TestNoQuota() {
+	config := &persistence.DomainConfig{}
+	for i := 0; i < 100; i++ {
+		s.True(s.rateLimiter.TryConsume("domain", apiClassStartWorkflow, config))
+		s.True(s.rateLimiter.TryConsume("domain", apiClassHistoryRead, config))
+	}
+	s.True(s.rateLimiter.TryConsume("domain", apiClassPoll, nil))
+}
+
+func (s *domainRateLimiterSuite) TestQuotaPerDomainAndClass() {
+	// A quota of 10 requests per second hands out one token every 100ms
+	config := &persistence.DomainConfig{StartWorkflowRPS: 10}
+	s.True(s.rateLimiter.TryConsume("domain1", apiClassStartWorkflow, config))
+	s.False(s.rateLimiter.TryConsume("domain1", apiClassStartWorkflow, config))
+
+	s.True(s.rateLimiter.TryConsume("domain1", apiClassSignalWorkflow, config))
+	s.True(s.rateLimiter.TryConsume("domain2", apiClassStartWorkflow, config))
+
+	s.timeSource.now = s.timeSource.now.Add(101 * time.Millisecond)
+	s.True(s.rateLimiter.TryConsume("domain1", apiClassStartWorkflow, config))
+}
+
+func (s *domainRateLimiterSuite) TestQuotaUpdated() {
+	config := &persistence.DomainConfig{PollRPS: 10}
+	s.True(s.rateLimiter.TryConsume("domain", apiClassPoll, config))
+	s.False(s.rateLimiter.TryConsume("domain", apiClassPoll, config))
+
+	config = &persistence.DomainConfig{PollRPS: 20}
+	s.True(s.rateLimiter.TryConsume("domain", apiClassPoll, config))
+	s.True(s.rateLimiter.TryConsume("domain", apiClassPoll, config))
+	s.False(s.rateLimiter.TryConsume("domain", apiClassPoll, config))
+
+	config = &persistence.DomainConfig{}
+	s.True(s.rateLimiter.TryConsume("domain", apiClassPoll, config))
+}
@@ -22,23 +22,30 @@ package frontend
 
 import (
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 )
 
 // Config represents configuration for cadence-frontend service
 type Config struct {
-	DefaultVisibilityMaxPageSize int32
-	DefaultHistoryMaxPageSize    int32
-	RPS                          int
+	DefaultVisibilityMaxPageSize dynamicconfig.IntPropertyFnWithDomainFilter `yaml:"defaultVisibilityMaxPageSize" validate:"min=1"`
+	DefaultHistoryMaxPageSize    dynamicconfig.IntPropertyFnWithDomainFilter `yaml:"defaultHistoryMaxPageSize" validate:"min=1"`
+	RPS                          dynamicconfig.IntPropertyFn                 `yaml:"rps" validate:"min=1"`
+
+	// ValidSearchAttributes are the search attributes accepted at start, with the type of their values
+	ValidSearchAttributes dynamicconfig.MapPropertyFn `yaml:"-"`
 }
 
 // NewConfig returns new service config with default values
-func NewConfig() *Config {
+func NewConfig(dc *dynamicconfig.Collection) *Config {
 	return &Config{
-		DefaultVisibilityMaxPageSize: 1000,
-		DefaultHistoryMaxPageSize:    1000,
-		RPS: 1200, // This limit is based on experimental runs.
+		DefaultVisibilityMaxPageSize: dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendVisibilityMaxPageSize, 1000),
+		DefaultHistoryMaxPageSize:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendHistoryMaxPageSize, 1000),
+		// This limit is based on experimental runs.
+		RPS: dc.GetIntProperty(dynamicconfig.FrontendRPS, 1200),
+		ValidSearchAttributes: dc.GetMapProperty(dynamicconfig.ValidSearchAttributes,
+			common.GetDefaultValidSearchAttributes()),
 	}
 }
 
@@ -68,38 +75,73 @@ func (s *Service) Start() {
 
 	base := service.New(p)
 
-	metadata, err := persistence.NewCassandraMetadataPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		p.Logger)
+	var metadata persistence.MetadataManager
+	var err error
+	if p.PersistenceConfig.IsInMemory() {
+		metadata, err = persistence.NewInMemoryMetadataPersistence(p.InMemoryStore, p.Logger)
+	} else if p.PersistenceConfig.IsSQL() {
+		metadata, err = persistence.NewSQLMetadataPersistence(p.PersistenceConfig.SQL.DriverName,
+			p.PersistenceConfig.SQL.DataSourceName,
+			p.Logger)
+	} else {
+		metadata, err = persistence.NewCassandraMetadataPersistence(p.CassandraConfig.Hosts,
+			p.CassandraConfig.Port,
+			p.CassandraConfig.User,
+			p.CassandraConfig.Password,
+			p.CassandraConfig.Datacenter,
+			p.CassandraConfig.Keyspace,
+			p.Logger)
+	}
 
 	if err != nil {
 		log.Fatalf("failed to create metadata manager: %v", err)
 	}
 	metadata = persistence.NewMetadataPersistenceClient(metadata, base.GetMetricsClient())
 
-	visibility, err := persistence.NewCassandraVisibilityPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.VisibilityKeyspace,
-		p.Logger)
+	var visibility persistence.VisibilityManager
+	if p.PersistenceConfig.IsInMemory() {
+		visibility, err = persistence.NewInMemoryVisibilityPersistence(p.InMemoryStore, p.Logger)
+	} else if p.PersistenceConfig.IsSQL() {
+		visibility, err = persistence.NewSQLVisibilityPersistence(p.PersistenceConfig.SQL.DriverName,
+			p.PersistenceConfig.SQL.VisibilityDataSourceName,
+			p.Logger)
+	} else {
+		visibility, err = persistence.NewCassandraVisibilityPersistence(p.CassandraConfig.Hosts,
+			p.CassandraConfig.Port,
+			p.CassandraConfig.User,
+			p.CassandraConfig.Password,
+			p.CassandraConfig.Datacenter,
+			p.CassandraConfig.VisibilityKeyspace,
+			p.Logger)
+	}
 
 	if err != nil {
 		log.Fatalf("failed to create visiblity manager: %v", err)
 	}
+	if p.PersistenceConfig.HasAdvancedVisibility() {
+		visibility, err = persistence.NewVisibilityManagerWithNamedIndex(
+			visibility, p.PersistenceConfig.AdvancedVisibilityStore, p.Logger)
+		if err != nil {
+			log.Fatalf("failed to create visibility index: %v", err)
+		}
+	}
 
-	history, err := persistence.NewCassandraHistoryPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		p.Logger)
+	var history persistence.HistoryManager
+	if p.PersistenceConfig.IsInMemory() {
+		history, err = persistence.NewInMemoryHistoryPersistence(p.InMemoryStore, p.Logger)
+	} else if p.PersistenceConfig.IsSQL() {
+		history, err = persistence.NewSQLHistoryPersistence(p.PersistenceConfig.SQL.DriverName,
+			p.PersistenceConfig.SQL.DataSourceName,
+			p.Logger)
+	} else {
+		history, err = persistence.NewCassandraHistoryPersistence(p.CassandraConfig.Hosts,
+			p.CassandraConfig.Port,
+			p.CassandraConfig.User,
+			p.CassandraConfig.Password,
+			p.CassandraConfig.Datacenter,
+			p.CassandraConfig.Keyspace,
+			p.Logger)
+	}
 
 	if err != nil {
 		log.Fatalf("Creating Cassandra history manager persistence failed: %v", err)
@@ -110,6 +152,11 @@ func (s *Service) Start() {
 	handler := NewWorkflowHandler(base, s.config, metadata, history, visibility)
 	handler.Start()
 
+	adminHandler := NewAdminHandler(base, metadata)
+	if err := adminHandler.Start(); err != nil {
+		log.Fatalf("failed to start admin handler: %v", err)
+	}
+
 	log.Infof("%v started", common.FrontendServiceName)
 
 	<-s.stopC
@@ -20,6 +20,7 @@
 
 package history
 
+import "context"
 import "github.com/stretchr/testify/mock"
 import gohistory "github.com/uber/cadence/.gen/go/history"
 import "github.com/uber/cadence/.gen/go/shared"
@@ -63,12 +64,12 @@ func (_m *MockHistoryEngine) StartWorkflowExecution(request *gohistory.StartWork
 }
 
 // GetWorkflowExecutionNextEventID is mock implementation for GetWorkflowExecutionNextEventID of HistoryEngine
-func (_m *MockHistoryEngine) GetWorkflowExecutionNextEventID(request *gohistory.GetWorkflowExecutionNextEventIDRequest) (*gohistory.GetWorkflowExecutionNextEventIDResponse, error) {
-	ret := _m.Called(request)
+func (_m *MockHistoryEngine) GetWorkflowExecutionNextEventID(ctx context.Context, request *gohistory.GetWorkflowExecutionNextEventIDRequest) (*gohistory.GetWorkflowExecutionNextEventIDResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *gohistory.GetWorkflowExecutionNextEventIDResponse
-	if rf, ok := ret.Get(0).(func(*gohistory.GetWorkflowExecutionNextEventIDRequest) *gohistory.GetWorkflowExecutionNextEventIDResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *gohistory.GetWorkflowExecutionNextEventIDRequest) *gohistory.GetWorkflowExecutionNextEventIDResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gohistory.GetWorkflowExecutionNextEventIDResponse)
@@ -76,7 +77,53 @@ func (_m *MockHistoryEngine) GetWorkflowExecutionNextEventID(request *gohistory.
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*gohistory.GetWorkflowExecutionNextEventIDRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *gohistory.GetWorkflowExecutionNextEventIDRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeWorkflowExecution is mock implementation for DescribeWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) DescribeWorkflowExecution(request *gohistory.DescribeWorkflowExecutionRequest) (*shared.DescribeWorkflowExecutionResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.DescribeWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.DescribeWorkflowExecutionRequest) *shared.DescribeWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.DescribeWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeMutableState is mock implementation for DescribeMutableState of HistoryEngine
+func (_m *MockHistoryEngine) DescribeMutableState(request *gohistory.DescribeMutableStateRequest) (*gohistory.DescribeMutableStateResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *gohistory.DescribeMutableStateResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.DescribeMutableStateRequest) *gohistory.DescribeMutableStateResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gohistory.DescribeMutableStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.DescribeMutableStateRequest) error); ok {
 		r1 = rf(request)
 	} else {
 		r1 = ret.Error(1)
@@ -85,6 +132,22 @@ func (_m *MockHistoryEngine) GetWorkflowExecutionNextEventID(request *gohistory.
 	return r0, r1
 }
 
+// DescribeShard is mock implementation for DescribeShard of HistoryEngine
+func (_m *MockHistoryEngine) DescribeShard() *shared.DescribeShardResponse {
+	ret := _m.Called()
+
+	var r0 *shared.DescribeShardResponse
+	if rf, ok := ret.Get(0).(func() *shared.DescribeShardResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeShardResponse)
+		}
+	}
+
+	return r0
+}
+
 // RecordDecisionTaskStarted is mock implementation for RecordDecisionTaskStarted of HistoryEngine
 func (_m *MockHistoryEngine) RecordDecisionTaskStarted(request *gohistory.RecordDecisionTaskStartedRequest) (*gohistory.RecordDecisionTaskStartedResponse, error) {
 	ret := _m.Called(request)
@@ -238,6 +301,30 @@ func (_m *MockHistoryEngine) SignalWorkflowExecution(request *gohistory.SignalWo
 	return r0
 }
 
+// SignalWithStartWorkflowExecution is mock implementation for SignalWithStartWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) SignalWithStartWorkflowExecution(
+	request *gohistory.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.StartWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.SignalWithStartWorkflowExecutionRequest) *shared.StartWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.StartWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.SignalWithStartWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TerminateWorkflowExecution is mock implementation for TerminateWorkflowExecution of HistoryEngine
 func (_m *MockHistoryEngine) TerminateWorkflowExecution(request *gohistory.TerminateWorkflowExecutionRequest) error {
 	ret := _m.Called(request)
@@ -252,6 +339,29 @@ func (_m *MockHistoryEngine) TerminateWorkflowExecution(request *gohistory.Termi
 	return r0
 }
 
+// ResetWorkflowExecution is mock implementation for ResetWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) ResetWorkflowExecution(request *gohistory.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.ResetWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.ResetWorkflowExecutionRequest) *shared.ResetWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ResetWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.ResetWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ScheduleDecisionTask is mock implementation for ScheduleDecisionTask of HistoryEngine
 func (_m *MockHistoryEngine) ScheduleDecisionTask(request *gohistory.ScheduleDecisionTaskRequest) error {
 	ret := _m.Called(request)
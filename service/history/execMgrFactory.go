@@ -30,34 +30,52 @@ import (
 // executionMgrFactory is an implementation of
 // persistence.ExecutionManagerFactory interface
 type executionMgrFactory struct {
-	config        *config.Cassandra
-	logger        bark.Logger
-	metricsClient metrics.Client
+	config            *config.Cassandra
+	persistenceConfig *config.Persistence
+	inMemoryStore     *persistence.InMemoryStore
+	logger            bark.Logger
+	metricsClient     metrics.Client
 }
 
-// NewExecutionManagerFactory builds and returns a factory object
-func NewExecutionManagerFactory(config *config.Cassandra,
-	logger bark.Logger, mClient metrics.Client) persistence.ExecutionManagerFactory {
+// NewExecutionManagerFactory builds and returns a factory object.  The in-memory store is only used if memory is the
+// default store.
+func NewExecutionManagerFactory(config *config.Cassandra, persistenceConfig *config.Persistence,
+	inMemoryStore *persistence.InMemoryStore, logger bark.Logger,
+	mClient metrics.Client) persistence.ExecutionManagerFactory {
 
 	return &executionMgrFactory{
-		config:        config,
-		logger:        logger,
-		metricsClient: mClient,
+		config:            config,
+		persistenceConfig: persistenceConfig,
+		inMemoryStore:     inMemoryStore,
+		logger:            logger,
+		metricsClient:     mClient,
 	}
 }
 
 // CreateExecutionManager implements ExecutionManagerFactory interface
 func (factory *executionMgrFactory) CreateExecutionManager(shardID int) (persistence.ExecutionManager, error) {
 
-	mgr, err := persistence.NewCassandraWorkflowExecutionPersistence(
-		factory.config.Hosts,
-		factory.config.Port,
-		factory.config.User,
-		factory.config.Password,
-		factory.config.Datacenter,
-		factory.config.Keyspace,
-		shardID,
-		factory.logger)
+	var mgr persistence.ExecutionManager
+	var err error
+	if factory.persistenceConfig.IsInMemory() {
+		mgr, err = persistence.NewInMemoryWorkflowExecutionPersistence(factory.inMemoryStore, shardID, factory.logger)
+	} else if factory.persistenceConfig.IsSQL() {
+		mgr, err = persistence.NewSQLWorkflowExecutionPersistence(
+			factory.persistenceConfig.SQL.DriverName,
+			factory.persistenceConfig.SQL.DataSourceName,
+			shardID,
+			factory.logger)
+	} else {
+		mgr, err = persistence.NewCassandraWorkflowExecutionPersistence(
+			factory.config.Hosts,
+			factory.config.Port,
+			factory.config.User,
+			factory.config.Password,
+			factory.config.Datacenter,
+			factory.config.Keyspace,
+			shardID,
+			factory.logger)
+	}
 
 	if err != nil {
 		return nil, err
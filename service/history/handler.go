@@ -64,6 +64,7 @@ var _ EngineFactory = (*Handler)(nil)
 var (
 	errDomainNotSet            = &gen.BadRequestError{Message: "Domain not set on request."}
 	errWorkflowExecutionNotSet = &gen.BadRequestError{Message: "WorkflowExecution not set on request."}
+	errShardIDNotSet           = &gen.BadRequestError{Message: "ShardID not set on request."}
 )
 
 // NewHandler creates a thrift handler for the history service
@@ -439,7 +440,7 @@ func (h *Handler) GetWorkflowExecutionNextEventID(ctx context.Context,
 		return nil, err1
 	}
 
-	resp, err2 := engine.GetWorkflowExecutionNextEventID(getRequest)
+	resp, err2 := engine.GetWorkflowExecutionNextEventID(ctx, getRequest)
 	if err2 != nil {
 		h.updateErrorMetric(metrics.HistoryGetWorkflowExecutionNextEventIDScope, h.convertError(err2))
 		return nil, h.convertError(err2)
@@ -447,6 +448,106 @@ func (h *Handler) GetWorkflowExecutionNextEventID(ctx context.Context,
 	return resp, nil
 }
 
+// DescribeWorkflowExecution returns information about the specified workflow execution.
+func (h *Handler) DescribeWorkflowExecution(ctx context.Context,
+	request *hist.DescribeWorkflowExecutionRequest) (*gen.DescribeWorkflowExecutionResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryDescribeWorkflowExecutionScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryDescribeWorkflowExecutionScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.DomainUUID == nil {
+		return nil, errDomainNotSet
+	}
+
+	workflowExecution := request.Request.Execution
+	engine, err1 := h.controller.GetEngine(*workflowExecution.WorkflowId)
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryDescribeWorkflowExecutionScope, err1)
+		return nil, err1
+	}
+
+	resp, err2 := engine.DescribeWorkflowExecution(request)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryDescribeWorkflowExecutionScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+	return resp, nil
+}
+
+// DescribeMutableState - returns the mutable state of a workflow execution, both from cache and from database
+func (h *Handler) DescribeMutableState(ctx context.Context,
+	request *hist.DescribeMutableStateRequest) (*hist.DescribeMutableStateResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryDescribeMutableStateScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryDescribeMutableStateScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.DomainUUID == nil {
+		return nil, errDomainNotSet
+	}
+
+	if request.Execution == nil {
+		return nil, errWorkflowExecutionNotSet
+	}
+
+	engine, err1 := h.controller.GetEngine(common.StringDefault(request.Execution.WorkflowId))
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryDescribeMutableStateScope, err1)
+		return nil, err1
+	}
+
+	resp, err2 := engine.DescribeMutableState(request)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryDescribeMutableStateScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+	return resp, nil
+}
+
+// DescribeHistoryHost - returns the shards owned by this host, along with its cache size and shard controller status
+func (h *Handler) DescribeHistoryHost(ctx context.Context,
+	request *gen.DescribeHistoryHostRequest) (*gen.DescribeHistoryHostResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryDescribeHistoryHostScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryDescribeHistoryHostScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	shardIDs := h.controller.shardIDs()
+	return &gen.DescribeHistoryHostResponse{
+		NumberOfShards:        common.Int32Ptr(int32(len(shardIDs))),
+		ShardIDs:              shardIDs,
+		CacheSize:             common.Int32Ptr(int32(h.config.HistoryCacheMaxSize())),
+		ShardControllerStatus: common.StringPtr(h.controller.status()),
+		Address:               common.StringPtr(h.GetHostInfo().GetAddress()),
+	}, nil
+}
+
+// DescribeShard - returns the range ID and the transfer and timer ack levels of a shard owned by this host
+func (h *Handler) DescribeShard(ctx context.Context,
+	request *gen.DescribeShardRequest) (*gen.DescribeShardResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryDescribeShardScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryDescribeShardScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardID == nil {
+		return nil, errShardIDNotSet
+	}
+
+	engine, err := h.controller.getEngineForShard(int(*request.ShardID))
+	if err != nil {
+		h.updateErrorMetric(metrics.HistoryDescribeShardScope, err)
+		return nil, err
+	}
+
+	return engine.DescribeShard(), nil
+}
+
 // RequestCancelWorkflowExecution - requests cancellation of a workflow
 func (h *Handler) RequestCancelWorkflowExecution(ctx context.Context,
 	request *hist.RequestCancelWorkflowExecutionRequest) error {
@@ -513,6 +614,39 @@ func (h *Handler) SignalWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
+// If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
+// and a decision task being created for the execution.
+// If workflow is not running or not found, this results in WorkflowExecutionStarted and WorkflowExecutionSignaled
+// event recorded in history, and a decision task being created for the execution
+func (h *Handler) SignalWithStartWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.SignalWithStartWorkflowExecutionRequest) (*gen.StartWorkflowExecutionResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistorySignalWithStartWorkflowExecutionScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistorySignalWithStartWorkflowExecutionScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if wrappedRequest.DomainUUID == nil {
+		return nil, errDomainNotSet
+	}
+
+	signalWithStartRequest := wrappedRequest.SignalWithStartRequest
+	engine, err1 := h.controller.GetEngine(*signalWithStartRequest.WorkflowId)
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistorySignalWithStartWorkflowExecutionScope, err1)
+		return nil, err1
+	}
+
+	response, err2 := engine.SignalWithStartWorkflowExecution(wrappedRequest)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistorySignalWithStartWorkflowExecutionScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+
+	return response, nil
+}
+
 // TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
 // in the history and immediately terminating the execution instance.
 func (h *Handler) TerminateWorkflowExecution(ctx context.Context,
@@ -544,6 +678,37 @@ func (h *Handler) TerminateWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// ResetWorkflowExecution terminates the current run of a workflow execution and starts a new run from the history
+// before the given decision finish event.
+func (h *Handler) ResetWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.ResetWorkflowExecutionRequest) (*gen.ResetWorkflowExecutionResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryResetWorkflowExecutionScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryResetWorkflowExecutionScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if wrappedRequest.DomainUUID == nil {
+		return nil, errDomainNotSet
+	}
+
+	resetRequest := wrappedRequest.ResetRequest
+	workflowExecution := resetRequest.WorkflowExecution
+	engine, err1 := h.controller.GetEngine(*workflowExecution.WorkflowId)
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryResetWorkflowExecutionScope, err1)
+		return nil, err1
+	}
+
+	response, err2 := engine.ResetWorkflowExecution(wrappedRequest)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryResetWorkflowExecutionScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+
+	return response, nil
+}
+
 // ScheduleDecisionTask is used for creating a decision task for already started workflow execution.  This is mainly
 // used by transfer queue processor during the processing of StartChildWorkflowExecution task, where it first starts
 // child execution without creating the decision task and then calls this API after updating the mutable state of
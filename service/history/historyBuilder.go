@@ -21,6 +21,8 @@
 package history
 
 import (
+	"time"
+
 	"github.com/uber-common/bark"
 	h "github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -32,6 +34,8 @@ import (
 const (
 	firstEventID int64 = 1
 	emptyEventID int64 = -23
+	// transientEventID is the ID of an event which is kept in mutable state and not yet written to history
+	transientEventID int64 = -124
 )
 
 type (
@@ -61,9 +65,9 @@ func (b *historyBuilder) Serialize() (*persistence.SerializedHistoryEventBatch,
 	return history, nil
 }
 
-func (b *historyBuilder) AddWorkflowExecutionStartedEvent(
-	request *workflow.StartWorkflowExecutionRequest) *workflow.HistoryEvent {
-	event := b.newWorkflowExecutionStartedEvent(request)
+func (b *historyBuilder) AddWorkflowExecutionStartedEvent(request *workflow.StartWorkflowExecutionRequest,
+	firstDecisionTaskBackoffSeconds int32, attempt int32, expirationTime time.Time) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionStartedEvent(request, firstDecisionTaskBackoffSeconds, attempt, expirationTime)
 
 	return b.addEventToHistory(event)
 }
@@ -91,7 +95,13 @@ func (b *historyBuilder) AddDecisionTaskCompletedEvent(scheduleEventID, startedE
 
 func (b *historyBuilder) AddDecisionTaskTimedOutEvent(scheduleEventID int64,
 	startedEventID int64) *workflow.HistoryEvent {
-	event := b.newDecisionTaskTimedOutEvent(scheduleEventID, startedEventID)
+	event := b.newDecisionTaskTimedOutEvent(scheduleEventID, startedEventID, workflow.TimeoutTypeStartToClose)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddDecisionTaskScheduleToStartTimeoutEvent(scheduleEventID int64) *workflow.HistoryEvent {
+	event := b.newDecisionTaskTimedOutEvent(scheduleEventID, 0, workflow.TimeoutTypeScheduleToStart)
 
 	return b.addEventToHistory(event)
 }
@@ -110,9 +120,9 @@ func (b *historyBuilder) AddActivityTaskScheduledEvent(decisionCompletedEventID
 	return b.addEventToHistory(event)
 }
 
-func (b *historyBuilder) AddActivityTaskStartedEvent(scheduleEventID int64, requestID string,
+func (b *historyBuilder) AddActivityTaskStartedEvent(scheduleEventID int64, attempt int32, requestID string,
 	request *workflow.PollForActivityTaskRequest) *workflow.HistoryEvent {
-	event := b.newActivityTaskStartedEvent(scheduleEventID, requestID, request)
+	event := b.newActivityTaskStartedEvent(scheduleEventID, attempt, requestID, request)
 
 	return b.addEventToHistory(event)
 }
@@ -309,6 +319,30 @@ func (b *historyBuilder) AddExternalWorkflowExecutionCancelRequested(initiatedEv
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddSignalExternalWorkflowExecutionInitiatedEvent(decisionTaskCompletedEventID int64,
+	attributes *workflow.SignalExternalWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
+	event := b.newSignalExternalWorkflowExecutionInitiatedEvent(decisionTaskCompletedEventID, attributes)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddSignalExternalWorkflowExecutionFailedEvent(
+	decisionTaskCompletedEventID, initiatedEventID int64, domain, workflowID, runID string, control []byte,
+	cause workflow.SignalExternalWorkflowExecutionFailedCause) *workflow.HistoryEvent {
+	event := b.newSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID,
+		domain, workflowID, runID, control, cause)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddExternalWorkflowExecutionSignaled(initiatedEventID int64,
+	domain, workflowID, runID string, control []byte) *workflow.HistoryEvent {
+	event := b.newExternalWorkflowExecutionSignaledEvent(initiatedEventID,
+		domain, workflowID, runID, control)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddMarkerRecordedEvent(decisionCompletedEventID int64,
 	attributes *workflow.RecordMarkerDecisionAttributes) *workflow.HistoryEvent {
 	event := b.newMarkerRecordedEventAttributes(decisionCompletedEventID, attributes)
@@ -316,6 +350,13 @@ func (b *historyBuilder) AddMarkerRecordedEvent(decisionCompletedEventID int64,
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddUpsertWorkflowSearchAttributesEvent(decisionCompletedEventID int64,
+	attributes *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) *workflow.HistoryEvent {
+	event := b.newUpsertWorkflowSearchAttributesEvent(decisionCompletedEventID, attributes)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddWorkflowExecutionSignaledEvent(
 	request *workflow.SignalWorkflowExecutionRequest) *workflow.HistoryEvent {
 	event := b.newWorkflowExecutionSignaledEvent(request)
@@ -386,8 +427,8 @@ func (b *historyBuilder) addEventToHistory(event *workflow.HistoryEvent) *workfl
 	return event
 }
 
-func (b *historyBuilder) newWorkflowExecutionStartedEvent(
-	request *workflow.StartWorkflowExecutionRequest) *workflow.HistoryEvent {
+func (b *historyBuilder) newWorkflowExecutionStartedEvent(request *workflow.StartWorkflowExecutionRequest,
+	firstDecisionTaskBackoffSeconds int32, attempt int32, expirationTime time.Time) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventTypeWorkflowExecutionStarted)
 	attributes := &workflow.WorkflowExecutionStartedEventAttributes{}
 	attributes.WorkflowType = request.WorkflowType
@@ -396,6 +437,20 @@ func (b *historyBuilder) newWorkflowExecutionStartedEvent(
 	attributes.ExecutionStartToCloseTimeoutSeconds = common.Int32Ptr(*request.ExecutionStartToCloseTimeoutSeconds)
 	attributes.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(*request.TaskStartToCloseTimeoutSeconds)
 	attributes.Identity = common.StringPtr(common.StringDefault(request.Identity))
+	if firstDecisionTaskBackoffSeconds > 0 {
+		attributes.FirstDecisionTaskBackoffSeconds = common.Int32Ptr(firstDecisionTaskBackoffSeconds)
+	}
+	if common.StringDefault(request.CronSchedule) != "" {
+		attributes.CronSchedule = common.StringPtr(*request.CronSchedule)
+	}
+	attributes.SearchAttributes = request.SearchAttributes
+	if request.RetryPolicy != nil {
+		attributes.RetryPolicy = request.RetryPolicy
+		attributes.Attempt = common.Int32Ptr(attempt)
+		if !expirationTime.IsZero() {
+			attributes.ExpirationTimestamp = common.Int64Ptr(expirationTime.UnixNano())
+		}
+	}
 	historyEvent.WorkflowExecutionStartedEventAttributes = attributes
 
 	return historyEvent
@@ -438,12 +493,13 @@ func (b *historyBuilder) newDecisionTaskCompletedEvent(scheduleEventID, startedE
 	return historyEvent
 }
 
-func (b *historyBuilder) newDecisionTaskTimedOutEvent(scheduleEventID int64, startedEventID int64) *workflow.HistoryEvent {
+func (b *historyBuilder) newDecisionTaskTimedOutEvent(scheduleEventID int64, startedEventID int64,
+	timeoutType workflow.TimeoutType) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventTypeDecisionTaskTimedOut)
 	attributes := &workflow.DecisionTaskTimedOutEventAttributes{}
 	attributes.ScheduledEventId = common.Int64Ptr(scheduleEventID)
 	attributes.StartedEventId = common.Int64Ptr(startedEventID)
-	attributes.TimeoutType = common.TimeoutTypePtr(workflow.TimeoutTypeStartToClose)
+	attributes.TimeoutType = common.TimeoutTypePtr(timeoutType)
 	historyEvent.DecisionTaskTimedOutEventAttributes = attributes
 
 	return historyEvent
@@ -475,21 +531,45 @@ func (b *historyBuilder) newActivityTaskScheduledEvent(decisionTaskCompletedEven
 	attributes.StartToCloseTimeoutSeconds = common.Int32Ptr(common.Int32Default(scheduleAttributes.StartToCloseTimeoutSeconds))
 	attributes.HeartbeatTimeoutSeconds = common.Int32Ptr(common.Int32Default(scheduleAttributes.HeartbeatTimeoutSeconds))
 	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	attributes.Domain = scheduleAttributes.Domain
+	attributes.RetryPolicy = scheduleAttributes.RetryPolicy
 	historyEvent.ActivityTaskScheduledEventAttributes = attributes
 
 	return historyEvent
 }
 
-func (b *historyBuilder) newActivityTaskStartedEvent(scheduledEventID int64, requestID string,
+func (b *historyBuilder) newActivityTaskStartedEvent(scheduledEventID int64, attempt int32, requestID string,
 	request *workflow.PollForActivityTaskRequest) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventTypeActivityTaskStarted)
+	historyEvent.ActivityTaskStartedEventAttributes = newActivityTaskStartedEventAttributes(scheduledEventID, attempt,
+		requestID, request)
+
+	return historyEvent
+}
+
+// newTransientActivityTaskStartedEvent creates the started event for an attempt of an activity with a retry policy.
+// The event does not consume an event ID, it is only added to history if the attempt closes the activity.
+func (b *historyBuilder) newTransientActivityTaskStartedEvent(scheduledEventID int64, attempt int32,
+	requestID string, request *workflow.PollForActivityTaskRequest) *workflow.HistoryEvent {
+	historyEvent := &workflow.HistoryEvent{}
+	historyEvent.EventId = common.Int64Ptr(transientEventID)
+	historyEvent.Timestamp = common.Int64Ptr(time.Now().UnixNano())
+	historyEvent.EventType = common.EventTypePtr(workflow.EventTypeActivityTaskStarted)
+	historyEvent.ActivityTaskStartedEventAttributes = newActivityTaskStartedEventAttributes(scheduledEventID, attempt,
+		requestID, request)
+
+	return historyEvent
+}
+
+func newActivityTaskStartedEventAttributes(scheduledEventID int64, attempt int32, requestID string,
+	request *workflow.PollForActivityTaskRequest) *workflow.ActivityTaskStartedEventAttributes {
 	attributes := &workflow.ActivityTaskStartedEventAttributes{}
 	attributes.ScheduledEventId = common.Int64Ptr(scheduledEventID)
 	attributes.Identity = common.StringPtr(common.StringDefault(request.Identity))
 	attributes.RequestId = common.StringPtr(requestID)
-	historyEvent.ActivityTaskStartedEventAttributes = attributes
+	attributes.Attempt = common.Int32Ptr(attempt)
 
-	return historyEvent
+	return attributes
 }
 
 func (b *historyBuilder) newActivityTaskCompletedEvent(scheduleEventID, startedEventID int64,
@@ -600,6 +680,17 @@ func (b *historyBuilder) newMarkerRecordedEventAttributes(decisionTaskCompletedE
 	return historyEvent
 }
 
+func (b *historyBuilder) newUpsertWorkflowSearchAttributesEvent(decisionTaskCompletedEventID int64,
+	request *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventTypeUpsertWorkflowSearchAttributes)
+	attributes := &workflow.UpsertWorkflowSearchAttributesEventAttributes{}
+	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	attributes.SearchAttributes = request.SearchAttributes
+	historyEvent.UpsertWorkflowSearchAttributesEventAttributes = attributes
+
+	return historyEvent
+}
+
 func (b *historyBuilder) newWorkflowExecutionCancelRequestedEvent(cause string,
 	request *h.RequestCancelWorkflowExecutionRequest) *workflow.HistoryEvent {
 	event := b.msBuilder.createNewHistoryEvent(workflow.EventTypeWorkflowExecutionCancelRequested)
@@ -676,6 +767,59 @@ func (b *historyBuilder) newExternalWorkflowExecutionCancelRequestedEvent(initia
 	return event
 }
 
+func (b *historyBuilder) newSignalExternalWorkflowExecutionInitiatedEvent(decisionTaskCompletedEventID int64,
+	request *workflow.SignalExternalWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
+	event := b.msBuilder.createNewHistoryEvent(workflow.EventTypeSignalExternalWorkflowExecutionInitiated)
+	attributes := &workflow.SignalExternalWorkflowExecutionInitiatedEventAttributes{}
+	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	attributes.Domain = common.StringPtr(*request.Domain)
+	attributes.WorkflowExecution = &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(*request.Execution.WorkflowId),
+		RunId:      common.StringPtr(common.StringDefault(request.Execution.RunId)),
+	}
+	attributes.SignalName = common.StringPtr(*request.SignalName)
+	attributes.Input = request.Input
+	attributes.Control = request.Control
+	event.SignalExternalWorkflowExecutionInitiatedEventAttributes = attributes
+
+	return event
+}
+
+func (b *historyBuilder) newSignalExternalWorkflowExecutionFailedEvent(
+	decisionTaskCompletedEventID, initiatedEventID int64, domain, workflowID, runID string, control []byte,
+	cause workflow.SignalExternalWorkflowExecutionFailedCause) *workflow.HistoryEvent {
+	event := b.msBuilder.createNewHistoryEvent(workflow.EventTypeSignalExternalWorkflowExecutionFailed)
+	attributes := &workflow.SignalExternalWorkflowExecutionFailedEventAttributes{}
+	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	attributes.InitiatedEventId = common.Int64Ptr(initiatedEventID)
+	attributes.Domain = common.StringPtr(domain)
+	attributes.WorkflowExecution = &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr(runID),
+	}
+	attributes.Control = control
+	attributes.Cause = common.SignalExternalWorkflowExecutionFailedCausePtr(cause)
+	event.SignalExternalWorkflowExecutionFailedEventAttributes = attributes
+
+	return event
+}
+
+func (b *historyBuilder) newExternalWorkflowExecutionSignaledEvent(initiatedEventID int64,
+	domain, workflowID, runID string, control []byte) *workflow.HistoryEvent {
+	event := b.msBuilder.createNewHistoryEvent(workflow.EventTypeExternalWorkflowExecutionSignaled)
+	attributes := &workflow.ExternalWorkflowExecutionSignaledEventAttributes{}
+	attributes.InitiatedEventId = common.Int64Ptr(initiatedEventID)
+	attributes.Domain = common.StringPtr(domain)
+	attributes.WorkflowExecution = &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr(runID),
+	}
+	attributes.Control = control
+	event.ExternalWorkflowExecutionSignaledEventAttributes = attributes
+
+	return event
+}
+
 func (b *historyBuilder) newWorkflowExecutionContinuedAsNewEvent(decisionTaskCompletedEventID int64,
 	newRunID string, request *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventTypeWorkflowExecutionContinuedAsNew)
@@ -687,6 +831,7 @@ func (b *historyBuilder) newWorkflowExecutionContinuedAsNewEvent(decisionTaskCom
 	attributes.ExecutionStartToCloseTimeoutSeconds = common.Int32Ptr(*request.ExecutionStartToCloseTimeoutSeconds)
 	attributes.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(*request.TaskStartToCloseTimeoutSeconds)
 	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	attributes.BackoffStartIntervalInSeconds = request.BackoffStartIntervalInSeconds
 	historyEvent.WorkflowExecutionContinuedAsNewEventAttributes = attributes
 
 	return historyEvent
@@ -53,12 +53,12 @@ var (
 func newHistoryCache(shard ShardContext, logger bark.Logger) *historyCache {
 	opts := &cache.Options{}
 	config := shard.GetConfig()
-	opts.InitialCapacity = config.HistoryCacheInitialSize
-	opts.TTL = config.HistoryCacheTTL
+	opts.InitialCapacity = config.HistoryCacheInitialSize()
+	opts.TTL = config.HistoryCacheTTL()
 	opts.Pin = true
 
 	return &historyCache{
-		Cache:            cache.New(config.HistoryCacheMaxSize, opts),
+		Cache:            cache.New(config.HistoryCacheMaxSize(), opts),
 		shard:            shard,
 		executionManager: shard.GetExecutionManager(),
 		logger: logger.WithFields(bark.Fields{
@@ -70,22 +70,8 @@ func newHistoryCache(shard ShardContext, logger bark.Logger) *historyCache {
 
 func (c *historyCache) getOrCreateWorkflowExecution(domainID string,
 	execution workflow.WorkflowExecution) (*workflowExecutionContext, releaseWorkflowExecutionFunc, error) {
-	if execution.WorkflowId == nil || *execution.WorkflowId == "" {
-		return nil, nil, &workflow.InternalServiceError{Message: "Can't load workflow execution.  WorkflowId not set."}
-	}
-
-	// RunID is not provided, lets try to retrieve the RunID for current active execution
-	if execution.RunId == nil || *execution.RunId == "" {
-		response, err := c.getCurrentExecutionWithRetry(&persistence.GetCurrentExecutionRequest{
-			DomainID:   domainID,
-			WorkflowID: *execution.WorkflowId,
-		})
-
-		if err != nil {
-			return nil, nil, err
-		}
-
-		execution.RunId = common.StringPtr(response.RunID)
+	if err := c.validateWorkflowExecutionInfo(domainID, &execution); err != nil {
+		return nil, nil, err
 	}
 
 	// Test hook for disabling the cache
@@ -116,6 +102,52 @@ func (c *historyCache) getOrCreateWorkflowExecution(domainID string,
 	return context, releaseFunc, nil
 }
 
+// getAndCreateWorkflowExecution returns the context of the workflow execution held by the cache, if any, together
+// with a new context which is never added to the cache, so the mutable state it loads always comes from the database.
+func (c *historyCache) getAndCreateWorkflowExecution(domainID string,
+	execution workflow.WorkflowExecution) (*workflowExecutionContext, *workflowExecutionContext,
+	releaseWorkflowExecutionFunc, bool, error) {
+	if err := c.validateWorkflowExecutionInfo(domainID, &execution); err != nil {
+		return nil, nil, nil, false, err
+	}
+
+	key := *execution.RunId
+	contextFromCache, cacheHit := c.Get(key).(*workflowExecutionContext)
+	releaseFunc := func() {}
+	if cacheHit {
+		contextFromCache.Lock()
+		releaseFunc = func() {
+			contextFromCache.Unlock()
+			c.Release(key)
+		}
+	}
+
+	contextFromDB := newWorkflowExecutionContext(domainID, execution, c.shard, c.executionManager, c.logger)
+	return contextFromCache, contextFromDB, releaseFunc, cacheHit, nil
+}
+
+func (c *historyCache) validateWorkflowExecutionInfo(domainID string, execution *workflow.WorkflowExecution) error {
+	if execution.WorkflowId == nil || *execution.WorkflowId == "" {
+		return &workflow.InternalServiceError{Message: "Can't load workflow execution.  WorkflowId not set."}
+	}
+
+	// RunID is not provided, lets try to retrieve the RunID for current active execution
+	if execution.RunId == nil || *execution.RunId == "" {
+		response, err := c.getCurrentExecutionWithRetry(&persistence.GetCurrentExecutionRequest{
+			DomainID:   domainID,
+			WorkflowID: *execution.WorkflowId,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		execution.RunId = common.StringPtr(response.RunID)
+	}
+
+	return nil
+}
+
 func (c *historyCache) getCurrentExecutionWithRetry(
 	request *persistence.GetCurrentExecutionRequest) (*persistence.GetCurrentExecutionResponse, error) {
 	var response *persistence.GetCurrentExecutionResponse
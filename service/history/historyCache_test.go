@@ -33,6 +33,7 @@ import (
 	"github.com/uber-go/tally"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -68,7 +69,7 @@ func (s *historyCacheSuite) SetupTest() {
 		shardManager:              &mocks.ShardManager{},
 		maxTransferSequenceNumber: 100000,
 		closeCh:                   make(chan int, 100),
-		config:                    NewConfig(1),
+		config:                    NewConfig(dynamicconfig.NewNopCollection(), 1),
 		logger:                    s.logger,
 		metricsClient:             metrics.NewClient(tally.NoopScope, metrics.History),
 	}
@@ -76,7 +77,7 @@ func (s *historyCacheSuite) SetupTest() {
 }
 
 func (s *historyCacheSuite) TestHistoryCachePinning() {
-	s.mockShard.GetConfig().HistoryCacheMaxSize = 2
+	s.mockShard.GetConfig().HistoryCacheMaxSize = dynamicconfig.GetIntPropertyFn(2)
 	domain := "test_domain"
 	s.cache = newHistoryCache(s.mockShard, s.logger)
 	we := workflow.WorkflowExecution{
@@ -109,3 +110,32 @@ func (s *historyCacheSuite) TestHistoryCachePinning() {
 	s.False(context == newContext)
 	release()
 }
+
+func (s *historyCacheSuite) TestHistoryCacheGetAndCreate() {
+	domain := "test_domain"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wf-cache-get-and-create-test"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+
+	// Nothing is cached yet, only a context which bypasses the cache is returned
+	contextFromCache, contextFromDB, release, cacheHit, err := s.cache.getAndCreateWorkflowExecution(domain, we)
+	s.Nil(err)
+	s.False(cacheHit)
+	s.Nil(contextFromCache)
+	s.NotNil(contextFromDB)
+	release()
+	s.Equal(0, s.cache.Size())
+
+	context, release, err := s.cache.getOrCreateWorkflowExecution(domain, we)
+	s.Nil(err)
+	release()
+
+	contextFromCache, contextFromDB, release, cacheHit, err = s.cache.getAndCreateWorkflowExecution(domain, we)
+	s.Nil(err)
+	s.True(cacheHit)
+	s.True(context == contextFromCache)
+	s.False(context == contextFromDB)
+	release()
+	s.Equal(1, s.cache.Size())
+}
@@ -21,6 +21,8 @@
 package history
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -32,6 +34,7 @@ import (
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
@@ -40,6 +43,7 @@ import (
 
 const (
 	conditionalRetryCount                    = 5
+	getHistoryPageSize                       = 100
 	activityCancelationMsgActivityIDUnknown  = "ACTIVITY_ID_UNKNOWN"
 	activityCancelationMsgActivityNotStarted = "ACTIVITY_ID_NOT_STARTED"
 	timerCancelationMsgTimerIDUnknown        = "TIMER_ID_UNKNOWN"
@@ -47,26 +51,29 @@ const (
 
 type (
 	historyEngineImpl struct {
-		shard              ShardContext
-		metadataMgr        persistence.MetadataManager
-		historyMgr         persistence.HistoryManager
-		executionManager   persistence.ExecutionManager
-		txProcessor        transferQueueProcessor
-		timerProcessor     timerQueueProcessor
-		tokenSerializer    common.TaskTokenSerializer
-		hSerializerFactory persistence.HistorySerializerFactory
-		metricsReporter    metrics.Client
-		historyCache       *historyCache
-		domainCache        cache.DomainCache
-		metricsClient      metrics.Client
-		logger             bark.Logger
-	}
-
-	// shardContextWrapper wraps ShardContext to notify transferQueueProcessor on new tasks.
-	// TODO: use to notify timerQueueProcessor as well.
+		shard                ShardContext
+		metadataMgr          persistence.MetadataManager
+		historyMgr           persistence.HistoryManager
+		executionManager     persistence.ExecutionManager
+		txProcessor          transferQueueProcessor
+		timerProcessor       timerQueueProcessor
+		tokenSerializer      common.TaskTokenSerializer
+		hSerializerFactory   persistence.HistorySerializerFactory
+		metricsReporter      metrics.Client
+		historyCache         *historyCache
+		historyEventNotifier *historyEventNotifier
+		domainCache          cache.DomainCache
+		metricsClient        metrics.Client
+		logger               bark.Logger
+	}
+
+	// shardContextWrapper wraps ShardContext to notify transferQueueProcessor and timerQueueProcessor on new tasks,
+	// and historyEventNotifier on updates of workflow executions.
 	shardContextWrapper struct {
 		ShardContext
-		txProcessor transferQueueProcessor
+		txProcessor          transferQueueProcessor
+		timerProcessor       timerQueueProcessor
+		historyEventNotifier *historyEventNotifier
 	}
 )
 
@@ -84,7 +91,8 @@ var (
 // NewEngineWithShardContext creates an instance of history engine
 func NewEngineWithShardContext(shard ShardContext, metadataMgr persistence.MetadataManager,
 	visibilityMgr persistence.VisibilityManager, matching matching.Client, historyClient hc.Client) Engine {
-	shardWrapper := &shardContextWrapper{ShardContext: shard}
+	historyEventNotifier := newHistoryEventNotifier()
+	shardWrapper := &shardContextWrapper{ShardContext: shard, historyEventNotifier: historyEventNotifier}
 	shard = shardWrapper
 	logger := shard.GetLogger()
 	executionManager := shard.GetExecutionManager()
@@ -93,15 +101,16 @@ func NewEngineWithShardContext(shard ShardContext, metadataMgr persistence.Metad
 	domainCache := cache.NewDomainCache(metadataMgr, logger)
 	txProcessor := newTransferQueueProcessor(shard, visibilityMgr, matching, historyClient, historyCache, domainCache)
 	historyEngImpl := &historyEngineImpl{
-		shard:              shard,
-		metadataMgr:        metadataMgr,
-		historyMgr:         historyManager,
-		executionManager:   executionManager,
-		txProcessor:        txProcessor,
-		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
-		hSerializerFactory: persistence.NewHistorySerializerFactory(),
-		historyCache:       historyCache,
-		domainCache:        domainCache,
+		shard:                shard,
+		metadataMgr:          metadataMgr,
+		historyMgr:           historyManager,
+		executionManager:     executionManager,
+		txProcessor:          txProcessor,
+		tokenSerializer:      common.NewJSONTaskTokenSerializer(),
+		hSerializerFactory:   persistence.NewHistorySerializerFactory(),
+		historyCache:         historyCache,
+		historyEventNotifier: historyEventNotifier,
+		domainCache:          domainCache,
 		logger: logger.WithFields(bark.Fields{
 			logging.TagWorkflowComponent: logging.TagValueHistoryEngineComponent,
 		}),
@@ -109,6 +118,7 @@ func NewEngineWithShardContext(shard ShardContext, metadataMgr persistence.Metad
 	}
 	historyEngImpl.timerProcessor = newTimerQueueProcessor(shard, historyEngImpl, executionManager, logger)
 	shardWrapper.txProcessor = txProcessor
+	shardWrapper.timerProcessor = historyEngImpl.timerProcessor
 	return historyEngImpl
 }
 
@@ -140,7 +150,14 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 		return nil, err
 	}
 
-	request := startRequest.StartRequest
+	return e.startWorkflowExecution(domainID, startRequest.StartRequest, startRequest.ParentExecutionInfo, nil)
+}
+
+// startWorkflowExecution creates a new run of the workflow of the request.  If signalRequest is set, the new run is
+// signaled right after the WorkflowExecutionStarted event, as part of creating it.
+func (e *historyEngineImpl) startWorkflowExecution(domainID string, request *workflow.StartWorkflowExecutionRequest,
+	parentInfo *h.ParentExecutionInfo, signalRequest *workflow.SignalWorkflowExecutionRequest) (
+	*workflow.StartWorkflowExecutionResponse, error) {
 	executionID := *request.WorkflowId
 
 	if request.ExecutionStartToCloseTimeoutSeconds == nil || *request.ExecutionStartToCloseTimeoutSeconds <= 0 {
@@ -149,6 +166,29 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 	if request.TaskStartToCloseTimeoutSeconds == nil || *request.TaskStartToCloseTimeoutSeconds <= 0 {
 		return nil, &workflow.BadRequestError{Message: "Missing or invalid TaskStartToCloseTimeoutSeconds."}
 	}
+	if cronSchedule := common.StringDefault(request.CronSchedule); cronSchedule != "" {
+		if err := backoff.ValidateSchedule(cronSchedule); err != nil {
+			return nil, err
+		}
+	}
+	if request.DelayStartSeconds != nil && *request.DelayStartSeconds < 0 {
+		return nil, &workflow.BadRequestError{Message: "Invalid DelayStartSeconds."}
+	}
+	if err := validateRetryPolicy(request.RetryPolicy); err != nil {
+		return nil, err
+	}
+
+	prevRunID, err := e.checkWorkflowIDReusePolicy(domainID, executionID, common.StringDefault(request.RequestId),
+		request.WorkflowIdReusePolicy)
+	if err != nil {
+		if t, ok := err.(*workflow.WorkflowExecutionAlreadyStartedError); ok && request.RequestId != nil &&
+			common.StringDefault(t.StartRequestId) == *request.RequestId {
+			return &workflow.StartWorkflowExecutionResponse{
+				RunId: t.RunId,
+			}, nil
+		}
+		return nil, err
+	}
 
 	// We generate a new workflow execution run_id on each StartWorkflowExecution call.  This generated run_id is
 	// returned back to the caller as the response to StartWorkflowExecution.
@@ -161,26 +201,46 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 	var parentExecution *workflow.WorkflowExecution
 	initiatedID := emptyEventID
 	parentDomainID := ""
-	parentInfo := startRequest.ParentExecutionInfo
 	if parentInfo != nil {
 		parentDomainID = *parentInfo.DomainUUID
 		parentExecution = parentInfo.Execution
 		initiatedID = *parentInfo.InitiatedId
 	}
 
+	// The first decision is delayed by the requested start delay, and the first run of a workflow with a cron
+	// schedule additionally waits for the next fire time of the schedule after that delay
+	now := e.shard.GetTimeSource().Now()
+	delayStartSeconds := common.Int32Default(request.DelayStartSeconds)
+	delayStart := time.Duration(delayStartSeconds) * time.Second
+	firstDecisionTaskBackoffSeconds := delayStartSeconds +
+		getCronBackoffSeconds(common.StringDefault(request.CronSchedule), now.Add(delayStart))
+
 	// Generate first decision task event.
 	taskList := *request.TaskList.Name
 	msBuilder := newMutableStateBuilder(e.shard.GetConfig(), e.logger)
-	startedEvent := msBuilder.AddWorkflowExecutionStartedEvent(domainID, workflowExecution, request)
+	startedEvent := msBuilder.AddWorkflowExecutionStartedEventWithBackoff(domainID, workflowExecution, request,
+		firstDecisionTaskBackoffSeconds)
 	if startedEvent == nil {
 		return nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution started event."}
 	}
+	if signalRequest != nil {
+		if msBuilder.AddWorkflowExecutionSignaled(signalRequest) == nil {
+			return nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution signaled event."}
+		}
+	}
 
 	var transferTasks []persistence.Task
+	var timerTasks []persistence.Task
 	decisionScheduleID := emptyEventID
 	decisionStartID := emptyEventID
 	decisionTimeout := int32(0)
-	if parentInfo == nil {
+	firstDecisionTaskBackoff := time.Duration(firstDecisionTaskBackoffSeconds) * time.Second
+	if firstDecisionTaskBackoff > 0 {
+		// The first decision is scheduled by the timer when the backoff expires
+		timerTasks = append(timerTasks, &persistence.WorkflowBackoffTimerTask{
+			VisibilityTimestamp: now.Add(firstDecisionTaskBackoff),
+		})
+	} else if parentInfo == nil {
 		// DecisionTask is only created when it is not a Child Workflow Execution
 		_, di := msBuilder.AddDecisionTaskScheduledEvent()
 		if di == nil {
@@ -194,11 +254,17 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 		decisionStartID = di.StartedID
 		decisionTimeout = di.DecisionTimeout
 	}
+	if signalRequest != nil || firstDecisionTaskBackoff > 0 {
+		// Visibility records the start when the first decision task is processed, which is identified by the schedule
+		// ID right after the started event.  A signal shifts that decision and a start delay or cron schedule
+		// postpones it, so record the start explicitly.
+		transferTasks = append(transferTasks, &persistence.RecordWorkflowStartedTask{})
+	}
 
 	duration := time.Duration(*request.ExecutionStartToCloseTimeoutSeconds) * time.Second
-	timerTasks := []persistence.Task{&persistence.WorkflowTimeoutTask{
-		VisibilityTimestamp: e.shard.GetTimeSource().Now().Add(duration),
-	}}
+	timerTasks = append(timerTasks, &persistence.WorkflowTimeoutTask{
+		VisibilityTimestamp: now.Add(firstDecisionTaskBackoff + duration),
+	})
 	// Serialize the history
 	serializedHistory, serializedError := msBuilder.hBuilder.Serialize()
 	if serializedError != nil {
@@ -224,6 +290,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 		RequestID:                   common.StringDefault(request.RequestId),
 		DomainID:                    domainID,
 		Execution:                   workflowExecution,
+		PreviousRunID:               prevRunID,
 		ParentDomainID:              parentDomainID,
 		ParentExecution:             parentExecution,
 		InitiatedID:                 initiatedID,
@@ -238,6 +305,9 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 		DecisionStartedID:           decisionStartID,
 		DecisionStartToCloseTimeout: decisionTimeout,
 		ContinueAsNew:               false,
+		CronSchedule:                common.StringDefault(request.CronSchedule),
+		HasRetryPolicy:              msBuilder.executionInfo.HasRetryPolicy,
+		SearchAttributes:            msBuilder.executionInfo.SearchAttributes,
 		TimerTasks:                  timerTasks,
 	})
 
@@ -281,8 +351,66 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 	}, nil
 }
 
-// GetWorkflowExecutionNextEventID retrieves the nextEventId of the workflow execution history
-func (e *historyEngineImpl) GetWorkflowExecutionNextEventID(
+// checkWorkflowIDReusePolicy validates that a new run can be started for the workflow ID, given the current run and
+// the WorkflowIdReusePolicy of the request.  It returns the run ID of the closed run the new run replaces, or an empty
+// string if there has never been a run with the workflow ID.  A WorkflowExecutionAlreadyStartedError carrying the
+// current run ID is returned if the new run is not allowed, including when the current run was started by the same
+// request.
+func (e *historyEngineImpl) checkWorkflowIDReusePolicy(domainID, workflowID, requestID string,
+	reusePolicy *workflow.WorkflowIdReusePolicy) (string, error) {
+	current, err := e.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+	})
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			return "", nil
+		}
+		return "", err
+	}
+
+	alreadyStartedErr := func(msg string) error {
+		return &workflow.WorkflowExecutionAlreadyStartedError{
+			Message: common.StringPtr(fmt.Sprintf("%v WorkflowId: %v, RunId: %v", msg, workflowID,
+				current.RunID)),
+			StartRequestId: common.StringPtr(current.StartRequestID),
+			RunId:          common.StringPtr(current.RunID),
+		}
+	}
+
+	if requestID != "" && current.StartRequestID == requestID {
+		return "", alreadyStartedErr("Workflow execution already started by the same request.")
+	}
+	if current.State != persistence.WorkflowStateCompleted {
+		return "", alreadyStartedErr("Workflow execution already running.")
+	}
+
+	policy := workflow.WorkflowIdReusePolicyAllowDuplicateFailedOnly
+	if reusePolicy != nil {
+		policy = *reusePolicy
+	}
+
+	switch policy {
+	case workflow.WorkflowIdReusePolicyAllowDuplicateFailedOnly:
+		if current.CloseStatus == persistence.WorkflowCloseStatusCompleted {
+			return "", alreadyStartedErr("Workflow execution already completed successfully and the reuse policy " +
+				"only allows a duplicate workflow ID if the last run failed.")
+		}
+	case workflow.WorkflowIdReusePolicyAllowDuplicate:
+	case workflow.WorkflowIdReusePolicyRejectDuplicate:
+		return "", alreadyStartedErr("Workflow execution already finished and the reuse policy does not allow a " +
+			"duplicate workflow ID.")
+	default:
+		return "", &workflow.BadRequestError{Message: "Unknown WorkflowIdReusePolicy."}
+	}
+
+	return current.RunID, nil
+}
+
+// GetWorkflowExecutionNextEventID retrieves the nextEventId of the workflow execution history.  If expectedNextEventId
+// is set and the running workflow execution has not gone past it, the call waits until the nextEventId goes past it,
+// the workflow execution closes or the long poll interval expires.
+func (e *historyEngineImpl) GetWorkflowExecutionNextEventID(ctx context.Context,
 	request *h.GetWorkflowExecutionNextEventIDRequest) (*h.GetWorkflowExecutionNextEventIDResponse, error) {
 
 	domainID, err := getDomainUUID(request.DomainUUID)
@@ -295,6 +423,51 @@ func (e *historyEngineImpl) GetWorkflowExecutionNextEventID(
 		RunId:      request.Execution.RunId,
 	}
 
+	response, err := e.getWorkflowExecutionNextEventID(domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.ExpectedNextEventId == nil {
+		return response, nil
+	}
+	expectedNextEventID := *request.ExpectedNextEventId
+	if *response.EventId > expectedNextEventID || !*response.IsWorkflowRunning {
+		return response, nil
+	}
+
+	// Start watching before loading the mutable state again, so an update which landed since the first load is
+	// not missed.
+	execution.RunId = response.RunId
+	identifier := newWorkflowIdentifier(domainID, *execution.WorkflowId, *execution.RunId)
+	notificationCh := e.historyEventNotifier.WatchHistoryEvent(identifier)
+	defer e.historyEventNotifier.UnwatchHistoryEvent(identifier, notificationCh)
+
+	response, err = e.getWorkflowExecutionNextEventID(domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(e.shard.GetConfig().LongPollExpirationInterval())
+	defer timer.Stop()
+	for *response.EventId <= expectedNextEventID && *response.IsWorkflowRunning {
+		select {
+		case event := <-notificationCh:
+			response.EventId = common.Int64Ptr(event.nextEventID)
+			response.IsWorkflowRunning = common.BoolPtr(event.isWorkflowRunning)
+		case <-timer.C:
+			return response, nil
+		case <-ctx.Done():
+			return response, nil
+		}
+	}
+
+	return response, nil
+}
+
+func (e *historyEngineImpl) getWorkflowExecutionNextEventID(domainID string,
+	execution workflow.WorkflowExecution) (*h.GetWorkflowExecutionNextEventIDResponse, error) {
+
 	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, execution)
 	if err0 != nil {
 		return nil, err0
@@ -309,6 +482,126 @@ func (e *historyEngineImpl) GetWorkflowExecutionNextEventID(
 	result := &h.GetWorkflowExecutionNextEventIDResponse{}
 	result.EventId = common.Int64Ptr(msBuilder.GetNextEventID())
 	result.RunId = context.workflowExecution.RunId
+	result.IsWorkflowRunning = common.BoolPtr(msBuilder.isWorkflowExecutionRunning())
+
+	return result, nil
+}
+
+// DescribeMutableState returns the mutable state of the workflow execution, both as cached by this host and as
+// persisted in the database
+func (e *historyEngineImpl) DescribeMutableState(
+	request *h.DescribeMutableStateRequest) (*h.DescribeMutableStateResponse, error) {
+
+	domainID, err := getDomainUUID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+
+	contextFromCache, contextFromDB, release, cacheHit, err0 := e.historyCache.getAndCreateWorkflowExecution(domainID,
+		execution)
+	if err0 != nil {
+		return nil, err0
+	}
+	defer release()
+
+	response := &h.DescribeMutableStateResponse{}
+	if cacheHit && contextFromCache.msBuilder != nil {
+		mutableStateInCache, err1 := toMutableStateJSON(contextFromCache.msBuilder)
+		if err1 != nil {
+			return nil, err1
+		}
+		response.MutableStateInCache = mutableStateInCache
+	}
+
+	msBuilder, err2 := contextFromDB.loadWorkflowExecution()
+	if err2 != nil {
+		return nil, err2
+	}
+	response.MutableStateInDatabase, err = toMutableStateJSON(msBuilder)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// DescribeShard returns the shard info of the shard owned by this engine
+func (e *historyEngineImpl) DescribeShard() *workflow.DescribeShardResponse {
+	shardInfo := e.shard.GetShardInfo()
+	return &workflow.DescribeShardResponse{
+		ShardID:              common.Int32Ptr(int32(shardInfo.ShardID)),
+		Owner:                common.StringPtr(shardInfo.Owner),
+		RangeID:              common.Int64Ptr(shardInfo.RangeID),
+		TransferAckLevel:     common.Int64Ptr(shardInfo.TransferAckLevel),
+		TransferMaxReadLevel: common.Int64Ptr(e.shard.GetTransferMaxReadLevel()),
+		TimerAckLevel:        common.Int64Ptr(shardInfo.TimerAckLevel.UnixNano()),
+	}
+}
+
+// DescribeWorkflowExecution returns the execution info, pending activities, pending child executions and pending
+// decision of the workflow execution as recorded in its mutable state
+func (e *historyEngineImpl) DescribeWorkflowExecution(
+	request *h.DescribeWorkflowExecutionRequest) (*workflow.DescribeWorkflowExecutionResponse, error) {
+
+	domainID, err := getDomainUUID(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Request.Execution.WorkflowId,
+		RunId:      request.Request.Execution.RunId,
+	}
+
+	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, execution)
+	if err0 != nil {
+		return nil, err0
+	}
+	defer release()
+
+	msBuilder, err1 := context.loadWorkflowExecution()
+	if err1 != nil {
+		return nil, err1
+	}
+
+	executionInfo := msBuilder.executionInfo
+	result := &workflow.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: &workflow.WorkflowExecutionConfiguration{
+			TaskList:                       &workflow.TaskList{Name: common.StringPtr(executionInfo.TaskList)},
+			TaskStartToCloseTimeoutSeconds: common.Int32Ptr(executionInfo.DecisionTimeoutValue),
+		},
+		WorkflowExecutionInfo: &workflow.WorkflowExecutionInfo{
+			Execution: &workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr(executionInfo.WorkflowID),
+				RunId:      common.StringPtr(executionInfo.RunID),
+			},
+			Type:          &workflow.WorkflowType{Name: common.StringPtr(executionInfo.WorkflowTypeName)},
+			StartTime:     common.Int64Ptr(executionInfo.StartTimestamp.UnixNano()),
+			HistoryLength: common.Int64Ptr(msBuilder.GetNextEventID() - common.FirstEventID),
+		},
+	}
+	if !msBuilder.isWorkflowExecutionRunning() {
+		closeStatus := getWorkflowExecutionCloseStatus(executionInfo.CloseStatus)
+		result.WorkflowExecutionInfo.CloseStatus = &closeStatus
+		result.WorkflowExecutionInfo.CloseTime = common.Int64Ptr(executionInfo.LastUpdatedTimestamp.UnixNano())
+	}
+
+	for _, ai := range msBuilder.pendingActivityInfoIDs {
+		result.PendingActivities = append(result.PendingActivities, msBuilder.getPendingActivityInfo(ai))
+	}
+
+	for _, ci := range msBuilder.pendingChildExecutionInfoIDs {
+		result.PendingChildren = append(result.PendingChildren, msBuilder.getPendingChildExecutionInfo(ci))
+	}
+
+	if msBuilder.HasPendingDecisionTask() {
+		result.PendingDecision = msBuilder.getPendingDecisionInfo()
+	}
 
 	return result, nil
 }
@@ -466,6 +759,7 @@ Update_History_Loop:
 				}
 				response.ScheduledEvent = scheduledEvent
 				response.StartedEvent = startedEvent
+				response.Attempt = common.Int64Ptr(int64(ai.Attempt))
 				return response, nil
 			}
 
@@ -511,6 +805,7 @@ Update_History_Loop:
 		response := &h.RecordActivityTaskStartedResponse{}
 		response.ScheduledEvent = scheduledEvent
 		response.StartedEvent = startedEvent
+		response.Attempt = common.Int64Ptr(int64(ai.Attempt))
 		return response, nil
 	}
 
@@ -569,6 +864,16 @@ Update_History_Loop:
 			return &workflow.InternalServiceError{Message: "Unable to add DecisionTaskCompleted event to history."}
 		}
 
+		// Dispatch the next decision to the worker which completed this one if it asked for a sticky task list
+		if stickyAttributes := request.StickyAttributes; stickyAttributes != nil &&
+			stickyAttributes.WorkerTaskList != nil && common.StringDefault(stickyAttributes.WorkerTaskList.Name) != "" &&
+			common.Int32Default(stickyAttributes.ScheduleToStartTimeoutSeconds) > 0 {
+			msBuilder.SetStickyness(*stickyAttributes.WorkerTaskList.Name,
+				*stickyAttributes.ScheduleToStartTimeoutSeconds)
+		} else {
+			msBuilder.ClearStickyness()
+		}
+
 		failDecision := false
 		var failCause workflow.DecisionTaskFailedCause
 		var err error
@@ -634,7 +939,13 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCauseBadCompleteWorkflowExecutionAttributes
 					break Process_Decision_Loop
 				}
-				if e := msBuilder.AddCompletedWorkflowEvent(completedID, attributes); e == nil {
+				if msBuilder.executionInfo.CronSchedule != "" {
+					// The run of a workflow with a cron schedule continues as a new run at the next fire time
+					continueAsNewBuilder, err = e.addCronContinueAsNewEvent(msBuilder, completedID)
+					if err != nil {
+						return err
+					}
+				} else if e := msBuilder.AddCompletedWorkflowEvent(completedID, attributes); e == nil {
 					return &workflow.InternalServiceError{Message: "Unable to add complete workflow event."}
 				}
 				isComplete = true
@@ -660,8 +971,19 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCauseBadFailWorkflowExecutionAttributes
 					break Process_Decision_Loop
 				}
-				if e := msBuilder.AddFailWorkflowEvent(completedID, attributes); e == nil {
-					return &workflow.InternalServiceError{Message: "Unable to add fail workflow event."}
+				// A failed run is retried by the retry policy of the workflow first, then by its cron schedule
+				continueAsNewBuilder, err = e.addRetryContinueAsNewEvent(msBuilder, completedID,
+					common.StringDefault(attributes.Reason))
+				if err == nil && continueAsNewBuilder == nil && msBuilder.executionInfo.CronSchedule != "" {
+					continueAsNewBuilder, err = e.addCronContinueAsNewEvent(msBuilder, completedID)
+				}
+				if err != nil {
+					return err
+				}
+				if continueAsNewBuilder == nil {
+					if e := msBuilder.AddFailWorkflowEvent(completedID, attributes); e == nil {
+						return &workflow.InternalServiceError{Message: "Unable to add fail workflow event."}
+					}
 				}
 				isComplete = true
 			case workflow.DecisionTypeCancelWorkflowExecution:
@@ -756,6 +1078,19 @@ Update_History_Loop:
 				}
 				msBuilder.AddRecordMarkerEvent(completedID, attributes)
 
+			case workflow.DecisionTypeUpsertWorkflowSearchAttributes:
+				e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope,
+					metrics.DecisionTypeUpsertSearchAttributesCounter)
+				attributes := d.UpsertWorkflowSearchAttributesDecisionAttributes
+				if err = validateUpsertWorkflowSearchAttributes(attributes,
+					e.shard.GetConfig().ValidSearchAttributes()); err != nil {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCauseBadSearchAttributes
+					break Process_Decision_Loop
+				}
+				msBuilder.AddUpsertWorkflowSearchAttributesEvent(completedID, attributes)
+				transferTasks = append(transferTasks, &persistence.UpsertWorkflowSearchAttributesTask{})
+
 			case workflow.DecisionTypeRequestCancelExternalWorkflowExecution:
 				e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope,
 					metrics.DecisionTypeCancelExternalWorkflowCounter)
@@ -787,6 +1122,37 @@ Update_History_Loop:
 					ScheduleID:       *wfCancelReqEvent.EventId,
 				})
 
+			case workflow.DecisionTypeSignalExternalWorkflowExecution:
+				e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope,
+					metrics.DecisionTypeSignalExternalWorkflowCounter)
+				attributes := d.SignalExternalWorkflowExecutionDecisionAttributes
+				if err = validateSignalExternalWorkflowExecutionAttributes(attributes); err != nil {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCauseBadSignalWorkflowExecutionAttributes
+					break Process_Decision_Loop
+				}
+
+				foreignInfo, _, err := e.domainCache.GetDomain(*attributes.Domain)
+				if err != nil {
+					return &workflow.InternalServiceError{
+						Message: fmt.Sprintf("Unable to signal workflow across domain: %v.",
+							*attributes.Domain)}
+				}
+
+				signalRequestID := uuid.New()
+				wfSignalReqEvent, _ := msBuilder.AddSignalExternalWorkflowExecutionInitiatedEvent(completedID,
+					signalRequestID, attributes)
+				if wfSignalReqEvent == nil {
+					return &workflow.InternalServiceError{Message: "Unable to add external signal workflow request."}
+				}
+
+				transferTasks = append(transferTasks, &persistence.SignalExecutionTask{
+					TargetDomainID:   foreignInfo.ID,
+					TargetWorkflowID: *attributes.Execution.WorkflowId,
+					TargetRunID:      common.StringDefault(attributes.Execution.RunId),
+					InitiatedID:      *wfSignalReqEvent.EventId,
+				})
+
 			case workflow.DecisionTypeContinueAsNewWorkflowExecution:
 				e.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskCompletedScope,
 					metrics.DecisionTypeContinueAsNewCounter)
@@ -809,6 +1175,12 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCauseBadContinueAsNewAttributes
 					break Process_Decision_Loop
 				}
+				if err = common.ValidateSearchAttributes(attributes.SearchAttributes,
+					e.shard.GetConfig().ValidSearchAttributes()); err != nil {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCauseBadSearchAttributes
+					break Process_Decision_Loop
+				}
 				runID := uuid.New()
 				_, newStateBuilder, err := msBuilder.AddContinueAsNewEvent(completedID, domainID, runID, attributes)
 				if err != nil {
@@ -875,6 +1247,10 @@ Update_History_Loop:
 				TaskList:   *newDecisionEvent.DecisionTaskScheduledEventAttributes.TaskList.Name,
 				ScheduleID: *newDecisionEvent.EventId,
 			})
+			if msBuilder.isStickyTaskListEnabled() {
+				timerTasks = append(timerTasks, tBuilder.AddScheduleToStartDecisionTimoutTask(*newDecisionEvent.EventId,
+					msBuilder.executionInfo.StickyScheduleToStartTimeout))
+			}
 		}
 
 		if isComplete {
@@ -895,7 +1271,9 @@ Update_History_Loop:
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
 		// the history and try the operation again.
 		var updateErr error
+		var continueAsNewTimerTasks []persistence.Task
 		if continueAsNewBuilder != nil {
+			continueAsNewTimerTasks = msBuilder.continueAsNew.TimerTasks
 			updateErr = context.continueAsNewWorkflowExecution(request.ExecutionContext, continueAsNewBuilder,
 				transferTasks, timerTasks, transactionID)
 		} else {
@@ -914,7 +1292,7 @@ Update_History_Loop:
 		}
 
 		// Inform timer about the new ones.
-		e.timerProcessor.NotifyNewTimer(timerTasks)
+		e.timerProcessor.NotifyNewTimer(append(timerTasks, continueAsNewTimerTasks...))
 
 		return err
 	}
@@ -964,7 +1342,8 @@ Update_History_Loop:
 		}
 
 		ai, isRunning := msBuilder.GetActivityInfo(scheduleID)
-		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || ai.StartedID == emptyEventID {
+		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || ai.StartedID == emptyEventID ||
+			token.ScheduleAttempt != int64(ai.Attempt) {
 			return &workflow.EntityNotExistsError{Message: "Activity task not found."}
 		}
 
@@ -975,6 +1354,7 @@ Update_History_Loop:
 		}
 
 		var transferTasks []persistence.Task
+		var timerTasks []persistence.Task
 		if !msBuilder.HasPendingDecisionTask() {
 			newDecisionEvent, _ := msBuilder.AddDecisionTaskScheduledEvent()
 			transferTasks = []persistence.Task{&persistence.DecisionTask{
@@ -982,6 +1362,11 @@ Update_History_Loop:
 				TaskList:   *newDecisionEvent.DecisionTaskScheduledEventAttributes.TaskList.Name,
 				ScheduleID: *newDecisionEvent.EventId,
 			}}
+			if msBuilder.isStickyTaskListEnabled() {
+				tBuilder := e.getTimerBuilder(&context.workflowExecution)
+				timerTasks = []persistence.Task{tBuilder.AddScheduleToStartDecisionTimoutTask(*newDecisionEvent.EventId,
+					msBuilder.executionInfo.StickyScheduleToStartTimeout)}
+			}
 		}
 
 		// Generate a transaction ID for appending events to history
@@ -992,7 +1377,7 @@ Update_History_Loop:
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
 		// the history and try the operation again.
-		if err := context.updateWorkflowExecution(transferTasks, nil, transactionID); err != nil {
+		if err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID); err != nil {
 			if err == ErrConflict {
 				e.metricsClient.IncCounter(metrics.HistoryRespondActivityTaskCompletedScope,
 					metrics.ConcurrencyUpdateFailureCounter)
@@ -1050,24 +1435,37 @@ Update_History_Loop:
 		}
 
 		ai, isRunning := msBuilder.GetActivityInfo(scheduleID)
-		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || ai.StartedID == emptyEventID {
+		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || ai.StartedID == emptyEventID ||
+			token.ScheduleAttempt != int64(ai.Attempt) {
 			return &workflow.EntityNotExistsError{Message: "Activity task not found."}
 		}
 
-		startedID := ai.StartedID
-		if msBuilder.AddActivityTaskFailedEvent(scheduleID, startedID, request) == nil {
-			// Unable to add ActivityTaskFailed event to history
-			return &workflow.InternalServiceError{Message: "Unable to add ActivityTaskFailed event to history."}
-		}
-
 		var transferTasks []persistence.Task
-		if !msBuilder.HasPendingDecisionTask() {
-			newDecisionEvent, _ := msBuilder.AddDecisionTaskScheduledEvent()
-			transferTasks = []persistence.Task{&persistence.DecisionTask{
-				DomainID:   domainID,
-				TaskList:   *newDecisionEvent.DecisionTaskScheduledEventAttributes.TaskList.Name,
-				ScheduleID: *newDecisionEvent.EventId,
-			}}
+		var timerTasks []persistence.Task
+		if retryTask := msBuilder.CreateActivityRetryTimer(ai, common.StringDefault(request.Reason)); retryTask != nil {
+			// The activity is scheduled again after the backoff, the failure is not recorded and the decider is not
+			// notified.
+			timerTasks = []persistence.Task{retryTask}
+		} else {
+			startedID := ai.StartedID
+			if msBuilder.AddActivityTaskFailedEvent(scheduleID, startedID, request) == nil {
+				// Unable to add ActivityTaskFailed event to history
+				return &workflow.InternalServiceError{Message: "Unable to add ActivityTaskFailed event to history."}
+			}
+
+			if !msBuilder.HasPendingDecisionTask() {
+				newDecisionEvent, _ := msBuilder.AddDecisionTaskScheduledEvent()
+				transferTasks = []persistence.Task{&persistence.DecisionTask{
+					DomainID:   domainID,
+					TaskList:   *newDecisionEvent.DecisionTaskScheduledEventAttributes.TaskList.Name,
+					ScheduleID: *newDecisionEvent.EventId,
+				}}
+				if msBuilder.isStickyTaskListEnabled() {
+					tBuilder := e.getTimerBuilder(&context.workflowExecution)
+					timerTasks = []persistence.Task{tBuilder.AddScheduleToStartDecisionTimoutTask(
+						*newDecisionEvent.EventId, msBuilder.executionInfo.StickyScheduleToStartTimeout)}
+				}
+			}
 		}
 
 		// Generate a transaction ID for appending events to history
@@ -1078,7 +1476,7 @@ Update_History_Loop:
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
 		// the history and try the operation again.
-		if err := context.updateWorkflowExecution(transferTasks, nil, transactionID); err != nil {
+		if err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID); err != nil {
 			if err == ErrConflict {
 				e.metricsClient.IncCounter(metrics.HistoryRespondActivityTaskFailedScope,
 					metrics.ConcurrencyUpdateFailureCounter)
@@ -1087,6 +1485,7 @@ Update_History_Loop:
 
 			return err
 		}
+		e.timerProcessor.NotifyNewTimer(timerTasks)
 
 		return nil
 	}
@@ -1137,7 +1536,8 @@ Update_History_Loop:
 		// Check execution state to make sure task is in the list of outstanding tasks and it is not yet started.  If
 		// task is not outstanding than it is most probably a duplicate and complete the task.
 		ai, isRunning := msBuilder.GetActivityInfo(scheduleID)
-		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || ai.StartedID == emptyEventID {
+		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || ai.StartedID == emptyEventID ||
+			token.ScheduleAttempt != int64(ai.Attempt) {
 			return &workflow.EntityNotExistsError{Message: "Activity task not found."}
 		}
 
@@ -1148,6 +1548,7 @@ Update_History_Loop:
 		}
 
 		var transferTasks []persistence.Task
+		var timerTasks []persistence.Task
 		if !msBuilder.HasPendingDecisionTask() {
 			newDecisionEvent, _ := msBuilder.AddDecisionTaskScheduledEvent()
 			transferTasks = []persistence.Task{&persistence.DecisionTask{
@@ -1155,6 +1556,11 @@ Update_History_Loop:
 				TaskList:   *newDecisionEvent.DecisionTaskScheduledEventAttributes.TaskList.Name,
 				ScheduleID: *newDecisionEvent.EventId,
 			}}
+			if msBuilder.isStickyTaskListEnabled() {
+				tBuilder := e.getTimerBuilder(&context.workflowExecution)
+				timerTasks = []persistence.Task{tBuilder.AddScheduleToStartDecisionTimoutTask(*newDecisionEvent.EventId,
+					msBuilder.executionInfo.StickyScheduleToStartTimeout)}
+			}
 		}
 
 		// Generate a transaction ID for appending events to history
@@ -1165,7 +1571,7 @@ Update_History_Loop:
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
 		// the history and try the operation again.
-		if err := context.updateWorkflowExecution(transferTasks, nil, transactionID); err != nil {
+		if err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID); err != nil {
 			if err == ErrConflict {
 				e.metricsClient.IncCounter(metrics.HistoryRespondActivityTaskCanceledScope,
 					metrics.ConcurrencyUpdateFailureCounter)
@@ -1226,7 +1632,8 @@ Update_History_Loop:
 		}
 
 		ai, isRunning := msBuilder.GetActivityInfo(scheduleID)
-		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || ai.StartedID == emptyEventID {
+		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || ai.StartedID == emptyEventID ||
+			token.ScheduleAttempt != int64(ai.Attempt) {
 			e.logger.Debugf("Activity HeartBeat: scheduleEventID: %v, ActivityInfo: %+v, Exist: %v",
 				scheduleID, ai, isRunning)
 			return nil, &workflow.EntityNotExistsError{Message: "Activity task not found."}
@@ -1331,6 +1738,125 @@ func (e *historyEngineImpl) SignalWorkflowExecution(signalRequest *h.SignalWorkf
 		})
 }
 
+// SignalWithStartWorkflowExecution signals the current run of the workflow if it is running, otherwise it starts a
+// new run with the signal recorded right after the WorkflowExecutionStarted event.
+func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
+	signalWithStartRequest *h.SignalWithStartWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse, error) {
+	domainID, err := getDomainUUID(signalWithStartRequest.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	sRequest := signalWithStartRequest.SignalWithStartRequest
+	signalRequest := &workflow.SignalWorkflowExecutionRequest{
+		Domain:            sRequest.Domain,
+		WorkflowExecution: &workflow.WorkflowExecution{WorkflowId: sRequest.WorkflowId},
+		SignalName:        sRequest.SignalName,
+		Input:             sRequest.SignalInput,
+		Identity:          sRequest.Identity,
+	}
+	startRequest := &workflow.StartWorkflowExecutionRequest{
+		Domain:                              sRequest.Domain,
+		WorkflowId:                          sRequest.WorkflowId,
+		WorkflowType:                        sRequest.WorkflowType,
+		TaskList:                            sRequest.TaskList,
+		Input:                               sRequest.Input,
+		ExecutionStartToCloseTimeoutSeconds: sRequest.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      sRequest.TaskStartToCloseTimeoutSeconds,
+		Identity:                            sRequest.Identity,
+		RequestId:                           sRequest.RequestId,
+		WorkflowIdReusePolicy:               sRequest.WorkflowIdReusePolicy,
+		SearchAttributes:                    sRequest.SearchAttributes,
+	}
+
+	var startErr error
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		resp, signaled, err := e.signalCurrentRun(domainID, signalRequest)
+		if err != nil || signaled {
+			return resp, err
+		}
+		if startErr != nil {
+			// The run which kept us from starting a new one is not running, for example a closed run which the
+			// reuse policy does not allow to start again
+			return nil, startErr
+		}
+
+		// There is no running execution for the workflow, so start a new run and signal it as part of the same request
+		resp, startErr = e.startWorkflowExecution(domainID, startRequest, nil, signalRequest)
+		if _, ok := startErr.(*workflow.WorkflowExecutionAlreadyStartedError); !ok {
+			return resp, startErr
+		}
+		// Another request started a run concurrently, so signal that run instead
+	}
+
+	return nil, ErrMaxAttemptsExceeded
+}
+
+// signalCurrentRun signals the current run of the workflow of the signal request if it is running.  It returns false
+// if there is no running execution to signal.
+func (e *historyEngineImpl) signalCurrentRun(domainID string,
+	signalRequest *workflow.SignalWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse, bool, error) {
+	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, *signalRequest.WorkflowExecution)
+	if err0 != nil {
+		if _, ok := err0.(*workflow.EntityNotExistsError); ok {
+			return nil, false, nil
+		}
+		return nil, false, err0
+	}
+	defer release()
+
+Signal_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err1 := context.loadWorkflowExecution()
+		if err1 != nil {
+			if _, ok := err1.(*workflow.EntityNotExistsError); ok {
+				// The current execution record outlives the mutable state of a closed run which is deleted
+				return nil, false, nil
+			}
+			return nil, false, err1
+		}
+		if !msBuilder.isWorkflowExecutionRunning() {
+			return nil, false, nil
+		}
+
+		if msBuilder.AddWorkflowExecutionSignaled(signalRequest) == nil {
+			return nil, false, &workflow.InternalServiceError{Message: "Unable to signal workflow execution."}
+		}
+
+		var transferTasks []persistence.Task
+		var timerTasks []persistence.Task
+		if !msBuilder.HasPendingDecisionTask() && !msBuilder.isFirstDecisionTaskBackoffPending() {
+			newDecisionEvent, _ := msBuilder.AddDecisionTaskScheduledEvent()
+			transferTasks = append(transferTasks, &persistence.DecisionTask{
+				DomainID:   domainID,
+				TaskList:   *newDecisionEvent.DecisionTaskScheduledEventAttributes.TaskList.Name,
+				ScheduleID: *newDecisionEvent.EventId,
+			})
+			if msBuilder.isStickyTaskListEnabled() {
+				tBuilder := e.getTimerBuilder(&context.workflowExecution)
+				timerTasks = append(timerTasks, tBuilder.AddScheduleToStartDecisionTimoutTask(
+					*newDecisionEvent.EventId, msBuilder.executionInfo.StickyScheduleToStartTimeout))
+			}
+		}
+
+		// Generate a transaction ID for appending events to history
+		transactionID, err2 := e.shard.GetNextTransferTaskID()
+		if err2 != nil {
+			return nil, false, err2
+		}
+
+		if err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID); err != nil {
+			if err == ErrConflict {
+				continue Signal_Loop
+			}
+			return nil, false, err
+		}
+		return &workflow.StartWorkflowExecutionResponse{RunId: context.workflowExecution.RunId}, true, nil
+	}
+
+	return nil, false, ErrMaxAttemptsExceeded
+}
+
 func (e *historyEngineImpl) TerminateWorkflowExecution(terminateRequest *h.TerminateWorkflowExecutionRequest) error {
 	domainID, err := getDomainUUID(terminateRequest.DomainUUID)
 	if err != nil {
@@ -1356,6 +1882,300 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(terminateRequest *h.Termi
 		})
 }
 
+// ResetWorkflowExecution terminates the current run of a workflow execution and starts a new run whose history is a
+// copy of the events before the given decision finish event, followed by a new decision.  Signals received after
+// that event are recorded again in the new run.
+func (e *historyEngineImpl) ResetWorkflowExecution(
+	resetRequest *h.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error) {
+	domainID, err := getDomainUUID(resetRequest.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	request := resetRequest.ResetRequest
+	if request.DecisionFinishEventId == nil {
+		return nil, &workflow.BadRequestError{Message: "DecisionFinishEventId is not set on request."}
+	}
+	resetEventID := *request.DecisionFinishEventId
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, execution)
+	if err0 != nil {
+		return nil, err0
+	}
+	defer release()
+
+Reset_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err1 := context.loadWorkflowExecution()
+		if err1 != nil {
+			return nil, err1
+		}
+		if !msBuilder.isWorkflowExecutionRunning() {
+			return nil, &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
+		}
+		if resetEventID <= firstEventID || resetEventID >= msBuilder.GetNextEventID() {
+			return nil, &workflow.BadRequestError{Message: "DecisionFinishEventId is out of range."}
+		}
+
+		events, err := e.getHistoryEvents(domainID, context.workflowExecution, msBuilder.GetNextEventID())
+		if err != nil {
+			return nil, err
+		}
+		for i, event := range events {
+			if *event.EventId != int64(i)+firstEventID {
+				return nil, &workflow.InternalServiceError{Message: "Unable to read workflow execution history."}
+			}
+		}
+		if int64(len(events)) < resetEventID {
+			return nil, &workflow.InternalServiceError{Message: "Unable to read workflow execution history."}
+		}
+		switch *events[resetEventID-firstEventID].EventType {
+		case workflow.EventTypeDecisionTaskCompleted,
+			workflow.EventTypeDecisionTaskFailed,
+			workflow.EventTypeDecisionTaskTimedOut:
+		default:
+			return nil, &workflow.BadRequestError{
+				Message: "DecisionFinishEventId is not a DecisionTaskCompleted, DecisionTaskFailed or DecisionTaskTimedOut event."}
+		}
+
+		// Rebuild the new run from the events before the decision finish event.  The decision which was finished by it
+		// is still pending at that point, and is failed so the new run starts over from a new decision.
+		newRunID := uuid.New()
+		newExecution := workflow.WorkflowExecution{
+			WorkflowId: context.workflowExecution.WorkflowId,
+			RunId:      common.StringPtr(newRunID),
+		}
+		replayer := newHistoryReplayer(domainID, newExecution, e.shard.GetConfig(), e.logger)
+		if err := replayer.replay(events[:resetEventID-firstEventID]); err != nil {
+			return nil, err
+		}
+		newStateBuilder := replayer.msBuilder
+		if len(newStateBuilder.pendingChildExecutionInfoIDs) > 0 ||
+			len(newStateBuilder.pendingRequestCancelInfoIDs) > 0 ||
+			len(newStateBuilder.pendingSignalInfoIDs) > 0 {
+			return nil, &workflow.BadRequestError{
+				Message: "Unable to reset workflow execution with pending child workflows or external workflow requests."}
+		}
+
+		newInfo := newStateBuilder.executionInfo
+		newInfo.ParentDomainID = msBuilder.executionInfo.ParentDomainID
+		newInfo.ParentWorkflowID = msBuilder.executionInfo.ParentWorkflowID
+		newInfo.ParentRunID = msBuilder.executionInfo.ParentRunID
+		newInfo.InitiatedID = msBuilder.executionInfo.InitiatedID
+
+		if newStateBuilder.AddDecisionTaskFailedEvent(newInfo.DecisionScheduleID, newInfo.DecisionStartedID,
+			workflow.DecisionTaskFailedCauseResetWorkflow, &workflow.RespondDecisionTaskCompletedRequest{
+				Identity: request.Identity,
+			}) == nil {
+			return nil, &workflow.InternalServiceError{Message: "Unable to reset workflow execution."}
+		}
+		for _, event := range events[resetEventID-firstEventID:] {
+			if *event.EventType != workflow.EventTypeWorkflowExecutionSignaled {
+				continue
+			}
+			attributes := event.WorkflowExecutionSignaledEventAttributes
+			if newStateBuilder.AddWorkflowExecutionSignaled(&workflow.SignalWorkflowExecutionRequest{
+				SignalName: attributes.SignalName,
+				Input:      attributes.Input,
+				Identity:   attributes.Identity,
+			}) == nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to signal workflow execution."}
+			}
+		}
+		newDecisionEvent, di := newStateBuilder.AddDecisionTaskScheduledEvent()
+		if newDecisionEvent == nil {
+			return nil, &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
+		}
+
+		// Tasks for the activities and timers still pending in the new run, which are persisted after it is created
+		newTransferTasks, newTimerTasks, err := e.getResetPendingTasks(domainID, newExecution, newStateBuilder)
+		if err != nil {
+			return nil, err
+		}
+
+		// Terminate the current run, which creates the new run as part of the same update
+		if msBuilder.AddWorkflowExecutionTerminatedEvent(&workflow.TerminateWorkflowExecutionRequest{
+			Reason:   request.Reason,
+			Details:  []byte(fmt.Sprintf("Workflow execution is reset to run %v.", newRunID)),
+			Identity: request.Identity,
+		}) == nil {
+			return nil, &workflow.InternalServiceError{Message: "Unable to terminate workflow execution."}
+		}
+
+		createRequestID := common.StringDefault(request.RequestId)
+		if createRequestID == "" {
+			createRequestID = uuid.New()
+		}
+		var parentExecution *workflow.WorkflowExecution
+		if newInfo.ParentDomainID != "" {
+			parentExecution = &workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr(newInfo.ParentWorkflowID),
+				RunId:      common.StringPtr(newInfo.ParentRunID),
+			}
+		}
+		startedAttributes := events[0].WorkflowExecutionStartedEventAttributes
+		executionTimeout := time.Duration(*startedAttributes.ExecutionStartToCloseTimeoutSeconds) * time.Second
+		msBuilder.continueAsNew = &persistence.CreateWorkflowExecutionRequest{
+			RequestID:            createRequestID,
+			DomainID:             domainID,
+			Execution:            newExecution,
+			ParentDomainID:       newInfo.ParentDomainID,
+			ParentExecution:      parentExecution,
+			InitiatedID:          newInfo.InitiatedID,
+			TaskList:             newInfo.TaskList,
+			WorkflowTypeName:     newInfo.WorkflowTypeName,
+			DecisionTimeoutValue: newInfo.DecisionTimeoutValue,
+			ExecutionContext:     newInfo.ExecutionContext,
+			NextEventID:          newStateBuilder.GetNextEventID(),
+			LastProcessedEvent:   newInfo.LastProcessedEvent,
+			TransferTasks: []persistence.Task{
+				&persistence.DecisionTask{
+					DomainID:   domainID,
+					TaskList:   *newDecisionEvent.DecisionTaskScheduledEventAttributes.TaskList.Name,
+					ScheduleID: di.ScheduleID,
+				},
+				&persistence.RecordWorkflowStartedTask{},
+			},
+			TimerTasks: []persistence.Task{
+				&persistence.WorkflowTimeoutTask{VisibilityTimestamp: time.Now().Add(executionTimeout)},
+			},
+			DecisionScheduleID:          di.ScheduleID,
+			DecisionStartedID:           di.StartedID,
+			DecisionStartToCloseTimeout: di.DecisionTimeout,
+			ContinueAsNew:               true,
+			CronSchedule:                newInfo.CronSchedule,
+			Attempt:                     newInfo.Attempt,
+			HasRetryPolicy:              newInfo.HasRetryPolicy,
+			SearchAttributes:            newInfo.SearchAttributes,
+		}
+		createTimerTasks := msBuilder.continueAsNew.TimerTasks
+
+		tBuilder := e.getTimerBuilder(&context.workflowExecution)
+		tranT, timerT, err := e.getDeleteWorkflowTasks(domainID, tBuilder)
+		if err != nil {
+			return nil, err
+		}
+		transferTasks := []persistence.Task{tranT}
+		timerTasks := []persistence.Task{timerT}
+
+		// Generate a transaction ID for appending events to history
+		transactionID, err2 := e.shard.GetNextTransferTaskID()
+		if err2 != nil {
+			return nil, err2
+		}
+
+		// Hold the lock on the new run until its pending activities and timers are persisted, so its decision is not
+		// started before that
+		_, newRelease, err3 := e.historyCache.getOrCreateWorkflowExecution(domainID, newExecution)
+		if err3 != nil {
+			return nil, err3
+		}
+		err = context.resetWorkflowExecution(newStateBuilder, transferTasks, timerTasks, newTransferTasks,
+			newTimerTasks, transactionID)
+		newRelease()
+		if err != nil {
+			if err == ErrConflict {
+				e.metricsClient.IncCounter(metrics.HistoryResetWorkflowExecutionScope,
+					metrics.ConcurrencyUpdateFailureCounter)
+				continue Reset_Loop
+			}
+			return nil, err
+		}
+
+		// Inform timer about the new ones.
+		e.timerProcessor.NotifyNewTimer(createTimerTasks)
+
+		return &workflow.ResetWorkflowExecutionResponse{RunId: common.StringPtr(newRunID)}, nil
+	}
+
+	return nil, ErrMaxAttemptsExceeded
+}
+
+// getResetPendingTasks creates the transfer and timer tasks for the activities and timers replayed as pending into the
+// new run of a reset workflow execution
+func (e *historyEngineImpl) getResetPendingTasks(domainID string, execution workflow.WorkflowExecution,
+	msBuilder *mutableStateBuilder) ([]persistence.Task, []persistence.Task, error) {
+	var transferTasks []persistence.Task
+	for _, ai := range msBuilder.pendingActivityInfoIDs {
+		if ai.StartedID != emptyEventID {
+			continue
+		}
+		scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(ai.ScheduleID)
+		if !ok {
+			return nil, nil, &workflow.InternalServiceError{Message: "Unable to get activity schedule event."}
+		}
+		attributes := scheduledEvent.ActivityTaskScheduledEventAttributes
+		targetDomainID := domainID
+		if attributes.Domain != nil && *attributes.Domain != "" {
+			info, _, err := e.domainCache.GetDomain(*attributes.Domain)
+			if err != nil {
+				return nil, nil, err
+			}
+			targetDomainID = info.ID
+		}
+		transferTasks = append(transferTasks, &persistence.ActivityTask{
+			DomainID:   targetDomainID,
+			TaskList:   *attributes.TaskList.Name,
+			ScheduleID: ai.ScheduleID,
+		})
+	}
+
+	var timerTasks []persistence.Task
+	tBuilder := e.getTimerBuilder(&execution)
+	if tt := tBuilder.GetUserTimerTaskIfNeeded(msBuilder); tt != nil {
+		timerTasks = append(timerTasks, tt)
+	}
+	if tt := tBuilder.GetActivityTimerTaskIfNeeded(msBuilder); tt != nil {
+		timerTasks = append(timerTasks, tt)
+	}
+
+	return transferTasks, timerTasks, nil
+}
+
+// getHistoryEvents reads the history events of a run of a workflow execution before nextEventID
+func (e *historyEngineImpl) getHistoryEvents(domainID string, execution workflow.WorkflowExecution,
+	nextEventID int64) ([]*workflow.HistoryEvent, error) {
+	var events []*workflow.HistoryEvent
+	var token []byte
+	for {
+		response, err := e.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+			DomainID:      domainID,
+			Execution:     execution,
+			FirstEventID:  firstEventID,
+			NextEventID:   nextEventID,
+			PageSize:      getHistoryPageSize,
+			NextPageToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, batch := range response.Events {
+			serializer, err := e.hSerializerFactory.Get(batch.EncodingType)
+			if err != nil {
+				return nil, err
+			}
+			history, err := serializer.Deserialize(&batch)
+			if err != nil {
+				return nil, err
+			}
+			for _, event := range history.Events {
+				if *event.EventId < nextEventID {
+					events = append(events, event)
+				}
+			}
+		}
+
+		if len(response.NextPageToken) == 0 {
+			return events, nil
+		}
+		token = response.NextPageToken
+	}
+}
+
 // ScheduleDecisionTask schedules a decision if no outstanding decision found
 func (e *historyEngineImpl) ScheduleDecisionTask(scheduleRequest *h.ScheduleDecisionTaskRequest) error {
 	domainID, err := getDomainUUID(scheduleRequest.DomainUUID)
@@ -1459,14 +2279,18 @@ Update_History_Loop:
 		}
 
 		if createDecisionTask {
-			// Create a transfer task to schedule a decision task
-			if !msBuilder.HasPendingDecisionTask() {
+			// Create a transfer task to schedule a decision task, unless the first decision is delayed by a backoff
+			if !msBuilder.HasPendingDecisionTask() && !msBuilder.isFirstDecisionTaskBackoffPending() {
 				newDecisionEvent, _ := msBuilder.AddDecisionTaskScheduledEvent()
 				transferTasks = append(transferTasks, &persistence.DecisionTask{
 					DomainID:   domainID,
 					TaskList:   *newDecisionEvent.DecisionTaskScheduledEventAttributes.TaskList.Name,
 					ScheduleID: *newDecisionEvent.EventId,
 				})
+				if msBuilder.isStickyTaskListEnabled() {
+					timerTasks = append(timerTasks, tBuilder.AddScheduleToStartDecisionTimoutTask(
+						*newDecisionEvent.EventId, msBuilder.executionInfo.StickyScheduleToStartTimeout))
+				}
 			}
 		}
 
@@ -1520,6 +2344,7 @@ func (e *historyEngineImpl) createRecordDecisionTaskStartedResponse(domainID str
 		response.PreviousStartedEventId = common.Int64Ptr(msBuilder.previousDecisionStartedEvent())
 	}
 	response.StartedEventId = common.Int64Ptr(startedEventID)
+	response.StickyExecutionEnabled = common.BoolPtr(msBuilder.isStickyTaskListEnabled())
 
 	return response
 }
@@ -1568,6 +2393,10 @@ func (s *shardContextWrapper) UpdateWorkflowExecution(request *persistence.Updat
 		if len(request.TransferTasks) > 0 {
 			s.txProcessor.NotifyNewTask()
 		}
+		if len(request.TimerTasks) > 0 {
+			s.timerProcessor.NotifyNewTimer(request.TimerTasks)
+		}
+		s.historyEventNotifier.NotifyNewHistoryEvent(newHistoryEventNotification(request.ExecutionInfo))
 	}
 	return err
 }
@@ -1579,6 +2408,9 @@ func (s *shardContextWrapper) CreateWorkflowExecution(request *persistence.Creat
 		if len(request.TransferTasks) > 0 {
 			s.txProcessor.NotifyNewTask()
 		}
+		if len(request.TimerTasks) > 0 {
+			s.timerProcessor.NotifyNewTimer(request.TimerTasks)
+		}
 	}
 	return resp, err
 }
@@ -1613,7 +2445,7 @@ func validateActivityScheduleAttributes(attributes *workflow.ScheduleActivityTas
 		return &workflow.BadRequestError{Message: "Ac valid HeartbeatTimeoutSeconds is not set on decision."}
 	}
 
-	return nil
+	return validateRetryPolicy(attributes.RetryPolicy)
 }
 
 func validateTimerScheduleAttributes(attributes *workflow.StartTimerDecisionAttributes) error {
@@ -1649,6 +2481,18 @@ func validateTimerCancelAttributes(attributes *workflow.CancelTimerDecisionAttri
 	return nil
 }
 
+func validateUpsertWorkflowSearchAttributes(attributes *workflow.UpsertWorkflowSearchAttributesDecisionAttributes,
+	validSearchAttributes map[string]interface{}) error {
+	if attributes == nil {
+		return &workflow.BadRequestError{
+			Message: "UpsertWorkflowSearchAttributesDecisionAttributes is not set on decision."}
+	}
+	if attributes.SearchAttributes == nil || len(attributes.SearchAttributes.IndexedFields) == 0 {
+		return &workflow.BadRequestError{Message: "SearchAttributes is not set on decision."}
+	}
+	return common.ValidateSearchAttributes(attributes.SearchAttributes, validSearchAttributes)
+}
+
 func validateRecordMarkerAttributes(attributes *workflow.RecordMarkerDecisionAttributes) error {
 	if attributes == nil {
 		return &workflow.BadRequestError{Message: "RecordMarkerDecisionAttributes is not set on decision."}
@@ -1702,6 +2546,152 @@ func validateCancelExternalWorkflowExecutionAttributes(attributes *workflow.Requ
 	return nil
 }
 
+func validateSignalExternalWorkflowExecutionAttributes(
+	attributes *workflow.SignalExternalWorkflowExecutionDecisionAttributes) error {
+	if attributes == nil {
+		return &workflow.BadRequestError{Message: "SignalExternalWorkflowExecutionDecisionAttributes is not set on decision."}
+	}
+	if attributes.Domain == nil {
+		return &workflow.BadRequestError{Message: "Domain is not set on decision."}
+	}
+	if attributes.Execution == nil || attributes.Execution.WorkflowId == nil {
+		return &workflow.BadRequestError{Message: "WorkflowId is not set on decision."}
+	}
+	if attributes.SignalName == nil || *attributes.SignalName == "" {
+		return &workflow.BadRequestError{Message: "SignalName is not set on decision."}
+	}
+
+	// RunId is optional, the signal is delivered to the current run of the workflow if it is not set
+	if attributes.Execution.RunId != nil && *attributes.Execution.RunId != "" &&
+		uuid.Parse(*attributes.Execution.RunId) == nil {
+		return &workflow.BadRequestError{Message: "Invalid RunId set on decision."}
+	}
+
+	return nil
+}
+
+// addCronContinueAsNewEvent closes the run of a workflow with a cron schedule by continuing it as a new run with the
+// same input, whose first decision is delayed until the next fire time of the schedule
+func (e *historyEngineImpl) addCronContinueAsNewEvent(msBuilder *mutableStateBuilder,
+	decisionCompletedEventID int64) (*mutableStateBuilder, error) {
+	executionInfo := msBuilder.executionInfo
+	startedEvent, err := e.getWorkflowStartedEvent(executionInfo.DomainID, workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(executionInfo.WorkflowID),
+		RunId:      common.StringPtr(executionInfo.RunID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	backoffSeconds := getCronBackoffSeconds(executionInfo.CronSchedule, e.shard.GetTimeSource().Now())
+	attributes := newContinueAsNewAttributes(startedEvent.WorkflowExecutionStartedEventAttributes, backoffSeconds)
+	_, newStateBuilder, err := msBuilder.AddContinueAsNewEvent(decisionCompletedEventID, executionInfo.DomainID,
+		uuid.New(), attributes)
+	if err != nil {
+		return nil, err
+	}
+	return newStateBuilder, nil
+}
+
+// addRetryContinueAsNewEvent closes a failed or timed out run of a workflow with a retry policy by continuing it as
+// the next attempt after the retry backoff.  It returns a nil builder if the retry policy does not allow another
+// attempt, in which case the run has to be closed by the caller.
+func (e *historyEngineImpl) addRetryContinueAsNewEvent(msBuilder *mutableStateBuilder, decisionCompletedEventID int64,
+	failureReason string) (*mutableStateBuilder, error) {
+	executionInfo := msBuilder.executionInfo
+	if !executionInfo.HasRetryPolicy {
+		return nil, nil
+	}
+
+	startedEvent, err := e.getWorkflowStartedEvent(executionInfo.DomainID, workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(executionInfo.WorkflowID),
+		RunId:      common.StringPtr(executionInfo.RunID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	startedAttributes := startedEvent.WorkflowExecutionStartedEventAttributes
+	if startedAttributes.RetryPolicy == nil {
+		return nil, nil
+	}
+
+	var expirationTime time.Time
+	if startedAttributes.ExpirationTimestamp != nil {
+		expirationTime = time.Unix(0, *startedAttributes.ExpirationTimestamp)
+	}
+	backoffInterval := getRetryBackoffInterval(startedAttributes.RetryPolicy, executionInfo.Attempt, failureReason,
+		e.shard.GetTimeSource().Now(), expirationTime)
+	if backoffInterval == noRetryBackoff {
+		return nil, nil
+	}
+
+	attributes := newContinueAsNewAttributes(startedAttributes, int32(backoffInterval/time.Second))
+	_, newStateBuilder, err := msBuilder.AddContinueAsNewEventForRetry(decisionCompletedEventID,
+		executionInfo.DomainID, uuid.New(), attributes, executionInfo.Attempt+1, expirationTime)
+	if err != nil {
+		return nil, err
+	}
+	return newStateBuilder, nil
+}
+
+// newContinueAsNewAttributes returns the attributes to start the next run of a workflow with the same input and
+// options as the run with the given started event
+func newContinueAsNewAttributes(startedAttributes *workflow.WorkflowExecutionStartedEventAttributes,
+	backoffSeconds int32) *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes {
+	return &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		WorkflowType:                        startedAttributes.WorkflowType,
+		TaskList:                            startedAttributes.TaskList,
+		Input:                               startedAttributes.Input,
+		ExecutionStartToCloseTimeoutSeconds: startedAttributes.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      startedAttributes.TaskStartToCloseTimeoutSeconds,
+		BackoffStartIntervalInSeconds:       common.Int32Ptr(backoffSeconds),
+		RetryPolicy:                         startedAttributes.RetryPolicy,
+	}
+}
+
+// getWorkflowStartedEvent reads the WorkflowExecutionStarted event, which is the first event in the history of a run
+func (e *historyEngineImpl) getWorkflowStartedEvent(domainID string,
+	execution workflow.WorkflowExecution) (*workflow.HistoryEvent, error) {
+	response, err := e.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+		DomainID:    domainID,
+		Execution:   execution,
+		NextEventID: firstEventID + 1,
+		PageSize:    1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, batch := range response.Events {
+		serializer, err := e.hSerializerFactory.Get(batch.EncodingType)
+		if err != nil {
+			return nil, err
+		}
+		history, err := serializer.Deserialize(&batch)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range history.Events {
+			if event.EventType != nil && *event.EventType == workflow.EventTypeWorkflowExecutionStarted {
+				return event, nil
+			}
+		}
+	}
+
+	return nil, &workflow.InternalServiceError{Message: "Unable to find workflow execution started event."}
+}
+
+// getCronBackoffSeconds returns the seconds until the next fire time of the cron schedule, or 0 if the workflow does
+// not have a cron schedule
+func getCronBackoffSeconds(cronSchedule string, now time.Time) int32 {
+	backoffInterval := backoff.GetBackoffForNextSchedule(cronSchedule, now)
+	if backoffInterval == backoff.NoBackoff {
+		return 0
+	}
+	return int32(backoffInterval / time.Second)
+}
+
 func validateContinueAsNewWorkflowExecutionAttributes(attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) error {
 	if attributes == nil {
 		return &workflow.BadRequestError{Message: "ContinueAsNewWorkflowExecutionDecisionAttributes is not set on decision."}
@@ -1723,7 +2713,19 @@ func validateContinueAsNewWorkflowExecutionAttributes(attributes *workflow.Conti
 		return &workflow.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on decision."}
 	}
 
-	return nil
+	if common.Int32Default(attributes.BackoffStartIntervalInSeconds) < 0 {
+		return &workflow.BadRequestError{Message: "BackoffStartIntervalInSeconds cannot be negative."}
+	}
+
+	return validateRetryPolicy(attributes.RetryPolicy)
+}
+
+func toMutableStateJSON(msBuilder *mutableStateBuilder) (*string, error) {
+	jsonBytes, err := json.Marshal(msBuilder.CopyToPersistence())
+	if err != nil {
+		return nil, err
+	}
+	return common.StringPtr(string(jsonBytes)), nil
 }
 
 func getDomainUUID(domainUUID *string) (string, error) {
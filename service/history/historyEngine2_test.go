@@ -25,6 +25,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
@@ -37,6 +38,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -76,7 +78,7 @@ func (s *engine2Suite) SetupSuite() {
 	l := log.New()
 	l.Level = log.DebugLevel
 	s.logger = bark.NewLoggerFromLogrus(l)
-	s.config = NewConfig(1)
+	s.config = NewConfig(dynamicconfig.NewNopCollection(), 1)
 }
 
 func (s *engine2Suite) TearDownSuite() {
@@ -472,6 +474,45 @@ func (s *engine2Suite) TestRecordDecisionTaskSuccess() {
 	s.Equal("wType", *response.WorkflowType.Name)
 	s.True(response.PreviousStartedEventId == nil)
 	s.Equal(int64(3), *response.StartedEventId)
+	s.False(*response.StickyExecutionEnabled)
+}
+
+func (s *engine2Suite) TestRecordDecisionTaskSuccessStickyEnabled() {
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+
+	tl := "testTaskList"
+	stickyTl := "stickyTaskList"
+	identity := "testIdentity"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, false)
+	msBuilder.SetStickyness(stickyTl, 5)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	response, err := s.historyEngine.RecordDecisionTaskStarted(&h.RecordDecisionTaskStartedRequest{
+		DomainUUID:        common.StringPtr("domainId"),
+		WorkflowExecution: &workflowExecution,
+		ScheduleId:        common.Int64Ptr(2),
+		TaskId:            common.Int64Ptr(100),
+		RequestId:         common.StringPtr("reqId"),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: &workflow.TaskList{
+				Name: common.StringPtr(stickyTl),
+			},
+			Identity: common.StringPtr(identity),
+		},
+	})
+
+	s.Nil(err)
+	s.NotNil(response)
+	s.Equal(int64(3), *response.StartedEventId)
+	s.True(*response.StickyExecutionEnabled)
 }
 
 func (s *engine2Suite) TestRecordActivityTaskStartedIfNoExecution() {
@@ -616,6 +657,475 @@ func (s *engine2Suite) TestRequestCancelWorkflowExecutionFail() {
 	s.IsType(&workflow.EntityNotExistsError{}, err)
 }
 
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowRunning() {
+	domainID := "domainId"
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, false)
+	ms1 := createMutableState(msBuilder)
+	gwmsResponse1 := &persistence.GetWorkflowExecutionResponse{State: ms1}
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		&persistence.GetCurrentExecutionResponse{RunID: *workflowExecution.RunId}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(s.newSignalWithStartRequest(domainID,
+		*workflowExecution.WorkflowId, tl, identity))
+	s.Nil(err)
+	s.Equal(*workflowExecution.RunId, *resp.RunId)
+
+	// Signal is recorded in the running execution, which already has a pending decision
+	executionBuilder := s.getBuilder(domainID, workflowExecution)
+	s.Equal(int64(4), executionBuilder.GetNextEventID())
+}
+
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotExist() {
+	domainID := "domainId"
+	workflowID := "wId"
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		nil, &workflow.EntityNotExistsError{}).Twice()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(
+		func(request *persistence.CreateWorkflowExecutionRequest) bool {
+			// WorkflowExecutionStarted, WorkflowExecutionSignaled and DecisionTaskScheduled events
+			return request.NextEventID == 4 && request.DecisionScheduleID == 3 && len(request.TransferTasks) == 2 &&
+				request.TransferTasks[1].GetType() == persistence.TransferTaskTypeRecordWorkflowStarted
+		})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
+
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(s.newSignalWithStartRequest(domainID, workflowID,
+		tl, identity))
+	s.Nil(err)
+	s.NotNil(resp.RunId)
+}
+
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowClosed() {
+	domainID := "domainId"
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	// The mutable state of a closed run is deleted, while the current execution record is kept
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+		RunID:       *workflowExecution.RunId,
+		State:       persistence.WorkflowStateCompleted,
+		CloseStatus: persistence.WorkflowCloseStatusFailed,
+	}, nil).Twice()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil,
+		&workflow.EntityNotExistsError{}).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(
+		func(request *persistence.CreateWorkflowExecutionRequest) bool {
+			return request.PreviousRunID == *workflowExecution.RunId
+		})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
+
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(s.newSignalWithStartRequest(domainID,
+		*workflowExecution.WorkflowId, tl, identity))
+	s.Nil(err)
+	s.NotEqual(*workflowExecution.RunId, *resp.RunId)
+}
+
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_ConcurrentStart() {
+	domainID := "domainId"
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, false)
+	ms1 := createMutableState(msBuilder)
+	gwmsResponse1 := &persistence.GetWorkflowExecutionResponse{State: ms1}
+
+	// Another request starts the workflow after the checks for a current run, so creating a new run fails and
+	// the signal is recorded in the run started by that request
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		nil, &workflow.EntityNotExistsError{}).Twice()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Twice()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(nil,
+		&workflow.WorkflowExecutionAlreadyStartedError{
+			StartRequestId: common.StringPtr("otherRequestId"),
+			RunId:          workflowExecution.RunId,
+		}).Once()
+	s.mockHistoryMgr.On("DeleteWorkflowExecutionHistory", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		&persistence.GetCurrentExecutionResponse{RunID: *workflowExecution.RunId}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(s.newSignalWithStartRequest(domainID,
+		*workflowExecution.WorkflowId, tl, identity))
+	s.Nil(err)
+	s.Equal(*workflowExecution.RunId, *resp.RunId)
+
+	executionBuilder := s.getBuilder(domainID, workflowExecution)
+	s.Equal(int64(4), executionBuilder.GetNextEventID())
+}
+
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_TerminatedBeforeStartRecorded() {
+	domainID := "domainId"
+	workflowID := "wId"
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		nil, &workflow.EntityNotExistsError{}).Twice()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(
+		func(request *persistence.CreateWorkflowExecutionRequest) bool {
+			return len(request.TransferTasks) == 2 &&
+				request.TransferTasks[1].GetType() == persistence.TransferTaskTypeRecordWorkflowStarted
+		})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
+
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(s.newSignalWithStartRequest(domainID, workflowID,
+		tl, identity))
+	s.Nil(err)
+
+	workflowExecution := workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID), RunId: resp.RunId}
+	s.terminateAndDeleteBeforeStartRecorded(domainID,
+		s.createExecutionStartedState(workflowExecution, tl, identity, false))
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_WorkflowNotExist() {
+	domainID := "domainId"
+	workflowID := "wId"
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		nil, &workflow.EntityNotExistsError{}).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(
+		func(request *persistence.CreateWorkflowExecutionRequest) bool {
+			return request.PreviousRunID == ""
+		})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
+
+	resp, err := s.historyEngine.StartWorkflowExecution(s.newStartRequest(domainID, workflowID, "requestId", nil))
+	s.Nil(err)
+	s.NotNil(resp.RunId)
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_WorkflowRunning() {
+	domainID := "domainId"
+	workflowID := "wId"
+	runID := "rId"
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+		RunID:          runID,
+		StartRequestID: "otherRequestId",
+		State:          persistence.WorkflowStateRunning,
+		CloseStatus:    persistence.WorkflowCloseStatusNone,
+	}, nil).Once()
+
+	resp, err := s.historyEngine.StartWorkflowExecution(s.newStartRequest(domainID, workflowID, "requestId",
+		common.WorkflowIDReusePolicyPtr(workflow.WorkflowIdReusePolicyAllowDuplicate)))
+	s.Nil(resp)
+	s.IsType(&workflow.WorkflowExecutionAlreadyStartedError{}, err)
+	s.Equal(runID, *err.(*workflow.WorkflowExecutionAlreadyStartedError).RunId)
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_DuplicateRequest() {
+	domainID := "domainId"
+	workflowID := "wId"
+	runID := "rId"
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+		RunID:          runID,
+		StartRequestID: "requestId",
+		State:          persistence.WorkflowStateRunning,
+		CloseStatus:    persistence.WorkflowCloseStatusNone,
+	}, nil).Once()
+
+	resp, err := s.historyEngine.StartWorkflowExecution(s.newStartRequest(domainID, workflowID, "requestId", nil))
+	s.Nil(err)
+	s.Equal(runID, *resp.RunId)
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_WorkflowIDReusePolicy() {
+	domainID := "domainId"
+	workflowID := "wId"
+	runID := "rId"
+
+	failedOnly := common.WorkflowIDReusePolicyPtr(workflow.WorkflowIdReusePolicyAllowDuplicateFailedOnly)
+	allowDuplicate := common.WorkflowIDReusePolicyPtr(workflow.WorkflowIdReusePolicyAllowDuplicate)
+	rejectDuplicate := common.WorkflowIDReusePolicyPtr(workflow.WorkflowIdReusePolicyRejectDuplicate)
+	testCases := []struct {
+		policy      *workflow.WorkflowIdReusePolicy
+		closeStatus int
+		allowed     bool
+	}{
+		{nil, persistence.WorkflowCloseStatusCompleted, false},
+		{nil, persistence.WorkflowCloseStatusFailed, true},
+		{failedOnly, persistence.WorkflowCloseStatusCompleted, false},
+		{failedOnly, persistence.WorkflowCloseStatusTimedOut, true},
+		{allowDuplicate, persistence.WorkflowCloseStatusCompleted, true},
+		{allowDuplicate, persistence.WorkflowCloseStatusTerminated, true},
+		{rejectDuplicate, persistence.WorkflowCloseStatusCompleted, false},
+		{rejectDuplicate, persistence.WorkflowCloseStatusCanceled, false},
+	}
+
+	for _, tc := range testCases {
+		s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+			RunID:          runID,
+			StartRequestID: "otherRequestId",
+			State:          persistence.WorkflowStateCompleted,
+			CloseStatus:    tc.closeStatus,
+		}, nil).Once()
+		if tc.allowed {
+			s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+			s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(
+				func(request *persistence.CreateWorkflowExecutionRequest) bool {
+					return request.PreviousRunID == runID
+				})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
+		}
+
+		resp, err := s.historyEngine.StartWorkflowExecution(s.newStartRequest(domainID, workflowID, "requestId",
+			tc.policy))
+		if tc.allowed {
+			s.Nil(err)
+			s.NotEqual(runID, *resp.RunId)
+		} else {
+			s.Nil(resp)
+			s.IsType(&workflow.WorkflowExecutionAlreadyStartedError{}, err)
+			s.Equal(runID, *err.(*workflow.WorkflowExecutionAlreadyStartedError).RunId)
+		}
+	}
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_CronSchedule() {
+	domainID := "domainId"
+	workflowID := "wId"
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		nil, &workflow.EntityNotExistsError{}).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(
+		func(request *persistence.CreateWorkflowExecutionRequest) bool {
+			// The run is listed in visibility before the first fire of the schedule
+			if request.CronSchedule != "@hourly" || len(request.TransferTasks) != 1 ||
+				request.TransferTasks[0].GetType() != persistence.TransferTaskTypeRecordWorkflowStarted ||
+				request.DecisionScheduleID != emptyEventID {
+				return false
+			}
+			for _, task := range request.TimerTasks {
+				if task.GetType() == persistence.TaskTypeWorkflowBackoffTimer {
+					return true
+				}
+			}
+			return false
+		})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
+
+	startRequest := s.newStartRequest(domainID, workflowID, "requestId", nil)
+	startRequest.StartRequest.CronSchedule = common.StringPtr("@hourly")
+	resp, err := s.historyEngine.StartWorkflowExecution(startRequest)
+	s.Nil(err)
+	s.NotNil(resp.RunId)
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_CronScheduleTerminatedBeforeStartRecorded() {
+	domainID := "domainId"
+	workflowID := "wId"
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		nil, &workflow.EntityNotExistsError{}).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(
+		&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
+
+	startRequest := s.newStartRequest(domainID, workflowID, "requestId", nil)
+	startRequest.StartRequest.CronSchedule = common.StringPtr("@hourly")
+	resp, err := s.historyEngine.StartWorkflowExecution(startRequest)
+	s.Nil(err)
+
+	// The run is terminated before the first fire of the schedule
+	workflowExecution := workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID), RunId: resp.RunId}
+	msBuilder := newMutableStateBuilder(s.config, s.logger)
+	addWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", "testTaskList", []byte("input"), 100,
+		200, "testIdentity")
+	msBuilder.executionInfo.CronSchedule = "@hourly"
+	s.terminateAndDeleteBeforeStartRecorded(domainID, msBuilder)
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_InvalidCronSchedule() {
+	startRequest := s.newStartRequest("domainId", "wId", "requestId", nil)
+	startRequest.StartRequest.CronSchedule = common.StringPtr("invalid")
+	resp, err := s.historyEngine.StartWorkflowExecution(startRequest)
+	s.Nil(resp)
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_DelayStart() {
+	domainID := "domainId"
+	workflowID := "wId"
+	delayStart := 30 * time.Second
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		nil, &workflow.EntityNotExistsError{}).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	var backoffTimer persistence.Task
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(
+		func(request *persistence.CreateWorkflowExecutionRequest) bool {
+			// The start is recorded in visibility right away, while the first decision waits for the backoff timer
+			if len(request.TransferTasks) != 1 ||
+				request.TransferTasks[0].GetType() != persistence.TransferTaskTypeRecordWorkflowStarted ||
+				request.DecisionScheduleID != emptyEventID {
+				return false
+			}
+			for _, task := range request.TimerTasks {
+				if task.GetType() == persistence.TaskTypeWorkflowBackoffTimer {
+					backoffTimer = task
+					return true
+				}
+			}
+			return false
+		})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
+
+	startRequest := s.newStartRequest(domainID, workflowID, "requestId", nil)
+	startRequest.StartRequest.DelayStartSeconds = common.Int32Ptr(int32(delayStart / time.Second))
+	start := time.Now()
+	resp, err := s.historyEngine.StartWorkflowExecution(startRequest)
+	s.Nil(err)
+	s.NotNil(resp.RunId)
+	s.NotNil(backoffTimer)
+	visibilityTimestamp := persistence.GetVisibilityTSFrom(backoffTimer)
+	s.False(visibilityTimestamp.Before(start.Add(delayStart)))
+	s.True(visibilityTimestamp.Before(time.Now().Add(delayStart + time.Second)))
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_DelayStartTerminatedBeforeStartRecorded() {
+	domainID := "domainId"
+	workflowID := "wId"
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		nil, &workflow.EntityNotExistsError{}).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(
+		&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
+
+	startRequest := s.newStartRequest(domainID, workflowID, "requestId", nil)
+	startRequest.StartRequest.DelayStartSeconds = common.Int32Ptr(30)
+	resp, err := s.historyEngine.StartWorkflowExecution(startRequest)
+	s.Nil(err)
+
+	// The run is terminated during the delay, before its first decision is scheduled
+	workflowExecution := workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID), RunId: resp.RunId}
+	msBuilder := newMutableStateBuilder(s.config, s.logger)
+	addWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", "testTaskList", []byte("input"), 100,
+		200, "testIdentity")
+	s.terminateAndDeleteBeforeStartRecorded(domainID, msBuilder)
+}
+
+func (s *engine2Suite) TestStartWorkflowExecution_InvalidDelayStart() {
+	startRequest := s.newStartRequest("domainId", "wId", "requestId", nil)
+	startRequest.StartRequest.DelayStartSeconds = common.Int32Ptr(-1)
+	resp, err := s.historyEngine.StartWorkflowExecution(startRequest)
+	s.Nil(resp)
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engine2Suite) newStartRequest(domainID, workflowID, requestID string,
+	policy *workflow.WorkflowIdReusePolicy) *h.StartWorkflowExecutionRequest {
+	return &h.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			Domain:                              common.StringPtr(domainID),
+			WorkflowId:                          common.StringPtr(workflowID),
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr("testTaskList")},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(200),
+			Identity:                            common.StringPtr("testIdentity"),
+			RequestId:                           common.StringPtr(requestID),
+			WorkflowIdReusePolicy:               policy,
+		},
+	}
+}
+
+func (s *engine2Suite) newSignalWithStartRequest(domainID, workflowID, tl,
+	identity string) *h.SignalWithStartWorkflowExecutionRequest {
+	return &h.SignalWithStartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalWithStartRequest: &workflow.SignalWithStartWorkflowExecutionRequest{
+			Domain:                              common.StringPtr(domainID),
+			WorkflowId:                          common.StringPtr(workflowID),
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr(tl)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(200),
+			Identity:                            common.StringPtr(identity),
+			SignalName:                          common.StringPtr("signal"),
+			SignalInput:                         []byte("signal input"),
+		},
+	}
+}
+
+// terminateAndDeleteBeforeStartRecorded terminates the run of msBuilder and processes its delete task before the
+// task recording its start in visibility, which must then complete without recording anything
+func (s *engine2Suite) terminateAndDeleteBeforeStartRecorded(domainID string, msBuilder *mutableStateBuilder) {
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(msBuilder.executionInfo.WorkflowID),
+		RunId:      common.StringPtr(msBuilder.executionInfo.RunID),
+	}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{State: createMutableState(msBuilder)}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(&persistence.GetDomainResponse{
+		Info:   &persistence.DomainInfo{ID: domainID, Name: "domain"},
+		Config: &persistence.DomainConfig{Retention: 1},
+	}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.historyEngine.TerminateWorkflowExecution(&h.TerminateWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		TerminateRequest: &workflow.TerminateWorkflowExecutionRequest{
+			WorkflowExecution: &execution,
+			Reason:            common.StringPtr("terminate"),
+			Identity:          common.StringPtr("identity"),
+		},
+	})
+	s.Nil(err)
+
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteWorkflowExecution", mock.Anything).Return(nil).Once()
+	txProcessor := s.historyEngine.txProcessor.(*transferQueueProcessorImpl)
+	s.Nil(txProcessor.processDeleteExecution(&persistence.TransferTaskInfo{
+		DomainID:   domainID,
+		WorkflowID: *execution.WorkflowId,
+		RunID:      *execution.RunId,
+		TaskType:   persistence.TransferTaskTypeDeleteExecution,
+	}))
+
+	recordStartedTask := &persistence.TransferTaskInfo{
+		DomainID:   domainID,
+		WorkflowID: *execution.WorkflowId,
+		RunID:      *execution.RunId,
+		TaskType:   persistence.TransferTaskTypeRecordWorkflowStarted,
+	}
+	s.Nil(txProcessor.processRecordWorkflowStarted(recordStartedTask))
+
+	// A host which has not cached the run, for example after the shard moved, no longer finds its mutable state
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, &workflow.EntityNotExistsError{}).Once()
+	otherProcessor := newTransferQueueProcessor(s.historyEngine.shard, s.mockVisibilityMgr, s.mockMatchingClient,
+		s.mockHistoryClient, newHistoryCache(s.historyEngine.shard, s.logger),
+		s.historyEngine.domainCache).(*transferQueueProcessorImpl)
+	s.Nil(otherProcessor.processRecordWorkflowStarted(recordStartedTask))
+}
+
 func (s *engine2Suite) createExecutionStartedState(we workflow.WorkflowExecution, tl, identity string,
 	startDecision bool) *mutableStateBuilder {
 	msBuilder := newMutableStateBuilder(s.config, s.logger)
@@ -21,6 +21,8 @@
 package history
 
 import (
+	"context"
+
 	h "github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
@@ -34,8 +36,12 @@ type (
 		// TODO: Convert workflow.WorkflowExecution to pointer all over the place
 		StartWorkflowExecution(request *h.StartWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse,
 			error)
-		GetWorkflowExecutionNextEventID(
+		GetWorkflowExecutionNextEventID(ctx context.Context,
 			request *h.GetWorkflowExecutionNextEventIDRequest) (*h.GetWorkflowExecutionNextEventIDResponse, error)
+		DescribeWorkflowExecution(
+			request *h.DescribeWorkflowExecutionRequest) (*workflow.DescribeWorkflowExecutionResponse, error)
+		DescribeMutableState(request *h.DescribeMutableStateRequest) (*h.DescribeMutableStateResponse, error)
+		DescribeShard() *workflow.DescribeShardResponse
 		RecordDecisionTaskStarted(request *h.RecordDecisionTaskStartedRequest) (*h.RecordDecisionTaskStartedResponse, error)
 		RecordActivityTaskStarted(request *h.RecordActivityTaskStartedRequest) (*h.RecordActivityTaskStartedResponse, error)
 		RespondDecisionTaskCompleted(request *h.RespondDecisionTaskCompletedRequest) error
@@ -45,7 +51,10 @@ type (
 		RecordActivityTaskHeartbeat(request *h.RecordActivityTaskHeartbeatRequest) (*workflow.RecordActivityTaskHeartbeatResponse, error)
 		RequestCancelWorkflowExecution(request *h.RequestCancelWorkflowExecutionRequest) error
 		SignalWorkflowExecution(request *h.SignalWorkflowExecutionRequest) error
+		SignalWithStartWorkflowExecution(request *h.SignalWithStartWorkflowExecutionRequest) (
+			*workflow.StartWorkflowExecutionResponse, error)
 		TerminateWorkflowExecution(request *h.TerminateWorkflowExecutionRequest) error
+		ResetWorkflowExecution(request *h.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error)
 		ScheduleDecisionTask(request *h.ScheduleDecisionTaskRequest) error
 		RecordChildExecutionCompleted(request *h.RecordChildExecutionCompletedRequest) error
 	}
@@ -21,10 +21,12 @@
 package history
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	log "github.com/sirupsen/logrus"
@@ -38,6 +40,7 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -75,7 +78,7 @@ func (s *engineSuite) SetupSuite() {
 	}
 
 	s.logger = bark.NewLoggerFromLogrus(log.New())
-	s.config = NewConfig(1)
+	s.config = NewConfig(dynamicconfig.NewNopCollection(), 1)
 }
 
 func (s *engineSuite) TearDownSuite() {
@@ -114,16 +117,17 @@ func (s *engineSuite) SetupTest() {
 	domainCache := cache.NewDomainCache(s.mockMetadataMgr, s.logger)
 	txProcessor := newTransferQueueProcessor(mockShard, s.mockVisibilityMgr, s.mockMatchingClient, s.mockHistoryClient, historyCache, domainCache)
 	h := &historyEngineImpl{
-		shard:              mockShard,
-		executionManager:   s.mockExecutionMgr,
-		historyMgr:         s.mockHistoryMgr,
-		txProcessor:        txProcessor,
-		historyCache:       historyCache,
-		domainCache:        domainCache,
-		logger:             s.logger,
-		metricsClient:      metrics.NewClient(tally.NoopScope, metrics.History),
-		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
-		hSerializerFactory: persistence.NewHistorySerializerFactory(),
+		shard:                mockShard,
+		executionManager:     s.mockExecutionMgr,
+		historyMgr:           s.mockHistoryMgr,
+		txProcessor:          txProcessor,
+		historyCache:         historyCache,
+		historyEventNotifier: newHistoryEventNotifier(),
+		domainCache:          domainCache,
+		logger:               s.logger,
+		metricsClient:        metrics.NewClient(tally.NoopScope, metrics.History),
+		tokenSerializer:      common.NewJSONTaskTokenSerializer(),
+		hSerializerFactory:   persistence.NewHistorySerializerFactory(),
 	}
 	h.timerProcessor = newTimerQueueProcessor(mockShard, h, s.mockExecutionMgr, s.logger)
 	s.mockHistoryEngine = h
@@ -762,6 +766,149 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedSingleActivityScheduledDec
 	s.Equal(int32(5), *activity1Attributes.HeartbeatTimeoutSeconds)
 }
 
+func (s *engineSuite) TestRespondDecisionTaskCompletedSignalExternalWorkflow() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+	signalInput := []byte("signal-input")
+	signalControl := []byte("signal-control")
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, *scheduleEvent.EventId, tl, identity)
+
+	decisions := []*workflow.Decision{{
+		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeSignalExternalWorkflowExecution),
+		SignalExternalWorkflowExecutionDecisionAttributes: &workflow.SignalExternalWorkflowExecutionDecisionAttributes{
+			Domain: common.StringPtr("targetDomain"),
+			Execution: &workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr("targetWorkflowId"),
+			},
+			SignalName: common.StringPtr("signal-name"),
+			Input:      signalInput,
+			Control:    signalControl,
+		},
+	}}
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(&persistence.GetDomainResponse{
+		Info:   &persistence.DomainInfo{ID: "targetDomainId", Name: "targetDomain"},
+		Config: &persistence.DomainConfig{Retention: 1},
+	}, nil).Once()
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Run(func(arguments mock.Arguments) {
+		updateRequest = arguments.Get(0).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
+			Decisions: decisions,
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(6), executionBuilder.executionInfo.NextEventID)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+
+	si, ok := executionBuilder.GetSignalInfo(int64(5))
+	s.True(ok)
+	s.Equal("signal-name", si.SignalName)
+	s.Equal(signalInput, si.Input)
+	s.Equal(signalControl, si.Control)
+
+	s.NotNil(updateRequest)
+	s.Equal(1, len(updateRequest.UpsertSignalInfos))
+	s.Equal(1, len(updateRequest.TransferTasks))
+	signalTask, ok := updateRequest.TransferTasks[0].(*persistence.SignalExecutionTask)
+	s.True(ok)
+	s.Equal("targetDomainId", signalTask.TargetDomainID)
+	s.Equal("targetWorkflowId", signalTask.TargetWorkflowID)
+	s.Equal("", signalTask.TargetRunID)
+	s.Equal(int64(5), signalTask.InitiatedID)
+}
+
+func (s *engineSuite) TestRespondDecisionTaskCompletedStickyExecution() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	stickyTl := "stickyTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, *scheduleEvent.EventId, tl, identity)
+	// Signal received while the decision is running, so a new decision is scheduled on completion
+	msBuilder.AddWorkflowExecutionSignaled(&workflow.SignalWorkflowExecutionRequest{
+		SignalName: common.StringPtr("signal-name"),
+	})
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Run(func(arguments mock.Arguments) {
+		updateRequest = arguments.Get(0).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
+			Identity:  &identity,
+			StickyAttributes: &workflow.StickyExecutionAttributes{
+				WorkerTaskList:                &workflow.TaskList{Name: common.StringPtr(stickyTl)},
+				ScheduleToStartTimeoutSeconds: common.Int32Ptr(5),
+			},
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(7), executionBuilder.executionInfo.NextEventID)
+	s.Equal(stickyTl, executionBuilder.executionInfo.StickyTaskList)
+	s.Equal(int32(5), executionBuilder.executionInfo.StickyScheduleToStartTimeout)
+	s.True(executionBuilder.HasPendingDecisionTask())
+
+	s.NotNil(updateRequest)
+	s.Equal(1, len(updateRequest.TransferTasks))
+	decisionTask, ok := updateRequest.TransferTasks[0].(*persistence.DecisionTask)
+	s.True(ok)
+	s.Equal(stickyTl, decisionTask.TaskList)
+	s.Equal(int64(6), decisionTask.ScheduleID)
+	s.Equal(1, len(updateRequest.TimerTasks))
+	timeoutTask, ok := updateRequest.TimerTasks[0].(*persistence.DecisionTimeoutTask)
+	s.True(ok)
+	s.Equal(int64(6), timeoutTask.EventID)
+	s.Equal(int(workflow.TimeoutTypeScheduleToStart), timeoutTask.TimeoutType)
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompletedCompleteWorkflowSuccess() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
@@ -817,6 +964,121 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedCompleteWorkflowSuccess()
 	s.False(executionBuilder.HasPendingDecisionTask())
 }
 
+func (s *engineSuite) TestRespondDecisionTaskCompletedUpsertWorkflowSearchAttributes() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+	context := []byte("context")
+	searchAttributes := map[string][]byte{"CustomKeywordField": []byte(`"keyword"`)}
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, *scheduleEvent.EventId, tl, identity)
+
+	decisions := []*workflow.Decision{{
+		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeUpsertWorkflowSearchAttributes),
+		UpsertWorkflowSearchAttributesDecisionAttributes: &workflow.UpsertWorkflowSearchAttributesDecisionAttributes{
+			SearchAttributes: &workflow.SearchAttributes{IndexedFields: searchAttributes},
+		},
+	}}
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(
+		request *persistence.UpdateWorkflowExecutionRequest) bool {
+		for _, task := range request.TransferTasks {
+			if task.GetType() == persistence.TransferTaskTypeUpsertWorkflowSearchAttributes {
+				return true
+			}
+		}
+		return false
+	})).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: context,
+			Identity:         &identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(6), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(searchAttributes, executionBuilder.executionInfo.SearchAttributes)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+	s.False(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestRespondDecisionTaskCompletedUpsertWorkflowSearchAttributesInvalid() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+	context := []byte("context")
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, *scheduleEvent.EventId, tl, identity)
+
+	decisions := []*workflow.Decision{{
+		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeUpsertWorkflowSearchAttributes),
+		UpsertWorkflowSearchAttributesDecisionAttributes: &workflow.UpsertWorkflowSearchAttributesDecisionAttributes{
+			SearchAttributes: &workflow.SearchAttributes{
+				IndexedFields: map[string][]byte{"UnknownField": []byte(`"value"`)},
+			},
+		},
+	}}
+
+	for i := 0; i < 2; i++ {
+		ms := createMutableState(msBuilder)
+		gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	}
+
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: context,
+			Identity:         &identity,
+		},
+	})
+	s.NotNil(err)
+	s.IsType(&workflow.BadRequestError{}, err)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Nil(executionBuilder.executionInfo.SearchAttributes)
+	s.True(executionBuilder.HasPendingDecisionTask())
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompletedFailWorkflowSuccess() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
@@ -874,6 +1136,179 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedFailWorkflowSuccess() {
 	s.False(executionBuilder.HasPendingDecisionTask())
 }
 
+func (s *engineSuite) TestRespondDecisionTaskCompletedCompleteWorkflowWithCronSchedule() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+	input := []byte("input")
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, input, 100, 200, identity)
+	msBuilder.executionInfo.CronSchedule = "@hourly"
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, *scheduleEvent.EventId, tl, identity)
+	serializedHistory, _ := msBuilder.hBuilder.Serialize()
+
+	decisions := []*workflow.Decision{{
+		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeCompleteWorkflowExecution),
+		CompleteWorkflowExecutionDecisionAttributes: &workflow.CompleteWorkflowExecutionDecisionAttributes{
+			Result: []byte("success"),
+		},
+	}}
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*serializedHistory},
+		}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Twice()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(
+		func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+			newRun := request.ContinueAsNew
+			return newRun != nil && newRun.CronSchedule == "@hourly" && len(newRun.TransferTasks) == 1 &&
+				newRun.TransferTasks[0].GetType() == persistence.TransferTaskTypeRecordWorkflowStarted &&
+				len(newRun.TimerTasks) == 2 &&
+				newRun.TimerTasks[0].GetType() == persistence.TaskTypeWorkflowBackoffTimer &&
+				newRun.TimerTasks[1].GetType() == persistence.TaskTypeWorkflowTimeout
+		})).Return(nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{Config: &persistence.DomainConfig{Retention: 1}}, nil).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
+			Decisions: decisions,
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(persistence.WorkflowStateCompleted, executionBuilder.executionInfo.State)
+	s.Equal(persistence.WorkflowCloseStatusContinuedAsNew, executionBuilder.executionInfo.CloseStatus)
+	s.False(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestRespondDecisionTaskCompletedFailWorkflowWithRetryPolicy() {
+	domainID := "domainId"
+	tl := "testTaskList"
+	identity := "testIdentity"
+	retryPolicy := &workflow.RetryPolicy{
+		InitialIntervalInSeconds: common.Int32Ptr(10),
+		BackoffCoefficient:       common.Float64Ptr(2),
+		MaximumAttempts:          common.Int32Ptr(3),
+		NonRetriableErrorReasons: []string{"bad-input"},
+	}
+
+	testCases := []struct {
+		attempt int32
+		reason  string
+		retried bool
+	}{
+		{0, "some-error", true},
+		{1, "some-error", true},
+		{2, "some-error", false},
+		{0, "bad-input", false},
+	}
+
+	for _, tc := range testCases {
+		we := workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr("wId"),
+			RunId:      common.StringPtr(uuid.New()),
+		}
+		taskToken, _ := json.Marshal(&common.TaskToken{
+			WorkflowID: *we.WorkflowId,
+			RunID:      *we.RunId,
+			ScheduleID: 2,
+		})
+
+		msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+		msBuilder.AddWorkflowExecutionStartedEvent(domainID, we, &workflow.StartWorkflowExecutionRequest{
+			WorkflowId:                          we.WorkflowId,
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr(tl)},
+			Input:                               []byte("input"),
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(200),
+			Identity:                            common.StringPtr(identity),
+			RetryPolicy:                         retryPolicy,
+		})
+		msBuilder.executionInfo.Attempt = tc.attempt
+		scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+		addDecisionTaskStartedEvent(msBuilder, *scheduleEvent.EventId, tl, identity)
+		serializedHistory, _ := msBuilder.hBuilder.Serialize()
+
+		decisions := []*workflow.Decision{{
+			DecisionType: common.DecisionTypePtr(workflow.DecisionTypeFailWorkflowExecution),
+			FailWorkflowExecutionDecisionAttributes: &workflow.FailWorkflowExecutionDecisionAttributes{
+				Reason:  common.StringPtr(tc.reason),
+				Details: []byte("details"),
+			},
+		}}
+
+		ms := createMutableState(msBuilder)
+		gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+		s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+			&persistence.GetWorkflowExecutionHistoryResponse{
+				Events: []persistence.SerializedHistoryEventBatch{*serializedHistory},
+			}, nil).Once()
+		expectedAttempt := tc.attempt + 1
+		expectedBackoff := time.Duration(10*(1<<uint(tc.attempt))) * time.Second
+		if tc.retried {
+			s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Twice()
+			s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(
+				func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+					newRun := request.ContinueAsNew
+					if newRun == nil || newRun.Attempt != expectedAttempt || !newRun.HasRetryPolicy ||
+						len(newRun.TransferTasks) != 1 || len(newRun.TimerTasks) == 0 {
+						return false
+					}
+					backoffTimer, ok := newRun.TimerTasks[0].(*persistence.WorkflowBackoffTimerTask)
+					return ok && !backoffTimer.VisibilityTimestamp.After(time.Now().Add(expectedBackoff))
+				})).Return(nil).Once()
+		} else {
+			s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+			s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(
+				func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+					return request.ContinueAsNew == nil
+				})).Return(nil).Once()
+		}
+		s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+			&persistence.GetDomainResponse{Config: &persistence.DomainConfig{Retention: 1}}, nil).Once()
+
+		err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+			DomainUUID: common.StringPtr(domainID),
+			CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+				TaskToken: taskToken,
+				Decisions: decisions,
+				Identity:  &identity,
+			},
+		})
+		s.Nil(err, s.printHistory(msBuilder))
+		executionBuilder := s.getBuilder(domainID, we)
+		s.Equal(persistence.WorkflowStateCompleted, executionBuilder.executionInfo.State)
+		if tc.retried {
+			s.Equal(persistence.WorkflowCloseStatusContinuedAsNew, executionBuilder.executionInfo.CloseStatus)
+		} else {
+			s.Equal(persistence.WorkflowCloseStatusFailed, executionBuilder.executionInfo.CloseStatus)
+		}
+	}
+}
+
 func (s *engineSuite) TestRespondActivityTaskCompletedInvalidToken() {
 	domainID := "domainId"
 	invalidToken, _ := json.Marshal("bad token")
@@ -1571,7 +2006,174 @@ func (s *engineSuite) TestRespondActivityTaskFailedMaxAttemptsExceeded() {
 	s.Equal(ErrMaxAttemptsExceeded, err)
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedSuccess() {
+func (s *engineSuite) TestRespondActivityTaskFailedSuccess() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+	failReason := "failed"
+	failDetails := []byte("fail details.")
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, *decisionScheduledEvent.EventId, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, *decisionScheduledEvent.EventId,
+		*decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, activityID,
+		activityType, tl, activityInput, 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, *activityScheduledEvent.EventId, tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Reason:    &failReason,
+			Details:   failDetails,
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(9), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+
+	s.True(executionBuilder.HasPendingDecisionTask())
+	di, ok := executionBuilder.GetPendingDecision(int64(8))
+	s.True(ok)
+	s.Equal(int32(200), di.DecisionTimeout)
+	s.Equal(int64(8), di.ScheduleID)
+	s.Equal(emptyEventID, di.StartedID)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedRetry() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	failReason := "failed"
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, *decisionScheduledEvent.EventId, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, *decisionScheduledEvent.EventId,
+		*decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEventWithRetry(msBuilder, *decisionCompletedEvent.EventId,
+		"activity1_id", "activity_type1", tl, []byte("input1"), 100, 10, 5, newTestRetryPolicy())
+	startedEvent := addActivityTaskStartedEvent(msBuilder, *activityScheduledEvent.EventId, tl, identity)
+	// Started event of an activity with a retry policy is not written to history until the activity is closed
+	s.Equal(transientEventID, *startedEvent.EventId)
+	s.Equal(int64(6), msBuilder.GetNextEventID())
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	isRetry := func(req *persistence.UpdateWorkflowExecutionRequest) bool {
+		return len(req.TransferTasks) == 0 && len(req.TimerTasks) == 1 &&
+			req.TimerTasks[0].GetType() == persistence.TaskTypeActivityRetryTimer
+	}
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(isRetry)).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Reason:    &failReason,
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(6), executionBuilder.executionInfo.NextEventID)
+	s.False(executionBuilder.HasPendingDecisionTask())
+	ai, ok := executionBuilder.GetActivityInfo(5)
+	s.True(ok)
+	s.Equal(int32(1), ai.Attempt)
+	s.Equal(emptyEventID, ai.StartedID)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedNonRetriable() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	failReason := "bad-input"
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, *decisionScheduledEvent.EventId, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, *decisionScheduledEvent.EventId,
+		*decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEventWithRetry(msBuilder, *decisionCompletedEvent.EventId,
+		"activity1_id", "activity_type1", tl, []byte("input1"), 100, 10, 5, newTestRetryPolicy())
+	addActivityTaskStartedEvent(msBuilder, *activityScheduledEvent.EventId, tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Reason:    &failReason,
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(domainID, we)
+	// Started, failed and decision scheduled events are added
+	s.Equal(int64(9), executionBuilder.executionInfo.NextEventID)
+	_, ok := executionBuilder.GetActivityInfo(5)
+	s.False(ok)
+	s.True(executionBuilder.HasPendingDecisionTask())
+	di, ok := executionBuilder.GetPendingDecision(int64(8))
+	s.True(ok)
+	s.Equal(int64(8), di.ScheduleID)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedStaleAttempt() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
@@ -1579,16 +2181,13 @@ func (s *engineSuite) TestRespondActivityTaskFailedSuccess() {
 	}
 	tl := "testTaskList"
 	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: *we.WorkflowId,
-		RunID:      *we.RunId,
-		ScheduleID: 5,
+		WorkflowID:      *we.WorkflowId,
+		RunID:           *we.RunId,
+		ScheduleID:      5,
+		ScheduleAttempt: 1,
 	})
 	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
 	failReason := "failed"
-	failDetails := []byte("fail details.")
 
 	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
@@ -1596,38 +2195,24 @@ func (s *engineSuite) TestRespondActivityTaskFailedSuccess() {
 	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, *decisionScheduledEvent.EventId, tl, identity)
 	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, *decisionScheduledEvent.EventId,
 		*decisionStartedEvent.EventId, nil, identity)
-	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, activityID,
-		activityType, tl, activityInput, 100, 10, 5)
+	activityScheduledEvent, _ := addActivityTaskScheduledEventWithRetry(msBuilder, *decisionCompletedEvent.EventId,
+		"activity1_id", "activity_type1", tl, []byte("input1"), 100, 10, 5, newTestRetryPolicy())
 	addActivityTaskStartedEvent(msBuilder, *activityScheduledEvent.EventId, tl, identity)
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
 
 	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
 		DomainUUID: common.StringPtr(domainID),
 		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
 			TaskToken: taskToken,
 			Reason:    &failReason,
-			Details:   failDetails,
 			Identity:  &identity,
 		},
 	})
-	s.Nil(err)
-	executionBuilder := s.getBuilder(domainID, we)
-	s.Equal(int64(9), executionBuilder.executionInfo.NextEventID)
-	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
-
-	s.True(executionBuilder.HasPendingDecisionTask())
-	di, ok := executionBuilder.GetPendingDecision(int64(8))
-	s.True(ok)
-	s.Equal(int32(200), di.DecisionTimeout)
-	s.Equal(int64(8), di.ScheduleID)
-	s.Equal(emptyEventID, di.StartedID)
+	s.IsType(&workflow.EntityNotExistsError{}, err)
 }
 
 func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_NoTimer() {
@@ -2349,6 +2934,418 @@ func (s *engineSuite) TestCancelTimer_RespondDecisionTaskCompleted_NoStartTimer(
 	s.False(executionBuilder.HasPendingDecisionTask())
 }
 
+func (s *engineSuite) TestResetWorkflowExecution() {
+	domainID := "domainId"
+	runID := uuid.New()
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(runID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, *di.EventId, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, *di.EventId, *di.EventId+1, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, "activity1",
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	msBuilder.AddWorkflowExecutionSignaled(&workflow.SignalWorkflowExecutionRequest{
+		SignalName: common.StringPtr("signal1"),
+		Identity:   common.StringPtr(identity),
+	})
+	di, _ = addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, *di.EventId, tl, identity)
+	resetEvent := addDecisionTaskCompletedEvent(msBuilder, *di.EventId, *di.EventId+1, nil, identity)
+	msBuilder.AddWorkflowExecutionSignaled(&workflow.SignalWorkflowExecutionRequest{
+		SignalName: common.StringPtr("signal2"),
+		Identity:   common.StringPtr(identity),
+	})
+	serializedHistory, _ := msBuilder.hBuilder.Serialize()
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*serializedHistory},
+		}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Twice()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(
+		func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+			newRun := request.ContinueAsNew
+			return newRun != nil && *newRun.Execution.RunId != runID &&
+				newRun.NextEventID == *resetEvent.EventId+3 && newRun.DecisionScheduleID == *resetEvent.EventId+2 &&
+				len(newRun.TransferTasks) == 2 &&
+				newRun.TransferTasks[0].GetType() == persistence.TransferTaskTypeDecisionTask &&
+				newRun.TransferTasks[1].GetType() == persistence.TransferTaskTypeRecordWorkflowStarted
+		})).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(
+		func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+			return request.ContinueAsNew == nil && request.ExecutionInfo.RunID != runID &&
+				len(request.UpsertActivityInfos) == 1 &&
+				request.UpsertActivityInfos[0].ScheduleID == *activityScheduledEvent.EventId &&
+				len(request.TransferTasks) == 1 &&
+				request.TransferTasks[0].GetType() == persistence.TransferTaskTypeActivityTask
+		})).Return(nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{Config: &persistence.DomainConfig{Retention: 1}}, nil).Once()
+
+	resp, err := s.mockHistoryEngine.ResetWorkflowExecution(&history.ResetWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+			Domain:                common.StringPtr(domainID),
+			WorkflowExecution:     &we,
+			Reason:                common.StringPtr("bad decider"),
+			DecisionFinishEventId: resetEvent.EventId,
+			Identity:              common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+	s.NotEqual(runID, *resp.RunId)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(persistence.WorkflowStateCompleted, executionBuilder.executionInfo.State)
+	s.Equal(persistence.WorkflowCloseStatusTerminated, executionBuilder.executionInfo.CloseStatus)
+}
+
+func (s *engineSuite) TestResetWorkflowExecution_NotDecisionFinishEvent() {
+	domainID := "domainId"
+	runID := uuid.New()
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(runID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di, _ := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, *di.EventId, tl, identity)
+	serializedHistory, _ := msBuilder.hBuilder.Serialize()
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*serializedHistory},
+		}, nil).Once()
+
+	_, err := s.mockHistoryEngine.ResetWorkflowExecution(&history.ResetWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+			Domain:                common.StringPtr(domainID),
+			WorkflowExecution:     &we,
+			DecisionFinishEventId: startedEvent.EventId,
+		},
+	})
+	s.NotNil(err)
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engineSuite) TestResetWorkflowExecution_EventIDOutOfRange() {
+	domainID := "domainId"
+	runID := uuid.New()
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(runID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	_, err := s.mockHistoryEngine.ResetWorkflowExecution(&history.ResetWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+			Domain:                common.StringPtr(domainID),
+			WorkflowExecution:     &we,
+			DecisionFinishEventId: common.Int64Ptr(10),
+		},
+	})
+	s.NotNil(err)
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engineSuite) TestDescribeWorkflowExecution() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	_, di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		*decisionStartedEvent.EventId, nil, identity)
+	activity1ScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId,
+		"activity1", "activity_type1", tl, []byte("input1"), 100, 10, 5)
+	addActivityTaskScheduledEvent(msBuilder, *decisionCompletedEvent.EventId, "activity2", "activity_type2", tl,
+		[]byte("input2"), 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, *activity1ScheduledEvent.EventId, tl, identity)
+	childInitiatedEvent, _ := addStartChildWorkflowExecutionInitiatedEvent(msBuilder, *decisionCompletedEvent.EventId,
+		uuid.New(), domainID, "childWId", "childType", tl, nil, 100, 10)
+	addDecisionTaskScheduledEvent(msBuilder)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	resp, err := s.mockHistoryEngine.DescribeWorkflowExecution(&history.DescribeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request: &workflow.DescribeWorkflowExecutionRequest{
+			Domain:    common.StringPtr("domain"),
+			Execution: &we,
+		},
+	})
+	s.Nil(err)
+	s.Equal(tl, *resp.ExecutionConfiguration.TaskList.Name)
+	s.Equal(int32(200), *resp.ExecutionConfiguration.TaskStartToCloseTimeoutSeconds)
+	s.Equal("wId", *resp.WorkflowExecutionInfo.Execution.WorkflowId)
+	s.Equal("wType", *resp.WorkflowExecutionInfo.Type.Name)
+	s.Equal(msBuilder.GetNextEventID()-common.FirstEventID, *resp.WorkflowExecutionInfo.HistoryLength)
+	s.Nil(resp.WorkflowExecutionInfo.CloseStatus)
+
+	s.Equal(2, len(resp.PendingActivities))
+	activities := make(map[string]*workflow.PendingActivityInfo)
+	for _, pa := range resp.PendingActivities {
+		activities[*pa.ActivityID] = pa
+	}
+	s.Equal("activity_type1", *activities["activity1"].ActivityType.Name)
+	s.Equal(workflow.PendingActivityStateStarted, *activities["activity1"].State)
+	s.NotNil(activities["activity1"].LastStartedTimestamp)
+	s.Equal("activity_type2", *activities["activity2"].ActivityType.Name)
+	s.Equal(workflow.PendingActivityStateScheduled, *activities["activity2"].State)
+	s.Nil(activities["activity2"].LastStartedTimestamp)
+
+	s.Equal(1, len(resp.PendingChildren))
+	s.Equal("childWId", *resp.PendingChildren[0].WorkflowID)
+	s.Equal("childType", *resp.PendingChildren[0].WorkflowTypeName)
+	s.Equal(*childInitiatedEvent.EventId, *resp.PendingChildren[0].InitiatedID)
+	s.Nil(resp.PendingChildren[0].RunID)
+
+	s.NotNil(resp.PendingDecision)
+	s.Equal(workflow.PendingDecisionStateScheduled, *resp.PendingDecision.State)
+}
+
+func (s *engineSuite) TestGetWorkflowExecutionNextEventID() {
+	domainID := "domainId"
+	runID := uuid.New()
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(runID),
+	}
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 200, "identity")
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	// nextEventID does not match the expected one, so the call returns immediately
+	resp, err := s.mockHistoryEngine.GetWorkflowExecutionNextEventID(context.Background(),
+		&history.GetWorkflowExecutionNextEventIDRequest{
+			DomainUUID:          common.StringPtr(domainID),
+			Execution:           &we,
+			ExpectedNextEventId: common.Int64Ptr(2),
+		})
+	s.Nil(err)
+	s.Equal(int64(3), *resp.EventId)
+	s.Equal(runID, *resp.RunId)
+	s.True(*resp.IsWorkflowRunning)
+}
+
+func (s *engineSuite) TestGetWorkflowExecutionNextEventID_WaitForNewEvent() {
+	domainID := "domainId"
+	runID := uuid.New()
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(runID),
+	}
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 200, "identity")
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		s.mockHistoryEngine.historyEventNotifier.NotifyNewHistoryEvent(&historyEventNotification{
+			workflowIdentifier: newWorkflowIdentifier(domainID, *we.WorkflowId, *we.RunId),
+			nextEventID:        5,
+			isWorkflowRunning:  true,
+		})
+	}()
+
+	resp, err := s.mockHistoryEngine.GetWorkflowExecutionNextEventID(context.Background(),
+		&history.GetWorkflowExecutionNextEventIDRequest{
+			DomainUUID:          common.StringPtr(domainID),
+			Execution:           &we,
+			ExpectedNextEventId: common.Int64Ptr(3),
+		})
+	s.Nil(err)
+	s.Equal(int64(5), *resp.EventId)
+	s.True(*resp.IsWorkflowRunning)
+}
+
+func (s *engineSuite) TestGetWorkflowExecutionNextEventID_WaitForClose() {
+	domainID := "domainId"
+	runID := uuid.New()
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(runID),
+	}
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 200, "identity")
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		s.mockHistoryEngine.historyEventNotifier.NotifyNewHistoryEvent(&historyEventNotification{
+			workflowIdentifier: newWorkflowIdentifier(domainID, *we.WorkflowId, *we.RunId),
+			nextEventID:        5,
+			isWorkflowRunning:  true,
+		})
+		time.Sleep(100 * time.Millisecond)
+		s.mockHistoryEngine.historyEventNotifier.NotifyNewHistoryEvent(&historyEventNotification{
+			workflowIdentifier: newWorkflowIdentifier(domainID, *we.WorkflowId, *we.RunId),
+			nextEventID:        8,
+			isWorkflowRunning:  false,
+		})
+	}()
+
+	resp, err := s.mockHistoryEngine.GetWorkflowExecutionNextEventID(context.Background(),
+		&history.GetWorkflowExecutionNextEventIDRequest{
+			DomainUUID:          common.StringPtr(domainID),
+			Execution:           &we,
+			ExpectedNextEventId: common.Int64Ptr(common.EndEventID),
+		})
+	s.Nil(err)
+	s.Equal(int64(8), *resp.EventId)
+	s.False(*resp.IsWorkflowRunning)
+}
+
+func (s *engineSuite) TestGetWorkflowExecutionNextEventID_WaitTimeout() {
+	domainID := "domainId"
+	runID := uuid.New()
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(runID),
+	}
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 200, "identity")
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	s.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFn(100 * time.Millisecond)
+	resp, err := s.mockHistoryEngine.GetWorkflowExecutionNextEventID(context.Background(),
+		&history.GetWorkflowExecutionNextEventIDRequest{
+			DomainUUID:          common.StringPtr(domainID),
+			Execution:           &we,
+			ExpectedNextEventId: common.Int64Ptr(3),
+		})
+	s.Nil(err)
+	s.Equal(int64(3), *resp.EventId)
+	s.True(*resp.IsWorkflowRunning)
+}
+
+func (s *engineSuite) TestDescribeMutableState_NotInCache() {
+	domainID := "domainId"
+	runID := uuid.New()
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(runID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	resp, err := s.mockHistoryEngine.DescribeMutableState(&history.DescribeMutableStateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+	})
+	s.Nil(err)
+	s.Nil(resp.MutableStateInCache)
+	s.NotNil(resp.MutableStateInDatabase)
+
+	stateInDatabase := &persistence.WorkflowMutableState{}
+	s.Nil(json.Unmarshal([]byte(*resp.MutableStateInDatabase), stateInDatabase))
+	s.Equal("wId", stateInDatabase.ExecutionInfo.WorkflowID)
+	s.Equal(runID, stateInDatabase.ExecutionInfo.RunID)
+	s.Equal(tl, stateInDatabase.ExecutionInfo.TaskList)
+}
+
+func (s *engineSuite) TestDescribeMutableState_InCache() {
+	domainID := "domainId"
+	runID := uuid.New()
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(runID),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(s.config, bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Twice()
+
+	context, release, err := s.mockHistoryEngine.historyCache.getOrCreateWorkflowExecution(domainID, we)
+	s.Nil(err)
+	_, err = context.loadWorkflowExecution()
+	s.Nil(err)
+	release()
+
+	resp, err := s.mockHistoryEngine.DescribeMutableState(&history.DescribeMutableStateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+	})
+	s.Nil(err)
+	s.NotNil(resp.MutableStateInCache)
+	s.NotNil(resp.MutableStateInDatabase)
+	s.Equal(*resp.MutableStateInCache, *resp.MutableStateInDatabase)
+}
+
+func (s *engineSuite) TestDescribeShard() {
+	resp := s.mockHistoryEngine.DescribeShard()
+	s.Equal(int32(0), *resp.ShardID)
+	s.Equal(int64(1), *resp.RangeID)
+	s.Equal(int64(0), *resp.TransferAckLevel)
+}
+
 func (s *engineSuite) getBuilder(domainID string, we workflow.WorkflowExecution) *mutableStateBuilder {
 	context, release, err := s.mockHistoryEngine.historyCache.getOrCreateWorkflowExecution(domainID, we)
 	if err != nil {
@@ -2462,6 +3459,31 @@ func addActivityTaskScheduledEvent(builder *mutableStateBuilder, decisionComplet
 	})
 }
 
+func addActivityTaskScheduledEventWithRetry(builder *mutableStateBuilder, decisionCompletedID int64, activityID,
+	activityType, taskList string, input []byte, timeout, queueTimeout, hearbeatTimeout int32,
+	retryPolicy *workflow.RetryPolicy) (*workflow.HistoryEvent, *persistence.ActivityInfo) {
+	return builder.AddActivityTaskScheduledEvent(decisionCompletedID, &workflow.ScheduleActivityTaskDecisionAttributes{
+		ActivityId:                    common.StringPtr(activityID),
+		ActivityType:                  &workflow.ActivityType{Name: common.StringPtr(activityType)},
+		TaskList:                      &workflow.TaskList{Name: common.StringPtr(taskList)},
+		Input:                         input,
+		ScheduleToCloseTimeoutSeconds: common.Int32Ptr(timeout),
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(queueTimeout),
+		HeartbeatTimeoutSeconds:       common.Int32Ptr(hearbeatTimeout),
+		StartToCloseTimeoutSeconds:    common.Int32Ptr(1),
+		RetryPolicy:                   retryPolicy,
+	})
+}
+
+func newTestRetryPolicy() *workflow.RetryPolicy {
+	return &workflow.RetryPolicy{
+		InitialIntervalInSeconds: common.Int32Ptr(1),
+		BackoffCoefficient:       common.Float64Ptr(2),
+		MaximumAttempts:          common.Int32Ptr(3),
+		NonRetriableErrorReasons: []string{"bad-input"},
+	}
+}
+
 func addActivityTaskStartedEvent(builder *mutableStateBuilder, scheduleID int64,
 	taskList, identity string) *workflow.HistoryEvent {
 	ai, _ := builder.GetActivityInfo(scheduleID)
@@ -2513,6 +3535,23 @@ func addRequestCancelInitiatedEvent(builder *mutableStateBuilder, decisionComple
 	return event
 }
 
+func addSignalInitiatedEvent(builder *mutableStateBuilder, decisionCompletedEventID int64,
+	signalRequestID, domain, workflowID, runID, signalName string, input, control []byte) *workflow.HistoryEvent {
+	event, _ := builder.AddSignalExternalWorkflowExecutionInitiatedEvent(decisionCompletedEventID,
+		signalRequestID, &workflow.SignalExternalWorkflowExecutionDecisionAttributes{
+			Domain: common.StringPtr(domain),
+			Execution: &workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr(workflowID),
+				RunId:      common.StringPtr(runID),
+			},
+			SignalName: common.StringPtr(signalName),
+			Input:      input,
+			Control:    control,
+		})
+
+	return event
+}
+
 func addStartChildWorkflowExecutionInitiatedEvent(builder *mutableStateBuilder, decisionCompletedID int64,
 	createRequestID, domain, workflowID, workflowType, tasklist string, input []byte,
 	executionStartToCloseTimeout, taskStartToCloseTimeout int32) (*workflow.HistoryEvent,
@@ -2550,37 +3589,53 @@ func createMutableState(builder *mutableStateBuilder) *persistence.WorkflowMutab
 	for id, info := range builder.pendingTimerInfoIDs {
 		timerInfos[id] = copyTimerInfo(info)
 	}
+	childExecutionInfos := make(map[int64]*persistence.ChildExecutionInfo)
+	for id, info := range builder.pendingChildExecutionInfoIDs {
+		childExecutionInfos[id] = copyChildExecutionInfo(info)
+	}
+	signalInfos := make(map[int64]*persistence.SignalInfo)
+	for id, info := range builder.pendingSignalInfoIDs {
+		signalInfos[id] = copySignalInfo(info)
+	}
 	return &persistence.WorkflowMutableState{
-		ExecutionInfo: info,
-		ActivitInfos:  activityInfos,
-		TimerInfos:    timerInfos,
+		ExecutionInfo:       info,
+		ActivitInfos:        activityInfos,
+		TimerInfos:          timerInfos,
+		ChildExecutionInfos: childExecutionInfos,
+		SignalInfos:         signalInfos,
 	}
 }
 
 func copyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *persistence.WorkflowExecutionInfo {
 	return &persistence.WorkflowExecutionInfo{
-		DomainID:             sourceInfo.DomainID,
-		WorkflowID:           sourceInfo.WorkflowID,
-		RunID:                sourceInfo.RunID,
-		ParentDomainID:       sourceInfo.ParentDomainID,
-		ParentWorkflowID:     sourceInfo.ParentWorkflowID,
-		ParentRunID:          sourceInfo.ParentRunID,
-		InitiatedID:          sourceInfo.InitiatedID,
-		CompletionEvent:      sourceInfo.CompletionEvent,
-		TaskList:             sourceInfo.TaskList,
-		WorkflowTypeName:     sourceInfo.WorkflowTypeName,
-		DecisionTimeoutValue: sourceInfo.DecisionTimeoutValue,
-		ExecutionContext:     sourceInfo.ExecutionContext,
-		State:                sourceInfo.State,
-		CloseStatus:          sourceInfo.CloseStatus,
-		NextEventID:          sourceInfo.NextEventID,
-		LastProcessedEvent:   sourceInfo.LastProcessedEvent,
-		LastUpdatedTimestamp: sourceInfo.LastUpdatedTimestamp,
-		CreateRequestID:      sourceInfo.CreateRequestID,
-		DecisionScheduleID:   sourceInfo.DecisionScheduleID,
-		DecisionStartedID:    sourceInfo.DecisionStartedID,
-		DecisionRequestID:    sourceInfo.DecisionRequestID,
-		DecisionTimeout:      sourceInfo.DecisionTimeout,
+		DomainID:                     sourceInfo.DomainID,
+		WorkflowID:                   sourceInfo.WorkflowID,
+		RunID:                        sourceInfo.RunID,
+		ParentDomainID:               sourceInfo.ParentDomainID,
+		ParentWorkflowID:             sourceInfo.ParentWorkflowID,
+		ParentRunID:                  sourceInfo.ParentRunID,
+		InitiatedID:                  sourceInfo.InitiatedID,
+		CompletionEvent:              sourceInfo.CompletionEvent,
+		TaskList:                     sourceInfo.TaskList,
+		WorkflowTypeName:             sourceInfo.WorkflowTypeName,
+		DecisionTimeoutValue:         sourceInfo.DecisionTimeoutValue,
+		ExecutionContext:             sourceInfo.ExecutionContext,
+		State:                        sourceInfo.State,
+		CloseStatus:                  sourceInfo.CloseStatus,
+		NextEventID:                  sourceInfo.NextEventID,
+		LastProcessedEvent:           sourceInfo.LastProcessedEvent,
+		LastUpdatedTimestamp:         sourceInfo.LastUpdatedTimestamp,
+		CreateRequestID:              sourceInfo.CreateRequestID,
+		DecisionScheduleID:           sourceInfo.DecisionScheduleID,
+		DecisionStartedID:            sourceInfo.DecisionStartedID,
+		DecisionRequestID:            sourceInfo.DecisionRequestID,
+		DecisionTimeout:              sourceInfo.DecisionTimeout,
+		CronSchedule:                 sourceInfo.CronSchedule,
+		Attempt:                      sourceInfo.Attempt,
+		HasRetryPolicy:               sourceInfo.HasRetryPolicy,
+		StickyTaskList:               sourceInfo.StickyTaskList,
+		StickyScheduleToStartTimeout: sourceInfo.StickyScheduleToStartTimeout,
+		SearchAttributes:             copySearchAttributes(sourceInfo.SearchAttributes),
 	}
 }
 
@@ -2599,6 +3654,7 @@ func copyActivityInfo(sourceInfo *persistence.ActivityInfo) *persistence.Activit
 		HeartbeatTimeout:       sourceInfo.HeartbeatTimeout,
 		CancelRequested:        sourceInfo.CancelRequested,
 		CancelRequestID:        sourceInfo.CancelRequestID,
+		Attempt:                sourceInfo.Attempt,
 	}
 }
 
@@ -2610,3 +3666,23 @@ func copyTimerInfo(sourceInfo *persistence.TimerInfo) *persistence.TimerInfo {
 		TaskID:     sourceInfo.TaskID,
 	}
 }
+
+func copyChildExecutionInfo(sourceInfo *persistence.ChildExecutionInfo) *persistence.ChildExecutionInfo {
+	return &persistence.ChildExecutionInfo{
+		InitiatedID:     sourceInfo.InitiatedID,
+		InitiatedEvent:  sourceInfo.InitiatedEvent,
+		StartedID:       sourceInfo.StartedID,
+		StartedEvent:    sourceInfo.StartedEvent,
+		CreateRequestID: sourceInfo.CreateRequestID,
+	}
+}
+
+func copySignalInfo(sourceInfo *persistence.SignalInfo) *persistence.SignalInfo {
+	return &persistence.SignalInfo{
+		InitiatedID:     sourceInfo.InitiatedID,
+		SignalRequestID: sourceInfo.SignalRequestID,
+		SignalName:      sourceInfo.SignalName,
+		Input:           sourceInfo.Input,
+		Control:         sourceInfo.Control,
+	}
+}
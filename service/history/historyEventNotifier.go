@@ -0,0 +1,126 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	workflowIdentifier struct {
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
+	historyEventNotification struct {
+		workflowIdentifier
+		nextEventID       int64
+		isWorkflowRunning bool
+	}
+
+	// historyEventNotifier lets callers wait for new history events of a workflow execution.  Notifications are
+	// delivered on buffered channels and never block the update path; a watcher which falls behind only sees the
+	// latest pending notification.
+	historyEventNotifier struct {
+		sync.Mutex
+		watchers map[workflowIdentifier]map[chan *historyEventNotification]struct{}
+	}
+)
+
+func newWorkflowIdentifier(domainID, workflowID, runID string) workflowIdentifier {
+	return workflowIdentifier{
+		domainID:   domainID,
+		workflowID: workflowID,
+		runID:      runID,
+	}
+}
+
+func newHistoryEventNotification(executionInfo *persistence.WorkflowExecutionInfo) *historyEventNotification {
+	return &historyEventNotification{
+		workflowIdentifier: newWorkflowIdentifier(executionInfo.DomainID, executionInfo.WorkflowID,
+			executionInfo.RunID),
+		nextEventID:       executionInfo.NextEventID,
+		isWorkflowRunning: executionInfo.State != persistence.WorkflowStateCompleted,
+	}
+}
+
+func newHistoryEventNotifier() *historyEventNotifier {
+	return &historyEventNotifier{
+		watchers: make(map[workflowIdentifier]map[chan *historyEventNotification]struct{}),
+	}
+}
+
+// WatchHistoryEvent returns a channel which receives a notification every time the workflow execution is updated.
+// The caller must call UnwatchHistoryEvent with the same channel once it is done waiting.
+func (n *historyEventNotifier) WatchHistoryEvent(identifier workflowIdentifier) chan *historyEventNotification {
+	n.Lock()
+	defer n.Unlock()
+
+	channels, ok := n.watchers[identifier]
+	if !ok {
+		channels = make(map[chan *historyEventNotification]struct{})
+		n.watchers[identifier] = channels
+	}
+	ch := make(chan *historyEventNotification, 1)
+	channels[ch] = struct{}{}
+	return ch
+}
+
+// UnwatchHistoryEvent stops the delivery of notifications to a channel returned by WatchHistoryEvent
+func (n *historyEventNotifier) UnwatchHistoryEvent(identifier workflowIdentifier,
+	ch chan *historyEventNotification) {
+	n.Lock()
+	defer n.Unlock()
+
+	channels, ok := n.watchers[identifier]
+	if !ok {
+		return
+	}
+	delete(channels, ch)
+	if len(channels) == 0 {
+		delete(n.watchers, identifier)
+	}
+}
+
+// NotifyNewHistoryEvent notifies all watchers of the workflow execution about its new state
+func (n *historyEventNotifier) NotifyNewHistoryEvent(event *historyEventNotification) {
+	n.Lock()
+	defer n.Unlock()
+
+	for ch := range n.watchers[event.workflowIdentifier] {
+		select {
+		case ch <- event:
+		default:
+			// Replace the pending notification with the latest one
+			select {
+			case <-ch:
+			default:
+			}
+			select {
+			case ch <- event:
+			default:
+			}
+		}
+	}
+}
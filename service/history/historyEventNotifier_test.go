@@ -0,0 +1,98 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	historyEventNotifierSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		notifier *historyEventNotifier
+	}
+)
+
+func TestHistoryEventNotifierSuite(t *testing.T) {
+	s := new(historyEventNotifierSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyEventNotifierSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+	s.notifier = newHistoryEventNotifier()
+}
+
+func (s *historyEventNotifierSuite) TestNotifyWatchers() {
+	identifier := newWorkflowIdentifier("domainId", "wId", "rId")
+	otherIdentifier := newWorkflowIdentifier("domainId", "wId", "otherRunId")
+
+	ch1 := s.notifier.WatchHistoryEvent(identifier)
+	ch2 := s.notifier.WatchHistoryEvent(identifier)
+	otherCh := s.notifier.WatchHistoryEvent(otherIdentifier)
+
+	event := &historyEventNotification{workflowIdentifier: identifier, nextEventID: 10, isWorkflowRunning: true}
+	s.notifier.NotifyNewHistoryEvent(event)
+
+	s.Equal(event, <-ch1)
+	s.Equal(event, <-ch2)
+	select {
+	case <-otherCh:
+		s.Fail("Watcher of another workflow execution should not be notified")
+	default:
+	}
+}
+
+func (s *historyEventNotifierSuite) TestNotifyKeepsLatestEvent() {
+	identifier := newWorkflowIdentifier("domainId", "wId", "rId")
+	ch := s.notifier.WatchHistoryEvent(identifier)
+
+	s.notifier.NotifyNewHistoryEvent(
+		&historyEventNotification{workflowIdentifier: identifier, nextEventID: 10, isWorkflowRunning: true})
+	s.notifier.NotifyNewHistoryEvent(
+		&historyEventNotification{workflowIdentifier: identifier, nextEventID: 12, isWorkflowRunning: false})
+
+	event := <-ch
+	s.Equal(int64(12), event.nextEventID)
+	s.False(event.isWorkflowRunning)
+}
+
+func (s *historyEventNotifierSuite) TestUnwatch() {
+	identifier := newWorkflowIdentifier("domainId", "wId", "rId")
+	ch := s.notifier.WatchHistoryEvent(identifier)
+	s.notifier.UnwatchHistoryEvent(identifier, ch)
+	s.Empty(s.notifier.watchers)
+
+	s.notifier.NotifyNewHistoryEvent(
+		&historyEventNotification{workflowIdentifier: identifier, nextEventID: 10, isWorkflowRunning: true})
+	select {
+	case <-ch:
+		s.Fail("Unwatched channel should not be notified")
+	default:
+	}
+}
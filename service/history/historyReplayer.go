@@ -0,0 +1,397 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"fmt"
+	"time"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+
+	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
+)
+
+type (
+	// historyReplayer rebuilds the mutable state of a new run of a workflow execution from the history events of a
+	// previous run.  Each event is applied through the same mutableStateBuilder call which recorded it, and the
+	// history of the new run keeps the events of the previous run as they are.
+	historyReplayer struct {
+		domainID  string
+		execution workflow.WorkflowExecution
+		msBuilder *mutableStateBuilder
+		logger    bark.Logger
+	}
+)
+
+func newHistoryReplayer(domainID string, execution workflow.WorkflowExecution, config *Config,
+	logger bark.Logger) *historyReplayer {
+	return &historyReplayer{
+		domainID:  domainID,
+		execution: execution,
+		msBuilder: newMutableStateBuilder(config, logger),
+		logger:    logger,
+	}
+}
+
+// replay applies the events, which have to start with the WorkflowExecutionStarted event, to the mutable state of the
+// new run
+func (r *historyReplayer) replay(events []*workflow.HistoryEvent) error {
+	for _, event := range events {
+		if err := r.replayEvent(event); err != nil {
+			return err
+		}
+	}
+
+	// Events are replayed in order, so the mutable state assigns them the same IDs.  Keep the original ones, with
+	// their timestamps and attributes, as the history of the new run.
+	history := r.msBuilder.hBuilder.history
+	if len(history) != len(events) {
+		return &workflow.InternalServiceError{Message: "Unable to replay workflow execution history."}
+	}
+	for i, event := range events {
+		if *history[i].EventId != *event.EventId {
+			return &workflow.InternalServiceError{Message: "Unable to replay workflow execution history."}
+		}
+		history[i] = event
+	}
+
+	return nil
+}
+
+func (r *historyReplayer) replayEvent(event *workflow.HistoryEvent) error {
+	msBuilder := r.msBuilder
+	var replayedEvent *workflow.HistoryEvent
+
+	switch *event.EventType {
+	case workflow.EventTypeWorkflowExecutionStarted:
+		attributes := event.WorkflowExecutionStartedEventAttributes
+		var expirationTime time.Time
+		if attributes.ExpirationTimestamp != nil {
+			expirationTime = time.Unix(0, *attributes.ExpirationTimestamp)
+		}
+		replayedEvent = msBuilder.addWorkflowExecutionStartedEvent(r.domainID, r.execution,
+			&workflow.StartWorkflowExecutionRequest{
+				RequestId:                           common.StringPtr(uuid.New()),
+				WorkflowId:                          r.execution.WorkflowId,
+				WorkflowType:                        attributes.WorkflowType,
+				TaskList:                            attributes.TaskList,
+				Input:                               attributes.Input,
+				ExecutionStartToCloseTimeoutSeconds: attributes.ExecutionStartToCloseTimeoutSeconds,
+				TaskStartToCloseTimeoutSeconds:      attributes.TaskStartToCloseTimeoutSeconds,
+				Identity:                            attributes.Identity,
+				CronSchedule:                        attributes.CronSchedule,
+				RetryPolicy:                         attributes.RetryPolicy,
+				SearchAttributes:                    attributes.SearchAttributes,
+			}, common.Int32Default(attributes.FirstDecisionTaskBackoffSeconds),
+			common.Int32Default(attributes.Attempt), expirationTime)
+
+	case workflow.EventTypeDecisionTaskScheduled:
+		replayedEvent, _ = msBuilder.AddDecisionTaskScheduledEvent()
+
+	case workflow.EventTypeDecisionTaskStarted:
+		attributes := event.DecisionTaskStartedEventAttributes
+		replayedEvent = msBuilder.AddDecisionTaskStartedEvent(*attributes.ScheduledEventId,
+			common.StringDefault(attributes.RequestId), &workflow.PollForDecisionTaskRequest{
+				Identity: attributes.Identity,
+			})
+
+	case workflow.EventTypeDecisionTaskCompleted:
+		attributes := event.DecisionTaskCompletedEventAttributes
+		replayedEvent = msBuilder.AddDecisionTaskCompletedEvent(*attributes.ScheduledEventId,
+			*attributes.StartedEventId, &workflow.RespondDecisionTaskCompletedRequest{
+				ExecutionContext: attributes.ExecutionContext,
+				Identity:         attributes.Identity,
+			})
+		msBuilder.executionInfo.ExecutionContext = attributes.ExecutionContext
+
+	case workflow.EventTypeDecisionTaskTimedOut:
+		attributes := event.DecisionTaskTimedOutEventAttributes
+		if *attributes.TimeoutType == workflow.TimeoutTypeScheduleToStart {
+			replayedEvent = msBuilder.AddDecisionTaskScheduleToStartTimeoutEvent(*attributes.ScheduledEventId)
+		} else {
+			replayedEvent = msBuilder.AddDecisionTaskTimedOutEvent(*attributes.ScheduledEventId,
+				*attributes.StartedEventId)
+		}
+
+	case workflow.EventTypeDecisionTaskFailed:
+		attributes := event.DecisionTaskFailedEventAttributes
+		replayedEvent = msBuilder.AddDecisionTaskFailedEvent(*attributes.ScheduledEventId,
+			*attributes.StartedEventId, *attributes.Cause, &workflow.RespondDecisionTaskCompletedRequest{
+				Identity: attributes.Identity,
+			})
+
+	case workflow.EventTypeActivityTaskScheduled:
+		attributes := event.ActivityTaskScheduledEventAttributes
+		replayedEvent, _ = msBuilder.AddActivityTaskScheduledEvent(*attributes.DecisionTaskCompletedEventId,
+			&workflow.ScheduleActivityTaskDecisionAttributes{
+				ActivityId:                    attributes.ActivityId,
+				ActivityType:                  attributes.ActivityType,
+				Domain:                        attributes.Domain,
+				TaskList:                      attributes.TaskList,
+				Input:                         attributes.Input,
+				ScheduleToCloseTimeoutSeconds: attributes.ScheduleToCloseTimeoutSeconds,
+				ScheduleToStartTimeoutSeconds: attributes.ScheduleToStartTimeoutSeconds,
+				StartToCloseTimeoutSeconds:    attributes.StartToCloseTimeoutSeconds,
+				HeartbeatTimeoutSeconds:       attributes.HeartbeatTimeoutSeconds,
+				RetryPolicy:                   attributes.RetryPolicy,
+			})
+
+	case workflow.EventTypeActivityTaskStarted:
+		attributes := event.ActivityTaskStartedEventAttributes
+		ai, ok := msBuilder.GetActivityInfo(*attributes.ScheduledEventId)
+		if ok {
+			ai.Attempt = common.Int32Default(attributes.Attempt)
+			// Started events of activities with a retry policy are only written to history together with their
+			// completion, so the replayed event stays transient until the completion is replayed
+			replayedEvent = msBuilder.AddActivityTaskStartedEvent(ai, *attributes.ScheduledEventId,
+				common.StringDefault(attributes.RequestId), &workflow.PollForActivityTaskRequest{
+					Identity: attributes.Identity,
+				})
+		}
+
+	case workflow.EventTypeActivityTaskCompleted:
+		attributes := event.ActivityTaskCompletedEventAttributes
+		if ai, ok := msBuilder.GetActivityInfo(*attributes.ScheduledEventId); ok {
+			replayedEvent = msBuilder.AddActivityTaskCompletedEvent(ai.ScheduleID, ai.StartedID,
+				&workflow.RespondActivityTaskCompletedRequest{
+					Result:   attributes.Result,
+					Identity: attributes.Identity,
+				})
+		}
+
+	case workflow.EventTypeActivityTaskFailed:
+		attributes := event.ActivityTaskFailedEventAttributes
+		if ai, ok := msBuilder.GetActivityInfo(*attributes.ScheduledEventId); ok {
+			replayedEvent = msBuilder.AddActivityTaskFailedEvent(ai.ScheduleID, ai.StartedID,
+				&workflow.RespondActivityTaskFailedRequest{
+					Reason:   attributes.Reason,
+					Details:  attributes.Details,
+					Identity: attributes.Identity,
+				})
+		}
+
+	case workflow.EventTypeActivityTaskTimedOut:
+		attributes := event.ActivityTaskTimedOutEventAttributes
+		if ai, ok := msBuilder.GetActivityInfo(*attributes.ScheduledEventId); ok {
+			replayedEvent = msBuilder.AddActivityTaskTimedOutEvent(ai.ScheduleID, ai.StartedID,
+				*attributes.TimeoutType, attributes.Details)
+		}
+
+	case workflow.EventTypeActivityTaskCancelRequested:
+		attributes := event.ActivityTaskCancelRequestedEventAttributes
+		// The event is recorded even when the activity is unknown, which is followed by a
+		// RequestCancelActivityTaskFailed event
+		msBuilder.AddActivityTaskCancelRequestedEvent(*attributes.DecisionTaskCompletedEventId,
+			common.StringDefault(attributes.ActivityId), "")
+		return nil
+
+	case workflow.EventTypeRequestCancelActivityTaskFailed:
+		attributes := event.RequestCancelActivityTaskFailedEventAttributes
+		replayedEvent = msBuilder.AddRequestCancelActivityTaskFailedEvent(*attributes.DecisionTaskCompletedEventId,
+			common.StringDefault(attributes.ActivityId), common.StringDefault(attributes.Cause))
+
+	case workflow.EventTypeActivityTaskCanceled:
+		attributes := event.ActivityTaskCanceledEventAttributes
+		if ai, ok := msBuilder.GetActivityInfo(*attributes.ScheduledEventId); ok {
+			replayedEvent = msBuilder.AddActivityTaskCanceledEvent(ai.ScheduleID, ai.StartedID,
+				*attributes.LatestCancelRequestedEventId, attributes.Details, common.StringDefault(attributes.Identity))
+		}
+
+	case workflow.EventTypeTimerStarted:
+		attributes := event.TimerStartedEventAttributes
+		var ti *persistence.TimerInfo
+		replayedEvent, ti = msBuilder.AddTimerStartedEvent(*attributes.DecisionTaskCompletedEventId,
+			&workflow.StartTimerDecisionAttributes{
+				TimerId:                   attributes.TimerId,
+				StartToFireTimeoutSeconds: attributes.StartToFireTimeoutSeconds,
+			})
+		if ti != nil {
+			// The timer keeps firing at the time it was started for
+			ti.ExpiryTime = time.Unix(0, *event.Timestamp).Add(
+				time.Duration(*attributes.StartToFireTimeoutSeconds) * time.Second)
+		}
+
+	case workflow.EventTypeTimerFired:
+		attributes := event.TimerFiredEventAttributes
+		replayedEvent = msBuilder.AddTimerFiredEvent(*attributes.StartedEventId, *attributes.TimerId)
+
+	case workflow.EventTypeTimerCanceled:
+		attributes := event.TimerCanceledEventAttributes
+		replayedEvent = msBuilder.AddTimerCanceledEvent(*attributes.DecisionTaskCompletedEventId,
+			&workflow.CancelTimerDecisionAttributes{TimerId: attributes.TimerId},
+			common.StringDefault(attributes.Identity))
+
+	case workflow.EventTypeCancelTimerFailed:
+		attributes := event.CancelTimerFailedEventAttributes
+		replayedEvent = msBuilder.AddCancelTimerFailedEvent(*attributes.DecisionTaskCompletedEventId,
+			&workflow.CancelTimerDecisionAttributes{TimerId: attributes.TimerId},
+			common.StringDefault(attributes.Identity))
+
+	case workflow.EventTypeMarkerRecorded:
+		attributes := event.MarkerRecordedEventAttributes
+		replayedEvent = msBuilder.AddRecordMarkerEvent(*attributes.DecisionTaskCompletedEventId,
+			&workflow.RecordMarkerDecisionAttributes{
+				MarkerName: attributes.MarkerName,
+				Details:    attributes.Details,
+			})
+
+	case workflow.EventTypeUpsertWorkflowSearchAttributes:
+		attributes := event.UpsertWorkflowSearchAttributesEventAttributes
+		replayedEvent = msBuilder.AddUpsertWorkflowSearchAttributesEvent(*attributes.DecisionTaskCompletedEventId,
+			&workflow.UpsertWorkflowSearchAttributesDecisionAttributes{
+				SearchAttributes: attributes.SearchAttributes,
+			})
+
+	case workflow.EventTypeWorkflowExecutionSignaled:
+		attributes := event.WorkflowExecutionSignaledEventAttributes
+		replayedEvent = msBuilder.AddWorkflowExecutionSignaled(&workflow.SignalWorkflowExecutionRequest{
+			SignalName: attributes.SignalName,
+			Input:      attributes.Input,
+			Identity:   attributes.Identity,
+		})
+
+	case workflow.EventTypeWorkflowExecutionCancelRequested:
+		attributes := event.WorkflowExecutionCancelRequestedEventAttributes
+		replayedEvent = msBuilder.AddWorkflowExecutionCancelRequestedEvent(common.StringDefault(attributes.Cause),
+			&h.RequestCancelWorkflowExecutionRequest{
+				CancelRequest: &workflow.RequestCancelWorkflowExecutionRequest{
+					Identity: attributes.Identity,
+				},
+				ExternalInitiatedEventId:  attributes.ExternalInitiatedEventId,
+				ExternalWorkflowExecution: attributes.ExternalWorkflowExecution,
+			})
+
+	case workflow.EventTypeRequestCancelExternalWorkflowExecutionInitiated:
+		attributes := event.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
+		replayedEvent, _ = msBuilder.AddRequestCancelExternalWorkflowExecutionInitiatedEvent(
+			*attributes.DecisionTaskCompletedEventId, uuid.New(),
+			&workflow.RequestCancelExternalWorkflowExecutionDecisionAttributes{
+				Domain:     attributes.Domain,
+				WorkflowId: attributes.WorkflowExecution.WorkflowId,
+				RunId:      attributes.WorkflowExecution.RunId,
+				Control:    attributes.Control,
+			})
+
+	case workflow.EventTypeRequestCancelExternalWorkflowExecutionFailed:
+		attributes := event.RequestCancelExternalWorkflowExecutionFailedEventAttributes
+		replayedEvent = msBuilder.AddRequestCancelExternalWorkflowExecutionFailedEvent(
+			*attributes.DecisionTaskCompletedEventId, *attributes.InitiatedEventId,
+			common.StringDefault(attributes.Domain), *attributes.WorkflowExecution.WorkflowId,
+			common.StringDefault(attributes.WorkflowExecution.RunId), *attributes.Cause)
+
+	case workflow.EventTypeExternalWorkflowExecutionCancelRequested:
+		attributes := event.ExternalWorkflowExecutionCancelRequestedEventAttributes
+		replayedEvent = msBuilder.AddExternalWorkflowExecutionCancelRequested(*attributes.InitiatedEventId,
+			common.StringDefault(attributes.Domain), *attributes.WorkflowExecution.WorkflowId,
+			common.StringDefault(attributes.WorkflowExecution.RunId))
+
+	case workflow.EventTypeSignalExternalWorkflowExecutionInitiated:
+		attributes := event.SignalExternalWorkflowExecutionInitiatedEventAttributes
+		replayedEvent, _ = msBuilder.AddSignalExternalWorkflowExecutionInitiatedEvent(
+			*attributes.DecisionTaskCompletedEventId, uuid.New(),
+			&workflow.SignalExternalWorkflowExecutionDecisionAttributes{
+				Domain:     attributes.Domain,
+				Execution:  attributes.WorkflowExecution,
+				SignalName: attributes.SignalName,
+				Input:      attributes.Input,
+				Control:    attributes.Control,
+			})
+
+	case workflow.EventTypeSignalExternalWorkflowExecutionFailed:
+		attributes := event.SignalExternalWorkflowExecutionFailedEventAttributes
+		replayedEvent = msBuilder.AddSignalExternalWorkflowExecutionFailedEvent(
+			*attributes.DecisionTaskCompletedEventId, *attributes.InitiatedEventId,
+			common.StringDefault(attributes.Domain), *attributes.WorkflowExecution.WorkflowId,
+			common.StringDefault(attributes.WorkflowExecution.RunId), attributes.Control, *attributes.Cause)
+
+	case workflow.EventTypeExternalWorkflowExecutionSignaled:
+		attributes := event.ExternalWorkflowExecutionSignaledEventAttributes
+		replayedEvent = msBuilder.AddExternalWorkflowExecutionSignaled(*attributes.InitiatedEventId,
+			common.StringDefault(attributes.Domain), *attributes.WorkflowExecution.WorkflowId,
+			common.StringDefault(attributes.WorkflowExecution.RunId), attributes.Control)
+
+	case workflow.EventTypeStartChildWorkflowExecutionInitiated:
+		attributes := event.StartChildWorkflowExecutionInitiatedEventAttributes
+		replayedEvent, _ = msBuilder.AddStartChildWorkflowExecutionInitiatedEvent(
+			*attributes.DecisionTaskCompletedEventId, uuid.New(),
+			&workflow.StartChildWorkflowExecutionDecisionAttributes{
+				Domain:                              attributes.Domain,
+				WorkflowId:                          attributes.WorkflowId,
+				WorkflowType:                        attributes.WorkflowType,
+				TaskList:                            attributes.TaskList,
+				Input:                               attributes.Input,
+				ExecutionStartToCloseTimeoutSeconds: attributes.ExecutionStartToCloseTimeoutSeconds,
+				TaskStartToCloseTimeoutSeconds:      attributes.TaskStartToCloseTimeoutSeconds,
+				ChildPolicy:                         attributes.ChildPolicy,
+				Control:                             attributes.Control,
+			})
+
+	case workflow.EventTypeStartChildWorkflowExecutionFailed:
+		attributes := event.StartChildWorkflowExecutionFailedEventAttributes
+		if initiatedEvent, ok := msBuilder.GetChildExecutionInitiatedEvent(*attributes.InitiatedEventId); ok {
+			replayedEvent = msBuilder.AddStartChildWorkflowExecutionFailedEvent(*attributes.InitiatedEventId,
+				*attributes.Cause, initiatedEvent.StartChildWorkflowExecutionInitiatedEventAttributes)
+		}
+
+	case workflow.EventTypeChildWorkflowExecutionStarted:
+		attributes := event.ChildWorkflowExecutionStartedEventAttributes
+		replayedEvent = msBuilder.AddChildWorkflowExecutionStartedEvent(common.StringDefault(attributes.Domain),
+			attributes.WorkflowExecution, attributes.WorkflowType, *attributes.InitiatedEventId)
+
+	case workflow.EventTypeChildWorkflowExecutionCompleted:
+		attributes := event.ChildWorkflowExecutionCompletedEventAttributes
+		replayedEvent = msBuilder.AddChildWorkflowExecutionCompletedEvent(*attributes.InitiatedEventId,
+			attributes.WorkflowExecution, &workflow.WorkflowExecutionCompletedEventAttributes{
+				Result: attributes.Result,
+			})
+
+	case workflow.EventTypeChildWorkflowExecutionFailed:
+		attributes := event.ChildWorkflowExecutionFailedEventAttributes
+		replayedEvent = msBuilder.AddChildWorkflowExecutionFailedEvent(*attributes.InitiatedEventId,
+			attributes.WorkflowExecution, &workflow.WorkflowExecutionFailedEventAttributes{
+				Reason:  attributes.Reason,
+				Details: attributes.Details,
+			})
+
+	case workflow.EventTypeChildWorkflowExecutionCanceled:
+		attributes := event.ChildWorkflowExecutionCanceledEventAttributes
+		replayedEvent = msBuilder.AddChildWorkflowExecutionCanceledEvent(*attributes.InitiatedEventId,
+			attributes.WorkflowExecution, &workflow.WorkflowExecutionCanceledEventAttributes{
+				Details: attributes.Details,
+			})
+
+	case workflow.EventTypeChildWorkflowExecutionTerminated:
+		attributes := event.ChildWorkflowExecutionTerminatedEventAttributes
+		replayedEvent = msBuilder.AddChildWorkflowExecutionTerminatedEvent(*attributes.InitiatedEventId,
+			attributes.WorkflowExecution, &workflow.WorkflowExecutionTerminatedEventAttributes{})
+	}
+
+	if replayedEvent == nil {
+		return &workflow.InternalServiceError{Message: fmt.Sprintf("Unable to replay %v event %v.",
+			*event.EventType, *event.EventId)}
+	}
+
+	return nil
+}
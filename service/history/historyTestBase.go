@@ -30,6 +30,7 @@ import (
 	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -181,11 +182,18 @@ func (s *TestShardContext) GetTimeSource() common.TimeSource {
 	return common.NewRealTimeSource()
 }
 
+// GetShardInfo test implementation
+func (s *TestShardContext) GetShardInfo() *persistence.ShardInfo {
+	s.RLock()
+	defer s.RUnlock()
+	return copyShardInfo(s.shardInfo)
+}
+
 // SetupWorkflowStoreWithOptions to setup workflow test base
 func (s *TestBase) SetupWorkflowStoreWithOptions(options persistence.TestBaseOptions) {
 	s.TestBase.SetupWorkflowStoreWithOptions(options)
 	log := bark.NewLoggerFromLogrus(log.New())
-	config := NewConfig(1)
+	config := NewConfig(dynamicconfig.NewNopCollection(), 1)
 	s.ShardContext = newTestShardContext(s.ShardInfo, 0, s.HistoryMgr, s.WorkflowMgr, config, log)
 	s.TestBase.TaskIDGenerator = s.ShardContext
 }
@@ -194,7 +202,7 @@ func (s *TestBase) SetupWorkflowStoreWithOptions(options persistence.TestBaseOpt
 func (s *TestBase) SetupWorkflowStore() {
 	s.TestBase.SetupWorkflowStore()
 	log := bark.NewLoggerFromLogrus(log.New())
-	config := NewConfig(1)
+	config := NewConfig(dynamicconfig.NewNopCollection(), 1)
 	s.ShardContext = newTestShardContext(s.ShardInfo, 0, s.HistoryMgr, s.WorkflowMgr, config, log)
 	s.TestBase.TaskIDGenerator = s.ShardContext
 }
@@ -58,6 +58,10 @@ type (
 		updateRequestCancelInfos    []*persistence.RequestCancelInfo         // Modified RequestCancel Infos since last update
 		deleteRequestCancelInfo     *int64                                   // Deleted RequestCancel Info since last update
 
+		pendingSignalInfoIDs map[int64]*persistence.SignalInfo // Initiated Event ID -> SignalInfo
+		updateSignalInfos    []*persistence.SignalInfo         // Modified Signal Infos since last update
+		deleteSignalInfo     *int64                            // Deleted Signal Info since last update
+
 		executionInfo   *persistence.WorkflowExecutionInfo // Workflow mutable state info.
 		continueAsNew   *persistence.CreateWorkflowExecutionRequest
 		hBuilder        *historyBuilder
@@ -74,6 +78,10 @@ type (
 		deleteTimerInfos          []string
 		updateChildExecutionInfos []*persistence.ChildExecutionInfo
 		deleteChildExecutionInfo  *int64
+		updateRequestCancelInfos  []*persistence.RequestCancelInfo
+		deleteRequestCancelInfo   *int64
+		updateSignalInfos         []*persistence.SignalInfo
+		deleteSignalInfo          *int64
 		continueAsNew             *persistence.CreateWorkflowExecutionRequest
 	}
 
@@ -98,6 +106,8 @@ func newMutableStateBuilder(config *Config, logger bark.Logger) *mutableStateBui
 		pendingChildExecutionInfoIDs:    make(map[int64]*persistence.ChildExecutionInfo),
 		updateRequestCancelInfos:        []*persistence.RequestCancelInfo{},
 		pendingRequestCancelInfoIDs:     make(map[int64]*persistence.RequestCancelInfo),
+		updateSignalInfos:               []*persistence.SignalInfo{},
+		pendingSignalInfoIDs:            make(map[int64]*persistence.SignalInfo),
 		eventSerializer:                 newJSONHistoryEventSerializer(),
 		config:                          config,
 		logger:                          logger,
@@ -118,12 +128,25 @@ func (e *mutableStateBuilder) Load(state *persistence.WorkflowMutableState) {
 	e.pendingTimerInfoIDs = state.TimerInfos
 	e.pendingChildExecutionInfoIDs = state.ChildExecutionInfos
 	e.pendingRequestCancelInfoIDs = state.RequestCancelInfos
+	e.pendingSignalInfoIDs = state.SignalInfos
 	e.executionInfo = state.ExecutionInfo
 	for _, ai := range state.ActivitInfos {
 		e.pendingActivityInfoByActivityID[ai.ActivityID] = ai.ScheduleID
 	}
 }
 
+func (e *mutableStateBuilder) CopyToPersistence() *persistence.WorkflowMutableState {
+	state := &persistence.WorkflowMutableState{}
+
+	state.ActivitInfos = e.pendingActivityInfoIDs
+	state.TimerInfos = e.pendingTimerInfoIDs
+	state.ChildExecutionInfos = e.pendingChildExecutionInfoIDs
+	state.RequestCancelInfos = e.pendingRequestCancelInfoIDs
+	state.SignalInfos = e.pendingSignalInfoIDs
+	state.ExecutionInfo = e.executionInfo
+	return state
+}
+
 func (e *mutableStateBuilder) CloseUpdateSession() *mutableStateSessionUpdates {
 	updates := &mutableStateSessionUpdates{
 		newEventsBuilder:          e.hBuilder,
@@ -133,6 +156,10 @@ func (e *mutableStateBuilder) CloseUpdateSession() *mutableStateSessionUpdates {
 		deleteTimerInfos:          e.deleteTimerInfos,
 		updateChildExecutionInfos: e.updateChildExecutionInfos,
 		deleteChildExecutionInfo:  e.deleteChildExecutionInfo,
+		updateRequestCancelInfos:  e.updateRequestCancelInfos,
+		deleteRequestCancelInfo:   e.deleteRequestCancelInfo,
+		updateSignalInfos:         e.updateSignalInfos,
+		deleteSignalInfo:          e.deleteSignalInfo,
 		continueAsNew:             e.continueAsNew,
 	}
 
@@ -146,6 +173,8 @@ func (e *mutableStateBuilder) CloseUpdateSession() *mutableStateSessionUpdates {
 	e.deleteChildExecutionInfo = nil
 	e.updateRequestCancelInfos = []*persistence.RequestCancelInfo{}
 	e.deleteRequestCancelInfo = nil
+	e.updateSignalInfos = []*persistence.SignalInfo{}
+	e.deleteSignalInfo = nil
 	e.continueAsNew = nil
 
 	return updates
@@ -209,6 +238,35 @@ func (e *mutableStateBuilder) GetActivityByActivityID(activityID string) (*persi
 	return ai, ok
 }
 
+// getPendingActivityInfo returns the description of a pending activity
+func (e *mutableStateBuilder) getPendingActivityInfo(ai *persistence.ActivityInfo) *workflow.PendingActivityInfo {
+	state := workflow.PendingActivityStateScheduled
+	if ai.CancelRequested {
+		state = workflow.PendingActivityStateCancelRequested
+	} else if ai.StartedID != emptyEventID {
+		state = workflow.PendingActivityStateStarted
+	}
+
+	p := &workflow.PendingActivityInfo{
+		ActivityID:         common.StringPtr(ai.ActivityID),
+		State:              &state,
+		HeartbeatDetails:   ai.Details,
+		ScheduledTimestamp: common.Int64Ptr(ai.ScheduledTime.UnixNano()),
+		Attempt:            common.Int32Ptr(ai.Attempt),
+	}
+	if scheduledEvent, ok := e.getHistoryEvent(ai.ScheduledEvent); ok {
+		p.ActivityType = scheduledEvent.ActivityTaskScheduledEventAttributes.ActivityType
+	}
+	if ai.StartedID != emptyEventID {
+		p.LastStartedTimestamp = common.Int64Ptr(ai.StartedTime.UnixNano())
+	}
+	if !ai.LastHeartBeatUpdatedTime.IsZero() {
+		p.LastHeartbeatTimestamp = common.Int64Ptr(ai.LastHeartBeatUpdatedTime.UnixNano())
+	}
+
+	return p
+}
+
 // GetChildExecutionInfo gives details about a child execution that is currently in progress.
 func (e *mutableStateBuilder) GetChildExecutionInfo(initiatedEventID int64) (*persistence.ChildExecutionInfo, bool) {
 	ci, ok := e.pendingChildExecutionInfoIDs[initiatedEventID]
@@ -237,12 +295,38 @@ func (e *mutableStateBuilder) GetChildExecutionStartedEvent(initiatedEventID int
 	return e.getHistoryEvent(ci.StartedEvent)
 }
 
+// getPendingChildExecutionInfo returns the description of a pending child execution
+func (e *mutableStateBuilder) getPendingChildExecutionInfo(
+	ci *persistence.ChildExecutionInfo) *workflow.PendingChildExecutionInfo {
+	p := &workflow.PendingChildExecutionInfo{
+		InitiatedID: common.Int64Ptr(ci.InitiatedID),
+	}
+	if initiatedEvent, ok := e.getHistoryEvent(ci.InitiatedEvent); ok {
+		attributes := initiatedEvent.StartChildWorkflowExecutionInitiatedEventAttributes
+		p.WorkflowID = attributes.WorkflowId
+		p.WorkflowTypeName = attributes.WorkflowType.Name
+	}
+	if ci.StartedID != emptyEventID {
+		if startedEvent, ok := e.getHistoryEvent(ci.StartedEvent); ok {
+			p.RunID = startedEvent.ChildWorkflowExecutionStartedEventAttributes.WorkflowExecution.RunId
+		}
+	}
+
+	return p
+}
+
 // GetRequestCancelInfo gives details about a request cancellation that is currently in progress.
 func (e *mutableStateBuilder) GetRequestCancelInfo(initiatedEventID int64) (*persistence.RequestCancelInfo, bool) {
 	ri, ok := e.pendingRequestCancelInfoIDs[initiatedEventID]
 	return ri, ok
 }
 
+// GetSignalInfo gives details about a signal request that is currently in progress.
+func (e *mutableStateBuilder) GetSignalInfo(initiatedEventID int64) (*persistence.SignalInfo, bool) {
+	ri, ok := e.pendingSignalInfoIDs[initiatedEventID]
+	return ri, ok
+}
+
 // GetCompletionEvent retrieves the workflow completion event from mutable state
 func (e *mutableStateBuilder) GetCompletionEvent() (*workflow.HistoryEvent, bool) {
 	serializedEvent := e.executionInfo.CompletionEvent
@@ -283,6 +367,21 @@ func (e *mutableStateBuilder) DeletePendingRequestCancel(initiatedEventID int64)
 	return nil
 }
 
+// DeletePendingSignal deletes details about a SignalInfo
+func (e *mutableStateBuilder) DeletePendingSignal(initiatedEventID int64) error {
+	_, ok := e.pendingSignalInfoIDs[initiatedEventID]
+	if !ok {
+		errorMsg := fmt.Sprintf("Unable to find signal request with initiated event id: %v in mutable state",
+			initiatedEventID)
+		logging.LogMutableStateInvalidAction(e.logger, errorMsg)
+		return errors.New(errorMsg)
+	}
+	delete(e.pendingSignalInfoIDs, initiatedEventID)
+
+	e.deleteSignalInfo = common.Int64Ptr(initiatedEventID)
+	return nil
+}
+
 func (e *mutableStateBuilder) writeCompletionEventToMutableState(completionEvent *workflow.HistoryEvent) error {
 	// First check to see if this is a Child Workflow
 	if e.hasParentExecution() {
@@ -386,10 +485,53 @@ func (e *mutableStateBuilder) GetPendingDecision(scheduleEventID int64) (*decisi
 	return nil, false
 }
 
+// getPendingDecisionInfo returns the description of the in-progress decision task
+func (e *mutableStateBuilder) getPendingDecisionInfo() *workflow.PendingDecisionInfo {
+	state := workflow.PendingDecisionStateScheduled
+	if e.executionInfo.DecisionStartedID != emptyEventID {
+		state = workflow.PendingDecisionStateStarted
+	}
+
+	return &workflow.PendingDecisionInfo{
+		State:                      &state,
+		ScheduleID:                 common.Int64Ptr(e.executionInfo.DecisionScheduleID),
+		StartedID:                  common.Int64Ptr(e.executionInfo.DecisionStartedID),
+		StartToCloseTimeoutSeconds: common.Int32Ptr(e.executionInfo.DecisionTimeout),
+	}
+}
+
 func (e *mutableStateBuilder) HasPendingDecisionTask() bool {
 	return e.executionInfo.DecisionScheduleID != emptyEventID
 }
 
+// HasProcessedOrPendingDecisionTask returns true if a decision has been scheduled for the run at any point
+func (e *mutableStateBuilder) HasProcessedOrPendingDecisionTask() bool {
+	return e.HasPendingDecisionTask() || e.executionInfo.LastProcessedEvent != emptyEventID
+}
+
+// isFirstDecisionTaskBackoffPending returns true if the run is still waiting for the backoff timer to schedule its
+// first decision.  Runs without a parent get their first decision when they are started, unless it is delayed by a
+// backoff.  No decision should be scheduled for new events until the backoff timer fires.
+func (e *mutableStateBuilder) isFirstDecisionTaskBackoffPending() bool {
+	return !e.hasParentExecution() && !e.HasProcessedOrPendingDecisionTask()
+}
+
+func (e *mutableStateBuilder) isStickyTaskListEnabled() bool {
+	return len(e.executionInfo.StickyTaskList) > 0
+}
+
+// SetStickyness routes decisions to the worker specific task list until stickyness is cleared.
+func (e *mutableStateBuilder) SetStickyness(stickyTaskList string, scheduleToStartTimeout int32) {
+	e.executionInfo.StickyTaskList = stickyTaskList
+	e.executionInfo.StickyScheduleToStartTimeout = scheduleToStartTimeout
+}
+
+// ClearStickyness routes decisions back to the task list the workflow execution was started with.
+func (e *mutableStateBuilder) ClearStickyness() {
+	e.executionInfo.StickyTaskList = ""
+	e.executionInfo.StickyScheduleToStartTimeout = 0
+}
+
 // UpdateDecision updates a decision task.
 func (e *mutableStateBuilder) UpdateDecision(di *decisionInfo) {
 	e.executionInfo.DecisionScheduleID = di.ScheduleID
@@ -435,9 +577,12 @@ func (e *mutableStateBuilder) getHistoryEvent(serializedEvent []byte) (*workflow
 	return event, true
 }
 
+// AddWorkflowExecutionStartedEventForContinueAsNew adds the started event of the run continuing the previous run.  An
+// attempt greater than 0 means the run retries the previous one and keeps the expiration time of the first attempt.
 func (e *mutableStateBuilder) AddWorkflowExecutionStartedEventForContinueAsNew(domainID string,
 	execution workflow.WorkflowExecution, previousExecutionState *mutableStateBuilder,
-	attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
+	attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes, attempt int32,
+	expirationTime time.Time) *workflow.HistoryEvent {
 	taskList := previousExecutionState.executionInfo.TaskList
 	if attributes.TaskList != nil {
 		taskList = *attributes.TaskList.Name
@@ -457,6 +602,19 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEventForContinueAsNew(d
 		decisionTimeout = *attributes.TaskStartToCloseTimeoutSeconds
 	}
 
+	var cronSchedule *string
+	if previousExecutionState.executionInfo.CronSchedule != "" {
+		cronSchedule = common.StringPtr(previousExecutionState.executionInfo.CronSchedule)
+	}
+
+	// The new run keeps the search attributes of the previous run unless the decision replaces them
+	searchAttributes := attributes.SearchAttributes
+	if searchAttributes == nil && len(previousExecutionState.executionInfo.SearchAttributes) > 0 {
+		searchAttributes = &workflow.SearchAttributes{
+			IndexedFields: copySearchAttributes(previousExecutionState.executionInfo.SearchAttributes),
+		}
+	}
+
 	createRequest := &workflow.StartWorkflowExecutionRequest{
 		RequestId:                           common.StringPtr(uuid.New()),
 		Domain:                              common.StringPtr(previousExecutionState.executionInfo.DomainID),
@@ -465,15 +623,38 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEventForContinueAsNew(d
 		WorkflowType:                        wType,
 		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(decisionTimeout),
 		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(*attributes.ExecutionStartToCloseTimeoutSeconds),
-		Input:    attributes.Input,
-		Identity: nil,
+		Input:            attributes.Input,
+		Identity:         nil,
+		CronSchedule:     cronSchedule,
+		RetryPolicy:      attributes.RetryPolicy,
+		SearchAttributes: searchAttributes,
+	}
+
+	if attempt == 0 {
+		expirationTime = getWorkflowExpirationTime(attributes.RetryPolicy, time.Now())
 	}
 
-	return e.AddWorkflowExecutionStartedEvent(domainID, execution, createRequest)
+	return e.addWorkflowExecutionStartedEvent(domainID, execution, createRequest,
+		common.Int32Default(attributes.BackoffStartIntervalInSeconds), attempt, expirationTime)
 }
 
 func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(domainID string, execution workflow.WorkflowExecution,
 	request *workflow.StartWorkflowExecutionRequest) *workflow.HistoryEvent {
+	return e.AddWorkflowExecutionStartedEventWithBackoff(domainID, execution, request, 0)
+}
+
+// AddWorkflowExecutionStartedEventWithBackoff adds the started event of a run whose first decision is delayed by the
+// given backoff.  The caller is responsible for creating the timer which schedules the first decision.
+func (e *mutableStateBuilder) AddWorkflowExecutionStartedEventWithBackoff(domainID string,
+	execution workflow.WorkflowExecution, request *workflow.StartWorkflowExecutionRequest,
+	firstDecisionTaskBackoffSeconds int32) *workflow.HistoryEvent {
+	return e.addWorkflowExecutionStartedEvent(domainID, execution, request, firstDecisionTaskBackoffSeconds, 0,
+		getWorkflowExpirationTime(request.RetryPolicy, time.Now()))
+}
+
+func (e *mutableStateBuilder) addWorkflowExecutionStartedEvent(domainID string, execution workflow.WorkflowExecution,
+	request *workflow.StartWorkflowExecutionRequest, firstDecisionTaskBackoffSeconds int32, attempt int32,
+	expirationTime time.Time) *workflow.HistoryEvent {
 	eventID := e.GetNextEventID()
 	if eventID != firstEventID {
 		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionWorkflowStarted, eventID, "")
@@ -495,13 +676,24 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(domainID string,
 	e.executionInfo.DecisionStartedID = emptyEventID
 	e.executionInfo.DecisionRequestID = emptyUUID
 	e.executionInfo.DecisionTimeout = 0
+	e.executionInfo.CronSchedule = common.StringDefault(request.CronSchedule)
+	e.executionInfo.Attempt = attempt
+	e.executionInfo.HasRetryPolicy = request.RetryPolicy != nil
+	e.executionInfo.SearchAttributes = nil
+	if request.SearchAttributes != nil {
+		e.executionInfo.SearchAttributes = copySearchAttributes(request.SearchAttributes.IndexedFields)
+	}
 
-	return e.hBuilder.AddWorkflowExecutionStartedEvent(request)
+	return e.hBuilder.AddWorkflowExecutionStartedEvent(request, firstDecisionTaskBackoffSeconds, attempt,
+		expirationTime)
 }
 
 func (e *mutableStateBuilder) AddDecisionTaskScheduledEvent() (*workflow.HistoryEvent, *decisionInfo) {
 	// Tasklist and decision timeout should already be set from workflow execution started event
 	taskList := e.executionInfo.TaskList
+	if e.isStickyTaskListEnabled() {
+		taskList = e.executionInfo.StickyTaskList
+	}
 	startToCloseTimeoutSeconds := e.executionInfo.DecisionTimeoutValue
 	if e.HasPendingDecisionTask() {
 		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionDecisionTaskScheduled, e.GetNextEventID(), fmt.Sprintf(
@@ -572,6 +764,23 @@ func (e *mutableStateBuilder) AddDecisionTaskTimedOutEvent(scheduleEventID int64
 	event := e.hBuilder.AddDecisionTaskTimedOutEvent(scheduleEventID, startedEventID)
 
 	e.DeleteDecision()
+	e.ClearStickyness()
+	return event
+}
+
+func (e *mutableStateBuilder) AddDecisionTaskScheduleToStartTimeoutEvent(scheduleEventID int64) *workflow.HistoryEvent {
+	hasPendingDecision := e.HasPendingDecisionTask()
+	pendingDecisionTask, ok := e.GetPendingDecision(scheduleEventID)
+	if !hasPendingDecision || !ok || pendingDecisionTask.StartedID != emptyEventID {
+		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionDecisionTaskTimedOut, e.GetNextEventID(), fmt.Sprintf(
+			"{HasPending: %v, ScheduleID: %v, Exist: %v}", hasPendingDecision, scheduleEventID, ok))
+		return nil
+	}
+
+	event := e.hBuilder.AddDecisionTaskScheduleToStartTimeoutEvent(scheduleEventID)
+
+	e.DeleteDecision()
+	e.ClearStickyness()
 	return event
 }
 
@@ -590,6 +799,7 @@ func (e *mutableStateBuilder) AddDecisionTaskFailedEvent(scheduleEventID int64,
 	event := e.hBuilder.AddDecisionTaskFailedEvent(scheduleEventID, startedEventID, cause, request)
 
 	e.DeleteDecision()
+	e.ClearStickyness()
 	return event
 }
 
@@ -671,9 +881,21 @@ func (e *mutableStateBuilder) AddActivityTaskStartedEvent(ai *persistence.Activi
 		return nil
 	}
 
-	event := e.hBuilder.AddActivityTaskStartedEvent(scheduleEventID, requestID, request)
+	var event *workflow.HistoryEvent
+	if e.getActivityRetryPolicy(ai) == nil {
+		event = e.hBuilder.AddActivityTaskStartedEvent(scheduleEventID, ai.Attempt, requestID, request)
+	} else {
+		// The started event is only written to history once we know this attempt is not going to be retried
+		event = e.hBuilder.newTransientActivityTaskStartedEvent(scheduleEventID, ai.Attempt, requestID, request)
+	}
+
+	startedEvent, err := e.eventSerializer.Serialize(event)
+	if err != nil {
+		return nil
+	}
 
 	ai.StartedID = *event.EventId
+	ai.StartedEvent = startedEvent
 	ai.RequestID = requestID
 	ai.StartedTime = time.Unix(0, *event.Timestamp)
 	e.updateActivityInfos = append(e.updateActivityInfos, ai)
@@ -681,14 +903,96 @@ func (e *mutableStateBuilder) AddActivityTaskStartedEvent(ai *persistence.Activi
 	return event
 }
 
+// addTransientActivityStartedEvent writes the transient started event of an activity with a retry policy to history
+// and returns the started event ID the activity should be closed with.
+func (e *mutableStateBuilder) addTransientActivityStartedEvent(ai *persistence.ActivityInfo) (int64, error) {
+	if ai.StartedID != transientEventID {
+		return ai.StartedID, nil
+	}
+
+	event, err := e.eventSerializer.Deserialize(ai.StartedEvent)
+	if err != nil {
+		return emptyEventID, err
+	}
+
+	// Keep the timestamp of when the activity was actually started
+	event.EventId = common.Int64Ptr(e.GetNextEventID())
+	e.executionInfo.NextEventID++
+	e.hBuilder.addEventToHistory(event)
+
+	ai.StartedID = *event.EventId
+	return ai.StartedID, nil
+}
+
+// getActivityRetryPolicy returns the retry policy the activity was scheduled with, or nil if it has none
+func (e *mutableStateBuilder) getActivityRetryPolicy(ai *persistence.ActivityInfo) *workflow.RetryPolicy {
+	scheduledEvent, ok := e.getHistoryEvent(ai.ScheduledEvent)
+	if !ok {
+		return nil
+	}
+
+	return scheduledEvent.ActivityTaskScheduledEventAttributes.RetryPolicy
+}
+
+// CreateActivityRetryTimer resets the activity for its next attempt when its retry policy allows one, and returns the
+// timer task which dispatches that attempt.  It returns nil when the failure has to be recorded in history instead.
+func (e *mutableStateBuilder) CreateActivityRetryTimer(ai *persistence.ActivityInfo,
+	failureReason string) persistence.Task {
+	if ai.CancelRequested {
+		return nil
+	}
+
+	scheduledEvent, ok := e.getHistoryEvent(ai.ScheduledEvent)
+	if !ok {
+		return nil
+	}
+	policy := scheduledEvent.ActivityTaskScheduledEventAttributes.RetryPolicy
+	if policy == nil {
+		return nil
+	}
+
+	var expirationTime time.Time
+	if expiration := common.Int32Default(policy.ExpirationIntervalInSeconds); expiration > 0 {
+		expirationTime = time.Unix(0, *scheduledEvent.Timestamp).Add(time.Duration(expiration) * time.Second)
+	}
+
+	now := time.Now()
+	backoff := getRetryBackoffInterval(policy, ai.Attempt, failureReason, now, expirationTime)
+	if backoff == noRetryBackoff {
+		return nil
+	}
+
+	ai.Attempt++
+	ai.ScheduledTime = now.Add(backoff)
+	ai.StartedID = emptyEventID
+	ai.StartedEvent = nil
+	ai.StartedTime = time.Time{}
+	ai.RequestID = ""
+	ai.LastHeartBeatUpdatedTime = time.Time{}
+	ai.TimerTaskStatus = TimerTaskStatusNone
+	e.updateActivityInfos = append(e.updateActivityInfos, ai)
+
+	return &persistence.ActivityRetryTimerTask{
+		VisibilityTimestamp: ai.ScheduledTime,
+		EventID:             ai.ScheduleID,
+		Attempt:             ai.Attempt,
+	}
+}
+
 func (e *mutableStateBuilder) AddActivityTaskCompletedEvent(scheduleEventID, startedEventID int64,
 	request *workflow.RespondActivityTaskCompletedRequest) *workflow.HistoryEvent {
-	if ai, ok := e.GetActivityInfo(scheduleEventID); !ok || ai.StartedID != startedEventID {
+	ai, ok := e.GetActivityInfo(scheduleEventID)
+	if !ok || ai.StartedID != startedEventID {
 		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionActivityTaskCompleted, e.GetNextEventID(), fmt.Sprintf(
 			"{ScheduleID: %v, StartedID: %v, Exist: %v}", scheduleEventID, startedEventID, ok))
 		return nil
 	}
 
+	startedEventID, err := e.addTransientActivityStartedEvent(ai)
+	if err != nil {
+		return nil
+	}
+
 	if err := e.DeleteActivity(scheduleEventID); err != nil {
 		return nil
 	}
@@ -698,12 +1002,18 @@ func (e *mutableStateBuilder) AddActivityTaskCompletedEvent(scheduleEventID, sta
 
 func (e *mutableStateBuilder) AddActivityTaskFailedEvent(scheduleEventID, startedEventID int64,
 	request *workflow.RespondActivityTaskFailedRequest) *workflow.HistoryEvent {
-	if ai, ok := e.GetActivityInfo(scheduleEventID); !ok || ai.StartedID != startedEventID {
+	ai, ok := e.GetActivityInfo(scheduleEventID)
+	if !ok || ai.StartedID != startedEventID {
 		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionActivityTaskFailed, e.GetNextEventID(), fmt.Sprintf(
 			"{ScheduleID: %v, StartedID: %v, Exist: %v}", scheduleEventID, startedEventID, ok))
 		return nil
 	}
 
+	startedEventID, err := e.addTransientActivityStartedEvent(ai)
+	if err != nil {
+		return nil
+	}
+
 	if err := e.DeleteActivity(scheduleEventID); err != nil {
 		return nil
 	}
@@ -713,7 +1023,8 @@ func (e *mutableStateBuilder) AddActivityTaskFailedEvent(scheduleEventID, starte
 
 func (e *mutableStateBuilder) AddActivityTaskTimedOutEvent(scheduleEventID, startedEventID int64,
 	timeoutType workflow.TimeoutType, lastHeartBeatDetails []byte) *workflow.HistoryEvent {
-	if ai, ok := e.GetActivityInfo(scheduleEventID); !ok || ai.StartedID != startedEventID ||
+	ai, ok := e.GetActivityInfo(scheduleEventID)
+	if !ok || ai.StartedID != startedEventID ||
 		((timeoutType == workflow.TimeoutTypeStartToClose || timeoutType == workflow.TimeoutTypeHeartbeat) &&
 			ai.StartedID == emptyEventID) {
 		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionActivityTaskTimedOut, e.GetNextEventID(), fmt.Sprintf(
@@ -722,6 +1033,11 @@ func (e *mutableStateBuilder) AddActivityTaskTimedOutEvent(scheduleEventID, star
 		return nil
 	}
 
+	startedEventID, err := e.addTransientActivityStartedEvent(ai)
+	if err != nil {
+		return nil
+	}
+
 	if err := e.DeleteActivity(scheduleEventID); err != nil {
 		return nil
 	}
@@ -772,6 +1088,11 @@ func (e *mutableStateBuilder) AddActivityTaskCanceledEvent(scheduleEventID, star
 		return nil
 	}
 
+	startedEventID, err := e.addTransientActivityStartedEvent(ai)
+	if err != nil {
+		return nil
+	}
+
 	if err := e.DeleteActivity(scheduleEventID); err != nil {
 		return nil
 	}
@@ -917,6 +1238,65 @@ func (e *mutableStateBuilder) AddRequestCancelExternalWorkflowExecutionFailedEve
 	return nil
 }
 
+func (e *mutableStateBuilder) AddSignalExternalWorkflowExecutionInitiatedEvent(decisionCompletedEventID int64,
+	signalRequestID string, request *workflow.SignalExternalWorkflowExecutionDecisionAttributes) (
+	*workflow.HistoryEvent, *persistence.SignalInfo) {
+	event := e.hBuilder.AddSignalExternalWorkflowExecutionInitiatedEvent(decisionCompletedEventID, request)
+	if event == nil {
+		return nil, nil
+	}
+
+	initiatedEventID := *event.EventId
+	si := &persistence.SignalInfo{
+		InitiatedID:     initiatedEventID,
+		SignalRequestID: signalRequestID,
+		SignalName:      common.StringDefault(request.SignalName),
+		Input:           request.Input,
+		Control:         request.Control,
+	}
+
+	e.pendingSignalInfoIDs[initiatedEventID] = si
+	e.updateSignalInfos = append(e.updateSignalInfos, si)
+
+	return event, si
+}
+
+func (e *mutableStateBuilder) AddExternalWorkflowExecutionSignaled(initiatedID int64,
+	domain, workflowID, runID string, control []byte) *workflow.HistoryEvent {
+	_, ok := e.GetSignalInfo(initiatedID)
+	if !ok {
+		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionWorkflowSignalRequested, e.GetNextEventID(),
+			fmt.Sprintf("{InitiatedID: %v, Exist: %v}", initiatedID, ok))
+
+		return nil
+	}
+
+	if e.DeletePendingSignal(initiatedID) == nil {
+		return e.hBuilder.AddExternalWorkflowExecutionSignaled(initiatedID, domain, workflowID, runID, control)
+	}
+
+	return nil
+}
+
+func (e *mutableStateBuilder) AddSignalExternalWorkflowExecutionFailedEvent(
+	decisionTaskCompletedEventID, initiatedID int64, domain, workflowID, runID string, control []byte,
+	cause workflow.SignalExternalWorkflowExecutionFailedCause) *workflow.HistoryEvent {
+	_, ok := e.GetSignalInfo(initiatedID)
+	if !ok {
+		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionWorkflowSignalFailed, e.GetNextEventID(),
+			fmt.Sprintf("{InitiatedID: %v, Exist: %v}", initiatedID, ok))
+
+		return nil
+	}
+
+	if e.DeletePendingSignal(initiatedID) == nil {
+		return e.hBuilder.AddSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedID,
+			domain, workflowID, runID, control, cause)
+	}
+
+	return nil
+}
+
 func (e *mutableStateBuilder) AddTimerStartedEvent(decisionCompletedEventID int64,
 	request *workflow.StartTimerDecisionAttributes) (*workflow.HistoryEvent, *persistence.TimerInfo) {
 	timerID := *request.TimerId
@@ -994,6 +1374,22 @@ func (e *mutableStateBuilder) AddRecordMarkerEvent(decisionCompletedEventID int6
 	return e.hBuilder.AddMarkerRecordedEvent(decisionCompletedEventID, attributes)
 }
 
+// AddUpsertWorkflowSearchAttributesEvent merges the search attributes of the decision into the search attributes of
+// the execution, replacing the values of the ones already set
+func (e *mutableStateBuilder) AddUpsertWorkflowSearchAttributesEvent(decisionCompletedEventID int64,
+	attributes *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) *workflow.HistoryEvent {
+	if attributes.SearchAttributes != nil && len(attributes.SearchAttributes.IndexedFields) > 0 {
+		if e.executionInfo.SearchAttributes == nil {
+			e.executionInfo.SearchAttributes = make(map[string][]byte, len(attributes.SearchAttributes.IndexedFields))
+		}
+		for key, value := range attributes.SearchAttributes.IndexedFields {
+			e.executionInfo.SearchAttributes[key] = value
+		}
+	}
+
+	return e.hBuilder.AddUpsertWorkflowSearchAttributesEvent(decisionCompletedEventID, attributes)
+}
+
 func (e *mutableStateBuilder) AddWorkflowExecutionTerminatedEvent(
 	request *workflow.TerminateWorkflowExecutionRequest) *workflow.HistoryEvent {
 	if e.executionInfo.State == persistence.WorkflowStateCompleted {
@@ -1024,6 +1420,20 @@ func (e *mutableStateBuilder) AddWorkflowExecutionSignaled(
 func (e *mutableStateBuilder) AddContinueAsNewEvent(decisionCompletedEventID int64, domainID, newRunID string,
 	attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) (*workflow.HistoryEvent, *mutableStateBuilder,
 	error) {
+	return e.addContinueAsNewEvent(decisionCompletedEventID, domainID, newRunID, attributes, 0, time.Time{})
+}
+
+// AddContinueAsNewEventForRetry continues a failed or timed out run as the given attempt of the workflow, which
+// expires at the expiration time of the first attempt.
+func (e *mutableStateBuilder) AddContinueAsNewEventForRetry(decisionCompletedEventID int64, domainID, newRunID string,
+	attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes, attempt int32,
+	expirationTime time.Time) (*workflow.HistoryEvent, *mutableStateBuilder, error) {
+	return e.addContinueAsNewEvent(decisionCompletedEventID, domainID, newRunID, attributes, attempt, expirationTime)
+}
+
+func (e *mutableStateBuilder) addContinueAsNewEvent(decisionCompletedEventID int64, domainID, newRunID string,
+	attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes, attempt int32,
+	expirationTime time.Time) (*workflow.HistoryEvent, *mutableStateBuilder, error) {
 	if e.hasPendingTasks() || e.HasPendingDecisionTask() {
 		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionContinueAsNew, e.GetNextEventID(), fmt.Sprintf(
 			"{OutStandingActivityTasks: %v, HasPendingDecision: %v}", len(e.pendingActivityInfoIDs),
@@ -1039,15 +1449,42 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(decisionCompletedEventID int
 
 	newStateBuilder := newMutableStateBuilder(e.config, e.logger)
 	startedEvent := newStateBuilder.AddWorkflowExecutionStartedEventForContinueAsNew(domainID, newExecution, e,
-		attributes)
+		attributes, attempt, expirationTime)
 	if startedEvent == nil {
 		return nil, nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution started event."}
 	}
 
-	_, di := newStateBuilder.AddDecisionTaskScheduledEvent()
-	if di == nil {
-		return nil, nil, &workflow.InternalServiceError{Message: "Failed to add decision started event."}
+	var transferTasks []persistence.Task
+	var timerTasks []persistence.Task
+	decisionScheduleID := emptyEventID
+	decisionStartID := emptyEventID
+	decisionTimeout := int32(0)
+	now := time.Now()
+	backoff := time.Duration(common.Int32Default(attributes.BackoffStartIntervalInSeconds)) * time.Second
+	if backoff > 0 {
+		// The first decision of the new run is scheduled by the timer when the backoff expires, so the start of the
+		// new run is recorded in visibility right away
+		transferTasks = []persistence.Task{&persistence.RecordWorkflowStartedTask{}}
+		timerTasks = []persistence.Task{&persistence.WorkflowBackoffTimerTask{
+			VisibilityTimestamp: now.Add(backoff),
+		}}
+	} else {
+		_, di := newStateBuilder.AddDecisionTaskScheduledEvent()
+		if di == nil {
+			return nil, nil, &workflow.InternalServiceError{Message: "Failed to add decision started event."}
+		}
+
+		transferTasks = []persistence.Task{&persistence.DecisionTask{
+			DomainID: domainID, TaskList: newStateBuilder.executionInfo.TaskList, ScheduleID: di.ScheduleID,
+		}}
+		decisionScheduleID = di.ScheduleID
+		decisionStartID = di.StartedID
+		decisionTimeout = di.DecisionTimeout
 	}
+	timeout := time.Duration(*attributes.ExecutionStartToCloseTimeoutSeconds) * time.Second
+	timerTasks = append(timerTasks, &persistence.WorkflowTimeoutTask{
+		VisibilityTimestamp: now.Add(backoff + timeout),
+	})
 
 	parentDomainID := ""
 	var parentExecution *workflow.WorkflowExecution
@@ -1062,25 +1499,28 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(decisionCompletedEventID int
 	}
 
 	e.continueAsNew = &persistence.CreateWorkflowExecutionRequest{
-		RequestID:            uuid.New(),
-		DomainID:             domainID,
-		Execution:            newExecution,
-		ParentDomainID:       parentDomainID,
-		ParentExecution:      parentExecution,
-		InitiatedID:          initiatedID,
-		TaskList:             newStateBuilder.executionInfo.TaskList,
-		WorkflowTypeName:     newStateBuilder.executionInfo.WorkflowTypeName,
-		DecisionTimeoutValue: newStateBuilder.executionInfo.DecisionTimeoutValue,
-		ExecutionContext:     nil,
-		NextEventID:          newStateBuilder.GetNextEventID(),
-		LastProcessedEvent:   common.EmptyEventID,
-		TransferTasks: []persistence.Task{&persistence.DecisionTask{
-			DomainID: domainID, TaskList: newStateBuilder.executionInfo.TaskList, ScheduleID: di.ScheduleID,
-		}},
-		DecisionScheduleID:          di.ScheduleID,
-		DecisionStartedID:           di.StartedID,
-		DecisionStartToCloseTimeout: di.DecisionTimeout,
+		RequestID:                   uuid.New(),
+		DomainID:                    domainID,
+		Execution:                   newExecution,
+		ParentDomainID:              parentDomainID,
+		ParentExecution:             parentExecution,
+		InitiatedID:                 initiatedID,
+		TaskList:                    newStateBuilder.executionInfo.TaskList,
+		WorkflowTypeName:            newStateBuilder.executionInfo.WorkflowTypeName,
+		DecisionTimeoutValue:        newStateBuilder.executionInfo.DecisionTimeoutValue,
+		ExecutionContext:            nil,
+		NextEventID:                 newStateBuilder.GetNextEventID(),
+		LastProcessedEvent:          common.EmptyEventID,
+		TransferTasks:               transferTasks,
+		TimerTasks:                  timerTasks,
+		DecisionScheduleID:          decisionScheduleID,
+		DecisionStartedID:           decisionStartID,
+		DecisionStartToCloseTimeout: decisionTimeout,
 		ContinueAsNew:               true,
+		CronSchedule:                newStateBuilder.executionInfo.CronSchedule,
+		Attempt:                     newStateBuilder.executionInfo.Attempt,
+		HasRetryPolicy:              newStateBuilder.executionInfo.HasRetryPolicy,
+		SearchAttributes:            newStateBuilder.executionInfo.SearchAttributes,
 	}
 
 	return e.hBuilder.AddContinuedAsNewEvent(decisionCompletedEventID, newRunID, attributes), newStateBuilder, nil
@@ -1241,3 +1681,16 @@ func (e *mutableStateBuilder) AddChildWorkflowExecutionTerminatedEvent(initiated
 
 	return nil
 }
+
+// copySearchAttributes copies the search attributes of a request or event, which are merged into the execution info
+// in place by the upsert decision
+func copySearchAttributes(searchAttributes map[string][]byte) map[string][]byte {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	result := make(map[string][]byte, len(searchAttributes))
+	for key, value := range searchAttributes {
+		result[key] = value
+	}
+	return result
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"math"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+const (
+	// noRetryBackoff is returned by getRetryBackoffInterval when the retry policy does not allow another attempt
+	noRetryBackoff = time.Duration(-1)
+
+	// defaultMaximumIntervalCoefficient caps the retry interval when the policy does not specify a maximum interval
+	defaultMaximumIntervalCoefficient = 100
+
+	timeoutReasonPrefix = "cadenceInternal:Timeout "
+)
+
+// getRetryBackoffInterval returns how long to wait before the attempt following currAttempt, which failed with the
+// given reason.  An expirationTime of zero means the retry policy never expires.
+func getRetryBackoffInterval(policy *workflow.RetryPolicy, currAttempt int32, failureReason string,
+	now, expirationTime time.Time) time.Duration {
+	for _, reason := range policy.NonRetriableErrorReasons {
+		if reason == failureReason {
+			return noRetryBackoff
+		}
+	}
+
+	maxAttempts := common.Int32Default(policy.MaximumAttempts)
+	if maxAttempts > 0 && currAttempt+1 >= maxAttempts {
+		return noRetryBackoff
+	}
+
+	initialInterval := common.Int32Default(policy.InitialIntervalInSeconds)
+	if initialInterval <= 0 {
+		return noRetryBackoff
+	}
+
+	coefficient := 1.0
+	if policy.BackoffCoefficient != nil {
+		coefficient = *policy.BackoffCoefficient
+	}
+
+	maxInterval := float64(common.Int32Default(policy.MaximumIntervalInSeconds))
+	if maxInterval <= 0 {
+		maxInterval = float64(initialInterval) * defaultMaximumIntervalCoefficient
+	}
+
+	interval := math.Min(float64(initialInterval)*math.Pow(coefficient, float64(currAttempt)), maxInterval)
+	backoff := time.Duration(interval) * time.Second
+	if !expirationTime.IsZero() && now.Add(backoff).After(expirationTime) {
+		return noRetryBackoff
+	}
+
+	return backoff
+}
+
+// getWorkflowExpirationTime returns when the retries of a workflow started now expire, or zero if they never expire
+func getWorkflowExpirationTime(policy *workflow.RetryPolicy, now time.Time) time.Time {
+	if policy == nil {
+		return time.Time{}
+	}
+
+	expiration := common.Int32Default(policy.ExpirationIntervalInSeconds)
+	if expiration <= 0 {
+		return time.Time{}
+	}
+	return now.Add(time.Duration(expiration) * time.Second)
+}
+
+// getTimeoutReason returns the failure reason used to match timeouts against the non retriable reasons of a policy
+func getTimeoutReason(timeoutType workflow.TimeoutType) string {
+	return timeoutReasonPrefix + timeoutType.String()
+}
+
+func validateRetryPolicy(policy *workflow.RetryPolicy) error {
+	if policy == nil {
+		// A nil policy means the operation is not retried
+		return nil
+	}
+
+	if policy.InitialIntervalInSeconds == nil || *policy.InitialIntervalInSeconds <= 0 {
+		return &workflow.BadRequestError{Message: "A valid InitialIntervalInSeconds is not set on retry policy."}
+	}
+	if policy.BackoffCoefficient != nil && *policy.BackoffCoefficient < 1 {
+		return &workflow.BadRequestError{Message: "BackoffCoefficient cannot be less than 1 on retry policy."}
+	}
+	if common.Int32Default(policy.MaximumIntervalInSeconds) < 0 {
+		return &workflow.BadRequestError{Message: "MaximumIntervalInSeconds cannot be negative on retry policy."}
+	}
+	if policy.MaximumIntervalInSeconds != nil && *policy.MaximumIntervalInSeconds > 0 &&
+		*policy.MaximumIntervalInSeconds < *policy.InitialIntervalInSeconds {
+		return &workflow.BadRequestError{
+			Message: "MaximumIntervalInSeconds cannot be less than InitialIntervalInSeconds on retry policy."}
+	}
+	if common.Int32Default(policy.MaximumAttempts) < 0 {
+		return &workflow.BadRequestError{Message: "MaximumAttempts cannot be negative on retry policy."}
+	}
+	if common.Int32Default(policy.ExpirationIntervalInSeconds) < 0 {
+		return &workflow.BadRequestError{Message: "ExpirationIntervalInSeconds cannot be negative on retry policy."}
+	}
+	if common.Int32Default(policy.MaximumAttempts) == 0 && common.Int32Default(policy.ExpirationIntervalInSeconds) == 0 {
+		return &workflow.BadRequestError{
+			Message: "MaximumAttempts and ExpirationIntervalInSeconds are both not set on retry policy."}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	retrySuite struct {
+		suite.Suite
+	}
+)
+
+func TestRetrySuite(t *testing.T) {
+	s := new(retrySuite)
+	suite.Run(t, s)
+}
+
+func (s *retrySuite) TestGetRetryBackoffInterval() {
+	policy := &workflow.RetryPolicy{
+		InitialIntervalInSeconds: common.Int32Ptr(1),
+		BackoffCoefficient:       common.Float64Ptr(2),
+		MaximumIntervalInSeconds: common.Int32Ptr(10),
+		MaximumAttempts:          common.Int32Ptr(6),
+		NonRetriableErrorReasons: []string{"bad-input"},
+	}
+	now := time.Now()
+
+	s.Equal(time.Second, getRetryBackoffInterval(policy, 0, "some-error", now, time.Time{}))
+	s.Equal(2*time.Second, getRetryBackoffInterval(policy, 1, "some-error", now, time.Time{}))
+	s.Equal(8*time.Second, getRetryBackoffInterval(policy, 3, "some-error", now, time.Time{}))
+	// Capped by the maximum interval
+	s.Equal(10*time.Second, getRetryBackoffInterval(policy, 4, "some-error", now, time.Time{}))
+	// Maximum attempts reached
+	s.Equal(noRetryBackoff, getRetryBackoffInterval(policy, 5, "some-error", now, time.Time{}))
+	// Non retriable failure
+	s.Equal(noRetryBackoff, getRetryBackoffInterval(policy, 0, "bad-input", now, time.Time{}))
+	// Next attempt would start after the policy expires
+	s.Equal(noRetryBackoff, getRetryBackoffInterval(policy, 3, "some-error", now, now.Add(5*time.Second)))
+	s.Equal(2*time.Second, getRetryBackoffInterval(policy, 1, "some-error", now, now.Add(5*time.Second)))
+}
+
+func (s *retrySuite) TestGetRetryBackoffInterval_DefaultMaximumInterval() {
+	policy := &workflow.RetryPolicy{
+		InitialIntervalInSeconds:    common.Int32Ptr(1),
+		BackoffCoefficient:          common.Float64Ptr(10),
+		ExpirationIntervalInSeconds: common.Int32Ptr(3600),
+	}
+	now := time.Now()
+
+	s.Equal(10*time.Second, getRetryBackoffInterval(policy, 1, "some-error", now, time.Time{}))
+	s.Equal(100*time.Second, getRetryBackoffInterval(policy, 3, "some-error", now, time.Time{}))
+}
+
+func (s *retrySuite) TestGetWorkflowExpirationTime() {
+	now := time.Now()
+
+	s.True(getWorkflowExpirationTime(nil, now).IsZero())
+	s.True(getWorkflowExpirationTime(&workflow.RetryPolicy{
+		InitialIntervalInSeconds: common.Int32Ptr(1),
+		MaximumAttempts:          common.Int32Ptr(3),
+	}, now).IsZero())
+	s.Equal(now.Add(time.Minute), getWorkflowExpirationTime(&workflow.RetryPolicy{
+		InitialIntervalInSeconds:    common.Int32Ptr(1),
+		ExpirationIntervalInSeconds: common.Int32Ptr(60),
+	}, now))
+}
+
+func (s *retrySuite) TestValidateRetryPolicy() {
+	s.Nil(validateRetryPolicy(nil))
+	s.Nil(validateRetryPolicy(&workflow.RetryPolicy{
+		InitialIntervalInSeconds: common.Int32Ptr(1),
+		BackoffCoefficient:       common.Float64Ptr(1.5),
+		MaximumAttempts:          common.Int32Ptr(3),
+	}))
+
+	invalidPolicies := []*workflow.RetryPolicy{
+		{MaximumAttempts: common.Int32Ptr(3)},
+		{InitialIntervalInSeconds: common.Int32Ptr(1), BackoffCoefficient: common.Float64Ptr(0.5),
+			MaximumAttempts: common.Int32Ptr(3)},
+		{InitialIntervalInSeconds: common.Int32Ptr(10), MaximumIntervalInSeconds: common.Int32Ptr(5),
+			MaximumAttempts: common.Int32Ptr(3)},
+		{InitialIntervalInSeconds: common.Int32Ptr(1), MaximumAttempts: common.Int32Ptr(-1)},
+		{InitialIntervalInSeconds: common.Int32Ptr(1)},
+	}
+	for _, policy := range invalidPolicies {
+		_, ok := validateRetryPolicy(policy).(*workflow.BadRequestError)
+		s.True(ok)
+	}
+}
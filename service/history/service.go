@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
@@ -31,59 +32,69 @@ import (
 
 // Config represents configuration for cadence-history service
 type Config struct {
-	NumberOfShards int
+	NumberOfShards int `yaml:"-"`
 
 	// HistoryCache settings
-	HistoryCacheInitialSize int
-	HistoryCacheMaxSize     int
-	HistoryCacheTTL         time.Duration
+	HistoryCacheInitialSize dynamicconfig.IntPropertyFn      `yaml:"historyCacheInitialSize" validate:"min=1"`
+	HistoryCacheMaxSize     dynamicconfig.IntPropertyFn      `yaml:"historyCacheMaxSize" validate:"min=1"`
+	HistoryCacheTTL         dynamicconfig.DurationPropertyFn `yaml:"historyCacheTTL" validate:"nonzero"`
 
 	// ShardController settings
-	RangeSizeBits        uint
-	AcquireShardInterval time.Duration
+	RangeSizeBits        uint          `yaml:"rangeSizeBits" validate:"min=1,max=62"`
+	AcquireShardInterval time.Duration `yaml:"acquireShardInterval" validate:"nonzero"`
 
 	// Timeout settings
-	DefaultScheduleToStartActivityTimeoutInSecs int32
-	DefaultScheduleToCloseActivityTimeoutInSecs int32
-	DefaultStartToCloseActivityTimeoutInSecs    int32
+	DefaultScheduleToStartActivityTimeoutInSecs int32 `yaml:"defaultScheduleToStartActivityTimeoutInSecs" validate:"min=1"`
+	DefaultScheduleToCloseActivityTimeoutInSecs int32 `yaml:"defaultScheduleToCloseActivityTimeoutInSecs" validate:"min=1"`
+	DefaultStartToCloseActivityTimeoutInSecs    int32 `yaml:"defaultStartToCloseActivityTimeoutInSecs" validate:"min=1"`
 
 	// TimerQueueProcessor settings
-	TimerTaskBatchSize                    int
-	ProcessTimerTaskWorkerCount           int
-	TimerProcessorUpdateFailureRetryCount int
-	TimerProcessorGetFailureRetryCount    int
-	TimerProcessorUpdateAckInterval       time.Duration
+	TimerTaskBatchSize                    dynamicconfig.IntPropertyFn `yaml:"timerTaskBatchSize" validate:"min=1"`
+	ProcessTimerTaskWorkerCount           dynamicconfig.IntPropertyFn `yaml:"processTimerTaskWorkerCount" validate:"min=1"`
+	TimerProcessorUpdateFailureRetryCount int                         `yaml:"timerProcessorUpdateFailureRetryCount" validate:"min=0"`
+	TimerProcessorGetFailureRetryCount    int                         `yaml:"timerProcessorGetFailureRetryCount" validate:"min=0"`
+	TimerProcessorUpdateAckInterval       time.Duration               `yaml:"timerProcessorUpdateAckInterval" validate:"nonzero"`
 
 	// TransferQueueProcessor settings
-	TransferTaskBatchSize              int
-	TransferProcessorMaxPollRPS        int
-	TransferProcessorMaxPollInterval   time.Duration
-	TransferProcessorUpdateAckInterval time.Duration
-	TransferTaskWorkerCount            int
+	TransferTaskBatchSize              dynamicconfig.IntPropertyFn `yaml:"transferTaskBatchSize" validate:"min=1"`
+	TransferProcessorMaxPollRPS        dynamicconfig.IntPropertyFn `yaml:"transferProcessorMaxPollRPS" validate:"min=1"`
+	TransferProcessorMaxPollInterval   time.Duration               `yaml:"transferProcessorMaxPollInterval" validate:"nonzero"`
+	TransferProcessorUpdateAckInterval time.Duration               `yaml:"transferProcessorUpdateAckInterval" validate:"nonzero"`
+	TransferTaskWorkerCount            dynamicconfig.IntPropertyFn `yaml:"transferTaskWorkerCount" validate:"min=1"`
+
+	// LongPollExpirationInterval is how long GetWorkflowExecutionNextEventID waits for new history events
+	LongPollExpirationInterval dynamicconfig.DurationPropertyFn `yaml:"longPollExpirationInterval" validate:"nonzero"`
+
+	// ValidSearchAttributes are the search attributes accepted by the upsert decision, with the type of their values
+	ValidSearchAttributes dynamicconfig.MapPropertyFn `yaml:"-"`
 }
 
 // NewConfig returns new service config with default values
-func NewConfig(numberOfShards int) *Config {
+func NewConfig(dc *dynamicconfig.Collection, numberOfShards int) *Config {
 	return &Config{
-		NumberOfShards:                              numberOfShards,
-		HistoryCacheInitialSize:                     256,
-		HistoryCacheMaxSize:                         1 * 1024,
-		HistoryCacheTTL:                             time.Hour,
-		RangeSizeBits:                               20, // 20 bits for sequencer, 2^20 sequence number for any range
-		AcquireShardInterval:                        time.Minute,
+		NumberOfShards:          numberOfShards,
+		HistoryCacheInitialSize: dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize, 256),
+		HistoryCacheMaxSize:     dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSize, 1*1024),
+		HistoryCacheTTL:         dc.GetDurationProperty(dynamicconfig.HistoryCacheTTL, time.Hour),
+		RangeSizeBits:           20, // 20 bits for sequencer, 2^20 sequence number for any range
+		AcquireShardInterval:    time.Minute,
 		DefaultScheduleToStartActivityTimeoutInSecs: 10,
 		DefaultScheduleToCloseActivityTimeoutInSecs: 10,
 		DefaultStartToCloseActivityTimeoutInSecs:    10,
-		TimerTaskBatchSize:                          100,
-		ProcessTimerTaskWorkerCount:                 30,
+		TimerTaskBatchSize:                          dc.GetIntProperty(dynamicconfig.TimerTaskBatchSize, 100),
+		ProcessTimerTaskWorkerCount:                 dc.GetIntProperty(dynamicconfig.TimerProcessorWorkerCount, 30),
 		TimerProcessorUpdateFailureRetryCount:       5,
 		TimerProcessorGetFailureRetryCount:          5,
 		TimerProcessorUpdateAckInterval:             10 * time.Second,
-		TransferTaskBatchSize:                       10,
-		TransferProcessorMaxPollRPS:                 100,
+		TransferTaskBatchSize:                       dc.GetIntProperty(dynamicconfig.TransferTaskBatchSize, 10),
+		TransferProcessorMaxPollRPS:                 dc.GetIntProperty(dynamicconfig.TransferProcessorMaxPollRPS, 100),
 		TransferProcessorMaxPollInterval:            10 * time.Second,
 		TransferProcessorUpdateAckInterval:          10 * time.Second,
-		TransferTaskWorkerCount:                     10,
+		TransferTaskWorkerCount:                     dc.GetIntProperty(dynamicconfig.TransferTaskWorkerCount, 10),
+		LongPollExpirationInterval: dc.GetDurationProperty(
+			dynamicconfig.HistoryLongPollExpirationInterval, time.Second*20),
+		ValidSearchAttributes: dc.GetMapProperty(dynamicconfig.ValidSearchAttributes,
+			common.GetDefaultValidSearchAttributes()),
 	}
 }
 
@@ -116,13 +127,23 @@ func (s *Service) Start() {
 
 	s.metricsClient = base.GetMetricsClient()
 
-	shardMgr, err := persistence.NewCassandraShardPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		p.Logger)
+	var shardMgr persistence.ShardManager
+	var err error
+	if p.PersistenceConfig.IsInMemory() {
+		shardMgr, err = persistence.NewInMemoryShardPersistence(p.InMemoryStore, p.Logger)
+	} else if p.PersistenceConfig.IsSQL() {
+		shardMgr, err = persistence.NewSQLShardPersistence(p.PersistenceConfig.SQL.DriverName,
+			p.PersistenceConfig.SQL.DataSourceName,
+			p.Logger)
+	} else {
+		shardMgr, err = persistence.NewCassandraShardPersistence(p.CassandraConfig.Hosts,
+			p.CassandraConfig.Port,
+			p.CassandraConfig.User,
+			p.CassandraConfig.Password,
+			p.CassandraConfig.Datacenter,
+			p.CassandraConfig.Keyspace,
+			p.Logger)
+	}
 
 	if err != nil {
 		log.Fatalf("failed to create shard manager: %v", err)
@@ -146,45 +167,80 @@ func (s *Service) Start() {
 		}
 	}
 
-	metadata, err := persistence.NewCassandraMetadataPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		p.Logger)
+	var metadata persistence.MetadataManager
+	if p.PersistenceConfig.IsInMemory() {
+		metadata, err = persistence.NewInMemoryMetadataPersistence(p.InMemoryStore, p.Logger)
+	} else if p.PersistenceConfig.IsSQL() {
+		metadata, err = persistence.NewSQLMetadataPersistence(p.PersistenceConfig.SQL.DriverName,
+			p.PersistenceConfig.SQL.DataSourceName,
+			p.Logger)
+	} else {
+		metadata, err = persistence.NewCassandraMetadataPersistence(p.CassandraConfig.Hosts,
+			p.CassandraConfig.Port,
+			p.CassandraConfig.User,
+			p.CassandraConfig.Password,
+			p.CassandraConfig.Datacenter,
+			p.CassandraConfig.Keyspace,
+			p.Logger)
+	}
 
 	if err != nil {
 		log.Fatalf("failed to create metadata manager: %v", err)
 	}
 	metadata = persistence.NewMetadataPersistenceClient(metadata, base.GetMetricsClient())
 
-	visibility, err := persistence.NewCassandraVisibilityPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.VisibilityKeyspace,
-		p.Logger)
+	var visibility persistence.VisibilityManager
+	if p.PersistenceConfig.IsInMemory() {
+		visibility, err = persistence.NewInMemoryVisibilityPersistence(p.InMemoryStore, p.Logger)
+	} else if p.PersistenceConfig.IsSQL() {
+		visibility, err = persistence.NewSQLVisibilityPersistence(p.PersistenceConfig.SQL.DriverName,
+			p.PersistenceConfig.SQL.VisibilityDataSourceName,
+			p.Logger)
+	} else {
+		visibility, err = persistence.NewCassandraVisibilityPersistence(p.CassandraConfig.Hosts,
+			p.CassandraConfig.Port,
+			p.CassandraConfig.User,
+			p.CassandraConfig.Password,
+			p.CassandraConfig.Datacenter,
+			p.CassandraConfig.VisibilityKeyspace,
+			p.Logger)
+	}
 
 	if err != nil {
 		log.Fatalf("failed to create visiblity manager: %v", err)
 	}
+	if p.PersistenceConfig.HasAdvancedVisibility() {
+		visibility, err = persistence.NewVisibilityManagerWithNamedIndex(
+			visibility, p.PersistenceConfig.AdvancedVisibilityStore, p.Logger)
+		if err != nil {
+			log.Fatalf("failed to create visibility index: %v", err)
+		}
+	}
 
-	history, err := persistence.NewCassandraHistoryPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		p.Logger)
+	var history persistence.HistoryManager
+	if p.PersistenceConfig.IsInMemory() {
+		history, err = persistence.NewInMemoryHistoryPersistence(p.InMemoryStore, p.Logger)
+	} else if p.PersistenceConfig.IsSQL() {
+		history, err = persistence.NewSQLHistoryPersistence(p.PersistenceConfig.SQL.DriverName,
+			p.PersistenceConfig.SQL.DataSourceName,
+			p.Logger)
+	} else {
+		history, err = persistence.NewCassandraHistoryPersistence(p.CassandraConfig.Hosts,
+			p.CassandraConfig.Port,
+			p.CassandraConfig.User,
+			p.CassandraConfig.Password,
+			p.CassandraConfig.Datacenter,
+			p.CassandraConfig.Keyspace,
+			p.Logger)
+	}
 
 	if err != nil {
 		log.Fatalf("Creating Cassandra history manager persistence failed: %v", err)
 	}
 
 	history = persistence.NewHistoryPersistenceClient(history, base.GetMetricsClient())
-	execMgrFactory := NewExecutionManagerFactory(&p.CassandraConfig, p.Logger, base.GetMetricsClient())
+	execMgrFactory := NewExecutionManagerFactory(&p.CassandraConfig, &p.PersistenceConfig, p.InMemoryStore,
+		p.Logger, base.GetMetricsClient())
 
 	handler := NewHandler(base,
 		s.config,
@@ -55,6 +55,7 @@ type (
 		GetTimerAckLevel() time.Time
 		UpdateTimerAckLevel(ackLevel time.Time) error
 		GetTimeSource() common.TimeSource
+		GetShardInfo() *persistence.ShardInfo
 	}
 
 	shardContextImpl struct {
@@ -234,6 +235,9 @@ func (s *shardContextImpl) UpdateWorkflowExecution(request *persistence.UpdateWo
 	defer s.updateMaxReadLevelLocked(transferMaxReadLevel)
 
 	s.allocateTimerIDsLocked(request.TimerTasks)
+	if request.ContinueAsNew != nil {
+		s.allocateTimerIDsLocked(request.ContinueAsNew.TimerTasks)
+	}
 
 Update_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
@@ -306,6 +310,12 @@ func (s *shardContextImpl) GetMetricsClient() metrics.Client {
 	return s.metricsClient
 }
 
+func (s *shardContextImpl) GetShardInfo() *persistence.ShardInfo {
+	s.RLock()
+	defer s.RUnlock()
+	return copyShardInfo(s.shardInfo)
+}
+
 func (s *shardContextImpl) getRangeID() int64 {
 	return s.shardInfo.RangeID
 }
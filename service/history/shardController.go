@@ -344,6 +344,28 @@ func (c *shardController) numShards() int {
 	return nShards
 }
 
+func (c *shardController) shardIDs() []int32 {
+	c.RLock()
+	defer c.RUnlock()
+
+	ids := make([]int32, 0, len(c.historyShards))
+	for shardID := range c.historyShards {
+		ids = append(ids, int32(shardID))
+	}
+	return ids
+}
+
+func (c *shardController) status() string {
+	switch {
+	case atomic.LoadInt32(&c.isStopped) == 1:
+		return "stopped"
+	case atomic.LoadInt32(&c.isStarted) == 1:
+		return "started"
+	default:
+		return "initialized"
+	}
+}
+
 func (i *historyShardsItem) getEngine() Engine {
 	i.RLock()
 	defer i.RUnlock()
@@ -135,12 +135,21 @@ func newTimerBuilder(config *Config, logger bark.Logger, timeSource common.TimeS
 // AddDecisionTimeoutTask - Add a decision timeout task.
 func (tb *timerBuilder) AddDecisionTimoutTask(scheduleID int64,
 	startToCloseTimeout int32) *persistence.DecisionTimeoutTask {
-	timeOutTask := tb.createDecisionTimeoutTask(startToCloseTimeout, scheduleID)
+	timeOutTask := tb.createDecisionTimeoutTask(startToCloseTimeout, w.TimeoutTypeStartToClose, scheduleID)
 	tb.logger.Debugf("Adding Decision Timeout: with timeout: %v sec, EventID: %v",
 		startToCloseTimeout, timeOutTask.EventID)
 	return timeOutTask
 }
 
+// AddScheduleToStartDecisionTimoutTask - Add a schedule to start timeout task for a decision on a sticky task list.
+func (tb *timerBuilder) AddScheduleToStartDecisionTimoutTask(scheduleID int64,
+	scheduleToStartTimeout int32) *persistence.DecisionTimeoutTask {
+	timeOutTask := tb.createDecisionTimeoutTask(scheduleToStartTimeout, w.TimeoutTypeScheduleToStart, scheduleID)
+	tb.logger.Debugf("Adding Decision Schedule To Start Timeout: with timeout: %v sec, EventID: %v",
+		scheduleToStartTimeout, timeOutTask.EventID)
+	return timeOutTask
+}
+
 func (tb *timerBuilder) AddScheduleToStartActivityTimeout(
 	ai *persistence.ActivityInfo) *persistence.ActivityTimeoutTask {
 	return tb.AddActivityTimeoutTask(ai.ScheduleID, w.TimeoutTypeScheduleToStart, ai.ScheduleToStartTimeout, nil)
@@ -335,10 +344,12 @@ func (tb *timerBuilder) createDeleteHistoryEventTimerTask(d time.Duration) *pers
 }
 
 // createDecisionTimeoutTask - Creates a decision timeout task.
-func (tb *timerBuilder) createDecisionTimeoutTask(fireTimeOut int32, eventID int64) *persistence.DecisionTimeoutTask {
+func (tb *timerBuilder) createDecisionTimeoutTask(fireTimeOut int32, timeoutType w.TimeoutType,
+	eventID int64) *persistence.DecisionTimeoutTask {
 	expiryTime := tb.timeSource.Now().Add(time.Duration(fireTimeOut) * time.Second)
 	return &persistence.DecisionTimeoutTask{
 		VisibilityTimestamp: expiryTime,
+		TimeoutType:         int(timeoutType),
 		EventID:             eventID,
 	}
 }
@@ -174,7 +174,7 @@ func (t *timerQueueProcessorImpl) Start() {
 	}
 
 	t.shutdownWG.Add(1)
-	go t.processorPump(t.config.ProcessTimerTaskWorkerCount)
+	go t.processorPump(t.config.ProcessTimerTaskWorkerCount())
 
 	t.logger.Info("Timer queue processor started.")
 }
@@ -219,6 +219,10 @@ func (t *timerQueueProcessorImpl) NotifyNewTimer(timerTasks []persistence.Task)
 			t.metricsClient.IncCounter(metrics.TimerTaskWorkflowTimeoutScope, metrics.NewTimerCounter)
 		case persistence.TaskTypeDeleteHistoryEvent:
 			t.metricsClient.IncCounter(metrics.TimerTaskDeleteHistoryEvent, metrics.NewTimerCounter)
+		case persistence.TaskTypeActivityRetryTimer:
+			t.metricsClient.IncCounter(metrics.TimerTaskActivityRetryTimerScope, metrics.NewTimerCounter)
+		case persistence.TaskTypeWorkflowBackoffTimer:
+			t.metricsClient.IncCounter(metrics.TimerTaskWorkflowBackoffTimerScope, metrics.NewTimerCounter)
 		}
 	}
 	t.lock.Unlock()
@@ -238,7 +242,7 @@ func (t *timerQueueProcessorImpl) processorPump(taskWorkerCount int) {
 	defer t.shutdownWG.Done()
 
 	// Workers to process timer tasks that are expired.
-	tasksCh := make(chan *persistence.TimerTaskInfo, 10*t.config.TimerTaskBatchSize)
+	tasksCh := make(chan *persistence.TimerTaskInfo, 10*t.config.TimerTaskBatchSize())
 	var workerWG sync.WaitGroup
 	for i := 0; i < taskWorkerCount; i++ {
 		workerWG.Add(1)
@@ -336,7 +340,7 @@ func (t *timerQueueProcessorImpl) internalProcessor(tasksCh chan<- *persistence.
 				tasksCh <- task
 			}
 
-			if lookAheadTask != nil || len(timerTasks) < t.config.TimerTaskBatchSize {
+			if lookAheadTask != nil || len(timerTasks) < t.config.TimerTaskBatchSize() {
 				// We have processed all the tasks.
 				nextKeyTask = lookAheadTask
 				break
@@ -443,6 +447,14 @@ func (t *timerQueueProcessorImpl) processTimerTask(timerTask *persistence.TimerT
 	case persistence.TaskTypeDeleteHistoryEvent:
 		scope = metrics.TimerTaskDeleteHistoryEvent
 		err = t.processDeleteHistoryEvent(timerTask)
+
+	case persistence.TaskTypeActivityRetryTimer:
+		scope = metrics.TimerTaskActivityRetryTimerScope
+		err = t.processActivityRetryTimer(timerTask)
+
+	case persistence.TaskTypeWorkflowBackoffTimer:
+		scope = metrics.TimerTaskWorkflowBackoffTimerScope
+		err = t.processWorkflowBackoffTimer(timerTask)
 	}
 
 	if err != nil {
@@ -589,11 +601,14 @@ Update_History_Loop:
 		var timerTasks []persistence.Task
 		scheduleNewDecision := false
 		updateHistory := false
+		// Activities which are retried instead of timing out.  Their remaining timers are recreated for the next
+		// attempt once it is dispatched.
+		retriedActivities := make(map[int64]bool)
 
 	ExpireActivityTimers:
 		for _, td := range tBuilder.GetActivityTimers(msBuilder) {
 			ai, isRunning := msBuilder.GetActivityInfo(td.ActivityID)
-			if !isRunning {
+			if !isRunning || retriedActivities[td.ActivityID] {
 				//  We might have time out this activity already.
 				continue ExpireActivityTimers
 			}
@@ -603,6 +618,16 @@ Update_History_Loop:
 				t.logger.Debugf("Activity TimeoutType: %v, scheduledID: %v, startedId: %v. \n",
 					timeoutType, ai.ScheduleID, ai.StartedID)
 
+				// Schedule to start timeouts are not retried, as the next attempt would be waiting for a worker as well
+				if timeoutType != workflow.TimeoutTypeScheduleToStart {
+					retryTask := msBuilder.CreateActivityRetryTimer(ai, getTimeoutReason(timeoutType))
+					if retryTask != nil {
+						timerTasks = append(timerTasks, retryTask)
+						retriedActivities[td.ActivityID] = true
+						continue ExpireActivityTimers
+					}
+				}
+
 				switch timeoutType {
 				case workflow.TimeoutTypeScheduleToClose:
 					{
@@ -656,7 +681,7 @@ Update_History_Loop:
 				// where we create next timer task based on that new updated timestamp.
 				if !td.TaskCreated || (isHeartBeatTask && td.ActivityID == scheduleID) {
 					nextTask := tBuilder.createNewTask(td)
-					timerTasks = []persistence.Task{nextTask}
+					timerTasks = append(timerTasks, nextTask)
 					at := nextTask.(*persistence.ActivityTimeoutTask)
 
 					ai.TimerTaskStatus = ai.TimerTaskStatus & getActivityTimerStatus(workflow.TimeoutType(at.TimeoutType))
@@ -671,10 +696,10 @@ Update_History_Loop:
 			}
 		}
 
-		if updateHistory {
+		if updateHistory || len(retriedActivities) > 0 {
 			// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
-			// the history and try the operation again.
-			scheduleNewDecision = !msBuilder.HasPendingDecisionTask()
+			// the history and try the operation again.  Retries do not add events, so they don't need a decision.
+			scheduleNewDecision = updateHistory && !msBuilder.HasPendingDecisionTask()
 			err := t.updateWorkflowExecution(context, msBuilder, scheduleNewDecision, false, timerTasks, nil)
 			if err != nil {
 				if err == ErrConflict {
@@ -691,6 +716,132 @@ Update_History_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
+func (t *timerQueueProcessorImpl) processActivityRetryTimer(task *persistence.TimerTaskInfo) error {
+	t.metricsClient.IncCounter(metrics.TimerTaskActivityRetryTimerScope, metrics.TaskRequests)
+	sw := t.metricsClient.StartTimer(metrics.TimerTaskActivityRetryTimerScope, metrics.TaskLatency)
+	defer sw.Stop()
+
+	context, release, err0 := t.cache.getOrCreateWorkflowExecution(getDomainIDAndWorkflowExecution(task))
+	if err0 != nil {
+		return err0
+	}
+	defer release()
+
+Update_History_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err1 := context.loadWorkflowExecution()
+		if err1 != nil {
+			return err1
+		}
+		tBuilder := t.historyService.getTimerBuilder(&context.workflowExecution)
+
+		scheduleID := task.EventID
+		// First check to see if cache needs to be refreshed as we could potentially have stale workflow execution in
+		// some extreme cassandra failure cases.
+		if scheduleID >= msBuilder.GetNextEventID() {
+			t.metricsClient.IncCounter(metrics.TimerQueueProcessorScope, metrics.StaleMutableStateCounter)
+			// Reload workflow execution history
+			context.clear()
+			continue Update_History_Loop
+		}
+
+		if !msBuilder.isWorkflowExecutionRunning() {
+			// Workflow is completed.
+			return nil
+		}
+
+		// The activity could have been completed or canceled while waiting for the retry.  Or this is a duplicate of
+		// a retry timer which already dispatched the attempt.
+		ai, isRunning := msBuilder.GetActivityInfo(scheduleID)
+		if !isRunning || int64(ai.Attempt) != task.ScheduleAttempt || ai.StartedID != emptyEventID {
+			return nil
+		}
+
+		scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(scheduleID)
+		if !ok {
+			return &workflow.InternalServiceError{Message: "Corrupted workflow execution state."}
+		}
+		attributes := scheduledEvent.ActivityTaskScheduledEventAttributes
+
+		targetDomainID := task.DomainID
+		if attributes.Domain != nil {
+			info, _, err := t.historyService.domainCache.GetDomain(*attributes.Domain)
+			if err != nil {
+				return err
+			}
+			targetDomainID = info.ID
+		}
+
+		transferTasks := []persistence.Task{&persistence.ActivityTask{
+			DomainID:   targetDomainID,
+			TaskList:   *attributes.TaskList.Name,
+			ScheduleID: scheduleID,
+		}}
+		var timerTasks []persistence.Task
+		if tt := tBuilder.GetActivityTimerTaskIfNeeded(msBuilder); tt != nil {
+			timerTasks = append(timerTasks, tt)
+		}
+
+		// Generate a transaction ID for appending events to history
+		transactionID, err2 := t.historyService.shard.GetNextTransferTaskID()
+		if err2 != nil {
+			return err2
+		}
+
+		if err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID); err != nil {
+			if err == ErrConflict {
+				continue Update_History_Loop
+			}
+			return err
+		}
+
+		t.NotifyNewTimer(timerTasks)
+		return nil
+	}
+	return ErrMaxAttemptsExceeded
+}
+
+func (t *timerQueueProcessorImpl) processWorkflowBackoffTimer(task *persistence.TimerTaskInfo) error {
+	t.metricsClient.IncCounter(metrics.TimerTaskWorkflowBackoffTimerScope, metrics.TaskRequests)
+	sw := t.metricsClient.StartTimer(metrics.TimerTaskWorkflowBackoffTimerScope, metrics.TaskLatency)
+	defer sw.Stop()
+
+	context, release, err0 := t.cache.getOrCreateWorkflowExecution(getDomainIDAndWorkflowExecution(task))
+	if err0 != nil {
+		return err0
+	}
+	defer release()
+
+Update_History_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err1 := context.loadWorkflowExecution()
+		if err1 != nil {
+			return err1
+		}
+
+		if !msBuilder.isWorkflowExecutionRunning() {
+			// Workflow is completed.
+			return nil
+		}
+
+		if msBuilder.HasProcessedOrPendingDecisionTask() {
+			// Already has a decision task, probably scheduled by a signal received during the backoff.
+			return nil
+		}
+
+		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
+		// the history and try the operation again.
+		err := t.updateWorkflowExecution(context, msBuilder, true, false, nil, nil)
+		if err != nil {
+			if err == ErrConflict {
+				continue Update_History_Loop
+			}
+		}
+		return err
+	}
+	return ErrMaxAttemptsExceeded
+}
+
 func (t *timerQueueProcessorImpl) processDeleteHistoryEvent(task *persistence.TimerTaskInfo) error {
 	t.metricsClient.IncCounter(metrics.TimerTaskDeleteHistoryEvent, metrics.TaskRequests)
 	sw := t.metricsClient.StartTimer(metrics.TimerTaskDeleteHistoryEvent, metrics.TaskLatency)
@@ -740,14 +891,30 @@ Update_History_Loop:
 		scheduleNewDecision := false
 		di, isRunning := msBuilder.GetPendingDecision(scheduleID)
 		if isRunning && msBuilder.isWorkflowExecutionRunning() {
-			// Add a decision task timeout event.
-			timeoutEvent := msBuilder.AddDecisionTaskTimedOutEvent(scheduleID, di.StartedID)
-			if timeoutEvent == nil {
-				// Unable to add DecisionTaskTimedout event to history
-				return &workflow.InternalServiceError{Message: "Unable to add DecisionTaskTimedout event to history."}
+			switch workflow.TimeoutType(task.TimeoutType) {
+			case workflow.TimeoutTypeStartToClose:
+				t.metricsClient.IncCounter(metrics.TimerTaskDecisionTimeoutScope, metrics.StartToCloseTimeoutCounter)
+				// Add a decision task timeout event.
+				timeoutEvent := msBuilder.AddDecisionTaskTimedOutEvent(scheduleID, di.StartedID)
+				if timeoutEvent == nil {
+					// Unable to add DecisionTaskTimedout event to history
+					return &workflow.InternalServiceError{Message: "Unable to add DecisionTaskTimedout event to history."}
+				}
+				scheduleNewDecision = true
+
+			case workflow.TimeoutTypeScheduleToStart:
+				t.metricsClient.IncCounter(metrics.TimerTaskDecisionTimeoutScope, metrics.ScheduleToStartTimeoutCounter)
+				// The sticky worker did not pick up the decision in time, so the timeout clears stickyness and the
+				// new decision is scheduled on the normal task list of the workflow execution.
+				if di.StartedID == emptyEventID {
+					timeoutEvent := msBuilder.AddDecisionTaskScheduleToStartTimeoutEvent(scheduleID)
+					if timeoutEvent == nil {
+						// Unable to add DecisionTaskTimedout event to history
+						return &workflow.InternalServiceError{Message: "Unable to add DecisionTaskTimedout event to history."}
+					}
+					scheduleNewDecision = true
+				}
 			}
-
-			scheduleNewDecision = true
 		}
 
 		if scheduleNewDecision {
@@ -790,6 +957,26 @@ Update_History_Loop:
 			return nil
 		}
 
+		// A workflow with a retry policy or a cron schedule is not closed by the timeout, the next run is started
+		// instead if the retry policy allows another attempt or the workflow has a cron schedule.
+		newStateBuilder, err := t.historyService.addRetryContinueAsNewEvent(msBuilder, emptyEventID,
+			getTimeoutReason(workflow.TimeoutTypeStartToClose))
+		if err == nil && newStateBuilder == nil && msBuilder.executionInfo.CronSchedule != "" {
+			newStateBuilder, err = t.historyService.addCronContinueAsNewEvent(msBuilder, emptyEventID)
+		}
+		if err != nil {
+			return err
+		}
+		if newStateBuilder != nil {
+			err = t.continueAsNewWorkflow(context, msBuilder, newStateBuilder)
+			if err != nil {
+				if err == ErrConflict {
+					continue Update_History_Loop
+				}
+			}
+			return err
+		}
+
 		if e := msBuilder.AddTimeoutWorkflowEvent(); e == nil {
 			// If we failed to add the event that means the workflow is already completed.
 			// we drop this timeout event.
@@ -798,7 +985,7 @@ Update_History_Loop:
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
 		// the history and try the operation again.
-		err := t.updateWorkflowExecution(context, msBuilder, false, true, nil, nil)
+		err = t.updateWorkflowExecution(context, msBuilder, false, true, nil, nil)
 		if err != nil {
 			if err == ErrConflict {
 				continue Update_History_Loop
@@ -809,6 +996,35 @@ Update_History_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
+func (t *timerQueueProcessorImpl) continueAsNewWorkflow(context *workflowExecutionContext,
+	msBuilder, newStateBuilder *mutableStateBuilder) error {
+	tBuilder := t.historyService.getTimerBuilder(&context.workflowExecution)
+	tranT, timerT, err := t.historyService.getDeleteWorkflowTasks(msBuilder.executionInfo.DomainID, tBuilder)
+	if err != nil {
+		return err
+	}
+	transferTasks := []persistence.Task{tranT}
+	timerTasks := []persistence.Task{timerT}
+
+	// Generate a transaction ID for appending events to history
+	transactionID, err1 := t.historyService.shard.GetNextTransferTaskID()
+	if err1 != nil {
+		return err1
+	}
+
+	err = context.continueAsNewWorkflowExecution(nil, newStateBuilder, transferTasks, timerTasks, transactionID)
+	if err != nil {
+		if isShardOwnershiptLostError(err) {
+			// Shard is stolen.  Stop timer processing to reduce duplicates
+			t.Stop()
+		}
+		return err
+	}
+
+	t.NotifyNewTimer(append(timerTasks, msBuilder.continueAsNew.TimerTasks...))
+	return nil
+}
+
 func (t *timerQueueProcessorImpl) updateWorkflowExecution(
 	context *workflowExecutionContext,
 	msBuilder *mutableStateBuilder,
@@ -826,6 +1042,11 @@ func (t *timerQueueProcessorImpl) updateWorkflowExecution(
 			TaskList:   *newDecisionEvent.DecisionTaskScheduledEventAttributes.TaskList.Name,
 			ScheduleID: *newDecisionEvent.EventId,
 		}}
+		if msBuilder.isStickyTaskListEnabled() {
+			tBuilder := t.historyService.getTimerBuilder(&context.workflowExecution)
+			timerTasks = append(timerTasks, tBuilder.AddScheduleToStartDecisionTimoutTask(*newDecisionEvent.EventId,
+				msBuilder.executionInfo.StickyScheduleToStartTimeout))
+		}
 	}
 
 	if createDeletionTask {
@@ -866,6 +1087,10 @@ func (t *timerQueueProcessorImpl) getTimerTaskType(taskType int) string {
 		return "WorkflowTimeout"
 	case persistence.TaskTypeDeleteHistoryEvent:
 		return "DeleteHistoryEvent"
+	case persistence.TaskTypeActivityRetryTimer:
+		return "ActivityRetryTimer"
+	case persistence.TaskTypeWorkflowBackoffTimer:
+		return "WorkflowBackoffTimer"
 	}
 	return "UnKnown"
 }
@@ -907,7 +1132,8 @@ func (t *timerAckMgr) readTimerTasks() ([]*persistence.TimerTaskInfo, *persisten
 	rLevel := t.readLevel
 	t.RUnlock()
 
-	tasks, err := t.processor.getTimerTasks(rLevel.VisibilityTimestamp, maxTimestamp, t.processor.config.TimerTaskBatchSize)
+	tasks, err := t.processor.getTimerTasks(
+		rLevel.VisibilityTimestamp, maxTimestamp, t.processor.config.TimerTaskBatchSize())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -73,7 +74,7 @@ func (s *timerQueueProcessor2Suite) SetupSuite() {
 	log2.Level = log.DebugLevel
 	s.logger = bark.NewLoggerFromLogrus(log2)
 
-	s.config = NewConfig(1)
+	s.config = NewConfig(dynamicconfig.NewNopCollection(), 1)
 }
 
 func (s *timerQueueProcessor2Suite) SetupTest() {
@@ -191,6 +192,75 @@ func (s *timerQueueProcessor2Suite) TestTimerUpdateTimesOut() {
 	processor.Stop()
 }
 
+func (s *timerQueueProcessor2Suite) TestDecisionScheduleToStartTimeoutOnStickyTaskList() {
+	domainID := "5bb49df8-71bc-4c63-b57f-05f2a508e7b5"
+	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr("sticky-decision-timesout-test"),
+		RunId: common.StringPtr("0d00698f-08e1-4d36-a3e2-3bf109f5d2d6")}
+	taskList := "task-sticky-decision-times-out"
+	stickyTaskList := "sticky-worker-task-list"
+
+	builder := newMutableStateBuilder(s.config, s.logger)
+	builder.AddWorkflowExecutionStartedEvent(domainID, we, &workflow.StartWorkflowExecutionRequest{
+		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
+		TaskList:                            common.TaskListPtr(workflow.TaskList{Name: common.StringPtr(taskList)}),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(10),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+	})
+	builder.SetStickyness(stickyTaskList, 1)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(builder)
+	s.Equal(stickyTaskList, *decisionScheduledEvent.DecisionTaskScheduledEventAttributes.TaskList.Name)
+
+	waitCh := make(chan struct{})
+
+	mockTS := &mockTimeSource{currTime: time.Now()}
+
+	taskID := int64(100)
+	timerTask := &persistence.TimerTaskInfo{WorkflowID: "wid", RunID: "rid", TaskID: taskID,
+		TaskType: persistence.TaskTypeDecisionTimeout, TimeoutType: int(workflow.TimeoutTypeScheduleToStart),
+		VisibilityTimestamp: mockTS.Now(),
+		EventID:             *decisionScheduledEvent.EventId}
+	timerIndexResponse := &persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{timerTask}}
+
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(timerIndexResponse, nil).Once()
+
+	ms := createMutableState(builder)
+	wfResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(wfResponse, nil).Once()
+
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(
+		&persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{}}, nil)
+	s.mockExecutionMgr.On("CompleteTimerTask", mock.Anything).Return(nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Run(func(arguments mock.Arguments) {
+		updateRequest = arguments.Get(0).(*persistence.UpdateWorkflowExecutionRequest)
+		// Done.
+		waitCh <- struct{}{}
+	}).Once()
+
+	// Start timer Processor.
+	processor := newTimerQueueProcessor(s.mockShard, s.mockHistoryEngine, s.mockExecutionMgr, s.logger).(*timerQueueProcessorImpl)
+	processor.Start()
+
+	processor.NotifyNewTimer([]persistence.Task{&persistence.DecisionTimeoutTask{
+		VisibilityTimestamp: timerTask.VisibilityTimestamp,
+		TimeoutType:         timerTask.TimeoutType,
+		EventID:             timerTask.EventID,
+	}})
+
+	<-waitCh
+	processor.Stop()
+
+	// The decision falls back to the normal task list and stickyness is cleared
+	s.Equal("", updateRequest.ExecutionInfo.StickyTaskList)
+	s.Equal(1, len(updateRequest.TransferTasks))
+	decisionTask, ok := updateRequest.TransferTasks[0].(*persistence.DecisionTask)
+	s.True(ok)
+	s.Equal(taskList, decisionTask.TaskList)
+	s.Equal(int64(4), decisionTask.ScheduleID)
+	s.Equal(0, len(updateRequest.TimerTasks))
+}
+
 func (s *timerQueueProcessor2Suite) TestWorkflowTimeout() {
 	domainID := "5bb49df8-71bc-4c63-b57f-05f2a508e7b5"
 	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr("workflow-timesout-test"),
@@ -95,7 +95,7 @@ func newTransferQueueProcessor(shard ShardContext, visibilityMgr persistence.Vis
 		visibilityManager: visibilityMgr,
 		cache:             cache,
 		domainCache:       domainCache,
-		rateLimiter:       common.NewTokenBucket(config.TransferProcessorMaxPollRPS, common.NewRealTimeSource()),
+		rateLimiter:       common.NewDynamicTokenBucket(config.TransferProcessorMaxPollRPS, common.NewRealTimeSource()),
 		appendCh:          make(chan struct{}, 1),
 		shutdownCh:        make(chan struct{}),
 		config:            config,
@@ -164,10 +164,10 @@ func (t *transferQueueProcessorImpl) NotifyNewTask() {
 
 func (t *transferQueueProcessorImpl) processorPump() {
 	defer t.shutdownWG.Done()
-	tasksCh := make(chan *persistence.TransferTaskInfo, t.config.TransferTaskBatchSize)
+	tasksCh := make(chan *persistence.TransferTaskInfo, t.config.TransferTaskBatchSize())
 
 	var workerWG sync.WaitGroup
-	for i := 0; i < t.config.TransferTaskWorkerCount; i++ {
+	for i := 0; i < t.config.TransferTaskWorkerCount(); i++ {
 		workerWG.Add(1)
 		go t.taskWorker(tasksCh, &workerWG)
 	}
@@ -224,7 +224,7 @@ func (t *transferQueueProcessorImpl) processTransferTasks(tasksCh chan<- *persis
 		tasksCh <- tsk
 	}
 
-	if len(tasks) == t.config.TransferTaskBatchSize {
+	if len(tasks) == t.config.TransferTaskBatchSize() {
 		// There might be more task
 		// We return now to yield, but enqueue an event to poll later
 		t.NotifyNewTask()
@@ -272,6 +272,15 @@ ProcessRetryLoop:
 			case persistence.TransferTaskTypeStartChildExecution:
 				scope = metrics.TransferTaskStartChildExecutionScope
 				err = t.processStartChildExecution(task)
+			case persistence.TransferTaskTypeSignalExecution:
+				scope = metrics.TransferTaskSignalExecutionScope
+				err = t.processSignalExecution(task)
+			case persistence.TransferTaskTypeRecordWorkflowStarted:
+				scope = metrics.TransferTaskRecordWorkflowStartedScope
+				err = t.processRecordWorkflowStarted(task)
+			case persistence.TransferTaskTypeUpsertWorkflowSearchAttributes:
+				scope = metrics.TransferTaskUpsertSearchAttributesScope
+				err = t.processUpsertWorkflowSearchAttributes(task)
 			}
 
 			if err != nil {
@@ -469,6 +478,7 @@ func (t *transferQueueProcessorImpl) processDeleteExecution(task *persistence.Tr
 		Status:           getWorkflowExecutionCloseStatus(mb.executionInfo.CloseStatus),
 		HistoryLength:    mb.GetNextEventID(),
 		RetentionSeconds: retentionSeconds,
+		SearchAttributes: mb.executionInfo.SearchAttributes,
 	})
 	if err != nil {
 		return err
@@ -568,6 +578,92 @@ func (t *transferQueueProcessorImpl) processCancelExecution(task *persistence.Tr
 	return err
 }
 
+func (t *transferQueueProcessorImpl) processSignalExecution(task *persistence.TransferTaskInfo) error {
+	t.metricsClient.IncCounter(metrics.TransferTaskSignalExecutionScope, metrics.TaskRequests)
+	sw := t.metricsClient.StartTimer(metrics.TransferTaskSignalExecutionScope, metrics.TaskLatency)
+	defer sw.Stop()
+
+	var err error
+	domainID := task.DomainID
+	targetDomainID := task.TargetDomainID
+	execution := workflow.WorkflowExecution{WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId: common.StringPtr(task.RunID)}
+
+	var context *workflowExecutionContext
+	var release releaseWorkflowExecutionFunc
+	context, release, err = t.cache.getOrCreateWorkflowExecution(domainID, execution)
+	defer release()
+	if err != nil {
+		return err
+	}
+
+	// First load the execution to validate if there is pending signal request for this transfer task
+	var msBuilder *mutableStateBuilder
+	msBuilder, err = context.loadWorkflowExecution()
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			// this could happen if this is a duplicate processing of the task, and the execution has already completed.
+			return nil
+		}
+		return err
+	}
+
+	initiatedEventID := task.ScheduleID
+	si, isPending := msBuilder.GetSignalInfo(initiatedEventID)
+	if !isPending {
+		// No pending signal request for this initiatedID, complete this transfer task
+		return nil
+	}
+
+	signalRequest := &history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(targetDomainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain: common.StringPtr(targetDomainID),
+			WorkflowExecution: &workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr(task.TargetWorkflowID),
+				RunId:      common.StringPtr(task.TargetRunID),
+			},
+			SignalName: common.StringPtr(si.SignalName),
+			Input:      si.Input,
+			Identity:   common.StringPtr("history-service"),
+		},
+	}
+
+	op := func() error {
+		return t.historyClient.SignalWorkflowExecution(nil, signalRequest)
+	}
+
+	// The signal is not deduped by the target execution, so a retry after a lost response could deliver it more
+	// than once.  Workflows are expected to tolerate duplicate signals.
+	err = backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	if err != nil {
+		t.logger.Debugf("Failed to signal external workflow execution. Error: %v", err)
+
+		// Check to see if the error is non-transient, in which case add SignalFailed
+		// event and complete transfer task by setting the err = nil
+		if common.IsServiceNonRetryableError(err) {
+			err = t.signalFailed(task, context, signalRequest, si)
+			if _, ok := err.(*workflow.EntityNotExistsError); ok {
+				// this could happen if this is a duplicate processing of the task, and the execution has already completed.
+				return nil
+			}
+		}
+		return err
+	}
+
+	t.logger.Debugf("Signal successfully recorded to external workflow execution.  WorkflowID: %v, RunID: %v",
+		task.TargetWorkflowID, task.TargetRunID)
+
+	// Record ExternalWorkflowExecutionSignaled in source execution
+	err = t.signalCompleted(task, context, signalRequest, si)
+	if _, ok := err.(*workflow.EntityNotExistsError); ok {
+		// this could happen if this is a duplicate processing of the task, and the execution has already completed.
+		return nil
+	}
+
+	return err
+}
+
 func (t *transferQueueProcessorImpl) processStartChildExecution(task *persistence.TransferTaskInfo) error {
 	t.metricsClient.IncCounter(metrics.TransferTaskStartChildExecutionScope, metrics.TaskRequests)
 	sw := t.metricsClient.StartTimer(metrics.TransferTaskStartChildExecutionScope, metrics.TaskLatency)
@@ -668,6 +764,24 @@ func (t *transferQueueProcessorImpl) processStartChildExecution(task *persistenc
 	return err
 }
 
+func (t *transferQueueProcessorImpl) processRecordWorkflowStarted(task *persistence.TransferTaskInfo) error {
+	t.metricsClient.IncCounter(metrics.TransferTaskRecordWorkflowStartedScope, metrics.TaskRequests)
+	sw := t.metricsClient.StartTimer(metrics.TransferTaskRecordWorkflowStartedScope, metrics.TaskLatency)
+	defer sw.Stop()
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId:      common.StringPtr(task.RunID),
+	}
+
+	err := t.recordWorkflowExecutionStarted(execution, task)
+	if _, ok := err.(*workflow.EntityNotExistsError); ok {
+		// the execution was already closed and deleted, which records it as closed
+		return nil
+	}
+	return err
+}
+
 func (t *transferQueueProcessorImpl) recordWorkflowExecutionStarted(
 	execution workflow.WorkflowExecution, task *persistence.TransferTaskInfo) error {
 	context, release, err := t.cache.getOrCreateWorkflowExecution(task.DomainID, execution)
@@ -679,17 +793,59 @@ func (t *transferQueueProcessorImpl) recordWorkflowExecutionStarted(
 	if err != nil {
 		return err
 	}
+	if !mb.isWorkflowExecutionRunning() {
+		// The execution is already recorded as closed, which a late start record would hide
+		return nil
+	}
 
 	err = t.visibilityManager.RecordWorkflowExecutionStarted(&persistence.RecordWorkflowExecutionStartedRequest{
 		DomainUUID:       task.DomainID,
 		Execution:        execution,
 		WorkflowTypeName: mb.executionInfo.WorkflowTypeName,
 		StartTimestamp:   mb.executionInfo.StartTimestamp.UnixNano(),
+		SearchAttributes: mb.executionInfo.SearchAttributes,
 	})
 
 	return err
 }
 
+func (t *transferQueueProcessorImpl) processUpsertWorkflowSearchAttributes(task *persistence.TransferTaskInfo) error {
+	t.metricsClient.IncCounter(metrics.TransferTaskUpsertSearchAttributesScope, metrics.TaskRequests)
+	sw := t.metricsClient.StartTimer(metrics.TransferTaskUpsertSearchAttributesScope, metrics.TaskLatency)
+	defer sw.Stop()
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId:      common.StringPtr(task.RunID),
+	}
+
+	context, release, err := t.cache.getOrCreateWorkflowExecution(task.DomainID, execution)
+	if err != nil {
+		return err
+	}
+	defer release()
+	mb, err := context.loadWorkflowExecution()
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			// the execution was already closed and deleted, which records its final search attributes
+			return nil
+		}
+		return err
+	}
+	if !mb.isWorkflowExecutionRunning() {
+		// closing the execution records its final search attributes
+		return nil
+	}
+
+	return t.visibilityManager.UpsertWorkflowExecution(&persistence.UpsertWorkflowExecutionRequest{
+		DomainUUID:       task.DomainID,
+		Execution:        execution,
+		WorkflowTypeName: mb.executionInfo.WorkflowTypeName,
+		StartTimestamp:   mb.executionInfo.StartTimestamp.UnixNano(),
+		SearchAttributes: mb.executionInfo.SearchAttributes,
+	})
+}
+
 func (t *transferQueueProcessorImpl) recordChildExecutionStarted(task *persistence.TransferTaskInfo,
 	context *workflowExecutionContext, initiatedAttributes *workflow.StartChildWorkflowExecutionInitiatedEventAttributes,
 	runID string) error {
@@ -814,6 +970,62 @@ func (t *transferQueueProcessorImpl) requestCancelFailed(task *persistence.Trans
 		})
 }
 
+func (t *transferQueueProcessorImpl) signalCompleted(task *persistence.TransferTaskInfo,
+	context *workflowExecutionContext, request *history.SignalWorkflowExecutionRequest,
+	si *persistence.SignalInfo) error {
+
+	return t.updateWorkflowExecution(task.DomainID, context, true,
+		func(msBuilder *mutableStateBuilder) error {
+			if !msBuilder.isWorkflowExecutionRunning() {
+				return &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
+			}
+
+			initiatedEventID := task.ScheduleID
+			_, isPending := msBuilder.GetSignalInfo(initiatedEventID)
+			if !isPending {
+				return &workflow.EntityNotExistsError{Message: "Pending signal request not found."}
+			}
+
+			msBuilder.AddExternalWorkflowExecutionSignaled(
+				initiatedEventID,
+				*request.DomainUUID,
+				*request.SignalRequest.WorkflowExecution.WorkflowId,
+				common.StringDefault(request.SignalRequest.WorkflowExecution.RunId),
+				si.Control)
+
+			return nil
+		})
+}
+
+func (t *transferQueueProcessorImpl) signalFailed(task *persistence.TransferTaskInfo,
+	context *workflowExecutionContext, request *history.SignalWorkflowExecutionRequest,
+	si *persistence.SignalInfo) error {
+
+	return t.updateWorkflowExecution(task.DomainID, context, true,
+		func(msBuilder *mutableStateBuilder) error {
+			if !msBuilder.isWorkflowExecutionRunning() {
+				return &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
+			}
+
+			initiatedEventID := task.ScheduleID
+			_, isPending := msBuilder.GetSignalInfo(initiatedEventID)
+			if !isPending {
+				return &workflow.EntityNotExistsError{Message: "Pending signal request not found."}
+			}
+
+			msBuilder.AddSignalExternalWorkflowExecutionFailedEvent(
+				emptyEventID,
+				initiatedEventID,
+				*request.DomainUUID,
+				*request.SignalRequest.WorkflowExecution.WorkflowId,
+				common.StringDefault(request.SignalRequest.WorkflowExecution.RunId),
+				si.Control,
+				workflow.SignalExternalWorkflowExecutionFailedCauseUnknownExternalWorkflowExecution)
+
+			return nil
+		})
+}
+
 func (t *transferQueueProcessorImpl) updateWorkflowExecution(domainID string, context *workflowExecutionContext,
 	createDecisionTask bool, action func(builder *mutableStateBuilder) error) error {
 Update_History_Loop:
@@ -824,6 +1036,7 @@ Update_History_Loop:
 		}
 
 		var transferTasks []persistence.Task
+		var timerTasks []persistence.Task
 		if err := action(msBuilder); err != nil {
 			return err
 		}
@@ -837,6 +1050,11 @@ Update_History_Loop:
 					TaskList:   *newDecisionEvent.DecisionTaskScheduledEventAttributes.TaskList.Name,
 					ScheduleID: *newDecisionEvent.EventId,
 				})
+				if msBuilder.isStickyTaskListEnabled() {
+					tBuilder := newTimerBuilder(t.config, t.logger, common.NewRealTimeSource())
+					timerTasks = append(timerTasks, tBuilder.AddScheduleToStartDecisionTimoutTask(
+						*newDecisionEvent.EventId, msBuilder.executionInfo.StickyScheduleToStartTimeout))
+				}
 			}
 		}
 
@@ -848,7 +1066,7 @@ Update_History_Loop:
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
 		// the history and try the operation again.
-		if err := context.updateWorkflowExecution(transferTasks, nil, transactionID); err != nil {
+		if err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID); err != nil {
 			if err == ErrConflict {
 				continue Update_History_Loop
 			}
@@ -872,7 +1090,7 @@ func (a *ackManager) readTransferTasks() ([]*persistence.TransferTaskInfo, error
 		response, err = a.executionMgr.GetTransferTasks(&persistence.GetTransferTasksRequest{
 			ReadLevel:    rLevel,
 			MaxReadLevel: a.shard.GetTransferMaxReadLevel(),
-			BatchSize:    a.processor.config.TransferTaskBatchSize,
+			BatchSize:    a.processor.config.TransferTaskBatchSize(),
 		})
 		return err
 	}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/pborman/uuid"
 	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/history"
 	m "github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
@@ -223,7 +224,8 @@ workerPump:
 		}
 	}
 
-	_, err3 := s.CreateWorkflowExecution(domainID, newExecution, taskList, "wType", 10, nil, 3, 0, 2, nil)
+	_, err3 := s.CreateWorkflowExecutionWithPreviousRun(domainID, newExecution, *workflowExecution.RunId, taskList,
+		"wType", 10, nil, 3, 0, 2, nil)
 	s.Nil(err3, "No error expected.")
 	s.logger.Infof("Execution created successfully: %v", err3)
 }
@@ -399,6 +401,131 @@ workerPump:
 	}
 }
 
+func (s *transferQueueProcessorSuite) TestSignalRemoteExecutionTransferTasks() {
+	domainID := "f5f1ece7-000d-495d-81c3-918ac29006ed"
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("signal-transfer-test"),
+		RunId:      common.StringPtr("0d00698f-08e1-4d36-a3e2-3bf109f5d2d6")}
+	taskList := "signal-transfer-queue"
+	identity := "signal-remote-execution-test"
+	targetDomain := "f2bfaab6-7e8b-4fac-9a62-17da8d37becb"
+	targetWorkflowID := "target-workflow_id"
+	targetRunID := "0d00698f-08e1-4d36-a3e2-3bf109f5d2d6"
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, taskList, "wType", 10, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	builder := newMutableStateBuilder(s.ShardContext.GetConfig(), s.logger)
+	info, _ := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	builder.Load(info)
+	startedEvent := addDecisionTaskStartedEvent(builder, int64(2), taskList, identity)
+	completeDecisionEvent := addDecisionTaskCompletedEvent(builder, int64(2), *startedEvent.EventId, nil, identity)
+	initiatedEvent := addSignalInitiatedEvent(builder, *completeDecisionEvent.EventId, uuid.New(),
+		targetDomain, targetWorkflowID, targetRunID, "signal-name", []byte("signal-input"), nil)
+
+	transferTasks := []persistence.Task{&persistence.SignalExecutionTask{
+		TaskID:           s.GetNextSequenceNumber(),
+		TargetDomainID:   targetDomain,
+		TargetWorkflowID: targetWorkflowID,
+		TargetRunID:      targetRunID,
+		InitiatedID:      *initiatedEvent.EventId,
+	}}
+	updatedInfo := copyWorkflowExecutionInfo(builder.executionInfo)
+	err1 := s.UpdateWorkflowExecutionForSignal(updatedInfo, int64(3), transferTasks, builder.updateSignalInfos)
+	s.Nil(err1, "No error expected.")
+
+	tasksCh := make(chan *persistence.TransferTaskInfo, 10)
+	s.processor.processTransferTasks(tasksCh)
+workerPump:
+	for {
+		select {
+		case task := <-tasksCh:
+			s.logger.Infof("Processing transfer task type: %v", task.TaskType)
+			if task.TaskType == persistence.TransferTaskTypeDecisionTask {
+				s.mockMatching.On("AddDecisionTask", mock.Anything, createAddRequestFromTask(task, 0)).Once().Return(nil)
+				if task.ScheduleID == firstEventID+1 {
+					s.mockVisibilityMgr.On("RecordWorkflowExecutionStarted", mock.Anything).Once().Return(nil)
+				}
+			} else if task.TaskType == persistence.TransferTaskTypeSignalExecution {
+				s.mockHistoryClient.On("SignalWorkflowExecution", mock.Anything,
+					mock.MatchedBy(func(request *history.SignalWorkflowExecutionRequest) bool {
+						return *request.SignalRequest.WorkflowExecution.WorkflowId == targetWorkflowID &&
+							*request.SignalRequest.SignalName == "signal-name"
+					})).Return(nil).Once()
+			}
+			s.processor.processTransferTask(task)
+		default:
+			break workerPump
+		}
+	}
+
+	state, err2 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err2, "No error expected.")
+	s.Equal(0, len(state.SignalInfos))
+}
+
+func (s *transferQueueProcessorSuite) TestSignalRemoteExecutionTransferTask_SignalFail() {
+	domainID := "f5f1ece7-000d-495d-81c3-918ac29006ed"
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("signal-transfer-fail-test"),
+		RunId:      common.StringPtr("0d00698f-08e1-4d36-a3e2-3bf109f5d2d6")}
+	taskList := "signal-transfer-fail-queue"
+	identity := "signal-transfer-fail-test"
+	targetDomain := "f2bfaab6-7e8b-4fac-9a62-17da8d37becb"
+	targetWorkflowID := "target-workflow_id"
+	targetRunID := "0d00698f-08e1-4d36-a3e2-3bf109f5d2d6"
+
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, taskList, "wType", 10, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	builder := newMutableStateBuilder(s.ShardContext.GetConfig(), s.logger)
+	info, _ := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	builder.Load(info)
+	startedEvent := addDecisionTaskStartedEvent(builder, int64(2), taskList, identity)
+	completeDecisionEvent := addDecisionTaskCompletedEvent(builder, int64(2), *startedEvent.EventId, nil, identity)
+	initiatedEvent := addSignalInitiatedEvent(builder, *completeDecisionEvent.EventId, uuid.New(),
+		targetDomain, targetWorkflowID, targetRunID, "signal-name", nil, nil)
+
+	transferTasks := []persistence.Task{&persistence.SignalExecutionTask{
+		TaskID:           s.GetNextSequenceNumber(),
+		TargetDomainID:   targetDomain,
+		TargetWorkflowID: targetWorkflowID,
+		TargetRunID:      targetRunID,
+		InitiatedID:      *initiatedEvent.EventId,
+	}}
+	updatedInfo := copyWorkflowExecutionInfo(builder.executionInfo)
+	err1 := s.UpdateWorkflowExecutionForSignal(updatedInfo, int64(3), transferTasks, builder.updateSignalInfos)
+	s.Nil(err1, "No error expected.")
+
+	tasksCh := make(chan *persistence.TransferTaskInfo, 10)
+	s.processor.processTransferTasks(tasksCh)
+workerPump:
+	for {
+		select {
+		case task := <-tasksCh:
+			s.logger.Infof("Processing transfer task type: %v, TaskID: %v, Task.ScheduleID: %v", task.TaskType,
+				task.TaskID, task.ScheduleID)
+			if task.TaskType == persistence.TransferTaskTypeDecisionTask {
+				s.mockMatching.On("AddDecisionTask", mock.Anything, createAddRequestFromTask(task, 0)).Once().Return(nil)
+				if task.ScheduleID == firstEventID+1 {
+					s.mockVisibilityMgr.On("RecordWorkflowExecutionStarted", mock.Anything).Once().Return(nil)
+				}
+			} else if task.TaskType == persistence.TransferTaskTypeSignalExecution {
+				s.mockHistoryClient.On("SignalWorkflowExecution", mock.Anything, mock.Anything).
+					Return(&workflow.EntityNotExistsError{}).Once()
+			}
+			s.processor.processTransferTask(task)
+		default:
+			break workerPump
+		}
+	}
+
+	state, err2 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err2, "No error expected.")
+	s.Equal(0, len(state.SignalInfos))
+}
+
 func (s *transferQueueProcessorSuite) TestCompleteTaskAfterExecutionDeleted() {
 	domainID := "b677a307-8261-40ea-b239-ab2ec78e443b"
 	workflowExecution := workflow.WorkflowExecution{WorkflowId: common.StringPtr("complete-task-execution-deleted-test"),
@@ -425,6 +552,29 @@ workerPump:
 	}
 }
 
+func (s *transferQueueProcessorSuite) TestRecordWorkflowStartedAfterExecutionDeleted() {
+	domainID := "b677a307-8261-40ea-b239-ab2ec78e443b"
+	workflowExecution := workflow.WorkflowExecution{WorkflowId: common.StringPtr("record-started-execution-deleted-test"),
+		RunId: common.StringPtr("5c3ac6c9-0b2a-4a08-9a4e-9e5a4e0f4c2d")}
+	taskList := "record-started-execution-deleted-queue"
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, taskList, "wType", 10, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	// The execution is closed and deleted before the task recording its start is processed
+	info, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	s.Nil(s.DeleteWorkflowExecution(info.ExecutionInfo))
+
+	err2 := s.processor.processRecordWorkflowStarted(&persistence.TransferTaskInfo{
+		DomainID:   domainID,
+		WorkflowID: *workflowExecution.WorkflowId,
+		RunID:      *workflowExecution.RunId,
+		TaskType:   persistence.TransferTaskTypeRecordWorkflowStarted,
+	})
+	s.Nil(err2)
+}
+
 func (s *transferQueueProcessorSuite) TestStartChildExecutionTransferTasks() {
 	domain := "start-child-execution-transfer-tasks-test-domain"
 	domainID := "b7f71853-0a8c-4eb1-af6b-c4e71dae50a1"
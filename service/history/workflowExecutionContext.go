@@ -165,6 +165,10 @@ func (c *workflowExecutionContext) updateWorkflowExecution(transferTasks []persi
 		DeleteTimerInfos:          updates.deleteTimerInfos,
 		UpsertChildExecutionInfos: updates.updateChildExecutionInfos,
 		DeleteChildExecutionInfo:  updates.deleteChildExecutionInfo,
+		UpsertRequestCancelInfos:  updates.updateRequestCancelInfos,
+		DeleteRequestCancelInfo:   updates.deleteRequestCancelInfo,
+		UpsertSignalInfos:         updates.updateSignalInfos,
+		DeleteSignalInfo:          updates.deleteSignalInfo,
 		ContinueAsNew:             continueAsNew,
 		CloseExecution:            deleteExecution,
 	}); err1 != nil {
@@ -228,6 +232,44 @@ func (c *workflowExecutionContext) continueAsNewWorkflowExecution(context []byte
 	return err2
 }
 
+// resetWorkflowExecution terminates the run of this context, which creates the new run built by newStateBuilder, and
+// then persists the activities and timers pending in the new run along with their tasks
+func (c *workflowExecutionContext) resetWorkflowExecution(newStateBuilder *mutableStateBuilder,
+	transferTasks []persistence.Task, timerTasks []persistence.Task, newTransferTasks []persistence.Task,
+	newTimerTasks []persistence.Task, transactionID int64) error {
+
+	err := c.continueAsNewWorkflowExecution(c.msBuilder.executionInfo.ExecutionContext, newStateBuilder,
+		transferTasks, timerTasks, transactionID)
+	if err != nil {
+		return err
+	}
+
+	state := newStateBuilder.CopyToPersistence()
+	var activityInfos []*persistence.ActivityInfo
+	for _, ai := range state.ActivitInfos {
+		activityInfos = append(activityInfos, ai)
+	}
+	var timerInfos []*persistence.TimerInfo
+	for _, ti := range state.TimerInfos {
+		timerInfos = append(timerInfos, ti)
+	}
+
+	if err := c.updateWorkflowExecutionWithRetry(&persistence.UpdateWorkflowExecutionRequest{
+		ExecutionInfo:       state.ExecutionInfo,
+		TransferTasks:       newTransferTasks,
+		TimerTasks:          newTimerTasks,
+		Condition:           newStateBuilder.GetNextEventID(),
+		UpsertActivityInfos: activityInfos,
+		UpserTimerInfos:     timerInfos,
+	}); err != nil {
+		logging.LogPersistantStoreErrorEvent(c.logger, logging.TagValueStoreOperationUpdateWorkflowExecution, err,
+			fmt.Sprintf("{RunID: %v}", state.ExecutionInfo.RunID))
+		return err
+	}
+
+	return nil
+}
+
 func (c *workflowExecutionContext) deleteWorkflowExecution() error {
 	err := c.deleteWorkflowExecutionWithRetry(&persistence.DeleteWorkflowExecutionRequest{
 		ExecutionInfo: c.msBuilder.executionInfo,
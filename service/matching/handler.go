@@ -67,8 +67,13 @@ func (h *Handler) Start() error {
 	if err != nil {
 		return err
 	}
+	matching, err := h.Service.GetClientFactory().NewMatchingClient()
+	if err != nil {
+		return err
+	}
 	h.metricsClient = h.Service.GetMetricsClient()
-	h.engine = NewEngine(h.taskPersistence, history, h.config, h.Service.GetLogger(), h.Service.GetMetricsClient())
+	h.engine = NewEngine(h.taskPersistence, history, matching, h.config, h.Service.GetLogger(),
+		h.Service.GetMetricsClient())
 	h.startWG.Done()
 	return nil
 }
@@ -140,6 +145,39 @@ func (h *Handler) PollForDecisionTask(ctx context.Context,
 	return response, h.handleErr(error, scope)
 }
 
+// QueryWorkflow queries a given workflow synchronously and return the query result.
+func (h *Handler) QueryWorkflow(ctx context.Context,
+	queryRequest *m.QueryWorkflowRequest) (*gen.QueryWorkflowResponse, error) {
+
+	scope := metrics.MatchingQueryWorkflowScope
+	sw := h.startRequestProfile("QueryWorkflow", scope)
+	defer sw.Stop()
+
+	response, error := h.engine.QueryWorkflow(ctx, queryRequest)
+	return response, h.handleErr(error, scope)
+}
+
+// RespondQueryTaskCompleted responds a query task completed
+func (h *Handler) RespondQueryTaskCompleted(ctx context.Context, request *m.RespondQueryTaskCompletedRequest) error {
+	scope := metrics.MatchingRespondQueryTaskCompletedScope
+	sw := h.startRequestProfile("RespondQueryTaskCompleted", scope)
+	defer sw.Stop()
+
+	return h.handleErr(h.engine.RespondQueryTaskCompleted(ctx, request), scope)
+}
+
+// DescribeTaskList returns information about the target task list
+func (h *Handler) DescribeTaskList(ctx context.Context,
+	request *m.DescribeTaskListRequest) (*gen.DescribeTaskListResponse, error) {
+
+	scope := metrics.MatchingDescribeTaskListScope
+	sw := h.startRequestProfile("DescribeTaskList", scope)
+	defer sw.Stop()
+
+	response, err := h.engine.DescribeTaskList(ctx, request)
+	return response, h.handleErr(err, scope)
+}
+
 func (h *Handler) handleErr(err error, scope int) error {
 
 	if err == nil {
@@ -162,6 +200,9 @@ func (h *Handler) handleErr(err error, scope int) error {
 	case *gen.DomainAlreadyExistsError:
 		h.metricsClient.IncCounter(scope, metrics.CadenceErrDomainAlreadyExistsCounter)
 		return err
+	case *gen.QueryFailedError:
+		h.metricsClient.IncCounter(scope, metrics.CadenceErrQueryFailedCounter)
+		return err
 	default:
 		h.metricsClient.IncCounter(scope, metrics.CadenceFailures)
 		return &gen.InternalServiceError{Message: err.Error()}
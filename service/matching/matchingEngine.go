@@ -34,6 +34,7 @@ import (
 	m "github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
@@ -46,12 +47,22 @@ import (
 type matchingEngineImpl struct {
 	taskManager     persistence.TaskManager
 	historyService  history.Client
+	matchingClient  matching.Client // used to forward tasks from idle task list partitions to the root partition
 	tokenSerializer common.TaskTokenSerializer
 	logger          bark.Logger
 	metricsClient   metrics.Client
 	taskListsLock   sync.RWMutex                   // locks mutation of taskLists
 	taskLists       map[taskListID]taskListManager // Convert to LRU cache
 	config          *Config
+	queryMapLock    sync.Mutex
+	// map from query task id to the channel used to deliver the worker response to the QueryWorkflow caller
+	queryTaskMap map[string]chan *queryResult
+}
+
+// queryResult is the outcome of a query task, either the response of the worker or an error hit while dispatching it
+type queryResult struct {
+	workerResponse *m.RespondQueryTaskCompletedRequest
+	internalError  error
 }
 
 type taskListID struct {
@@ -69,8 +80,11 @@ var (
 	historyServiceOperationRetryPolicy = common.CreateHistoryServiceRetryPolicy()
 
 	// ErrNoTasks is exported temporarily for integration test
-	ErrNoTasks    = errors.New("No tasks")
-	errPumpClosed = errors.New("Task list pump closed its channel")
+	ErrNoTasks           = errors.New("No tasks")
+	errPumpClosed        = errors.New("Task list pump closed its channel")
+	errQueryTaskIDNotSet = &workflow.BadRequestError{Message: "TaskID is not set on request."}
+	errNoQueryPoller     = &workflow.QueryFailedError{
+		Message: "No worker polled the decision task list of the workflow to pick up the query."}
 )
 
 func (t *taskListID) String() string {
@@ -91,6 +105,7 @@ var _ Engine = (*matchingEngineImpl)(nil) // Asserts that interface is indeed im
 // NewEngine creates an instance of matching engine
 func NewEngine(taskManager persistence.TaskManager,
 	historyService history.Client,
+	matchingClient matching.Client,
 	config *Config,
 	logger bark.Logger,
 	metricsClient metrics.Client) Engine {
@@ -98,8 +113,10 @@ func NewEngine(taskManager persistence.TaskManager,
 	return &matchingEngineImpl{
 		taskManager:     taskManager,
 		historyService:  historyService,
+		matchingClient:  matchingClient,
 		tokenSerializer: common.NewJSONTaskTokenSerializer(),
 		taskLists:       make(map[taskListID]taskListManager),
+		queryTaskMap:    make(map[string]chan *queryResult),
 		logger: logger.WithFields(bark.Fields{
 			logging.TagWorkflowComponent: logging.TagValueMatchingEngineComponent,
 		}),
@@ -187,6 +204,13 @@ func (e *matchingEngineImpl) AddDecisionTask(addRequest *m.AddDecisionTaskReques
 	if err != nil {
 		return err
 	}
+	if rootTaskListName, ok := e.getForwardTarget(tlMgr, taskListName); ok {
+		request := *addRequest
+		request.TaskList = &workflow.TaskList{Name: common.StringPtr(rootTaskListName)}
+		request.ForwardedFrom = common.StringPtr(taskListName)
+		e.metricsClient.IncCounter(metrics.MatchingAddDecisionTaskScope, metrics.ForwardedTaskCounter)
+		return e.matchingClient.AddDecisionTask(nil, &request)
+	}
 	taskInfo := &persistence.TaskInfo{
 		DomainID:   domainID,
 		RunID:      *addRequest.Execution.RunId,
@@ -208,6 +232,13 @@ func (e *matchingEngineImpl) AddActivityTask(addRequest *m.AddActivityTaskReques
 	if err != nil {
 		return err
 	}
+	if rootTaskListName, ok := e.getForwardTarget(tlMgr, taskListName); ok {
+		request := *addRequest
+		request.TaskList = &workflow.TaskList{Name: common.StringPtr(rootTaskListName)}
+		request.ForwardedFrom = common.StringPtr(taskListName)
+		e.metricsClient.IncCounter(metrics.MatchingAddActivityTaskScope, metrics.ForwardedTaskCounter)
+		return e.matchingClient.AddActivityTask(nil, &request)
+	}
 	taskInfo := &persistence.TaskInfo{
 		DomainID:               sourceDomainID,
 		RunID:                  *addRequest.Execution.RunId,
@@ -233,7 +264,7 @@ pollLoop:
 		}
 
 		taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeDecision)
-		tCtx, err := e.getTask(ctx, taskList)
+		tCtx, err := e.getTask(ctx, taskList, common.StringDefault(request.Identity), nil)
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
 			if err == ErrNoTasks || err == errPumpClosed {
@@ -242,6 +273,22 @@ pollLoop:
 			return nil, err
 		}
 
+		if tCtx.queryTaskInfo != nil {
+			// Query tasks are only matched synchronously and never persisted, so there is nothing to complete.
+			// The history of the execution is loaded up to its latest event so the worker can answer the query.
+			describeResp, err := e.historyService.DescribeWorkflowExecution(ctx, &h.DescribeWorkflowExecutionRequest{
+				DomainUUID: common.StringPtr(domainID),
+				Request: &workflow.DescribeWorkflowExecutionRequest{
+					Execution: &tCtx.workflowExecution,
+				},
+			})
+			if err != nil {
+				e.deliverQueryResult(tCtx.queryTaskInfo.taskID, &queryResult{internalError: err})
+				continue pollLoop
+			}
+			return e.createPollForDecisionTaskResponseForQuery(tCtx, taskListName, describeResp), nil
+		}
+
 		// Generate a unique requestId for this task which will be used for all retries
 		requestID := uuid.New()
 		resp, err := tCtx.RecordDecisionTaskStartedWithRetry(&h.RecordDecisionTaskStartedRequest{
@@ -277,6 +324,10 @@ func (e *matchingEngineImpl) PollForActivityTask(ctx context.Context, req *m.Pol
 	domainID := *req.DomainUUID
 	request := req.PollRequest
 	taskListName := *request.TaskList.Name
+	var maxDispatchPerSecond *float64
+	if request.TaskListMetadata != nil {
+		maxDispatchPerSecond = request.TaskListMetadata.MaxTasksPerSecond
+	}
 	e.logger.Debugf("Received PollForActivityTask for taskList=%v", taskListName)
 pollLoop:
 	for {
@@ -286,7 +337,7 @@ pollLoop:
 		}
 
 		taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeActivity)
-		tCtx, err := e.getTask(ctx, taskList)
+		tCtx, err := e.getTask(ctx, taskList, common.StringDefault(request.Identity), maxDispatchPerSecond)
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
 			if err == ErrNoTasks || err == errPumpClosed {
@@ -321,13 +372,127 @@ pollLoop:
 	}
 }
 
+// QueryWorkflow hands the query to a poller of the decision task list of the execution and waits for the worker to
+// respond through RespondQueryTaskCompleted.  The query fails if no poller picks it up within the query handoff
+// timeout, rather than waiting for a worker until the deadline of the caller.
+func (e *matchingEngineImpl) QueryWorkflow(ctx context.Context, queryRequest *m.QueryWorkflowRequest) (
+	*workflow.QueryWorkflowResponse, error) {
+	domainID := *queryRequest.DomainUUID
+	taskListName := *queryRequest.TaskList.Name
+	taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeDecision)
+	tlMgr, err := e.getTaskListManager(taskList)
+	if err != nil {
+		return nil, err
+	}
+
+	queryTask := &queryTaskInfo{
+		taskID:       uuid.New(),
+		queryRequest: queryRequest,
+	}
+	resultCh := make(chan *queryResult, 1)
+	e.queryMapLock.Lock()
+	e.queryTaskMap[queryTask.taskID] = resultCh
+	e.queryMapLock.Unlock()
+	defer func() {
+		e.queryMapLock.Lock()
+		delete(e.queryTaskMap, queryTask.taskID)
+		e.queryMapLock.Unlock()
+	}()
+
+	handoffCtx, cancel := context.WithTimeout(ctx, e.config.QueryHandoffTimeout())
+	err = tlMgr.DispatchQueryTask(handoffCtx, queryTask)
+	cancel()
+	if err != nil {
+		if err == context.DeadlineExceeded && ctx.Err() == nil {
+			return nil, errNoQueryPoller
+		}
+		return nil, err
+	}
+
+	select {
+	case result := <-resultCh:
+		if result.internalError != nil {
+			return nil, result.internalError
+		}
+
+		completedRequest := result.workerResponse.CompletedRequest
+		if completedRequest.CompletedType != nil {
+			switch *completedRequest.CompletedType {
+			case workflow.QueryTaskCompletedTypeCompleted:
+				return &workflow.QueryWorkflowResponse{QueryResult: completedRequest.QueryResult}, nil
+			case workflow.QueryTaskCompletedTypeFailed:
+				return nil, &workflow.QueryFailedError{Message: common.StringDefault(completedRequest.ErrorMessage)}
+			}
+		}
+		return nil, &workflow.InternalServiceError{Message: "Invalid query task completed type."}
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// RespondQueryTaskCompleted routes the response of the worker to the QueryWorkflow call waiting for it
+func (e *matchingEngineImpl) RespondQueryTaskCompleted(ctx context.Context,
+	request *m.RespondQueryTaskCompletedRequest) error {
+	if request.TaskID == nil {
+		return errQueryTaskIDNotSet
+	}
+
+	if !e.deliverQueryResult(*request.TaskID, &queryResult{workerResponse: request}) {
+		return &workflow.EntityNotExistsError{Message: "Query task not found, or already expired."}
+	}
+	return nil
+}
+
+// DescribeTaskList returns the recent pollers and the backlog of a task list
+func (e *matchingEngineImpl) DescribeTaskList(ctx context.Context, request *m.DescribeTaskListRequest) (
+	*workflow.DescribeTaskListResponse, error) {
+	domainID := *request.DomainUUID
+	taskListType := persistence.TaskListTypeDecision
+	if request.DescRequest.TaskListType != nil && *request.DescRequest.TaskListType == workflow.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	taskList := newTaskListID(domainID, *request.DescRequest.TaskList.Name, taskListType)
+	tlMgr, err := e.getTaskListManager(taskList)
+	if err != nil {
+		return nil, err
+	}
+	return tlMgr.DescribeTaskList(), nil
+}
+
+func (e *matchingEngineImpl) deliverQueryResult(taskID string, result *queryResult) bool {
+	e.queryMapLock.Lock()
+	resultCh, ok := e.queryTaskMap[taskID]
+	e.queryMapLock.Unlock()
+	if !ok {
+		return false
+	}
+
+	select {
+	case resultCh <- result:
+	default: // the query already has a result, later ones are dropped
+	}
+	return true
+}
+
+// Returns the name of the root partition if the task list is a non-root partition without pollers, in which case new
+// tasks are forwarded to the root partition instead of piling up in a partition nobody polls.
+func (e *matchingEngineImpl) getForwardTarget(tlMgr taskListManager, taskListName string) (string, bool) {
+	rootTaskListName, isPartition := common.RootTaskListName(taskListName)
+	if !isPartition || tlMgr.HasPollers() {
+		return "", false
+	}
+	return rootTaskListName, true
+}
+
 // Loads a task from persistence and wraps it in a task context
-func (e *matchingEngineImpl) getTask(ctx context.Context, taskList *taskListID) (*taskContext, error) {
+func (e *matchingEngineImpl) getTask(ctx context.Context, taskList *taskListID, pollerIdentity string,
+	maxDispatchPerSecond *float64) (*taskContext, error) {
 	tlMgr, err := e.getTaskListManager(taskList)
 	if err != nil {
 		return nil, err
 	}
-	return tlMgr.GetTaskContext(ctx)
+	tlMgr.UpdatePollerInfo(pollerIdentity)
+	return tlMgr.GetTaskContext(ctx, maxDispatchPerSecond)
 }
 
 func (e *matchingEngineImpl) unloadTaskList(id *taskListID) {
@@ -362,6 +527,28 @@ func (e *matchingEngineImpl) createPollForDecisionTaskResponse(context *taskCont
 		response.PreviousStartedEventId = historyResponse.PreviousStartedEventId
 	}
 	response.StartedEventId = historyResponse.StartedEventId
+	response.StickyExecutionEnabled = historyResponse.StickyExecutionEnabled
+
+	return response
+}
+
+// Populate the decision task response for a query task.  The started event id is set to the last event of the
+// execution so the whole history is handed to the worker.
+func (e *matchingEngineImpl) createPollForDecisionTaskResponseForQuery(context *taskContext, taskListName string,
+	describeResponse *workflow.DescribeWorkflowExecutionResponse) *m.PollForDecisionTaskResponse {
+	queryTask := context.queryTaskInfo
+
+	response := &m.PollForDecisionTaskResponse{}
+	response.WorkflowExecution = describeResponse.WorkflowExecutionInfo.Execution
+	token := &common.QueryTaskToken{
+		DomainID: context.info.DomainID,
+		TaskList: taskListName,
+		TaskID:   queryTask.taskID,
+	}
+	response.TaskToken, _ = e.tokenSerializer.SerializeQueryTaskToken(token)
+	response.WorkflowType = describeResponse.WorkflowExecutionInfo.Type
+	response.StartedEventId = describeResponse.WorkflowExecutionInfo.HistoryLength
+	response.Query = queryTask.queryRequest.QueryRequest.Query
 
 	return response
 }
@@ -392,12 +579,14 @@ func (e *matchingEngineImpl) createPollForActivityTaskResponse(context *taskCont
 	response.StartedTimestamp = common.Int64Ptr(*startedEvent.Timestamp)
 	response.StartToCloseTimeoutSeconds = common.Int32Ptr(*attributes.StartToCloseTimeoutSeconds)
 	response.HeartbeatTimeoutSeconds = common.Int32Ptr(*attributes.HeartbeatTimeoutSeconds)
+	response.Attempt = common.Int32Ptr(int32(common.Int64Default(historyResponse.Attempt)))
 
 	token := &common.TaskToken{
-		DomainID:   task.DomainID,
-		WorkflowID: task.WorkflowID,
-		RunID:      task.RunID,
-		ScheduleID: task.ScheduleID,
+		DomainID:        task.DomainID,
+		WorkflowID:      task.WorkflowID,
+		RunID:           task.RunID,
+		ScheduleID:      task.ScheduleID,
+		ScheduleAttempt: common.Int64Default(historyResponse.Attempt),
 	}
 	response.TaskToken, _ = e.tokenSerializer.Serialize(token)
 	return response
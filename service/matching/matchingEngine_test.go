@@ -27,6 +27,7 @@ import (
 	"net/http"
 	"os"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -42,6 +43,7 @@ import (
 	"github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -51,6 +53,7 @@ type (
 	matchingEngineSuite struct {
 		suite.Suite
 		historyClient        *mocks.HistoryClient
+		matchingClient       *mocks.MatchingClient
 		matchingEngine       *matchingEngineImpl
 		taskManager          *testTaskManager
 		mockExecutionManager *mocks.ExecutionManager
@@ -100,6 +103,7 @@ func (s *matchingEngineSuite) SetupTest() {
 	defer s.Unlock()
 	s.mockExecutionManager = &mocks.ExecutionManager{}
 	s.historyClient = &mocks.HistoryClient{}
+	s.matchingClient = &mocks.MatchingClient{}
 	s.taskManager = newTestTaskManager(s.logger)
 
 	s.matchingEngine = s.newMatchingEngine(defaultTestConfig(), s.taskManager)
@@ -110,16 +114,19 @@ func (s *matchingEngineSuite) newMatchingEngine(config *Config, taskMgr persiste
 	return &matchingEngineImpl{
 		taskManager:     taskMgr,
 		historyService:  s.historyClient,
+		matchingClient:  s.matchingClient,
 		taskLists:       make(map[taskListID]taskListManager),
 		logger:          s.logger,
 		metricsClient:   metrics.NewClient(tally.NoopScope, metrics.Matching),
 		tokenSerializer: common.NewJSONTaskTokenSerializer(),
 		config:          config,
+		queryTaskMap:    make(map[string]chan *queryResult),
 	}
 }
 
 func (s *matchingEngineSuite) TearDownTest() {
 	s.mockExecutionManager.AssertExpectations(s.T())
+	s.matchingClient.AssertExpectations(s.T())
 	s.matchingEngine.Stop()
 }
 
@@ -180,7 +187,8 @@ func (s *matchingEngineSuite) TestPollForDecisionTasksEmptyResult() {
 
 func (s *matchingEngineSuite) PollForTasksEmptyResultTest(taskType int) {
 	s.matchingEngine.config.RangeSize = 2 // to test that range is not updated without tasks
-	s.matchingEngine.config.LongPollExpirationInterval = 10 * time.Millisecond
+	s.matchingEngine.config.LongPollExpirationInterval =
+		dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
 
 	domainID := "domainId"
 	tl := "makeToast"
@@ -215,6 +223,94 @@ func (s *matchingEngineSuite) PollForTasksEmptyResultTest(taskType int) {
 	s.EqualValues(1, s.taskManager.taskLists[*tlID].rangeID)
 }
 
+func (s *matchingEngineSuite) TestPollForActivityTaskSetsMaxDispatch() {
+	s.matchingEngine.config.LongPollExpirationInterval =
+		dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+
+	domainID := "domainId"
+	tl := "makeToast"
+	tlID := newTaskListID(domainID, tl, persistence.TaskListTypeActivity)
+
+	resp, err := s.matchingEngine.PollForActivityTask(s.callContext, &matching.PollForActivityTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollRequest: &workflow.PollForActivityTaskRequest{
+			TaskList:         &workflow.TaskList{Name: common.StringPtr(tl)},
+			Identity:         common.StringPtr("selfDrivingToaster"),
+			TaskListMetadata: &workflow.TaskListMetadata{MaxTasksPerSecond: common.Float64Ptr(5)},
+		},
+	})
+	s.NoError(err)
+	s.Equal(emptyPollForActivityTaskResponse, resp)
+
+	tlMgr, err := s.matchingEngine.getTaskListManager(tlID)
+	s.NoError(err)
+	rateLimiter := tlMgr.(*taskListManagerImpl).rateLimiter
+	s.True(rateLimiter.throttled)
+	s.Equal(float64(5), rateLimiter.maxDispatchPerSecond)
+}
+
+func (s *matchingEngineSuite) TestDescribeTaskList() {
+	s.matchingEngine.config.LongPollExpirationInterval =
+		dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+
+	domainID := "domainId"
+	tl := "makeToast"
+	identity := "selfDrivingToaster"
+	taskList := &workflow.TaskList{Name: common.StringPtr(tl)}
+
+	resp, err := s.matchingEngine.PollForActivityTask(s.callContext, &matching.PollForActivityTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollRequest: &workflow.PollForActivityTaskRequest{
+			TaskList: taskList,
+			Identity: common.StringPtr(identity),
+		},
+	})
+	s.NoError(err)
+	s.Equal(emptyPollForActivityTaskResponse, resp)
+
+	const taskCount = 5
+	runID := "run1"
+	workflowID := "workflow1"
+	execution := workflow.WorkflowExecution{RunId: &runID, WorkflowId: &workflowID}
+	for i := int64(0); i < taskCount; i++ {
+		err = s.matchingEngine.AddActivityTask(&matching.AddActivityTaskRequest{
+			SourceDomainUUID:              common.StringPtr(domainID),
+			DomainUUID:                    common.StringPtr(domainID),
+			Execution:                     &execution,
+			ScheduleId:                    common.Int64Ptr(i),
+			TaskList:                      taskList,
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
+		})
+		s.NoError(err)
+	}
+
+	descResp, err := s.matchingEngine.DescribeTaskList(s.callContext, &matching.DescribeTaskListRequest{
+		DomainUUID: common.StringPtr(domainID),
+		DescRequest: &workflow.DescribeTaskListRequest{
+			TaskList:     taskList,
+			TaskListType: common.TaskListTypePtr(workflow.TaskListTypeActivity),
+		},
+	})
+	s.NoError(err)
+	s.Equal(1, len(descResp.Pollers))
+	s.Equal(identity, *descResp.Pollers[0].Identity)
+	s.True(*descResp.Pollers[0].LastAccessTime > 0)
+	s.EqualValues(taskCount, *descResp.TaskListStatus.BacklogCountHint)
+	s.EqualValues(0, *descResp.TaskListStatus.AckLevel)
+
+	// the decision task list of the same name has never been polled
+	descResp, err = s.matchingEngine.DescribeTaskList(s.callContext, &matching.DescribeTaskListRequest{
+		DomainUUID: common.StringPtr(domainID),
+		DescRequest: &workflow.DescribeTaskListRequest{
+			TaskList:     taskList,
+			TaskListType: common.TaskListTypePtr(workflow.TaskListTypeDecision),
+		},
+	})
+	s.NoError(err)
+	s.Equal(0, len(descResp.Pollers))
+	s.EqualValues(0, *descResp.TaskListStatus.BacklogCountHint)
+}
+
 func (s *matchingEngineSuite) TestAddActivityTasks() {
 	s.AddTasksTest(persistence.TaskListTypeActivity)
 }
@@ -272,6 +368,148 @@ func (s *matchingEngineSuite) AddTasksTest(taskType int) {
 
 }
 
+func (s *matchingEngineSuite) TestAddActivityTaskToIdlePartitionIsForwarded() {
+	// partitions are idle unless a poll is waiting on them
+	s.matchingEngine.config.IdlePartitionTimeout = dynamicconfig.GetDurationPropertyFn(0)
+
+	domainID := "domainId"
+	rootTaskList := "makeToast"
+	partition := common.TaskListPartitionName(rootTaskList, 2)
+	runID := "run1"
+	workflowID := "workflow1"
+	execution := workflow.WorkflowExecution{RunId: &runID, WorkflowId: &workflowID}
+
+	addRequest := &matching.AddActivityTaskRequest{
+		SourceDomainUUID:              common.StringPtr(domainID),
+		DomainUUID:                    common.StringPtr(domainID),
+		Execution:                     &execution,
+		ScheduleId:                    common.Int64Ptr(5),
+		TaskList:                      &workflow.TaskList{Name: common.StringPtr(partition)},
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
+	}
+	s.matchingClient.On("AddActivityTask", mock.Anything, mock.MatchedBy(
+		func(req *matching.AddActivityTaskRequest) bool {
+			return *req.TaskList.Name == rootTaskList && *req.ForwardedFrom == partition && *req.ScheduleId == 5
+		})).Return(nil).Once()
+
+	err := s.matchingEngine.AddActivityTask(addRequest)
+	s.NoError(err)
+	s.Equal(partition, *addRequest.TaskList.Name)
+	s.Nil(addRequest.ForwardedFrom)
+	s.EqualValues(0, s.taskManager.getTaskCount(&taskListID{
+		domainID:     domainID,
+		taskListName: partition,
+		taskType:     persistence.TaskListTypeActivity,
+	}))
+}
+
+func (s *matchingEngineSuite) TestAddDecisionTaskToPolledPartitionIsNotForwarded() {
+	domainID := "domainId"
+	partition := common.TaskListPartitionName("makeToast", 1)
+	runID := "run1"
+	workflowID := "workflow1"
+	execution := workflow.WorkflowExecution{RunId: &runID, WorkflowId: &workflowID}
+
+	// A newly loaded partition is given the idle partition timeout to receive its first poll
+	addRequest := &matching.AddDecisionTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &execution,
+		ScheduleId: common.Int64Ptr(5),
+		TaskList:   &workflow.TaskList{Name: common.StringPtr(partition)},
+	}
+	err := s.matchingEngine.AddDecisionTask(addRequest)
+	s.NoError(err)
+	s.EqualValues(1, s.taskManager.getTaskCount(&taskListID{
+		domainID:     domainID,
+		taskListName: partition,
+		taskType:     persistence.TaskListTypeDecision,
+	}))
+}
+
+func (s *matchingEngineSuite) TestBacklogOfIdlePartitionIsForwarded() {
+	// the partition is loaded with a long idle timeout, which is lowered once its backlog is persisted
+	idlePartitionTimeout := int64(time.Hour)
+	s.matchingEngine.config.IdlePartitionTimeout = func() time.Duration {
+		return time.Duration(atomic.LoadInt64(&idlePartitionTimeout))
+	}
+
+	domainID := "domainId"
+	rootTaskList := "makeToast"
+	partition := common.TaskListPartitionName(rootTaskList, 1)
+	runID := "run1"
+	workflowID := "workflow1"
+	execution := workflow.WorkflowExecution{RunId: &runID, WorkflowId: &workflowID}
+	tlID := &taskListID{
+		domainID:     domainID,
+		taskListName: partition,
+		taskType:     persistence.TaskListTypeDecision,
+	}
+
+	// A newly loaded partition is given the idle partition timeout to receive its first poll, so the tasks are
+	// persisted in its backlog
+	const taskCount = 3
+	for i := int64(0); i < taskCount; i++ {
+		addRequest := &matching.AddDecisionTaskRequest{
+			DomainUUID: common.StringPtr(domainID),
+			Execution:  &execution,
+			ScheduleId: common.Int64Ptr(i),
+			TaskList:   &workflow.TaskList{Name: common.StringPtr(partition)},
+		}
+		err := s.matchingEngine.AddDecisionTask(addRequest)
+		s.NoError(err)
+	}
+	s.EqualValues(taskCount, s.taskManager.getTaskCount(tlID))
+
+	forwarded := make(chan int64, taskCount)
+	s.matchingClient.On("AddDecisionTask", mock.Anything, mock.MatchedBy(
+		func(req *matching.AddDecisionTaskRequest) bool {
+			return *req.TaskList.Name == rootTaskList && *req.ForwardedFrom == partition
+		})).Return(nil).Run(func(args mock.Arguments) {
+		forwarded <- *args.Get(1).(*matching.AddDecisionTaskRequest).ScheduleId
+	}).Times(taskCount)
+
+	// the partition becomes idle without any poll picking up its backlog
+	atomic.StoreInt64(&idlePartitionTimeout, 0)
+	for i := int64(0); i < taskCount; i++ {
+		select {
+		case scheduleID := <-forwarded:
+			s.Equal(i, scheduleID)
+		case <-time.After(5 * time.Second):
+			s.Fail("backlog of idle partition was not forwarded")
+		}
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for s.taskManager.getTaskCount(tlID) > 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
+}
+
+func (s *matchingEngineSuite) TestAddDecisionTaskToRootPartitionIsNotForwarded() {
+	s.matchingEngine.config.IdlePartitionTimeout = dynamicconfig.GetDurationPropertyFn(0)
+
+	domainID := "domainId"
+	tl := "makeToast"
+	runID := "run1"
+	workflowID := "workflow1"
+	execution := workflow.WorkflowExecution{RunId: &runID, WorkflowId: &workflowID}
+
+	addRequest := &matching.AddDecisionTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &execution,
+		ScheduleId: common.Int64Ptr(5),
+		TaskList:   &workflow.TaskList{Name: common.StringPtr(tl)},
+	}
+	err := s.matchingEngine.AddDecisionTask(addRequest)
+	s.NoError(err)
+	s.EqualValues(1, s.taskManager.getTaskCount(&taskListID{
+		domainID:     domainID,
+		taskListName: tl,
+		taskType:     persistence.TaskListTypeDecision,
+	}))
+}
+
 func (s *matchingEngineSuite) TestTaskWriterShutdown() {
 	s.matchingEngine.config.RangeSize = 300 // override to low number for the test
 
@@ -320,7 +558,8 @@ func (s *matchingEngineSuite) TestTaskWriterShutdown() {
 }
 
 func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
-	s.matchingEngine.config.LongPollExpirationInterval = 10 * time.Millisecond
+	s.matchingEngine.config.LongPollExpirationInterval =
+		dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
 
 	runID := "run1"
 	workflowID := "workflow1"
@@ -434,7 +673,8 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 }
 
 func (s *matchingEngineSuite) TestSyncMatchActivities() {
-	s.matchingEngine.config.LongPollExpirationInterval = 1 * time.Minute
+	s.matchingEngine.config.LongPollExpirationInterval =
+		dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(1 * time.Minute)
 
 	runID := "run1"
 	workflowID := "workflow1"
@@ -549,6 +789,139 @@ func (s *matchingEngineSuite) TestSyncMatchActivities() {
 	s.True(expectedRange <= s.taskManager.getTaskListManager(tlID).rangeID)
 }
 
+func (s *matchingEngineSuite) TestQueryWorkflow() {
+	s.matchingEngine.config.LongPollExpirationInterval =
+		dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(1 * time.Minute)
+
+	runID := "run1"
+	workflowID := "workflow1"
+	workflowExecution := workflow.WorkflowExecution{RunId: &runID, WorkflowId: &workflowID}
+	workflowType := &workflow.WorkflowType{Name: common.StringPtr("workflow-type")}
+
+	domainID := "domainId"
+	tl := "queryTaskList"
+	taskList := &workflow.TaskList{Name: &tl}
+	identity := "nobody"
+	var historyLength int64 = 10
+
+	// History service is using mock
+	s.historyClient.On("DescribeWorkflowExecution", mock.Anything,
+		mock.AnythingOfType("*history.DescribeWorkflowExecutionRequest")).Return(
+		&workflow.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &workflow.WorkflowExecutionInfo{
+				Execution:     &workflowExecution,
+				Type:          workflowType,
+				HistoryLength: common.Int64Ptr(historyLength),
+			},
+		}, nil)
+
+	queryType := "query-type"
+	queryResultBlob := []byte("query result")
+	completedTypes := []workflow.QueryTaskCompletedType{
+		workflow.QueryTaskCompletedTypeCompleted,
+		workflow.QueryTaskCompletedTypeFailed,
+	}
+	for _, completedType := range completedTypes {
+		var wg sync.WaitGroup
+		var pollErr, respondErr error
+		var pollResp *matching.PollForDecisionTaskResponse
+		wg.Add(1)
+		go func(completedType workflow.QueryTaskCompletedType) {
+			defer wg.Done()
+			pollResp, pollErr = s.matchingEngine.PollForDecisionTask(s.callContext, &matching.PollForDecisionTaskRequest{
+				DomainUUID: common.StringPtr(domainID),
+				PollRequest: &workflow.PollForDecisionTaskRequest{
+					TaskList: taskList,
+					Identity: &identity},
+			})
+			if pollErr != nil || pollResp.Query == nil {
+				return
+			}
+
+			queryTaskToken, err := s.matchingEngine.tokenSerializer.DeserializeQueryTaskToken(pollResp.TaskToken)
+			if err != nil {
+				respondErr = err
+				return
+			}
+			respondErr = s.matchingEngine.RespondQueryTaskCompleted(s.callContext, &matching.RespondQueryTaskCompletedRequest{
+				DomainUUID: common.StringPtr(queryTaskToken.DomainID),
+				TaskList:   &workflow.TaskList{Name: common.StringPtr(queryTaskToken.TaskList)},
+				TaskID:     common.StringPtr(queryTaskToken.TaskID),
+				CompletedRequest: &workflow.RespondQueryTaskCompletedRequest{
+					TaskToken:     pollResp.TaskToken,
+					CompletedType: &completedType,
+					QueryResult:   queryResultBlob,
+					ErrorMessage:  common.StringPtr("query failed"),
+				},
+			})
+		}(completedType)
+
+		time.Sleep(50 * time.Millisecond)
+		queryResp, err := s.matchingEngine.QueryWorkflow(s.callContext, &matching.QueryWorkflowRequest{
+			DomainUUID: common.StringPtr(domainID),
+			TaskList:   taskList,
+			QueryRequest: &workflow.QueryWorkflowRequest{
+				Domain:    common.StringPtr("domain"),
+				Execution: &workflowExecution,
+				Query:     &workflow.WorkflowQuery{QueryType: &queryType},
+			},
+		})
+		wg.Wait()
+
+		s.NoError(pollErr)
+		s.NoError(respondErr)
+		s.NotNil(pollResp.Query)
+		s.Equal(queryType, *pollResp.Query.QueryType)
+		s.EqualValues(workflowExecution, *pollResp.WorkflowExecution)
+		s.Equal(workflowType, pollResp.WorkflowType)
+		s.Equal(historyLength, *pollResp.StartedEventId)
+		if completedType == workflow.QueryTaskCompletedTypeCompleted {
+			s.NoError(err)
+			s.Equal(queryResultBlob, queryResp.QueryResult)
+		} else {
+			s.IsType(&workflow.QueryFailedError{}, err)
+			s.Nil(queryResp)
+		}
+	}
+
+	tlID := &taskListID{domainID: domainID, taskListName: tl, taskType: persistence.TaskListTypeDecision}
+	s.EqualValues(0, s.taskManager.getCreateTaskCount(tlID)) // Query tasks are never persisted
+	s.Equal(0, len(s.matchingEngine.queryTaskMap))
+
+	err := s.matchingEngine.RespondQueryTaskCompleted(s.callContext, &matching.RespondQueryTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		TaskList:   taskList,
+		TaskID:     common.StringPtr("unknown-task-id"),
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
+
+func (s *matchingEngineSuite) TestQueryWorkflowWithoutPoller() {
+	s.matchingEngine.config.QueryHandoffTimeout = dynamicconfig.GetDurationPropertyFn(50 * time.Millisecond)
+
+	runID := "run1"
+	workflowID := "workflow1"
+	workflowExecution := workflow.WorkflowExecution{RunId: &runID, WorkflowId: &workflowID}
+	queryType := "query-type"
+
+	ctx, cancel := context.WithTimeout(s.callContext, time.Minute)
+	defer cancel()
+	start := time.Now()
+	queryResp, err := s.matchingEngine.QueryWorkflow(ctx, &matching.QueryWorkflowRequest{
+		DomainUUID: common.StringPtr("domainId"),
+		TaskList:   &workflow.TaskList{Name: common.StringPtr("queryTaskList")},
+		QueryRequest: &workflow.QueryWorkflowRequest{
+			Domain:    common.StringPtr("domain"),
+			Execution: &workflowExecution,
+			Query:     &workflow.WorkflowQuery{QueryType: &queryType},
+		},
+	})
+	s.Nil(queryResp)
+	s.Equal(errNoQueryPoller, err)
+	s.True(time.Since(start) < 10*time.Second)
+	s.Equal(0, len(s.matchingEngine.queryTaskMap))
+}
+
 func (s *matchingEngineSuite) TestConcurrentPublishConsumeActivities() {
 	runID := "run1"
 	workflowID := "workflow1"
@@ -1146,12 +1519,12 @@ func (s *matchingEngineSuite) TestAddTaskAfterStartFailure() {
 	s.NoError(err)
 	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
 
-	ctx, err := s.matchingEngine.getTask(context.Background(), tlID)
+	ctx, err := s.matchingEngine.getTask(context.Background(), tlID, "", nil)
 	s.NoError(err)
 
 	ctx.completeTask(errors.New("test error"))
 	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
-	ctx2, err := s.matchingEngine.getTask(context.Background(), tlID)
+	ctx2, err := s.matchingEngine.getTask(context.Background(), tlID, "", nil)
 	s.NoError(err)
 
 	s.NotEqual(ctx.info.TaskID, ctx2.info.TaskID)
@@ -1443,7 +1816,8 @@ func validateTimeRange(t time.Time, expectedDuration time.Duration) bool {
 }
 
 func defaultTestConfig() *Config {
-	config := NewConfig()
-	config.LongPollExpirationInterval = 100 * time.Millisecond
+	config := NewConfig(dynamicconfig.NewNopCollection())
+	config.LongPollExpirationInterval =
+		dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(100 * time.Millisecond)
 	return config
 }
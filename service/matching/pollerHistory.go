@@ -0,0 +1,80 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+
+	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+const (
+	// pollers that have not polled the task list for longer than this are no longer reported
+	pollerHistoryTTL = 5 * time.Minute
+	// the number of recorded pollers above which pollers past their ttl are dropped when a new poller shows up
+	pollerHistoryMaxSize = 1000
+)
+
+// pollerHistory tracks the identity and the last access time of the recent pollers of a task list
+type pollerHistory struct {
+	sync.Mutex
+	lastAccessTime map[string]time.Time
+}
+
+func newPollerHistory() *pollerHistory {
+	return &pollerHistory{
+		lastAccessTime: make(map[string]time.Time),
+	}
+}
+
+func (p *pollerHistory) updatePollerInfo(identity string) {
+	p.Lock()
+	defer p.Unlock()
+	now := time.Now()
+	if _, ok := p.lastAccessTime[identity]; !ok && len(p.lastAccessTime) >= pollerHistoryMaxSize {
+		p.removeExpiredLocked(now)
+	}
+	p.lastAccessTime[identity] = now
+}
+
+func (p *pollerHistory) getPollerInfo() []*s.PollerInfo {
+	p.Lock()
+	defer p.Unlock()
+	p.removeExpiredLocked(time.Now())
+	result := make([]*s.PollerInfo, 0, len(p.lastAccessTime))
+	for identity, lastAccessTime := range p.lastAccessTime {
+		result = append(result, &s.PollerInfo{
+			Identity:       common.StringPtr(identity),
+			LastAccessTime: common.Int64Ptr(lastAccessTime.UnixNano()),
+		})
+	}
+	return result
+}
+
+func (p *pollerHistory) removeExpiredLocked(now time.Time) {
+	for identity, lastAccessTime := range p.lastAccessTime {
+		if now.Sub(lastAccessTime) > pollerHistoryTTL {
+			delete(p.lastAccessTime, identity)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package matching
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"golang.org/x/net/context"
+)
+
+// rateLimiter throttles the dispatch of tasks from a task list.  The dispatch rate is set by the pollers of the task
+// list, which is not throttled until one of them does.  It is a token bucket that refills at the dispatch rate, which
+// may be fractional, and holds up to one second worth of tokens, but never less than one.
+type rateLimiter struct {
+	sync.Mutex
+	throttled            bool
+	maxDispatchPerSecond float64
+	tokens               float64
+	lastRefill           time.Time
+	timeSource           common.TimeSource
+}
+
+func newRateLimiter() *rateLimiter {
+	return newRateLimiterWithTimeSource(common.NewRealTimeSource())
+}
+
+func newRateLimiterWithTimeSource(timeSource common.TimeSource) *rateLimiter {
+	return &rateLimiter{timeSource: timeSource}
+}
+
+// UpdateMaxDispatch sets the dispatch rate of the task list in tasks per second.  A rate of zero or less stops the
+// dispatch of tasks until it is raised again.
+func (rl *rateLimiter) UpdateMaxDispatch(maxDispatchPerSecond float64) {
+	rl.Lock()
+	defer rl.Unlock()
+	if rl.throttled && rl.maxDispatchPerSecond == maxDispatchPerSecond {
+		return
+	}
+
+	if !rl.throttled {
+		rl.throttled = true
+		rl.lastRefill = rl.timeSource.Now()
+		rl.maxDispatchPerSecond = maxDispatchPerSecond
+		rl.tokens = rl.burstLocked()
+		return
+	}
+	rl.refillLocked()
+	rl.maxDispatchPerSecond = maxDispatchPerSecond
+	rl.tokens = math.Min(rl.tokens, rl.burstLocked())
+}
+
+// Wait blocks until a task can be dispatched, without using up the token for it.  Consume must be called once a task
+// is actually handed out.  It returns false if no task could be dispatched within the timeout or before the deadline of
+// the context.
+func (rl *rateLimiter) Wait(ctx context.Context, timeout time.Duration) bool {
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := deadline.Sub(time.Now()); remaining < timeout {
+			timeout = remaining
+		}
+	}
+	expiration := time.Now().Add(timeout)
+	for {
+		wait := rl.timeUntilAvailable()
+		if wait <= 0 {
+			return true
+		}
+		remaining := expiration.Sub(time.Now())
+		if remaining <= 0 {
+			return false
+		}
+		if wait > remaining {
+			wait = remaining
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return false
+		}
+	}
+}
+
+// Consume uses up the token of a dispatched task.  Pollers that were let through by Wait at the same time can take the
+// bucket below empty, in which case the tasks after them wait for it to refill.
+func (rl *rateLimiter) Consume() {
+	rl.Lock()
+	defer rl.Unlock()
+	if !rl.throttled {
+		return
+	}
+	rl.refillLocked()
+	rl.tokens--
+}
+
+// timeUntilAvailable returns how long it takes until a task can be dispatched, which is zero or less if it can be
+// right away.
+func (rl *rateLimiter) timeUntilAvailable() time.Duration {
+	rl.Lock()
+	defer rl.Unlock()
+	if !rl.throttled {
+		return 0
+	}
+	rl.refillLocked()
+	if rl.tokens >= 1 {
+		return 0
+	}
+	if rl.maxDispatchPerSecond <= 0 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration((1 - rl.tokens) / rl.maxDispatchPerSecond * float64(time.Second))
+}
+
+func (rl *rateLimiter) refillLocked() {
+	now := rl.timeSource.Now()
+	elapsed := now.Sub(rl.lastRefill)
+	rl.lastRefill = now
+	if elapsed <= 0 || rl.maxDispatchPerSecond <= 0 {
+		return
+	}
+	rl.tokens = math.Min(rl.tokens+elapsed.Seconds()*rl.maxDispatchPerSecond, rl.burstLocked())
+}
+
+func (rl *rateLimiter) burstLocked() float64 {
+	return math.Max(1, rl.maxDispatchPerSecond)
+}
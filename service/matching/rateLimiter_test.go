@@ -0,0 +1,123 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/net/context"
+)
+
+type (
+	rateLimiterSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+
+	mockTimeSource struct {
+		now time.Time
+	}
+)
+
+func (ts *mockTimeSource) Now() time.Time {
+	return ts.now
+}
+
+func (ts *mockTimeSource) advance(d time.Duration) {
+	ts.now = ts.now.Add(d)
+}
+
+func TestRateLimiterSuite(t *testing.T) {
+	suite.Run(t, new(rateLimiterSuite))
+}
+
+func (s *rateLimiterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *rateLimiterSuite) TestNotThrottledByDefault() {
+	rl := newRateLimiter()
+	for i := 0; i < 100; i++ {
+		s.True(rl.Wait(context.Background(), 0))
+		rl.Consume()
+	}
+}
+
+func (s *rateLimiterSuite) TestThrottled() {
+	ts := &mockTimeSource{now: time.Now()}
+	rl := newRateLimiterWithTimeSource(ts)
+	rl.UpdateMaxDispatch(1)
+	s.True(rl.Wait(context.Background(), 10*time.Millisecond))
+	rl.Consume()
+	s.False(rl.Wait(context.Background(), 10*time.Millisecond))
+	ts.advance(time.Second)
+	s.True(rl.Wait(context.Background(), 10*time.Millisecond))
+}
+
+func (s *rateLimiterSuite) TestFractionalRate() {
+	ts := &mockTimeSource{now: time.Now()}
+	rl := newRateLimiterWithTimeSource(ts)
+	rl.UpdateMaxDispatch(0.5)
+	s.True(rl.Wait(context.Background(), 10*time.Millisecond))
+	rl.Consume()
+	ts.advance(1900 * time.Millisecond)
+	s.False(rl.Wait(context.Background(), 10*time.Millisecond))
+	ts.advance(100 * time.Millisecond)
+	s.True(rl.Wait(context.Background(), 10*time.Millisecond))
+	rl.Consume()
+
+	// a second at 1.9 tasks per second refills 1.9 tokens, so a tenth of a second after one of them is used there
+	// is another one, which there would not be at 1 task per second
+	rl.UpdateMaxDispatch(1.9)
+	ts.advance(time.Second)
+	s.True(rl.Wait(context.Background(), 10*time.Millisecond))
+	rl.Consume()
+	s.False(rl.Wait(context.Background(), 10*time.Millisecond))
+	ts.advance(100 * time.Millisecond)
+	s.True(rl.Wait(context.Background(), 10*time.Millisecond))
+}
+
+func (s *rateLimiterSuite) TestOnlyConsumedTasksCount() {
+	ts := &mockTimeSource{now: time.Now()}
+	rl := newRateLimiterWithTimeSource(ts)
+	rl.UpdateMaxDispatch(1)
+	for i := 0; i < 10; i++ {
+		s.True(rl.Wait(context.Background(), 10*time.Millisecond))
+	}
+	rl.Consume()
+	s.False(rl.Wait(context.Background(), 10*time.Millisecond))
+}
+
+func (s *rateLimiterSuite) TestContextDeadline() {
+	rl := newRateLimiter()
+	rl.UpdateMaxDispatch(1)
+	s.True(rl.Wait(context.Background(), time.Second))
+	rl.Consume()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	s.False(rl.Wait(ctx, time.Minute))
+	s.True(time.Since(start) < time.Second)
+}
@@ -24,36 +24,46 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 )
 
 // Config represents configuration for cadence-matching service
 type Config struct {
-	EnableSyncMatch bool
+	EnableSyncMatch dynamicconfig.BoolPropertyFnWithTaskListInfoFilters `yaml:"enableSyncMatch"`
 	// Time to hold a poll request before returning an empty response if there are no tasks
-	LongPollExpirationInterval time.Duration
+	LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskListInfoFilters `yaml:"longPollExpirationInterval" validate:"nonzero"`
 
 	// taskListManager configuration
-	RangeSize         int64
-	GetTasksBatchSize int
-	UpdateAckInterval time.Duration
+	RangeSize         int64                       `yaml:"rangeSize" validate:"min=1"`
+	GetTasksBatchSize dynamicconfig.IntPropertyFn `yaml:"getTasksBatchSize" validate:"min=1"`
+	UpdateAckInterval time.Duration               `yaml:"updateAckInterval" validate:"nonzero"`
 
 	// taskWriter configuration
-	OutstandingTaskAppendsThreshold int
-	MaxTaskBatchSize                int
+	OutstandingTaskAppendsThreshold dynamicconfig.IntPropertyFn `yaml:"outstandingTaskAppendsThreshold" validate:"min=1"`
+	MaxTaskBatchSize                dynamicconfig.IntPropertyFn `yaml:"maxTaskBatchSize" validate:"min=1"`
+
+	// Time a non-root task list partition can go without pollers before it forwards new tasks to the root partition
+	IdlePartitionTimeout dynamicconfig.DurationPropertyFn `yaml:"idlePartitionTimeout" validate:"min=0"`
+	// Time a query waits for a poller of the decision task list to pick it up before it fails
+	QueryHandoffTimeout dynamicconfig.DurationPropertyFn `yaml:"queryHandoffTimeout" validate:"nonzero"`
 }
 
 // NewConfig returns new service config with default values
-func NewConfig() *Config {
+func NewConfig(dc *dynamicconfig.Collection) *Config {
 	return &Config{
-		EnableSyncMatch:                 true,
-		LongPollExpirationInterval:      time.Minute,
-		RangeSize:                       100000,
-		GetTasksBatchSize:               1000,
-		UpdateAckInterval:               10 * time.Second,
-		OutstandingTaskAppendsThreshold: 250,
-		MaxTaskBatchSize:                100,
+		EnableSyncMatch: dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableSyncMatch, true),
+		LongPollExpirationInterval: dc.GetDurationPropertyFilteredByTaskListInfo(
+			dynamicconfig.MatchingLongPollExpirationInterval, time.Minute),
+		RangeSize:         100000,
+		GetTasksBatchSize: dc.GetIntProperty(dynamicconfig.MatchingGetTasksBatchSize, 1000),
+		UpdateAckInterval: 10 * time.Second,
+		OutstandingTaskAppendsThreshold: dc.GetIntProperty(
+			dynamicconfig.MatchingOutstandingTaskAppendsThreshold, 250),
+		MaxTaskBatchSize:     dc.GetIntProperty(dynamicconfig.MatchingMaxTaskBatchSize, 100),
+		IdlePartitionTimeout: dc.GetDurationProperty(dynamicconfig.MatchingIdlePartitionTimeout, 10*time.Second),
+		QueryHandoffTimeout:  dc.GetDurationProperty(dynamicconfig.MatchingQueryHandoffTimeout, 5*time.Second),
 	}
 }
 
@@ -83,13 +93,23 @@ func (s *Service) Start() {
 
 	base := service.New(p)
 
-	taskPersistence, err := persistence.NewCassandraTaskPersistence(p.CassandraConfig.Hosts,
-		p.CassandraConfig.Port,
-		p.CassandraConfig.User,
-		p.CassandraConfig.Password,
-		p.CassandraConfig.Datacenter,
-		p.CassandraConfig.Keyspace,
-		base.GetLogger())
+	var taskPersistence persistence.TaskManager
+	var err error
+	if p.PersistenceConfig.IsInMemory() {
+		taskPersistence, err = persistence.NewInMemoryTaskPersistence(p.InMemoryStore, base.GetLogger())
+	} else if p.PersistenceConfig.IsSQL() {
+		taskPersistence, err = persistence.NewSQLTaskPersistence(p.PersistenceConfig.SQL.DriverName,
+			p.PersistenceConfig.SQL.DataSourceName,
+			base.GetLogger())
+	} else {
+		taskPersistence, err = persistence.NewCassandraTaskPersistence(p.CassandraConfig.Hosts,
+			p.CassandraConfig.Port,
+			p.CassandraConfig.User,
+			p.CassandraConfig.Password,
+			p.CassandraConfig.Datacenter,
+			p.CassandraConfig.Keyspace,
+			base.GetLogger())
+	}
 
 	if err != nil {
 		log.Fatalf("failed to create task persistence: %v", err)
@@ -28,6 +28,7 @@ import (
 
 	"github.com/uber-common/bark"
 	h "github.com/uber/cadence/.gen/go/history"
+	m "github.com/uber/cadence/.gen/go/matching"
 	s "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
@@ -39,26 +40,35 @@ import (
 
 const (
 	done time.Duration = -1
+	// How often a non-root partition checks whether it became idle, and backs off after failing to forward a task
+	idlePartitionCheckInterval = time.Second
 )
 
 type taskListManager interface {
 	Start() error
 	Stop()
 	AddTask(execution *s.WorkflowExecution, taskInfo *persistence.TaskInfo) error
-	GetTaskContext(ctx context.Context) (*taskContext, error)
+	GetTaskContext(ctx context.Context, maxDispatchPerSecond *float64) (*taskContext, error)
+	DispatchQueryTask(ctx context.Context, queryTask *queryTaskInfo) error
+	HasPollers() bool
+	UpdatePollerInfo(identity string)
+	DescribeTaskList() *s.DescribeTaskListResponse
 	String() string
 }
 
 func newTaskListManager(e *matchingEngineImpl, taskList *taskListID, config *Config) taskListManager {
+	// Partitions of a task list share the settings of the task list, which are looked up by the root partition name
+	rootTaskListName, _ := common.RootTaskListName(taskList.taskListName)
 	// To perform one db operation if there are no pollers
-	taskBufferSize := config.GetTasksBatchSize - 1
+	taskBufferSize := config.GetTasksBatchSize() - 1
 
 	tlMgr := &taskListManagerImpl{
-		engine:     e,
-		taskBuffer: make(chan *persistence.TaskInfo, taskBufferSize),
-		notifyCh:   make(chan struct{}, 1),
-		shutdownCh: make(chan struct{}),
-		taskListID: taskList,
+		engine:           e,
+		taskBuffer:       make(chan *persistence.TaskInfo, taskBufferSize),
+		notifyCh:         make(chan struct{}, 1),
+		shutdownCh:       make(chan struct{}),
+		taskListID:       taskList,
+		rootTaskListName: rootTaskListName,
 		logger: e.logger.WithFields(bark.Fields{
 			logging.TagTaskListType: taskList.taskType,
 			logging.TagTaskListName: taskList.taskListName,
@@ -67,6 +77,9 @@ func newTaskListManager(e *matchingEngineImpl, taskList *taskListID, config *Con
 		taskAckManager: newAckManager(e.logger),
 		syncMatch:      make(chan *getTaskResult),
 		config:         config,
+		lastPollTime:   time.Now().UnixNano(),
+		pollerHistory:  newPollerHistory(),
+		rateLimiter:    newRateLimiter(),
 	}
 	tlMgr.taskWriter = newTaskWriter(tlMgr)
 	tlMgr.startWG.Add(1)
@@ -79,15 +92,23 @@ type taskContext struct {
 	info              *persistence.TaskInfo
 	syncResponseCh    chan<- *syncMatchResponse
 	workflowExecution s.WorkflowExecution
+	queryTaskInfo     *queryTaskInfo // set only for query tasks
+}
+
+// queryTaskInfo contains the query request dispatched to a decision task poller
+type queryTaskInfo struct {
+	taskID       string
+	queryRequest *m.QueryWorkflowRequest
 }
 
 // Single task list in memory state
 type taskListManagerImpl struct {
-	taskListID    *taskListID
-	logger        bark.Logger
-	metricsClient metrics.Client
-	engine        *matchingEngineImpl
-	config        *Config
+	taskListID       *taskListID
+	rootTaskListName string
+	logger           bark.Logger
+	metricsClient    metrics.Client
+	engine           *matchingEngineImpl
+	config           *Config
 	// serializes all writes to persistence
 	// This is needed because of a known Cassandra issue where concurrent LWT to the same partition
 	// cause timeout errors.
@@ -102,6 +123,12 @@ type taskListManagerImpl struct {
 	shutdownCh chan struct{}  // Delivers stop to the pump that populates taskBuffer
 	startWG    sync.WaitGroup // ensures that background processes do not start until setup is ready
 	stopped    int32
+	// number of polls currently waiting on the task list and the time the last poll returned, in nanoseconds
+	outstandingPolls int32
+	lastPollTime     int64
+	pollerHistory    *pollerHistory
+	// throttles the dispatch of tasks to the rate requested by the pollers
+	rateLimiter *rateLimiter
 
 	sync.Mutex
 	taskAckManager          ackManager // tracks ackLevel for delivered messages
@@ -113,8 +140,9 @@ type taskListManagerImpl struct {
 // getTaskResult contains task info and optional channel to notify createTask caller
 // that task is successfully started and returned to a poller
 type getTaskResult struct {
-	task *persistence.TaskInfo
-	C    chan *syncMatchResponse
+	task      *persistence.TaskInfo
+	C         chan *syncMatchResponse
+	queryTask *queryTaskInfo
 }
 
 // syncMatchResponse result of sync match delivered to a createTask caller
@@ -131,6 +159,9 @@ func (c *taskListManagerImpl) Start() error {
 	c.taskWriter.Start()
 	c.signalNewTask()
 	go c.getTasksPump()
+	if c.rootTaskListName != c.taskListID.taskListName {
+		go c.forwardTasksPump()
+	}
 	err := c.updateRangeIfNeeded() // Grabs a new range and updates read and ackLevels
 	if err != nil {
 		c.Stop()
@@ -167,8 +198,72 @@ func (c *taskListManagerImpl) AddTask(execution *s.WorkflowExecution, taskInfo *
 	return err
 }
 
-// Loads a task from DB or from sync match and wraps it in a task context
-func (c *taskListManagerImpl) GetTaskContext(ctx context.Context) (*taskContext, error) {
+// DispatchQueryTask hands the query task to a waiting poller.  Query tasks are never persisted, so the call blocks
+// until a poller picks the task up or the context expires.
+func (c *taskListManagerImpl) DispatchQueryTask(ctx context.Context, queryTask *queryTaskInfo) error {
+	c.startWG.Wait()
+	execution := queryTask.queryRequest.QueryRequest.Execution
+	request := &getTaskResult{
+		task: &persistence.TaskInfo{
+			DomainID:   *queryTask.queryRequest.DomainUUID,
+			WorkflowID: *execution.WorkflowId,
+			RunID:      *execution.RunId,
+		},
+		queryTask: queryTask,
+	}
+
+	select {
+	case c.syncMatch <- request:
+		return nil
+	case <-c.shutdownCh:
+		return errPumpClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// HasPollers returns true if the task list has a poll waiting on it, or was polled within the idle partition timeout
+func (c *taskListManagerImpl) HasPollers() bool {
+	if atomic.LoadInt32(&c.outstandingPolls) > 0 {
+		return true
+	}
+	lastPollTime := time.Unix(0, atomic.LoadInt64(&c.lastPollTime))
+	return time.Since(lastPollTime) < c.config.IdlePartitionTimeout()
+}
+
+// UpdatePollerInfo records a poll of the task list by the poller with the given identity
+func (c *taskListManagerImpl) UpdatePollerInfo(identity string) {
+	c.pollerHistory.updatePollerInfo(identity)
+}
+
+// DescribeTaskList returns the recent pollers of the task list and the state of its backlog.  The backlog count is
+// approximate, as task ids handed out by a range are not necessarily all used by persisted tasks.
+func (c *taskListManagerImpl) DescribeTaskList() *s.DescribeTaskListResponse {
+	c.Lock()
+	ackLevel := c.taskAckManager.getAckLevel()
+	readLevel := c.taskAckManager.getReadLevel()
+	c.Unlock()
+	backlogCountHint := c.taskWriter.GetMaxReadLevel() - ackLevel
+	if backlogCountHint < 0 {
+		backlogCountHint = 0
+	}
+
+	return &s.DescribeTaskListResponse{
+		Pollers: c.pollerHistory.getPollerInfo(),
+		TaskListStatus: &s.TaskListStatus{
+			BacklogCountHint: common.Int64Ptr(backlogCountHint),
+			ReadLevel:        common.Int64Ptr(readLevel),
+			AckLevel:         common.Int64Ptr(ackLevel),
+		},
+	}
+}
+
+// Loads a task from DB or from sync match and wraps it in a task context.  If maxDispatchPerSecond is set, the rate at
+// which tasks are handed out to all pollers of the task list is updated to it.
+func (c *taskListManagerImpl) GetTaskContext(ctx context.Context, maxDispatchPerSecond *float64) (*taskContext, error) {
+	if maxDispatchPerSecond != nil {
+		c.rateLimiter.UpdateMaxDispatch(*maxDispatchPerSecond)
+	}
 	result, err := c.getTask(ctx)
 	if err != nil {
 		return nil, err
@@ -183,6 +278,7 @@ func (c *taskListManagerImpl) GetTaskContext(ctx context.Context) (*taskContext,
 		workflowExecution: workflowExecution,
 		tlMgr:             c,
 		syncResponseCh:    result.C, // nil if task is loaded from persistence
+		queryTaskInfo:     result.queryTask,
 	}
 	return tCtx, nil
 }
@@ -257,17 +353,35 @@ func (c *taskListManagerImpl) completeTaskPoll(taskID int64) (ackLevel int64) {
 // Loads task from taskBuffer (which is populated from persistence) or from sync match to add task call
 func (c *taskListManagerImpl) getTask(ctx context.Context) (*getTaskResult, error) {
 	scope := metrics.MatchingTaskListMgrScope
-	timer := time.NewTimer(c.config.LongPollExpirationInterval)
+	longPollExpirationInterval := c.config.LongPollExpirationInterval(c.rootTaskListName, c.taskListID.taskType)
+	timer := time.NewTimer(longPollExpirationInterval)
 	defer timer.Stop()
+	atomic.AddInt32(&c.outstandingPolls, 1)
+	defer func() {
+		atomic.AddInt32(&c.outstandingPolls, -1)
+		atomic.StoreInt64(&c.lastPollTime, time.Now().UnixNano())
+	}()
+
+	// A poll only waits for a task once it is allowed to dispatch one, so tasks are handed out at no more than the
+	// dispatch rate whether they come from the backlog or from a sync match.  Only the tasks that are actually handed
+	// out count against the rate, which query tasks do not.
+	if !c.rateLimiter.Wait(ctx, longPollExpirationInterval) {
+		c.metricsClient.IncCounter(scope, metrics.PollThrottledCounter)
+		return nil, ErrNoTasks
+	}
 	select {
 	case task, ok := <-c.taskBuffer:
 		if !ok { // Task list getTasks pump is shutdown
 			c.metricsClient.IncCounter(scope, metrics.PollErrorsCounter)
 			return nil, errPumpClosed
 		}
+		c.rateLimiter.Consume()
 		c.metricsClient.IncCounter(scope, metrics.PollSuccessCounter)
 		return &getTaskResult{task: task}, nil
 	case resultFromSyncMatch := <-c.syncMatch:
+		if resultFromSyncMatch.queryTask == nil {
+			c.rateLimiter.Consume()
+		}
 		c.metricsClient.IncCounter(scope, metrics.PollSuccessCounter)
 		c.metricsClient.IncCounter(scope, metrics.PollSuccessWithSyncCounter)
 		return resultFromSyncMatch, nil
@@ -292,7 +406,7 @@ func (c *taskListManagerImpl) getTaskBatch() ([]*persistence.TaskInfo, error) {
 			DomainID:     c.taskListID.domainID,
 			TaskList:     c.taskListID.taskListName,
 			TaskType:     c.taskListID.taskType,
-			BatchSize:    c.config.GetTasksBatchSize,
+			BatchSize:    c.config.GetTasksBatchSize(),
 			RangeID:      rangeID,
 			ReadLevel:    c.taskAckManager.getReadLevel(),
 			MaxReadLevel: c.taskWriter.GetMaxReadLevel(),
@@ -373,7 +487,7 @@ func (c *taskListManagerImpl) String() string {
 // and sent to a poller. So it not necessary to persist it.
 // Returns (nil, nil) if there is no waiting poller which indicates that task has to be persisted.
 func (c *taskListManagerImpl) trySyncMatch(task *persistence.TaskInfo) (*persistence.CreateTasksResponse, error) {
-	if !c.config.EnableSyncMatch {
+	if !c.config.EnableSyncMatch(c.rootTaskListName, c.taskListID.taskType) {
 		return nil, nil
 	}
 	// Request from the point of view of Add(Activity|Decision)Task operation.
@@ -451,6 +565,84 @@ getTasksPumpLoop:
 	updateAckTimer.Stop()
 }
 
+// Drains the backlog of a non-root partition to the root partition while the partition has no pollers.  New tasks are
+// forwarded when they are added, but tasks persisted while the partition was still polled would otherwise be stranded
+// in it once its pollers go away.
+func (c *taskListManagerImpl) forwardTasksPump() {
+	c.startWG.Wait()
+
+	checkTimer := time.NewTimer(idlePartitionCheckInterval)
+	defer checkTimer.Stop()
+
+	for {
+		var taskBuffer <-chan *persistence.TaskInfo // nil while the partition is polled, which blocks forever
+		if !c.HasPollers() {
+			taskBuffer = c.taskBuffer
+		}
+		select {
+		case task, ok := <-taskBuffer:
+			if !ok { // Task list getTasks pump is shutdown
+				return
+			}
+			if err := c.forwardTask(task); err != nil {
+				c.logger.Warnf("Failed to forward task to root partition %v: %v", c.rootTaskListName, err)
+				select {
+				case <-time.After(idlePartitionCheckInterval):
+				case <-c.shutdownCh:
+					return
+				}
+			}
+		case <-checkTimer.C:
+			checkTimer.Reset(idlePartitionCheckInterval)
+		case <-c.shutdownCh:
+			return
+		}
+	}
+}
+
+// Adds a task loaded from the backlog of this partition to the root partition and completes it.  A task that failed
+// to be forwarded is written back to the backlog.
+func (c *taskListManagerImpl) forwardTask(task *persistence.TaskInfo) error {
+	execution := s.WorkflowExecution{
+		WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId:      common.StringPtr(task.RunID),
+	}
+	taskList := &s.TaskList{Name: common.StringPtr(c.rootTaskListName)}
+	forwardedFrom := common.StringPtr(c.taskListID.taskListName)
+
+	var err error
+	if c.taskListID.taskType == persistence.TaskListTypeDecision {
+		err = c.engine.matchingClient.AddDecisionTask(nil, &m.AddDecisionTaskRequest{
+			DomainUUID:    common.StringPtr(task.DomainID),
+			Execution:     &execution,
+			TaskList:      taskList,
+			ScheduleId:    common.Int64Ptr(task.ScheduleID),
+			ForwardedFrom: forwardedFrom,
+		})
+	} else {
+		err = c.engine.matchingClient.AddActivityTask(nil, &m.AddActivityTaskRequest{
+			DomainUUID:                    common.StringPtr(c.taskListID.domainID),
+			SourceDomainUUID:              common.StringPtr(task.DomainID),
+			Execution:                     &execution,
+			TaskList:                      taskList,
+			ScheduleId:                    common.Int64Ptr(task.ScheduleID),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(task.ScheduleToStartTimeout),
+			ForwardedFrom:                 forwardedFrom,
+		})
+	}
+	if err == nil {
+		c.metricsClient.IncCounter(metrics.MatchingTaskListMgrScope, metrics.ForwardedTaskCounter)
+	}
+
+	tCtx := &taskContext{
+		info:              task,
+		workflowExecution: execution,
+		tlMgr:             c,
+	}
+	tCtx.completeTask(err)
+	return err
+}
+
 // Retry operation on transient error and on rangeID change. On rangeID update by another process calls c.Stop().
 func (c *taskListManagerImpl) executeWithRetry(
 	operation func(rangeID int64) (interface{}, error)) (result interface{}, err error) {
@@ -0,0 +1,384 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import "github.com/urfave/cli"
+
+const (
+	// Version is the current version of the cadence cli
+	Version = "0.0.1"
+
+	defaultFrontendAddress       = "127.0.0.1:7933"
+	defaultContextTimeoutSeconds = 5
+)
+
+// NewCliApp builds the cadence command line tool
+func NewCliApp() *cli.App {
+	app := cli.NewApp()
+	app.Name = "cadence"
+	app.Usage = "A command-line tool for cadence users"
+	app.Version = Version
+
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:   FlagAddressWithAlias,
+			Value:  defaultFrontendAddress,
+			Usage:  "host:port for cadence frontend service",
+			EnvVar: "CADENCE_CLI_ADDRESS",
+		},
+		cli.StringFlag{
+			Name:   FlagDomainWithAlias,
+			Usage:  "cadence workflow domain",
+			EnvVar: "CADENCE_CLI_DOMAIN",
+		},
+		cli.IntFlag{
+			Name:  FlagContextTimeoutWithAlias,
+			Value: defaultContextTimeoutSeconds,
+			Usage: "timeout in seconds for each call to the frontend service",
+		},
+	}
+
+	app.Commands = []cli.Command{
+		{
+			Name:        "domain",
+			Aliases:     []string{"d"},
+			Usage:       "Operate cadence domain",
+			Subcommands: newDomainCommands(),
+		},
+		{
+			Name:        "workflow",
+			Aliases:     []string{"wf"},
+			Usage:       "Operate cadence workflow",
+			Subcommands: newWorkflowCommands(),
+		},
+	}
+
+	return app
+}
+
+func newDomainCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "register",
+			Aliases: []string{"re"},
+			Usage:   "Register workflow domain",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDescriptionWithAlias,
+					Usage: "Domain description",
+				},
+				cli.StringFlag{
+					Name:  FlagOwnerEmailWithAlias,
+					Usage: "Owner email",
+				},
+				cli.IntFlag{
+					Name:  FlagRetentionDaysWithAlias,
+					Value: defaultDomainRetentionDays,
+					Usage: "Workflow execution retention in days",
+				},
+				cli.BoolFlag{
+					Name:  FlagEmitMetricWithAlias,
+					Usage: "Emit metrics for workflows of the domain",
+				},
+			},
+			Action: func(c *cli.Context) {
+				RegisterDomain(c)
+			},
+		},
+		{
+			Name:    "update",
+			Aliases: []string{"up", "u"},
+			Usage:   "Update existing workflow domain",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDescriptionWithAlias,
+					Usage: "Domain description",
+				},
+				cli.StringFlag{
+					Name:  FlagOwnerEmailWithAlias,
+					Usage: "Owner email",
+				},
+				cli.IntFlag{
+					Name:  FlagRetentionDaysWithAlias,
+					Usage: "Workflow execution retention in days",
+				},
+				cli.BoolFlag{
+					Name:  FlagEmitMetricWithAlias,
+					Usage: "Emit metrics for workflows of the domain",
+				},
+				cli.IntFlag{
+					Name:  FlagStartWorkflowRPS,
+					Usage: "Rate limit for starting workflows in the domain, 0 means unlimited",
+				},
+				cli.IntFlag{
+					Name:  FlagSignalWorkflowRPS,
+					Usage: "Rate limit for signaling workflows in the domain, 0 means unlimited",
+				},
+				cli.IntFlag{
+					Name:  FlagPollRPS,
+					Usage: "Rate limit for polling task lists of the domain, 0 means unlimited",
+				},
+				cli.IntFlag{
+					Name:  FlagHistoryReadRPS,
+					Usage: "Rate limit for reading workflow histories of the domain, 0 means unlimited",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UpdateDomain(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe existing workflow domain",
+			Action: func(c *cli.Context) {
+				DescribeDomain(c)
+			},
+		},
+		{
+			Name:    "deprecate",
+			Aliases: []string{"dep"},
+			Usage:   "Deprecate existing workflow domain",
+			Action: func(c *cli.Context) {
+				DeprecateDomain(c)
+			},
+		},
+	}
+}
+
+func newWorkflowCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "start",
+			Usage: "Start a new workflow execution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID, a random uuid is used if not provided",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowTypeWithAlias,
+					Usage: "WorkflowTypeName",
+				},
+				cli.IntFlag{
+					Name:  FlagExecutionTimeoutWithAlias,
+					Usage: "Execution start to close timeout in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagDecisionTimeoutWithAlias,
+					Value: defaultDecisionTimeoutInSeconds,
+					Usage: "Decision task start to close timeout in seconds",
+				},
+				cli.StringFlag{
+					Name:  FlagInputWithAlias,
+					Usage: "Optional input for the workflow, in JSON format",
+				},
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "Optional file containing the JSON input for the workflow, ignored if input is set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				StartWorkflow(c)
+			},
+		},
+		{
+			Name:    "signal",
+			Aliases: []string{"s"},
+			Usage:   "Signal a workflow execution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, the current run of the workflow is signaled if not provided",
+				},
+				cli.StringFlag{
+					Name:  FlagNameWithAlias,
+					Usage: "SignalName",
+				},
+				cli.StringFlag{
+					Name:  FlagInputWithAlias,
+					Usage: "Optional input for the signal, in JSON format",
+				},
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "Optional file containing the JSON input for the signal, ignored if input is set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				SignalWorkflow(c)
+			},
+		},
+		{
+			Name:    "terminate",
+			Aliases: []string{"term"},
+			Usage:   "Terminate a workflow execution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, the current run of the workflow is terminated if not provided",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to terminate the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				TerminateWorkflow(c)
+			},
+		},
+		{
+			Name:  "reset",
+			Usage: "Reset a workflow execution by terminating it and starting a new run from an earlier decision",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.IntFlag{
+					Name:  FlagEventIDWithAlias,
+					Usage: "The ID of the DecisionTaskCompleted, DecisionTaskFailed or DecisionTaskTimedOut event to reset to",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to reset the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ResetWorkflow(c)
+			},
+		},
+		{
+			Name:  "cancel",
+			Usage: "Request cancellation of a workflow execution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, cancellation of the current run of the workflow is requested if not provided",
+				},
+			},
+			Action: func(c *cli.Context) {
+				CancelWorkflow(c)
+			},
+		},
+		{
+			Name:  "show",
+			Usage: "Show the history of a workflow execution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, the history of the current run of the workflow is shown if not provided",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintRawTimeWithAlias,
+					Usage: "Print raw timestamps of the events instead of formatted times",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ShowHistory(c)
+			},
+		},
+		{
+			Name:    "observe",
+			Aliases: []string{"ob"},
+			Usage:   "Show the history of a workflow execution and keep following it until the workflow closes",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, the current run of the workflow is observed if not provided",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintRawTimeWithAlias,
+					Usage: "Print raw timestamps of the events instead of formatted times",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ObserveHistory(c)
+			},
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List open or closed workflow executions",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  FlagOpenWithAlias,
+					Usage: "List open workflow executions, closed workflow executions are listed by default",
+				},
+				cli.StringFlag{
+					Name:  FlagEarliestTimeWithAlias,
+					Usage: "Earliest start time to list, in RFC3339 format",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTimeWithAlias,
+					Usage: "Latest start time to list, in RFC3339 format, defaults to now",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "Only list executions with the given WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowTypeWithAlias,
+					Usage: "Only list executions of the given WorkflowTypeName",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: defaultPageSizeForList,
+					Usage: "Number of executions fetched from the frontend per call",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintRawTimeWithAlias,
+					Usage: "Print raw timestamps instead of formatted times",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ListWorkflow(c)
+			},
+		},
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	"github.com/uber/cadence/.gen/go/cadence/workflowservicetest"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+type (
+	cliAppSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		app           *cli.App
+		mockCtrl      *gomock.Controller
+		serviceClient *workflowservicetest.MockClient
+	}
+
+	clientFactoryMock struct {
+		serviceClient workflowserviceclient.Interface
+	}
+)
+
+func (m *clientFactoryMock) FrontendClient(c *cli.Context) workflowserviceclient.Interface {
+	return m.serviceClient
+}
+
+func TestCliAppSuite(t *testing.T) {
+	suite.Run(t, new(cliAppSuite))
+}
+
+func (s *cliAppSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+	s.app = NewCliApp()
+	s.mockCtrl = gomock.NewController(s.T())
+	s.serviceClient = workflowservicetest.NewMockClient(s.mockCtrl)
+	SetFactory(&clientFactoryMock{serviceClient: s.serviceClient})
+}
+
+func (s *cliAppSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *cliAppSuite) TestDomainRegister() {
+	s.serviceClient.EXPECT().RegisterDomain(gomock.Any(), &shared.RegisterDomainRequest{
+		Name:                                   common.StringPtr("test-domain"),
+		Description:                            common.StringPtr("test description"),
+		OwnerEmail:                             common.StringPtr("owner@example.com"),
+		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(7),
+		EmitMetric:                             common.BoolPtr(true),
+	}).Return(nil)
+
+	err := s.app.Run([]string{"", "--do", "test-domain", "domain", "register", "--desc", "test description",
+		"--oe", "owner@example.com", "--rd", "7", "--em"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainUpdate_OnlySetOptions() {
+	s.serviceClient.EXPECT().UpdateDomain(gomock.Any(), &shared.UpdateDomainRequest{
+		Name:        common.StringPtr("test-domain"),
+		UpdatedInfo: &shared.UpdateDomainInfo{OwnerEmail: common.StringPtr("new-owner@example.com")},
+		Configuration: &shared.DomainConfiguration{
+			WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(10),
+			PollRPS:                                common.Int32Ptr(50),
+		},
+	}).Return(&shared.UpdateDomainResponse{}, nil)
+
+	err := s.app.Run([]string{"", "--do", "test-domain", "domain", "update", "--oe", "new-owner@example.com",
+		"--rd", "10", "--poll_rps", "50"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainDescribe() {
+	status := shared.DomainStatusRegistered
+	s.serviceClient.EXPECT().DescribeDomain(gomock.Any(), &shared.DescribeDomainRequest{
+		Name: common.StringPtr("test-domain"),
+	}).Return(&shared.DescribeDomainResponse{
+		DomainInfo: &shared.DomainInfo{
+			Name:   common.StringPtr("test-domain"),
+			Status: &status,
+		},
+		Configuration: &shared.DomainConfiguration{
+			WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(3),
+		},
+	}, nil)
+
+	err := s.app.Run([]string{"", "--do", "test-domain", "domain", "describe"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainDeprecate() {
+	s.serviceClient.EXPECT().DeprecateDomain(gomock.Any(), &shared.DeprecateDomainRequest{
+		Name: common.StringPtr("test-domain"),
+	}).Return(nil)
+
+	err := s.app.Run([]string{"", "--do", "test-domain", "domain", "deprecate"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestStartWorkflow() {
+	s.serviceClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Do(
+		func(_ interface{}, request *shared.StartWorkflowExecutionRequest) {
+			s.Equal("test-domain", *request.Domain)
+			s.Equal("wid", *request.WorkflowId)
+			s.Equal("test-type", *request.WorkflowType.Name)
+			s.Equal("test-tasklist", *request.TaskList.Name)
+			s.Equal(`{"key": "value"}`, string(request.Input))
+			s.Equal(int32(60), *request.ExecutionStartToCloseTimeoutSeconds)
+			s.Equal(int32(defaultDecisionTimeoutInSeconds), *request.TaskStartToCloseTimeoutSeconds)
+			s.NotEmpty(*request.RequestId)
+		}).Return(&shared.StartWorkflowExecutionResponse{RunId: common.StringPtr("rid")}, nil)
+
+	err := s.app.Run([]string{"", "--do", "test-domain", "workflow", "start", "--tl", "test-tasklist",
+		"--wt", "test-type", "--wid", "wid", "--et", "60", "-i", `{"key": "value"}`})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestSignalWorkflow() {
+	s.serviceClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Do(
+		func(_ interface{}, request *shared.SignalWorkflowExecutionRequest) {
+			s.Equal("test-domain", *request.Domain)
+			s.Equal("wid", *request.WorkflowExecution.WorkflowId)
+			s.Nil(request.WorkflowExecution.RunId)
+			s.Equal("test-signal", *request.SignalName)
+			s.Equal(`"input"`, string(request.Input))
+		}).Return(nil)
+
+	err := s.app.Run([]string{"", "--do", "test-domain", "workflow", "signal", "-w", "wid", "-n", "test-signal",
+		"-i", `"input"`})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestTerminateWorkflow() {
+	s.serviceClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Do(
+		func(_ interface{}, request *shared.TerminateWorkflowExecutionRequest) {
+			s.Equal("wid", *request.WorkflowExecution.WorkflowId)
+			s.Equal("rid", *request.WorkflowExecution.RunId)
+			s.Equal("test-reason", *request.Reason)
+		}).Return(nil)
+
+	err := s.app.Run([]string{"", "--do", "test-domain", "workflow", "terminate", "-w", "wid", "-r", "rid",
+		"--reason", "test-reason"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestResetWorkflow() {
+	s.serviceClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any()).Do(
+		func(_ interface{}, request *shared.ResetWorkflowExecutionRequest) {
+			s.Equal("wid", *request.WorkflowExecution.WorkflowId)
+			s.Equal("rid", *request.WorkflowExecution.RunId)
+			s.Equal(int64(4), *request.DecisionFinishEventId)
+			s.Equal("test-reason", *request.Reason)
+		}).Return(&shared.ResetWorkflowExecutionResponse{RunId: common.StringPtr("new-rid")}, nil)
+
+	err := s.app.Run([]string{"", "--do", "test-domain", "workflow", "reset", "-w", "wid", "-r", "rid",
+		"--eid", "4", "--reason", "test-reason"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestCancelWorkflow() {
+	s.serviceClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any()).Do(
+		func(_ interface{}, request *shared.RequestCancelWorkflowExecutionRequest) {
+			s.Equal("wid", *request.WorkflowExecution.WorkflowId)
+			s.Equal("rid", *request.WorkflowExecution.RunId)
+		}).Return(nil)
+
+	err := s.app.Run([]string{"", "--do", "test-domain", "workflow", "cancel", "-w", "wid", "-r", "rid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestShowHistory() {
+	gomock.InOrder(
+		s.serviceClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(
+			&shared.GetWorkflowExecutionHistoryResponse{
+				History:       &shared.History{Events: []*shared.HistoryEvent{newStartedEvent(1)}},
+				NextPageToken: []byte("token"),
+			}, nil),
+		s.serviceClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Do(
+			func(_ interface{}, request *shared.GetWorkflowExecutionHistoryRequest) {
+				s.Equal([]byte("token"), request.NextPageToken)
+			}).Return(&shared.GetWorkflowExecutionHistoryResponse{
+			History: &shared.History{Events: []*shared.HistoryEvent{newCompletedEvent(2)}},
+		}, nil),
+	)
+
+	err := s.app.Run([]string{"", "--do", "test-domain", "workflow", "show", "-w", "wid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestObserveHistory() {
+	gomock.InOrder(
+		s.serviceClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Do(
+			func(_ interface{}, request *shared.GetWorkflowExecutionHistoryRequest) {
+				s.True(*request.WaitForNewEvent)
+				s.Nil(request.NextPageToken)
+			}).Return(&shared.GetWorkflowExecutionHistoryResponse{
+			History:       &shared.History{Events: []*shared.HistoryEvent{newStartedEvent(1)}},
+			NextPageToken: []byte("token"),
+		}, nil),
+		s.serviceClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Do(
+			func(_ interface{}, request *shared.GetWorkflowExecutionHistoryRequest) {
+				s.True(*request.WaitForNewEvent)
+				s.Equal([]byte("token"), request.NextPageToken)
+			}).Return(&shared.GetWorkflowExecutionHistoryResponse{
+			History: &shared.History{Events: []*shared.HistoryEvent{newCompletedEvent(2)}},
+		}, nil),
+	)
+
+	err := s.app.Run([]string{"", "--do", "test-domain", "workflow", "observe", "-w", "wid", "-r", "rid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestListWorkflow() {
+	closeStatus := shared.WorkflowExecutionCloseStatusCompleted
+	s.serviceClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Do(
+		func(_ interface{}, request *shared.ListClosedWorkflowExecutionsRequest) {
+			s.Equal("test-domain", *request.Domain)
+			s.Equal("test-type", *request.TypeFilter.Name)
+			s.Nil(request.ExecutionFilter)
+			s.Equal(int64(0), *request.StartTimeFilter.EarliestTime)
+		}).Return(&shared.ListClosedWorkflowExecutionsResponse{
+		Executions: []*shared.WorkflowExecutionInfo{
+			{
+				Execution: &shared.WorkflowExecution{
+					WorkflowId: common.StringPtr("wid"),
+					RunId:      common.StringPtr("rid"),
+				},
+				Type:        &shared.WorkflowType{Name: common.StringPtr("test-type")},
+				StartTime:   common.Int64Ptr(1),
+				CloseTime:   common.Int64Ptr(2),
+				CloseStatus: &closeStatus,
+			},
+		},
+	}, nil)
+
+	err := s.app.Run([]string{"", "--do", "test-domain", "workflow", "list", "--wt", "test-type"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestListOpenWorkflow() {
+	s.serviceClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Do(
+		func(_ interface{}, request *shared.ListOpenWorkflowExecutionsRequest) {
+			s.Equal("wid", *request.ExecutionFilter.WorkflowId)
+			s.Nil(request.TypeFilter)
+		}).Return(&shared.ListOpenWorkflowExecutionsResponse{}, nil)
+
+	err := s.app.Run([]string{"", "--do", "test-domain", "workflow", "list", "--open", "-w", "wid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestListWorkflowByWorkflowIDAndType() {
+	s.serviceClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Do(
+		func(_ interface{}, request *shared.ListClosedWorkflowExecutionsRequest) {
+			s.Equal("wid", *request.ExecutionFilter.WorkflowId)
+			s.Equal("test-type", *request.TypeFilter.Name)
+		}).Return(&shared.ListClosedWorkflowExecutionsResponse{}, nil)
+
+	err := s.app.Run([]string{"", "--do", "test-domain", "workflow", "list", "-w", "wid", "--wt", "test-type"})
+	s.Nil(err)
+}
+
+func newStartedEvent(eventID int64) *shared.HistoryEvent {
+	eventType := shared.EventTypeWorkflowExecutionStarted
+	return &shared.HistoryEvent{
+		EventId:   common.Int64Ptr(eventID),
+		Timestamp: common.Int64Ptr(0),
+		EventType: &eventType,
+		WorkflowExecutionStartedEventAttributes: &shared.WorkflowExecutionStartedEventAttributes{
+			WorkflowType: &shared.WorkflowType{Name: common.StringPtr("test-type")},
+			TaskList:     &shared.TaskList{Name: common.StringPtr("test-tasklist")},
+			Input:        []byte(`{"key": "value"}`),
+		},
+	}
+}
+
+func newCompletedEvent(eventID int64) *shared.HistoryEvent {
+	eventType := shared.EventTypeWorkflowExecutionCompleted
+	return &shared.HistoryEvent{
+		EventId:   common.Int64Ptr(eventID),
+		Timestamp: common.Int64Ptr(0),
+		EventType: &eventType,
+		WorkflowExecutionCompletedEventAttributes: &shared.WorkflowExecutionCompletedEventAttributes{
+			Result: []byte("result"),
+		},
+	}
+}
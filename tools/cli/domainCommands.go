@@ -0,0 +1,164 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+const (
+	defaultDomainRetentionDays = 3
+)
+
+// RegisterDomain registers a new workflow domain
+func RegisterDomain(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	serviceClient := cFactory.FrontendClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := serviceClient.RegisterDomain(ctx, &s.RegisterDomainRequest{
+		Name:                                   common.StringPtr(domain),
+		Description:                            common.StringPtr(c.String(FlagDescription)),
+		OwnerEmail:                             common.StringPtr(c.String(FlagOwnerEmail)),
+		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(int32(c.Int(FlagRetentionDays))),
+		EmitMetric:                             common.BoolPtr(c.Bool(FlagEmitMetric)),
+	})
+	if err != nil {
+		if _, ok := err.(*s.DomainAlreadyExistsError); ok {
+			ErrorAndExit(fmt.Sprintf("Domain %s already registered.", domain), err)
+		}
+		ErrorAndExit("Failed to register domain.", err)
+	}
+
+	fmt.Printf("Domain %s successfully registered.\n", domain)
+}
+
+// UpdateDomain updates the settings given on the command line for an existing workflow domain
+func UpdateDomain(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	updatedInfo := &s.UpdateDomainInfo{}
+	if c.IsSet(FlagDescription) {
+		updatedInfo.Description = common.StringPtr(c.String(FlagDescription))
+	}
+	if c.IsSet(FlagOwnerEmail) {
+		updatedInfo.OwnerEmail = common.StringPtr(c.String(FlagOwnerEmail))
+	}
+
+	configuration := &s.DomainConfiguration{}
+	if c.IsSet(FlagRetentionDays) {
+		configuration.WorkflowExecutionRetentionPeriodInDays = common.Int32Ptr(int32(c.Int(FlagRetentionDays)))
+	}
+	if c.IsSet(FlagEmitMetric) {
+		configuration.EmitMetric = common.BoolPtr(c.Bool(FlagEmitMetric))
+	}
+	if c.IsSet(FlagStartWorkflowRPS) {
+		configuration.StartWorkflowRPS = common.Int32Ptr(int32(c.Int(FlagStartWorkflowRPS)))
+	}
+	if c.IsSet(FlagSignalWorkflowRPS) {
+		configuration.SignalWorkflowRPS = common.Int32Ptr(int32(c.Int(FlagSignalWorkflowRPS)))
+	}
+	if c.IsSet(FlagPollRPS) {
+		configuration.PollRPS = common.Int32Ptr(int32(c.Int(FlagPollRPS)))
+	}
+	if c.IsSet(FlagHistoryReadRPS) {
+		configuration.HistoryReadRPS = common.Int32Ptr(int32(c.Int(FlagHistoryReadRPS)))
+	}
+
+	serviceClient := cFactory.FrontendClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := serviceClient.UpdateDomain(ctx, &s.UpdateDomainRequest{
+		Name:          common.StringPtr(domain),
+		UpdatedInfo:   updatedInfo,
+		Configuration: configuration,
+	})
+	if err != nil {
+		if _, ok := err.(*s.EntityNotExistsError); ok {
+			ErrorAndExit(fmt.Sprintf("Domain %s does not exist.", domain), err)
+		}
+		ErrorAndExit("Failed to update domain.", err)
+	}
+
+	fmt.Printf("Domain %s successfully updated.\n", domain)
+}
+
+// DescribeDomain prints the info and configuration of a workflow domain
+func DescribeDomain(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	serviceClient := cFactory.FrontendClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := serviceClient.DescribeDomain(ctx, &s.DescribeDomainRequest{
+		Name: common.StringPtr(domain),
+	})
+	if err != nil {
+		if _, ok := err.(*s.EntityNotExistsError); ok {
+			ErrorAndExit(fmt.Sprintf("Domain %s does not exist.", domain), err)
+		}
+		ErrorAndExit("Failed to describe domain.", err)
+	}
+
+	info := resp.DomainInfo
+	config := resp.Configuration
+	fmt.Printf("Name: %v\nDescription: %v\nOwnerEmail: %v\nDomainStatus: %v\nRetentionInDays: %v\n"+
+		"EmitMetrics: %v\nStartWorkflowRPS: %v\nSignalWorkflowRPS: %v\nPollRPS: %v\nHistoryReadRPS: %v\n",
+		common.StringDefault(info.Name),
+		common.StringDefault(info.Description),
+		common.StringDefault(info.OwnerEmail),
+		info.Status.String(),
+		common.Int32Default(config.WorkflowExecutionRetentionPeriodInDays),
+		common.BoolDefault(config.EmitMetric),
+		common.Int32Default(config.StartWorkflowRPS),
+		common.Int32Default(config.SignalWorkflowRPS),
+		common.Int32Default(config.PollRPS),
+		common.Int32Default(config.HistoryReadRPS))
+}
+
+// DeprecateDomain deprecates a workflow domain
+func DeprecateDomain(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	serviceClient := cFactory.FrontendClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := serviceClient.DeprecateDomain(ctx, &s.DeprecateDomainRequest{
+		Name: common.StringPtr(domain),
+	})
+	if err != nil {
+		if _, ok := err.(*s.EntityNotExistsError); ok {
+			ErrorAndExit(fmt.Sprintf("Domain %s does not exist.", domain), err)
+		}
+		ErrorAndExit("Failed to deprecate domain.", err)
+	}
+
+	fmt.Printf("Domain %s successfully deprecated.\n", domain)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/transport/tchannel"
+)
+
+const (
+	cadenceClientName = "cadence-client"
+)
+
+// ClientFactory is used to construct the rpc clients used by the cli
+type ClientFactory interface {
+	FrontendClient(c *cli.Context) workflowserviceclient.Interface
+}
+
+type clientFactory struct{}
+
+var cFactory ClientFactory = NewClientFactory()
+
+// NewClientFactory creates a ClientFactory which talks to the frontend over tchannel
+func NewClientFactory() ClientFactory {
+	return &clientFactory{}
+}
+
+// SetFactory replaces the ClientFactory used by the cli, for example with one returning mock clients
+func SetFactory(factory ClientFactory) {
+	cFactory = factory
+}
+
+// FrontendClient builds a frontend client connected to the address given on the command line
+func (f *clientFactory) FrontendClient(c *cli.Context) workflowserviceclient.Interface {
+	ch, err := tchannel.NewChannelTransport(tchannel.ServiceName(cadenceClientName))
+	if err != nil {
+		ErrorAndExit("Failed to create transport channel", err)
+	}
+
+	dispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name: cadenceClientName,
+		Outbounds: yarpc.Outbounds{
+			common.FrontendServiceName: {Unary: ch.NewSingleOutbound(c.GlobalString(FlagAddress))},
+		},
+	})
+	if err := dispatcher.Start(); err != nil {
+		ErrorAndExit("Failed to create outbound transport channel", err)
+	}
+
+	return workflowserviceclient.New(dispatcher.ClientConfig(common.FrontendServiceName))
+}
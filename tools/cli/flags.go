@@ -0,0 +1,75 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+// Flags used to specify cli command line arguments
+const (
+	FlagAddress                   = "address"
+	FlagAddressWithAlias          = FlagAddress + ", ad"
+	FlagDomain                    = "domain"
+	FlagDomainWithAlias           = FlagDomain + ", do"
+	FlagWorkflowID                = "workflow_id"
+	FlagWorkflowIDWithAlias       = FlagWorkflowID + ", wid, w"
+	FlagRunID                     = "run_id"
+	FlagRunIDWithAlias            = FlagRunID + ", rid, r"
+	FlagTaskList                  = "tasklist"
+	FlagTaskListWithAlias         = FlagTaskList + ", tl"
+	FlagWorkflowType              = "workflow_type"
+	FlagWorkflowTypeWithAlias     = FlagWorkflowType + ", wt"
+	FlagExecutionTimeout          = "execution_timeout"
+	FlagExecutionTimeoutWithAlias = FlagExecutionTimeout + ", et"
+	FlagDecisionTimeout           = "decision_timeout"
+	FlagDecisionTimeoutWithAlias  = FlagDecisionTimeout + ", dt"
+	FlagInput                     = "input"
+	FlagInputWithAlias            = FlagInput + ", i"
+	FlagInputFile                 = "input_file"
+	FlagInputFileWithAlias        = FlagInputFile + ", if"
+	FlagName                      = "name"
+	FlagNameWithAlias             = FlagName + ", n"
+	FlagReason                    = "reason"
+	FlagReasonWithAlias           = FlagReason + ", re"
+	FlagEventID                   = "event_id"
+	FlagEventIDWithAlias          = FlagEventID + ", eid"
+	FlagOpen                      = "open"
+	FlagOpenWithAlias             = FlagOpen + ", op"
+	FlagEarliestTime              = "earliest_time"
+	FlagEarliestTimeWithAlias     = FlagEarliestTime + ", early"
+	FlagLatestTime                = "latest_time"
+	FlagLatestTimeWithAlias       = FlagLatestTime + ", late"
+	FlagPageSize                  = "pagesize"
+	FlagPageSizeWithAlias         = FlagPageSize + ", ps"
+	FlagPrintRawTime              = "print_raw_time"
+	FlagPrintRawTimeWithAlias     = FlagPrintRawTime + ", prt"
+	FlagDescription               = "description"
+	FlagDescriptionWithAlias      = FlagDescription + ", desc"
+	FlagOwnerEmail                = "owner_email"
+	FlagOwnerEmailWithAlias       = FlagOwnerEmail + ", oe"
+	FlagRetentionDays             = "retention"
+	FlagRetentionDaysWithAlias    = FlagRetentionDays + ", rd"
+	FlagEmitMetric                = "emit_metric"
+	FlagEmitMetricWithAlias       = FlagEmitMetric + ", em"
+	FlagStartWorkflowRPS          = "start_workflow_rps"
+	FlagSignalWorkflowRPS         = "signal_workflow_rps"
+	FlagPollRPS                   = "poll_rps"
+	FlagHistoryReadRPS            = "history_read_rps"
+	FlagContextTimeout            = "context_timeout"
+	FlagContextTimeoutWithAlias   = FlagContextTimeout + ", ct"
+)
@@ -0,0 +1,225 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/urfave/cli"
+)
+
+// ErrorAndExit prints the given message and error to stderr and exits with status 1
+func ErrorAndExit(msg string, err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s Error: %v\n", msg, err)
+	} else {
+		fmt.Fprintln(os.Stderr, msg)
+	}
+	os.Exit(1)
+}
+
+func getRequiredOption(c *cli.Context, optionName string) string {
+	value := c.String(optionName)
+	if len(value) == 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s is required", optionName), nil)
+	}
+	return value
+}
+
+func getRequiredGlobalOption(c *cli.Context, optionName string) string {
+	value := c.GlobalString(optionName)
+	if len(value) == 0 {
+		ErrorAndExit(fmt.Sprintf("Global option %s is required", optionName), nil)
+	}
+	return value
+}
+
+func newContext(c *cli.Context) (context.Context, context.CancelFunc) {
+	timeout := time.Duration(c.GlobalInt(FlagContextTimeout)) * time.Second
+	return context.WithTimeout(context.Background(), timeout)
+}
+
+func getCliIdentity() string {
+	hostName, err := os.Hostname()
+	if err != nil {
+		hostName = "UnKnown"
+	}
+	return fmt.Sprintf("cadence-cli@%s", hostName)
+}
+
+// processJSONInput returns the input given inline or through a file, after making sure it is valid JSON
+func processJSONInput(c *cli.Context) []byte {
+	var input []byte
+	if c.IsSet(FlagInput) {
+		input = []byte(c.String(FlagInput))
+	} else if c.IsSet(FlagInputFile) {
+		data, err := ioutil.ReadFile(c.String(FlagInputFile))
+		if err != nil {
+			ErrorAndExit("Failed to read input file.", err)
+		}
+		input = data
+	} else {
+		return nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(input, &v); err != nil {
+		ErrorAndExit("Input is not valid JSON.", err)
+	}
+	return input
+}
+
+func parseTime(timeStr string, defaultValue int64) int64 {
+	if len(timeStr) == 0 {
+		return defaultValue
+	}
+	t, err := time.Parse(time.RFC3339, timeStr)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Cannot parse time '%s', expected RFC3339 format.", timeStr), err)
+	}
+	return t.UnixNano()
+}
+
+func convertTime(unixNano int64, printRawTime bool) string {
+	if printRawTime {
+		return fmt.Sprintf("%d", unixNano)
+	}
+	return time.Unix(0, unixNano).Format(time.RFC3339)
+}
+
+// printHistory prints one line per event, with the event ID, timestamp, type and attributes of the event
+func printHistory(w io.Writer, events []*s.HistoryEvent, printRawTime bool) {
+	for _, e := range events {
+		var timestamp string
+		if e.Timestamp != nil {
+			timestamp = convertTime(*e.Timestamp, printRawTime)
+		}
+		fmt.Fprintf(w, "%5d  %-25s  %-47s  %s\n", *e.EventId, timestamp, e.EventType.String(),
+			historyEventAttributesToString(e))
+	}
+}
+
+// historyEventAttributesToString returns the attributes of the event as JSON, with binary payloads decoded
+func historyEventAttributesToString(e *s.HistoryEvent) string {
+	v := reflect.ValueOf(e).Elem()
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if strings.HasSuffix(t.Field(i).Name, "EventAttributes") && !f.IsNil() {
+			data, err := json.Marshal(toReadable(f))
+			if err != nil {
+				return fmt.Sprintf("%+v", f.Interface())
+			}
+			return string(data)
+		}
+	}
+	return ""
+}
+
+// toReadable converts thrift structs into values which marshal to readable JSON: enums are converted to their
+// names and binary payloads are decoded as JSON when possible, or printed as strings otherwise.
+func toReadable(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return toReadable(v.Elem())
+	case reflect.Struct:
+		fields := make(map[string]interface{})
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" || isNil(v.Field(i)) {
+				continue
+			}
+			fields[jsonFieldName(field)] = toReadable(v.Field(i))
+		}
+		return fields
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return decodePayload(v.Bytes())
+		}
+		list := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			list[i] = toReadable(v.Index(i))
+		}
+		return list
+	default:
+		if stringer, ok := v.Interface().(fmt.Stringer); ok {
+			return stringer.String()
+		}
+		return v.Interface()
+	}
+}
+
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	tag := strings.Split(field.Tag.Get("json"), ",")[0]
+	if len(tag) == 0 || tag == "-" {
+		return field.Name
+	}
+	return tag
+}
+
+// decodePayload decodes a payload made of one or more JSON values, falling back to the raw string if it is not JSON
+func decodePayload(payload []byte) interface{} {
+	var values []interface{}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	for {
+		var v interface{}
+		err := decoder.Decode(&v)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return string(payload)
+		}
+		values = append(values, v)
+	}
+
+	switch len(values) {
+	case 0:
+		return string(payload)
+	case 1:
+		return values[0]
+	default:
+		return values
+	}
+}
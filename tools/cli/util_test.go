@@ -0,0 +1,66 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+)
+
+type utilSuite struct {
+	suite.Suite
+	*require.Assertions
+}
+
+func TestUtilSuite(t *testing.T) {
+	suite.Run(t, new(utilSuite))
+}
+
+func (s *utilSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *utilSuite) TestDecodePayload() {
+	s.Equal(map[string]interface{}{"key": "value"}, decodePayload([]byte(`{"key": "value"}`)))
+	s.Equal([]interface{}{"a", float64(1)}, decodePayload([]byte(`"a" 1`)))
+	s.Equal("not json", decodePayload([]byte("not json")))
+	s.Equal("", decodePayload(nil))
+}
+
+func (s *utilSuite) TestHistoryEventAttributesToString() {
+	s.Equal(`{"input":{"key":"value"},"taskList":{"name":"test-tasklist"},"workflowType":{"name":"test-type"}}`,
+		historyEventAttributesToString(newStartedEvent(1)))
+	s.Equal(`{"result":"result"}`, historyEventAttributesToString(newCompletedEvent(2)))
+}
+
+func (s *utilSuite) TestPrintHistory() {
+	var buf bytes.Buffer
+	printHistory(&buf, []*shared.HistoryEvent{newStartedEvent(1), newCompletedEvent(2)}, true)
+
+	output := buf.String()
+	s.Contains(output, "WorkflowExecutionStarted")
+	s.Contains(output, "WorkflowExecutionCompleted")
+	s.Contains(output, `"input":{"key":"value"}`)
+}
@@ -0,0 +1,327 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pborman/uuid"
+	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+const (
+	defaultDecisionTimeoutInSeconds = 10
+	defaultPageSizeForList          = 100
+	// the frontend holds a history long poll for up to 20 seconds by default
+	defaultLongPollTimeout = time.Minute
+)
+
+// StartWorkflow starts a new workflow execution
+func StartWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	workflowType := getRequiredOption(c, FlagWorkflowType)
+	executionTimeout := c.Int(FlagExecutionTimeout)
+	if executionTimeout <= 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s must be a positive number of seconds", FlagExecutionTimeout), nil)
+	}
+	workflowID := c.String(FlagWorkflowID)
+	if len(workflowID) == 0 {
+		workflowID = uuid.New()
+	}
+	input := processJSONInput(c)
+
+	serviceClient := cFactory.FrontendClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := serviceClient.StartWorkflowExecution(ctx, &s.StartWorkflowExecutionRequest{
+		RequestId:                           common.StringPtr(uuid.New()),
+		Domain:                              common.StringPtr(domain),
+		WorkflowId:                          common.StringPtr(workflowID),
+		WorkflowType:                        &s.WorkflowType{Name: common.StringPtr(workflowType)},
+		TaskList:                            &s.TaskList{Name: common.StringPtr(taskList)},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(executionTimeout)),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(c.Int(FlagDecisionTimeout))),
+		Identity:                            common.StringPtr(getCliIdentity()),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to start workflow.", err)
+	}
+
+	fmt.Printf("Started Workflow Id: %s, run Id: %s\n", workflowID, *resp.RunId)
+}
+
+// SignalWorkflow sends a signal to a workflow execution
+func SignalWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	execution := getWorkflowExecution(c)
+	signalName := getRequiredOption(c, FlagName)
+	input := processJSONInput(c)
+
+	serviceClient := cFactory.FrontendClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := serviceClient.SignalWorkflowExecution(ctx, &s.SignalWorkflowExecutionRequest{
+		Domain:            common.StringPtr(domain),
+		WorkflowExecution: execution,
+		SignalName:        common.StringPtr(signalName),
+		Input:             input,
+		Identity:          common.StringPtr(getCliIdentity()),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to signal workflow.", err)
+	}
+
+	fmt.Println("Signal workflow succeeded.")
+}
+
+// TerminateWorkflow terminates a workflow execution
+func TerminateWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	execution := getWorkflowExecution(c)
+
+	serviceClient := cFactory.FrontendClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := serviceClient.TerminateWorkflowExecution(ctx, &s.TerminateWorkflowExecutionRequest{
+		Domain:            common.StringPtr(domain),
+		WorkflowExecution: execution,
+		Reason:            common.StringPtr(c.String(FlagReason)),
+		Identity:          common.StringPtr(getCliIdentity()),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to terminate workflow.", err)
+	}
+
+	fmt.Println("Terminate workflow succeeded.")
+}
+
+// ResetWorkflow resets a workflow execution to the given decision finish event
+func ResetWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	execution := &s.WorkflowExecution{
+		WorkflowId: common.StringPtr(getRequiredOption(c, FlagWorkflowID)),
+		RunId:      common.StringPtr(getRequiredOption(c, FlagRunID)),
+	}
+	eventID := c.Int(FlagEventID)
+	if eventID <= 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s is required", FlagEventID), nil)
+	}
+
+	serviceClient := cFactory.FrontendClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := serviceClient.ResetWorkflowExecution(ctx, &s.ResetWorkflowExecutionRequest{
+		Domain:                common.StringPtr(domain),
+		WorkflowExecution:     execution,
+		Reason:                common.StringPtr(c.String(FlagReason)),
+		DecisionFinishEventId: common.Int64Ptr(int64(eventID)),
+		RequestId:             common.StringPtr(uuid.New()),
+		Identity:              common.StringPtr(getCliIdentity()),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to reset workflow.", err)
+	}
+
+	fmt.Printf("Reset workflow succeeded, new run ID: %v\n", *resp.RunId)
+}
+
+// CancelWorkflow requests cancellation of a workflow execution
+func CancelWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	execution := getWorkflowExecution(c)
+
+	serviceClient := cFactory.FrontendClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := serviceClient.RequestCancelWorkflowExecution(ctx, &s.RequestCancelWorkflowExecutionRequest{
+		Domain:            common.StringPtr(domain),
+		WorkflowExecution: execution,
+		Identity:          common.StringPtr(getCliIdentity()),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to cancel workflow.", err)
+	}
+
+	fmt.Println("Cancel workflow succeeded.")
+}
+
+// ShowHistory prints the history of a workflow execution
+func ShowHistory(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	execution := getWorkflowExecution(c)
+	printRawTime := c.Bool(FlagPrintRawTime)
+
+	serviceClient := cFactory.FrontendClient(c)
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := serviceClient.GetWorkflowExecutionHistory(ctx, &s.GetWorkflowExecutionHistoryRequest{
+			Domain:        common.StringPtr(domain),
+			Execution:     execution,
+			NextPageToken: nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to get history of workflow.", err)
+		}
+
+		printHistory(os.Stdout, resp.History.Events, printRawTime)
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return
+		}
+	}
+}
+
+// ObserveHistory prints the history of a workflow execution as it progresses, until the workflow closes
+func ObserveHistory(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	execution := getWorkflowExecution(c)
+	printRawTime := c.Bool(FlagPrintRawTime)
+
+	serviceClient := cFactory.FrontendClient(c)
+	var nextPageToken []byte
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), defaultLongPollTimeout)
+		resp, err := serviceClient.GetWorkflowExecutionHistory(ctx, &s.GetWorkflowExecutionHistoryRequest{
+			Domain:          common.StringPtr(domain),
+			Execution:       execution,
+			NextPageToken:   nextPageToken,
+			WaitForNewEvent: common.BoolPtr(true),
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to get history of workflow.", err)
+		}
+		printHistory(os.Stdout, resp.History.Events, printRawTime)
+
+		// the frontend keeps handing out a token as long as the workflow is running
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return
+		}
+	}
+}
+
+// ListWorkflow lists the open or closed workflow executions of a domain
+func ListWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	queryOpen := c.Bool(FlagOpen)
+	printRawTime := c.Bool(FlagPrintRawTime)
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSizeForList
+	}
+
+	startTimeFilter := &s.StartTimeFilter{
+		EarliestTime: common.Int64Ptr(parseTime(c.String(FlagEarliestTime), 0)),
+		LatestTime:   common.Int64Ptr(parseTime(c.String(FlagLatestTime), time.Now().UnixNano())),
+	}
+	var executionFilter *s.WorkflowExecutionFilter
+	var typeFilter *s.WorkflowTypeFilter
+	if c.IsSet(FlagWorkflowID) {
+		executionFilter = &s.WorkflowExecutionFilter{WorkflowId: common.StringPtr(c.String(FlagWorkflowID))}
+	}
+	if c.IsSet(FlagWorkflowType) {
+		typeFilter = &s.WorkflowTypeFilter{Name: common.StringPtr(c.String(FlagWorkflowType))}
+	}
+
+	serviceClient := cFactory.FrontendClient(c)
+	table := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if queryOpen {
+		fmt.Fprintln(table, "WORKFLOW TYPE\tWORKFLOW ID\tRUN ID\tSTART TIME")
+	} else {
+		fmt.Fprintln(table, "WORKFLOW TYPE\tWORKFLOW ID\tRUN ID\tSTART TIME\tCLOSE TIME\tCLOSE STATUS")
+	}
+
+	var nextPageToken []byte
+	for {
+		var executions []*s.WorkflowExecutionInfo
+		ctx, cancel := newContext(c)
+		if queryOpen {
+			resp, err := serviceClient.ListOpenWorkflowExecutions(ctx, &s.ListOpenWorkflowExecutionsRequest{
+				Domain:          common.StringPtr(domain),
+				MaximumPageSize: common.Int32Ptr(int32(pageSize)),
+				NextPageToken:   nextPageToken,
+				StartTimeFilter: startTimeFilter,
+				ExecutionFilter: executionFilter,
+				TypeFilter:      typeFilter,
+			})
+			cancel()
+			if err != nil {
+				ErrorAndExit("Failed to list open workflows.", err)
+			}
+			executions, nextPageToken = resp.Executions, resp.NextPageToken
+		} else {
+			resp, err := serviceClient.ListClosedWorkflowExecutions(ctx, &s.ListClosedWorkflowExecutionsRequest{
+				Domain:          common.StringPtr(domain),
+				MaximumPageSize: common.Int32Ptr(int32(pageSize)),
+				NextPageToken:   nextPageToken,
+				StartTimeFilter: startTimeFilter,
+				ExecutionFilter: executionFilter,
+				TypeFilter:      typeFilter,
+			})
+			cancel()
+			if err != nil {
+				ErrorAndExit("Failed to list closed workflows.", err)
+			}
+			executions, nextPageToken = resp.Executions, resp.NextPageToken
+		}
+
+		for _, e := range executions {
+			row := fmt.Sprintf("%s\t%s\t%s\t%s", *e.Type.Name, *e.Execution.WorkflowId, *e.Execution.RunId,
+				convertTime(*e.StartTime, printRawTime))
+			if !queryOpen {
+				row += fmt.Sprintf("\t%s\t%s", convertTime(common.Int64Default(e.CloseTime), printRawTime),
+					e.CloseStatus.String())
+			}
+			fmt.Fprintln(table, row)
+		}
+
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	table.Flush()
+}
+
+func getWorkflowExecution(c *cli.Context) *s.WorkflowExecution {
+	execution := &s.WorkflowExecution{
+		WorkflowId: common.StringPtr(getRequiredOption(c, FlagWorkflowID)),
+	}
+	if runID := c.String(FlagRunID); len(runID) > 0 {
+		execution.RunId = common.StringPtr(runID)
+	}
+	return execution
+}